@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/auth"
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/report"
+	"github.com/bjess9/pr-compass/internal/ui"
+)
+
+// runDoctor implements the `doctor` subcommand and returns the process exit code
+func runDoctor(args []string) int {
+	flags := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	timeout := flags.Duration("timeout", 30*time.Second, "Maximum time to spend checking against the API")
+	profile := flags.String("profile", "", "Check the named profile in ~/.config/pr-compass/profiles")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if !useProfile(*profile) {
+		return 2
+	}
+
+	in := report.DoctorInput{ConfigPath: ui.ConfigFilePath(), Now: time.Now()}
+	tokenEnv := ""
+	multiConfig, err := ui.LoadMultiTabConfig()
+	if err != nil {
+		in.ConfigErr = err
+	} else {
+		tokenEnv = multiConfig.TokenEnv
+		for i := range multiConfig.Tabs {
+			in.Scopes = append(in.Scopes, report.ListScope{Name: multiConfig.Tabs[i].Name, Config: multiConfig.Tabs[i].ConvertToConfig()})
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	token, err := auth.AuthenticateWith(tokenEnv)
+	if err != nil {
+		in.AuthErr = err
+	} else {
+		in.Token, in.TokenErr = github.InspectToken(ctx, token)
+		in.Checker = ui.GitHubScopeChecker{Token: token}
+	}
+
+	if report.RunDoctor(ctx, in, os.Stdout) > 0 {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/bjess9/pr-compass/internal/auth"
+	"github.com/bjess9/pr-compass/internal/ui"
+)
+
+// runInit implements the `init` subcommand and returns the process exit code
+func runInit(args []string) int {
+	flags := flag.NewFlagSet("init", flag.ContinueOnError)
+	force := flags.Bool("force", false, "Replace an existing configuration file")
+	offline := flags.Bool("offline", false, "Don't check repos, organizations and teams against the API")
+	profile := flags.String("profile", "", "Set up the named profile in ~/.config/pr-compass/profiles")
+	tokenEnv := flags.String("token-env", "", "Environment variable holding the profile's token, e.g. WORK_GITHUB_TOKEN")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if !useProfile(*profile) {
+		return 2
+	}
+
+	path := ui.ConfigFilePath()
+	if _, err := os.Stat(path); err == nil && !*force {
+		fmt.Fprintf(os.Stderr, "%s already exists. Use --force to replace it.\n", path)
+		return 1
+	}
+
+	var checker ui.ScopeChecker
+	if !*offline {
+		token, err := auth.AuthenticateWith(*tokenEnv)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Authentication failed: %v\nRun with --offline to skip checking against the API.\n", err)
+			return 1
+		}
+		checker = ui.GitHubScopeChecker{Token: token}
+	}
+
+	fmt.Println("Let's set up your first PR Compass tab.")
+	if err := ui.RunConfigWizard(context.Background(), os.Stdin, os.Stdout, checker, path, *tokenEnv); err != nil {
+		fmt.Fprintf(os.Stderr, "Setup failed: %v\n", err)
+		return 1
+	}
+	if *profile != "" {
+		fmt.Printf("Start it with pr-compass --profile %s, or switch to it with P.\n", *profile)
+	}
+	return 0
+}
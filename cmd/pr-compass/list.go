@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/auth"
+	"github.com/bjess9/pr-compass/internal/report"
+	"github.com/bjess9/pr-compass/internal/ui"
+)
+
+// runList implements the `list` subcommand and returns the process exit code
+func runList(args []string) int {
+	flags := flag.NewFlagSet("list", flag.ContinueOnError)
+	asJSON := flags.Bool("json", false, "Write PRs as a JSON array instead of text columns")
+	enhance := flags.Bool("enhance", false, "Include reviews, checks and file stats for each PR")
+	concurrency := flags.Int("concurrency", 5, "Parallel enhancement requests")
+	budget := flags.Int("budget", 0, fmt.Sprintf("Maximum API requests to spend on enhancement, %d per PR (0 = unlimited)", report.RequestsPerEnhancement))
+	tabName := flags.String("tab", "", "Only list PRs from the tab with this name")
+	timeout := flags.Duration("timeout", 5*time.Minute, "Maximum time to spend fetching data")
+	profile := flags.String("profile", "", "Use the named profile in ~/.config/pr-compass/profiles")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if !useProfile(*profile) {
+		return 2
+	}
+	if *concurrency < 1 || *budget < 0 {
+		fmt.Fprintln(os.Stderr, "Usage: pr-compass list [--json] [--enhance [--concurrency N] [--budget REQUESTS]] [--tab NAME]")
+		return 2
+	}
+
+	multiConfig, err := ui.LoadMultiTabConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		return 1
+	}
+
+	var scopes []report.ListScope
+	for i := range multiConfig.Tabs {
+		if *tabName != "" && multiConfig.Tabs[i].Name != *tabName {
+			continue
+		}
+		scopes = append(scopes, report.ListScope{Name: multiConfig.Tabs[i].Name, Config: multiConfig.Tabs[i].ConvertToConfig()})
+	}
+	if len(scopes) == 0 {
+		fmt.Fprintf(os.Stderr, "No tab named %q in the configuration\n", *tabName)
+		return 1
+	}
+
+	token, err := auth.AuthenticateWith(multiConfig.TokenEnv)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Authentication failed: %v\n", err)
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	opts := report.ListOptions{Enhance: *enhance, Concurrency: *concurrency, Budget: *budget}
+	if err := report.RunList(ctx, token, scopes, opts, *asJSON, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "List failed: %v\n", err)
+		return 1
+	}
+	return 0
+}
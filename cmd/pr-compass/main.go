@@ -4,9 +4,14 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/bjess9/pr-compass/internal/auth"
 	"github.com/bjess9/pr-compass/internal/config"
+	"github.com/bjess9/pr-compass/internal/github"
 	"github.com/bjess9/pr-compass/internal/ui"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -14,31 +19,172 @@ import (
 const version = "v0.1.0-pre"
 
 func main() {
+	// Users upgrading from PR Pilot get their configuration carried over once
+	migrateLegacyConfig()
+
+	// Subcommands run headless and exit without starting the TUI
+	if len(os.Args) > 1 && os.Args[1] == "report" {
+		os.Exit(runReport(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "list" {
+		os.Exit(runList(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "status" {
+		os.Exit(runStatus(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "init" {
+		os.Exit(runInit(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "doctor" {
+		os.Exit(runDoctor(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "release-notes" {
+		os.Exit(runReleaseNotes(os.Args[2:]))
+	}
+
 	// Check for version flag first
-	for _, arg := range os.Args[1:] {
+	public := false
+	fixturesPath := ""
+	profile := ""
+	for i, arg := range os.Args[1:] {
 		if arg == "--version" || arg == "-v" {
 			fmt.Printf("PR Compass %s\n", version)
 			return
 		}
+		if arg == "--public" {
+			public = true
+		}
+		if arg == "--record-fixtures" && i+2 < len(os.Args) {
+			fixturesPath = os.Args[i+2]
+		}
+		if path, ok := strings.CutPrefix(arg, "--record-fixtures="); ok {
+			fixturesPath = path
+		}
+		if arg == "--profile" && i+2 < len(os.Args) {
+			profile = os.Args[i+2]
+		}
+		if name, ok := strings.CutPrefix(arg, "--profile="); ok {
+			profile = name
+		}
+	}
+
+	// Dev mode: record every GitHub API response of the session as test fixtures
+	var recorder *github.Recorder
+	if fixturesPath != "" {
+		recorder = github.NewRecorder(fixturesPath, nil)
+		github.UseTransport(recorder)
+	}
+
+	// Switching profiles in the app quits and starts over with the new one
+	for {
+		if !useProfile(profile) {
+			os.Exit(1)
+		}
+		next, err := runTUI(public)
+		if recorder != nil && next == "" {
+			if err := recorder.Save(); err != nil {
+				fmt.Printf("Error saving fixtures: %v\n", err)
+			} else {
+				fmt.Printf("Recorded %d GitHub API responses to %s - review them for private data before committing.\n", recorder.Len(), fixturesPath)
+			}
+		}
+		if err != nil {
+			fmt.Printf("Error starting program: %v\n", err)
+			os.Exit(1)
+		}
+		if next == "" {
+			return
+		}
+		profile = next
 	}
+}
 
-	if !config.ConfigExists() {
-		fmt.Println("No configuration found. Create ~/.prcompass_config.yaml")
-		fmt.Println("See example_config.yaml for reference.")
+// migrateLegacyConfig converts a PR Pilot configuration, reporting what
+// changed on stderr so headless output stays clean
+func migrateLegacyConfig() {
+	migration, err := config.MigrateLegacy()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Could not migrate your PR Pilot configuration: %v\n", err)
+		return
+	}
+	if migration == nil {
 		return
 	}
+	fmt.Fprintln(os.Stderr, "Migrated your PR Pilot configuration to PR Compass:")
+	for _, change := range migration.Changes {
+		fmt.Fprintf(os.Stderr, "  - %s\n", change)
+	}
+}
 
-	token, err := auth.Authenticate()
+// useProfile reads configuration from a named profile, reporting invalid names
+func useProfile(name string) bool {
+	if err := config.UseProfile(name); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return false
+	}
+	return true
+}
+
+// runTUI runs the TUI with the active profile's configuration. It returns
+// the profile chosen in the app to restart with, if any.
+func runTUI(public bool) (string, error) {
+	cfg, err := config.LoadConfig()
 	if err != nil {
-		log.Fatalf("Authentication failed: %v", err)
+		if profile := config.ActiveProfile(); profile != config.DefaultProfile {
+			fmt.Printf("No configuration found for profile %s. Run `pr-compass init --profile %s` to set it up,\n", profile, profile)
+			fmt.Printf("or create %s yourself from example_config.yaml.\n", config.ConfigFilePath())
+			return "", nil
+		}
+		fmt.Println("No configuration found. Run `pr-compass init` to set up a first tab,")
+		fmt.Println("or create ~/.prcompass_config.yaml yourself from example_config.yaml.")
+		return "", nil
+	}
+
+	token := ""
+	if public {
+		// Token-less mode only sees public repos and gets 60 requests/hour
+		fmt.Println("Running without authentication: public repos only, read-only, 60 API requests/hour.")
+		fmt.Println("PR lists are cached for 30 minutes and PR details are disabled. Starting PR Compass...")
+	} else {
+		token, err = auth.AuthenticateWith(cfg.TokenEnv)
+		if err != nil {
+			log.Fatalf("Authentication failed: %v", err)
+		}
+		fmt.Println("Authentication successful. Starting PR Compass...")
 	}
 
-	fmt.Println("Authentication successful. Starting PR Compass...")
 	model := ui.InitialModelMultiTab(token)
 
 	p := tea.NewProgram(model, tea.WithAltScreen())
-	if _, err := p.Run(); err != nil {
-		fmt.Printf("Error starting program: %v\n", err)
-		os.Exit(1)
+
+	// Bubble Tea quits on SIGINT and SIGTERM, but tmux kill-session and
+	// closing the terminal send SIGHUP; quit the same way on it
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	go func() {
+		if _, ok := <-hangup; ok {
+			p.Quit()
+		}
+	}()
+
+	final, runErr := p.Run()
+	signal.Stop(hangup)
+	close(hangup)
+
+	// Cancel outstanding API calls and let cache writes underway finish
+	if session, ok := model.(interface{ Shutdown(time.Duration) error }); ok {
+		if err := session.Shutdown(ui.ShutdownTimeout); err != nil {
+			fmt.Printf("Error saving state: %v\n", err)
+		}
+	}
+	if runErr != nil {
+		return "", runErr
+	}
+
+	if switcher, ok := final.(interface{ NextProfile() (string, bool) }); ok {
+		if next, switching := switcher.NextProfile(); switching {
+			return next, nil
+		}
 	}
+	return "", nil
 }
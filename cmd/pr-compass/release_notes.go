@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/auth"
+	"github.com/bjess9/pr-compass/internal/config"
+	"github.com/bjess9/pr-compass/internal/report"
+	"github.com/bjess9/pr-compass/internal/ui"
+)
+
+// runReleaseNotes implements the `release-notes` subcommand and returns the
+// process exit code
+func runReleaseNotes(args []string) int {
+	flags := flag.NewFlagSet("release-notes", flag.ContinueOnError)
+	since := flags.String("since", "", "Tag or YYYY-MM-DD date to list merged PRs from")
+	group := flags.String("group", report.GroupByType, "Group PRs by conventional-commit type or by first label: type or label")
+	tabName := flags.String("tab", "", "Only cover the scope of the tab with this name")
+	repos := flags.String("repo", "", "Comma-separated repos (owner/name) to cover instead of the configured tabs")
+	output := flags.String("output", "", "Write the release notes to this file instead of stdout")
+	timeout := flags.Duration("timeout", 5*time.Minute, "Maximum time to spend fetching data")
+	profile := flags.String("profile", "", "Use the named profile in ~/.config/pr-compass/profiles")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if !useProfile(*profile) {
+		return 2
+	}
+	if *since == "" || (*group != report.GroupByType && *group != report.GroupByLabel) {
+		fmt.Fprintln(os.Stderr, "Usage: pr-compass release-notes --since TAG|YYYY-MM-DD [--group type|label] [--tab NAME | --repo OWNER/NAME,...] [--output FILE]")
+		return 2
+	}
+
+	multiConfig, err := ui.LoadMultiTabConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		return 1
+	}
+
+	var scopes []report.ReleaseScope
+	if *repos != "" {
+		cfg := &config.Config{Mode: "repos", Repos: strings.Split(*repos, ",")}
+		scopes, _ = report.ReleaseScopes("--repo", cfg)
+	} else {
+		for i := range multiConfig.Tabs {
+			tab := &multiConfig.Tabs[i]
+			if *tabName != "" && tab.Name != *tabName {
+				continue
+			}
+			tabScopes, err := report.ReleaseScopes(tab.Name, tab.ConvertToConfig())
+			if err != nil {
+				if *tabName != "" {
+					fmt.Fprintln(os.Stderr, err)
+					return 1
+				}
+				fmt.Fprintf(os.Stderr, "Skipping %v\n", err)
+				continue
+			}
+			scopes = append(scopes, tabScopes...)
+		}
+	}
+	if len(scopes) == 0 {
+		if *tabName != "" {
+			fmt.Fprintf(os.Stderr, "No tab named %q in the configuration\n", *tabName)
+		} else {
+			fmt.Fprintln(os.Stderr, "No repos to cover: configure a repos, organization or search tab, or pass --repo")
+		}
+		return 1
+	}
+
+	token, err := auth.AuthenticateWith(multiConfig.TokenEnv)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Authentication failed: %v\n", err)
+		return 1
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to create output file: %v\n", err)
+			return 1
+		}
+		defer file.Close()
+		w = file
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	opts := report.ReleaseNotesOptions{Since: *since, GroupBy: *group}
+	if err := report.RunReleaseNotes(ctx, token, scopes, opts, w); err != nil {
+		fmt.Fprintf(os.Stderr, "Release notes failed: %v\n", err)
+		return 1
+	}
+	return 0
+}
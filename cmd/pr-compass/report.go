@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/auth"
+	"github.com/bjess9/pr-compass/internal/config"
+	"github.com/bjess9/pr-compass/internal/report"
+	"github.com/bjess9/pr-compass/internal/ui"
+)
+
+// runReport implements the `report` subcommand and returns the process exit code
+func runReport(args []string) int {
+	flags := flag.NewFlagSet("report", flag.ContinueOnError)
+	audit := flags.Bool("audit", false, "List open PRs with risky characteristics for compliance reviews")
+	format := flags.String("format", "csv", "Output format: csv or json")
+	output := flags.String("output", "", "Write the report to this file instead of stdout")
+	timeout := flags.Duration("timeout", 5*time.Minute, "Maximum time to spend fetching data")
+	profile := flags.String("profile", "", "Use the named profile in ~/.config/pr-compass/profiles")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if !useProfile(*profile) {
+		return 2
+	}
+
+	if !*audit {
+		fmt.Fprintln(os.Stderr, "Usage: pr-compass report --audit [--format csv|json] [--output FILE]")
+		return 2
+	}
+
+	multiConfig, err := ui.LoadMultiTabConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		return 1
+	}
+
+	token, err := auth.AuthenticateWith(multiConfig.TokenEnv)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Authentication failed: %v\n", err)
+		return 1
+	}
+
+	cfgs := make([]*config.Config, len(multiConfig.Tabs))
+	for i := range multiConfig.Tabs {
+		cfgs[i] = multiConfig.Tabs[i].ConvertToConfig()
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to create output file: %v\n", err)
+			return 1
+		}
+		defer file.Close()
+		w = file
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	if err := report.RunAudit(ctx, token, cfgs, *format, w); err != nil {
+		fmt.Fprintf(os.Stderr, "Audit failed: %v\n", err)
+		return 1
+	}
+	return 0
+}
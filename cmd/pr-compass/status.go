@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/auth"
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/report"
+	"github.com/bjess9/pr-compass/internal/ui"
+)
+
+// runStatus implements the `status` subcommand and returns the process exit code
+func runStatus(args []string) int {
+	flags := flag.NewFlagSet("status", flag.ContinueOnError)
+	tabName := flags.String("tab", "", "Only count PRs from the tab with this name")
+	user := flags.String("user", "", "Count review requests for this login instead of the token's user")
+	offline := flags.Bool("offline", false, "Only read the cache, never call the API")
+	timeout := flags.Duration("timeout", 10*time.Second, "Maximum time to spend fetching data")
+	profile := flags.String("profile", "", "Use the named profile in ~/.config/pr-compass/profiles")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if !useProfile(*profile) {
+		return 2
+	}
+
+	multiConfig, err := ui.LoadMultiTabConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		return 1
+	}
+
+	var scopes []report.ListScope
+	for i := range multiConfig.Tabs {
+		if *tabName != "" && multiConfig.Tabs[i].Name != *tabName {
+			continue
+		}
+		scopes = append(scopes, report.ListScope{Name: multiConfig.Tabs[i].Name, Config: multiConfig.Tabs[i].ConvertToConfig()})
+	}
+	if len(scopes) == 0 {
+		fmt.Fprintf(os.Stderr, "No tab named %q in the configuration\n", *tabName)
+		return 1
+	}
+
+	prCache, err := cache.Open(multiConfig.Cache)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to open cache: %v\n", err)
+		return 1
+	}
+	defer prCache.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	token := ""
+	if !*offline {
+		if token, err = auth.AuthenticateWith(multiConfig.TokenEnv); err != nil {
+			fmt.Fprintf(os.Stderr, "Authentication failed: %v\n", err)
+			return 1
+		}
+	}
+
+	login := *user
+	if login == "" && token != "" {
+		// Cached after the first lookup; without it the review count is left out
+		login, _ = github.FetchViewerLogin(ctx, token, prCache)
+	}
+
+	if err := report.RunStatus(ctx, token, scopes, prCache, login, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Status failed: %v\n", err)
+		return 1
+	}
+	return 0
+}
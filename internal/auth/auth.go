@@ -2,6 +2,7 @@ package auth
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"strings"
@@ -9,9 +10,28 @@ import (
 	"github.com/bjess9/pr-compass/internal/errors"
 )
 
+// tokenEnvVars are checked in order. GH_TOKEN is what the GitHub CLI and
+// many CI setups export.
+var tokenEnvVars = []string{"GITHUB_TOKEN", "GH_TOKEN"}
+
+// statusOutput receives authentication progress messages. It is stderr so
+// headless subcommands keep stdout clean for their JSON or CSV output.
+var statusOutput io.Writer = os.Stderr
+
+// RunningInCI reports whether we appear to run inside a CI runner, where
+// nobody is around to answer prompts
+func RunningInCI() bool {
+	if os.Getenv("GITHUB_ACTIONS") == "true" {
+		return true
+	}
+	ci := strings.ToLower(os.Getenv("CI"))
+	return ci != "" && ci != "false" && ci != "0"
+}
+
 // getGitHubCLIToken attempts to get the current GitHub CLI token
 func getGitHubCLIToken() string {
 	cmd := exec.Command("gh", "auth", "token")
+	cmd.Env = append(os.Environ(), "GH_PROMPT_DISABLED=1")
 	output, err := cmd.Output()
 	if err != nil {
 		return ""
@@ -26,21 +46,30 @@ func getGitHubCLIToken() string {
 }
 
 // Authenticate returns a GitHub token from available sources in order of preference:
-// 1. GITHUB_TOKEN environment variable
-// 2. GitHub CLI token (gh auth token)
+// 1. GITHUB_TOKEN or GH_TOKEN environment variable
+// 2. GitHub CLI token (gh auth token), except in CI where no one is logged in
 func Authenticate() (string, error) {
-	// 1. Check environment variable first (highest priority)
-	if envToken := os.Getenv("GITHUB_TOKEN"); envToken != "" {
+	// 1. Check environment variables first (highest priority)
+	for _, name := range tokenEnvVars {
+		envToken := strings.TrimSpace(os.Getenv(name))
+		if envToken == "" {
+			continue
+		}
 		if validateToken(envToken) {
-			fmt.Println("[✓] Using GitHub token from GITHUB_TOKEN environment variable")
+			fmt.Fprintf(statusOutput, "[✓] Using GitHub token from %s environment variable\n", name)
 			return envToken, nil
 		}
-		fmt.Println("[!] GITHUB_TOKEN environment variable contains invalid token format")
+		fmt.Fprintf(statusOutput, "[!] %s environment variable contains invalid token format\n", name)
+	}
+
+	// CI runners have no logged-in GitHub CLI to fall back to
+	if RunningInCI() {
+		return "", errors.ErrAuthTokenMissingCI
 	}
 
 	// 2. Try GitHub CLI token (gh auth token)
 	if ghToken := getGitHubCLIToken(); ghToken != "" && validateToken(ghToken) {
-		fmt.Println("[✓] Using GitHub CLI token (gh auth token)")
+		fmt.Fprintln(statusOutput, "[✓] Using GitHub CLI token (gh auth token)")
 		return ghToken, nil
 	}
 
@@ -48,6 +77,25 @@ func Authenticate() (string, error) {
 	return "", errors.ErrAuthTokenMissing
 }
 
+// AuthenticateWith returns the token in the tokenEnv environment variable,
+// which a profile sets to use another account. Without one it falls back
+// to Authenticate.
+func AuthenticateWith(tokenEnv string) (string, error) {
+	if tokenEnv == "" {
+		return Authenticate()
+	}
+
+	envToken := strings.TrimSpace(os.Getenv(tokenEnv))
+	if envToken == "" {
+		return "", fmt.Errorf("the profile's token_env names %s, which isn't set", tokenEnv)
+	}
+	if !validateToken(envToken) {
+		return "", fmt.Errorf("%s environment variable contains invalid token format", tokenEnv)
+	}
+	fmt.Fprintf(statusOutput, "[✓] Using GitHub token from %s environment variable\n", tokenEnv)
+	return envToken, nil
+}
+
 // validateToken performs basic validation on token format
 func validateToken(token string) bool {
 	token = strings.TrimSpace(token)
@@ -66,3 +114,30 @@ func validateToken(token string) bool {
 	// No valid prefix found
 	return false
 }
+
+// gitlabTokenEnvVar holds the personal access token for GitLab tabs
+const gitlabTokenEnvVar = "GITLAB_TOKEN"
+
+// GitLabToken returns the GitLab token from the environment. It may be empty:
+// public GitLab projects can be read without one.
+func GitLabToken() string {
+	return strings.TrimSpace(os.Getenv(gitlabTokenEnvVar))
+}
+
+// giteaTokenEnvVar holds the access token for Gitea and Forgejo tabs
+const giteaTokenEnvVar = "GITEA_TOKEN"
+
+// GiteaToken returns the Gitea token from the environment. It may be empty:
+// public repositories can be read without one.
+func GiteaToken() string {
+	return strings.TrimSpace(os.Getenv(giteaTokenEnvVar))
+}
+
+// jiraTokenEnvVar holds the API token for the Jira integration
+const jiraTokenEnvVar = "JIRA_TOKEN"
+
+// JiraToken returns the Jira API token from the environment. It may be
+// empty: some instances let anyone browse their issues.
+func JiraToken() string {
+	return strings.TrimSpace(os.Getenv(jiraTokenEnvVar))
+}
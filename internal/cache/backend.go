@@ -0,0 +1,115 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+)
+
+// Cache backends accepted by the cache.backend config setting
+const (
+	BackendFile   = "file"
+	BackendMemory = "memory"
+	BackendSQLite = "sqlite"
+	BackendRedis  = "redis"
+)
+
+// staleRetention is how long backends keep entries past their TTL. Expired
+// PR lists are still shown at startup while a fresh fetch runs.
+const staleRetention = 7 * 24 * time.Hour
+
+// ErrNotFound is returned by backends for keys they don't store
+var ErrNotFound = errors.New("cache entry not found")
+
+// Backend stores encoded cache entries by key. Entries carry their own
+// timestamp and TTL; retention is how long the backend must keep them.
+type Backend interface {
+	Get(key string) ([]byte, error)
+	Set(key string, value []byte, retention time.Duration) error
+	Delete(key string) error
+
+	// Clean removes entries past their retention and entries expired
+	// reports true for
+	Clean(ctx context.Context, expired func([]byte) bool) error
+
+	// Stats returns the number of entries and their total size in bytes
+	Stats() (int, int64, error)
+
+	Close() error
+}
+
+// BackendConfig selects where cached API responses are stored
+type BackendConfig struct {
+	// "file" (default), "memory", "sqlite" or "redis"
+	Backend string `mapstructure:"backend" yaml:"backend,omitempty"`
+
+	// Directory for file, database file for sqlite (defaults under ~/.cache/pr-compass)
+	Path string `mapstructure:"path" yaml:"path,omitempty"`
+
+	// Server for redis, e.g. redis://:password@cache.internal:6379/0
+	URL string `mapstructure:"url" yaml:"url,omitempty"`
+
+	// Prepended to redis keys, so several tools can share a database (default "pr-compass:")
+	KeyPrefix string `mapstructure:"key_prefix" yaml:"key_prefix,omitempty"`
+}
+
+// Validate checks the backend settings without connecting
+func (c BackendConfig) Validate() error {
+	if c.Backend == BackendSQLite && !sqliteAvailable {
+		return fmt.Errorf("cache.backend %q isn't available in this build, which lacks cgo; use %q or %q", BackendSQLite, BackendFile, BackendRedis)
+	}
+
+	switch c.Backend {
+	case "", BackendFile, BackendMemory, BackendSQLite:
+		if c.URL != "" {
+			return fmt.Errorf("cache.url only applies to the %q backend", BackendRedis)
+		}
+	case BackendRedis:
+		if c.URL == "" {
+			return fmt.Errorf("cache.url is required for the %q backend", BackendRedis)
+		}
+		if c.Path != "" {
+			return fmt.Errorf("cache.path doesn't apply to the %q backend", BackendRedis)
+		}
+	default:
+		return fmt.Errorf("cache.backend must be %q, %q, %q or %q, got %q", BackendFile, BackendMemory, BackendSQLite, BackendRedis, c.Backend)
+	}
+	return nil
+}
+
+// Open creates a PR cache on the configured backend, connecting to it
+func Open(cfg BackendConfig) (*PRCache, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	var backend Backend
+	var err error
+	switch cfg.Backend {
+	case "", BackendFile:
+		if cfg.Path == "" {
+			return NewPRCache()
+		}
+		return NewPRCacheWithDir(cfg.Path)
+	case BackendMemory:
+		backend = NewMemoryBackend()
+	case BackendSQLite:
+		path := cfg.Path
+		if path == "" {
+			dir, dirErr := defaultCacheDir()
+			if dirErr != nil {
+				return nil, dirErr
+			}
+			path = filepath.Join(dir, "cache.db")
+		}
+		backend, err = NewSQLiteBackend(path)
+	case BackendRedis:
+		backend, err = NewRedisBackend(cfg.URL, cfg.KeyPrefix)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewPRCacheWithBackend(backend), nil
+}
@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/go-github/v55/github"
+)
+
+// testBackends opens each backend against temporary storage
+func testBackends(t *testing.T) map[string]Backend {
+	file, err := NewFileBackend(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileBackend() error = %v", err)
+	}
+	sqlite, err := NewSQLiteBackend(filepath.Join(t.TempDir(), "cache.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteBackend() error = %v", err)
+	}
+	server := miniredis.RunT(t)
+	redis, err := NewRedisBackend("redis://"+server.Addr(), "")
+	if err != nil {
+		t.Fatalf("NewRedisBackend() error = %v", err)
+	}
+
+	backends := map[string]Backend{BackendFile: file, BackendMemory: NewMemoryBackend(), BackendSQLite: sqlite, BackendRedis: redis}
+	t.Cleanup(func() {
+		for _, backend := range backends {
+			backend.Close()
+		}
+	})
+	return backends
+}
+
+// TestBackends tests every backend behind the PR cache
+func TestBackends(t *testing.T) {
+	for name, backend := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			if _, err := backend.Get("missing"); !errors.Is(err, ErrNotFound) {
+				t.Errorf("Expected ErrNotFound for a missing key, got %v", err)
+			}
+
+			prCache := NewPRCacheWithBackend(backend)
+			prs := []*github.PullRequest{{Number: github.Int(1), Title: github.String("Add retries")}}
+			if err := prCache.SetPRList("fresh", prs, time.Hour); err != nil {
+				t.Fatalf("SetPRList() error = %v", err)
+			}
+			if err := prCache.SetPRList("expired", prs, -time.Minute); err != nil {
+				t.Fatalf("SetPRList() error = %v", err)
+			}
+
+			if cached, found := prCache.GetPRList("fresh"); !found || cached[0].GetTitle() != "Add retries" {
+				t.Errorf("Expected the fresh list back, got %v (found %v)", cached, found)
+			}
+			if _, _, found := prCache.GetStalePRList("expired"); !found {
+				t.Error("Expected an expired list to be kept for the startup preview")
+			}
+
+			if err := prCache.CleanExpiredEntries(context.Background()); err != nil {
+				t.Fatalf("CleanExpiredEntries() error = %v", err)
+			}
+			if _, _, found := prCache.GetStalePRList("expired"); found {
+				t.Error("Expected cleaning to remove the expired list")
+			}
+			if count, size, err := prCache.GetCacheStats(); err != nil || count != 1 || size == 0 {
+				t.Errorf("Expected one entry left, got %d entries of %d bytes (%v)", count, size, err)
+			}
+		})
+	}
+}
+
+// TestMemoryBackendRetention tests that entries go once their retention passes
+func TestMemoryBackendRetention(t *testing.T) {
+	backend := NewMemoryBackend()
+	if err := backend.Set("key", []byte("value"), -time.Second); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if _, err := backend.Get("key"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected an entry past its retention to be gone, got %v", err)
+	}
+}
+
+// TestBackendConfigValidate tests the cache settings checks
+func TestBackendConfigValidate(t *testing.T) {
+	tests := []struct {
+		cfg     BackendConfig
+		wantErr bool
+	}{
+		{BackendConfig{}, false},
+		{BackendConfig{Backend: BackendSQLite, Path: "/tmp/cache.db"}, !sqliteAvailable},
+		{BackendConfig{Backend: BackendRedis, URL: "redis://localhost:6379/0"}, false},
+		{BackendConfig{Backend: BackendRedis}, true},
+		{BackendConfig{Backend: BackendMemory, URL: "redis://localhost:6379/0"}, true},
+		{BackendConfig{Backend: "memcached"}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.cfg.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%+v) error = %v, wantErr %v", tt.cfg, err, tt.wantErr)
+		}
+	}
+}
+
+// TestOpenMemory tests opening a configured backend
+func TestOpenMemory(t *testing.T) {
+	prCache, err := Open(BackendConfig{Backend: BackendMemory})
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if err := prCache.SetViewerLogin("token", "alice", time.Hour); err != nil {
+		t.Fatalf("SetViewerLogin() error = %v", err)
+	}
+	if login, found := prCache.GetViewerLogin("token"); !found || login != "alice" {
+		t.Errorf("Expected alice, got %q", login)
+	}
+}
+
+// TestFlushWaitsForWrites tests that Flush returns once writes in progress finish
+func TestFlushWaitsForWrites(t *testing.T) {
+	prCache := NewPRCacheWithBackend(NewMemoryBackend())
+
+	prCache.writing.RLock() // A write in progress
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := prCache.Flush(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected Flush to time out during a write, got %v", err)
+	}
+
+	prCache.writing.RUnlock()
+	if err := prCache.Flush(context.Background()); err != nil {
+		t.Errorf("Expected Flush to return after the write, got %v", err)
+	}
+}
@@ -1,14 +1,17 @@
 package cache
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/gob"
 	"encoding/hex"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
-	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/go-github/v55/github"
@@ -26,33 +29,53 @@ func (e *CacheEntry[T]) IsExpired() bool {
 	return time.Since(e.Timestamp) > e.TTL
 }
 
+// Lookups across every cache, for the hit rate in metrics
+var cacheHits, cacheMisses atomic.Int64
+
+// LookupStats returns how many cache lookups found a fresh entry and how
+// many found nothing or an expired one
+func LookupStats() (hits, misses int64) {
+	return cacheHits.Load(), cacheMisses.Load()
+}
+
 // PRCache handles caching of PR data
 type PRCache struct {
-	cacheDir string
+	backend Backend
+
+	// Held for reading by each write, so Flush can wait for them
+	writing sync.RWMutex
 }
 
 // NewPRCache creates a new PR cache instance
 func NewPRCache() (*PRCache, error) {
-	homeDir, err := os.UserHomeDir()
+	cacheDir, err := defaultCacheDir()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user home directory: %w", err)
-	}
-
-	cacheDir := filepath.Join(homeDir, ".cache", "pr-compass")
-	if err := os.MkdirAll(cacheDir, 0750); err != nil {
-		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+		return nil, err
 	}
-
-	return &PRCache{cacheDir: cacheDir}, nil
+	return NewPRCacheWithDir(cacheDir)
 }
 
 // NewPRCacheWithDir creates a new PR cache instance with custom directory (for testing)
 func NewPRCacheWithDir(cacheDir string) (*PRCache, error) {
-	if err := os.MkdirAll(cacheDir, 0750); err != nil {
-		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	backend, err := NewFileBackend(cacheDir)
+	if err != nil {
+		return nil, err
 	}
+	return NewPRCacheWithBackend(backend), nil
+}
+
+// NewPRCacheWithBackend creates a new PR cache instance storing entries in backend
+func NewPRCacheWithBackend(backend Backend) *PRCache {
+	return &PRCache{backend: backend}
+}
 
-	return &PRCache{cacheDir: cacheDir}, nil
+// defaultCacheDir returns ~/.cache/pr-compass
+func defaultCacheDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".cache", "pr-compass"), nil
 }
 
 // generateCacheKey creates a cache key from configuration parameters
@@ -61,92 +84,127 @@ func (c *PRCache) generateCacheKey(params ...string) string {
 	return hex.EncodeToString(hash[:])[:16] // Use first 16 chars for shorter filenames
 }
 
-// getCachePath returns the full path for a cache file
-func (c *PRCache) getCachePath(key string, suffix string) string {
-	return filepath.Join(c.cacheDir, fmt.Sprintf("%s_%s.cache", key, suffix))
+// getEntryKey returns the backend key for a cache entry
+func (c *PRCache) getEntryKey(key string, suffix string) string {
+	return fmt.Sprintf("%s_%s", key, suffix)
 }
 
-// GetCachePath returns the full path for a cache file (exported for external use)
-func (c *PRCache) GetCachePath(key string, suffix string) string {
-	return c.getCachePath(key, suffix)
-}
+// saveCacheEntry encodes a cache entry into the backend. Backends keep it
+// for staleRetention past its TTL, so expired PR lists can still be shown
+// while a fresh fetch runs.
+func (c *PRCache) saveCacheEntry(key string, entry interface{}, ttl time.Duration) error {
+	c.writing.RLock()
+	defer c.writing.RUnlock()
 
-// removeCacheFile removes a cache file (used for invalidation)
-func (c *PRCache) removeCacheFile(path string) error {
-	return os.Remove(path)
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(entry); err != nil {
+		return fmt.Errorf("failed to encode cache entry: %w", err)
+	}
+	return c.backend.Set(key, buf.Bytes(), ttl+staleRetention)
 }
 
-// RemoveCacheFile removes a cache file (exported for external use)
-func (c *PRCache) RemoveCacheFile(path string) error {
-	return c.removeCacheFile(path)
+// loadCacheEntry decodes a cache entry from the backend. Failing lookups
+// count as misses; callers count the rest once they know whether the entry
+// is fresh.
+func (c *PRCache) loadCacheEntry(key string, entry interface{}) error {
+	data, err := c.backend.Get(key)
+	if err == nil {
+		err = gob.NewDecoder(bytes.NewReader(data)).Decode(entry)
+	}
+	if err != nil {
+		cacheMisses.Add(1)
+		return err // Not stored, unreachable or unreadable
+	}
+	return nil
 }
 
-// isValidCachePath validates that the path is within the cache directory to prevent directory traversal
-func (c *PRCache) isValidCachePath(path string) bool {
-	cleanPath := filepath.Clean(path)
-	cleanCacheDir := filepath.Clean(c.cacheDir)
+// countLookup counts a loaded entry as a hit, or as a miss if it has expired,
+// and reports whether it's fresh
+func countLookup[T any](entry *CacheEntry[T]) bool {
+	if entry.IsExpired() {
+		cacheMisses.Add(1)
+		return false
+	}
+	cacheHits.Add(1)
+	return true
+}
 
-	// Check if the path is within the cache directory
-	return strings.HasPrefix(cleanPath, cleanCacheDir)
+// removeCacheEntry removes an expired cache entry; cleanup is best effort
+func (c *PRCache) removeCacheEntry(key string) {
+	_ = c.backend.Delete(key)
 }
 
-// saveCacheEntry saves a cache entry to disk
-func (c *PRCache) saveCacheEntry(path string, entry interface{}) error {
-	// Validate that path is within cache directory to prevent directory traversal
-	if !c.isValidCachePath(path) {
-		return fmt.Errorf("invalid cache path: %s", path)
+// entryExpired reports whether an encoded cache entry is past its TTL. Gob
+// skips fields the target lacks, so this reads any CacheEntry's header.
+func entryExpired(data []byte) bool {
+	var header struct {
+		Timestamp time.Time
+		TTL       time.Duration
 	}
-
-	// #nosec G304 - path is validated above to prevent directory traversal
-	file, err := os.Create(path)
-	if err != nil {
-		return fmt.Errorf("failed to create cache file: %w", err)
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&header); err != nil {
+		return false
 	}
-	defer file.Close()
-
-	encoder := gob.NewEncoder(file)
-	return encoder.Encode(entry)
+	return time.Since(header.Timestamp) > header.TTL
 }
 
-// loadCacheEntry loads a cache entry from disk
-func (c *PRCache) loadCacheEntry(path string, entry interface{}) error {
-	// Validate that path is within cache directory to prevent directory traversal
-	if !c.isValidCachePath(path) {
-		return fmt.Errorf("invalid cache path: %s", path)
-	}
-
-	// #nosec G304 - path is validated above to prevent directory traversal
-	file, err := os.Open(path)
-	if err != nil {
-		return err // File doesn't exist or can't be opened
+// Flush waits for writes in progress to finish, or for ctx to end, so
+// quitting doesn't leave an entry half-written
+func (c *PRCache) Flush(ctx context.Context) error {
+	flushed := make(chan struct{})
+	go func() {
+		// Taken only once every write holding the read lock is done
+		c.writing.Lock()
+		defer c.writing.Unlock()
+		close(flushed)
+	}()
+
+	select {
+	case <-flushed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
-	defer file.Close()
+}
 
-	decoder := gob.NewDecoder(file)
-	return decoder.Decode(entry)
+// Close releases the backend's connections
+func (c *PRCache) Close() error {
+	return c.backend.Close()
 }
 
-// GetPRList retrieves cached PR list
+// GetPRList retrieves cached PR list. Expired lists stay stored for
+// GetStalePRList, in case the refresh replacing them fails.
 func (c *PRCache) GetPRList(cacheKey string) ([]*github.PullRequest, bool) {
-	path := c.getCachePath(cacheKey, "prlist")
+	key := c.getEntryKey(cacheKey, "prlist")
 
 	var entry CacheEntry[[]*github.PullRequest]
-	if err := c.loadCacheEntry(path, &entry); err != nil {
+	if err := c.loadCacheEntry(key, &entry); err != nil {
 		return nil, false
 	}
 
-	if entry.IsExpired() {
-		// Clean up expired cache file
-		os.Remove(path) // #nosec G104 - Ignore errors - file cleanup is best effort
+	if !countLookup(&entry) {
 		return nil, false
 	}
 
 	return entry.Data, true
 }
 
+// GetStalePRList retrieves a cached PR list even if it has expired, along with
+// when it was cached. Used to show something immediately while a fresh fetch runs.
+func (c *PRCache) GetStalePRList(cacheKey string) ([]*github.PullRequest, time.Time, bool) {
+	key := c.getEntryKey(cacheKey, "prlist")
+
+	var entry CacheEntry[[]*github.PullRequest]
+	if err := c.loadCacheEntry(key, &entry); err != nil {
+		return nil, time.Time{}, false
+	}
+
+	cacheHits.Add(1) // Anything stored serves as a preview
+	return entry.Data, entry.Timestamp, true
+}
+
 // SetPRList caches PR list with TTL
 func (c *PRCache) SetPRList(cacheKey string, prs []*github.PullRequest, ttl time.Duration) error {
-	path := c.getCachePath(cacheKey, "prlist")
+	key := c.getEntryKey(cacheKey, "prlist")
 
 	entry := CacheEntry[[]*github.PullRequest]{
 		Data:      prs,
@@ -154,32 +212,47 @@ func (c *PRCache) SetPRList(cacheKey string, prs []*github.PullRequest, ttl time
 		TTL:       ttl,
 	}
 
-	return c.saveCacheEntry(path, &entry)
+	return c.saveCacheEntry(key, &entry, ttl)
 }
 
 // EnhancedPRData represents the enhanced PR information we cache
 type EnhancedPRData struct {
 	Number          int       `json:"number"`
+	HeadSHA         string    `json:"head_sha"`
 	ReviewStatus    string    `json:"review_status"`
 	ChecksStatus    string    `json:"checks_status"`
+	ReportedChecks  []string  `json:"reported_checks"`
 	MergeableStatus string    `json:"mergeable_status"`
+	Comments        int       `json:"comments"`
+	ReviewComments  int       `json:"review_comments"`
+	Additions       int       `json:"additions"`
+	Deletions       int       `json:"deletions"`
+	ChangedFiles    int       `json:"changed_files"`
 	Author          string    `json:"author"`
 	Title           string    `json:"title"`
 	UpdatedAt       time.Time `json:"updated_at"`
+	EnhancedAt      time.Time `json:"enhanced_at"`
+
+	Reviewers map[string]string `json:"reviewers,omitempty"` // Reviewer -> latest verdict
+
+	// Branch protection's review decision and merge state from GraphQL
+	ReviewDecision string `json:"review_decision,omitempty"`
+	MergeState     string `json:"merge_state,omitempty"`
+
+	FirstReviewAt time.Time `json:"first_review_at,omitempty"` // Zero before anyone but the author reviewed
 }
 
 // GetEnhancedPRData retrieves cached enhanced PR data
 func (c *PRCache) GetEnhancedPRData(prKey string) (map[string]EnhancedPRData, bool) {
-	path := c.getCachePath(prKey, "enhanced")
+	key := c.getEntryKey(prKey, "enhanced")
 
 	var entry CacheEntry[map[string]EnhancedPRData]
-	if err := c.loadCacheEntry(path, &entry); err != nil {
+	if err := c.loadCacheEntry(key, &entry); err != nil {
 		return nil, false
 	}
 
-	if entry.IsExpired() {
-		// Clean up expired cache file
-		os.Remove(path) // #nosec G104 - Ignore errors - file cleanup is best effort
+	if !countLookup(&entry) {
+		c.removeCacheEntry(key)
 		return nil, false
 	}
 
@@ -188,7 +261,7 @@ func (c *PRCache) GetEnhancedPRData(prKey string) (map[string]EnhancedPRData, bo
 
 // SetEnhancedPRData caches enhanced PR data with TTL
 func (c *PRCache) SetEnhancedPRData(prKey string, data map[string]EnhancedPRData, ttl time.Duration) error {
-	path := c.getCachePath(prKey, "enhanced")
+	key := c.getEntryKey(prKey, "enhanced")
 
 	entry := CacheEntry[map[string]EnhancedPRData]{
 		Data:      data,
@@ -196,54 +269,315 @@ func (c *PRCache) SetEnhancedPRData(prKey string, data map[string]EnhancedPRData
 		TTL:       ttl,
 	}
 
-	return c.saveCacheEntry(path, &entry)
+	return c.saveCacheEntry(key, &entry, ttl)
 }
 
-// GenerateFetcherKey creates a cache key for a specific fetcher configuration
-func (c *PRCache) GenerateFetcherKey(fetcherType string, params ...string) string {
-	allParams := append([]string{fetcherType}, params...)
-	return c.generateCacheKey(allParams...)
+// RepoMetadata represents the repository information we cache for repo tooltips
+type RepoMetadata struct {
+	FullName      string    `json:"full_name"`
+	Description   string    `json:"description"`
+	Language      string    `json:"language"`
+	DefaultBranch string    `json:"default_branch"`
+	OpenPRs       int       `json:"open_prs"`
+	Topics        []string  `json:"topics"`
+	Archived      bool      `json:"archived"`
+	FetchedAt     time.Time `json:"fetched_at"`
 }
 
-// CleanExpiredEntries removes expired cache files
-func (c *PRCache) CleanExpiredEntries(ctx context.Context) error {
-	files, err := filepath.Glob(filepath.Join(c.cacheDir, "*.cache"))
-	if err != nil {
-		return err
+// GetRepoMetadata retrieves cached repository metadata
+func (c *PRCache) GetRepoMetadata(repoFullName string) (*RepoMetadata, bool) {
+	key := c.getEntryKey(c.generateCacheKey("repo", repoFullName), "repometa")
+
+	var entry CacheEntry[RepoMetadata]
+	if err := c.loadCacheEntry(key, &entry); err != nil {
+		return nil, false
+	}
+
+	if !countLookup(&entry) {
+		c.removeCacheEntry(key)
+		return nil, false
 	}
 
-	for _, file := range files {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
-		}
+	return &entry.Data, true
+}
+
+// SetRepoMetadata caches repository metadata with TTL
+func (c *PRCache) SetRepoMetadata(metadata *RepoMetadata, ttl time.Duration) error {
+	key := c.getEntryKey(c.generateCacheKey("repo", metadata.FullName), "repometa")
 
-		// Try to load and check if expired
-		var entry CacheEntry[interface{}]
-		if err := c.loadCacheEntry(file, &entry); err == nil {
-			if entry.IsExpired() {
-				os.Remove(file) // #nosec G104 - Ignore errors - file cleanup is best effort
-			}
-		}
+	entry := CacheEntry[RepoMetadata]{
+		Data:      *metadata,
+		Timestamp: time.Now(),
+		TTL:       ttl,
 	}
 
-	return nil
+	return c.saveCacheEntry(key, &entry, ttl)
 }
 
-// GetCacheStats returns cache statistics
-func (c *PRCache) GetCacheStats() (int, int64, error) {
-	files, err := filepath.Glob(filepath.Join(c.cacheDir, "*.cache"))
-	if err != nil {
-		return 0, 0, err
+// UserProfile represents the public profile information we cache for PR authors
+type UserProfile struct {
+	Login     string    `json:"login"`
+	Name      string    `json:"name"`
+	Location  string    `json:"location"`
+	Company   string    `json:"company"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// GetUserProfile retrieves a cached user profile
+func (c *PRCache) GetUserProfile(login string) (*UserProfile, bool) {
+	key := c.getEntryKey(c.generateCacheKey("user", login), "userprofile")
+
+	var entry CacheEntry[UserProfile]
+	if err := c.loadCacheEntry(key, &entry); err != nil {
+		return nil, false
+	}
+
+	if !countLookup(&entry) {
+		c.removeCacheEntry(key)
+		return nil, false
 	}
 
-	var totalSize int64
-	for _, file := range files {
-		if info, err := os.Stat(file); err == nil {
-			totalSize += info.Size()
-		}
+	return &entry.Data, true
+}
+
+// SetUserProfile caches a user profile with TTL
+func (c *PRCache) SetUserProfile(profile *UserProfile, ttl time.Duration) error {
+	key := c.getEntryKey(c.generateCacheKey("user", profile.Login), "userprofile")
+
+	entry := CacheEntry[UserProfile]{
+		Data:      *profile,
+		Timestamp: time.Now(),
+		TTL:       ttl,
+	}
+
+	return c.saveCacheEntry(key, &entry, ttl)
+}
+
+// GetTeamMembers retrieves the cached member logins of an organization team
+func (c *PRCache) GetTeamMembers(org, slug string) ([]string, bool) {
+	key := c.getEntryKey(c.generateCacheKey("team", org, slug), "team")
+
+	var entry CacheEntry[[]string]
+	if err := c.loadCacheEntry(key, &entry); err != nil {
+		return nil, false
+	}
+
+	if !countLookup(&entry) {
+		c.removeCacheEntry(key)
+		return nil, false
+	}
+
+	return entry.Data, true
+}
+
+// SetTeamMembers caches the member logins of an organization team with TTL
+func (c *PRCache) SetTeamMembers(org, slug string, members []string, ttl time.Duration) error {
+	key := c.getEntryKey(c.generateCacheKey("team", org, slug), "team")
+
+	entry := CacheEntry[[]string]{
+		Data:      members,
+		Timestamp: time.Now(),
+		TTL:       ttl,
+	}
+
+	return c.saveCacheEntry(key, &entry, ttl)
+}
+
+// GetRepoLabels retrieves the cached label names of a repository
+func (c *PRCache) GetRepoLabels(repoFullName string) ([]string, bool) {
+	key := c.getEntryKey(c.generateCacheKey("labels", repoFullName), "labels")
+
+	var entry CacheEntry[[]string]
+	if err := c.loadCacheEntry(key, &entry); err != nil {
+		return nil, false
 	}
 
-	return len(files), totalSize, nil
+	if !countLookup(&entry) {
+		c.removeCacheEntry(key)
+		return nil, false
+	}
+
+	return entry.Data, true
+}
+
+// SetRepoLabels caches the label names of a repository with TTL
+func (c *PRCache) SetRepoLabels(repoFullName string, labels []string, ttl time.Duration) error {
+	key := c.getEntryKey(c.generateCacheKey("labels", repoFullName), "labels")
+
+	entry := CacheEntry[[]string]{
+		Data:      labels,
+		Timestamp: time.Now(),
+		TTL:       ttl,
+	}
+
+	return c.saveCacheEntry(key, &entry, ttl)
+}
+
+// JiraIssue represents the Jira issue status we cache for ticket keys found in PRs
+type JiraIssue struct {
+	Key      string `json:"key"`
+	Summary  string `json:"summary"`
+	Status   string `json:"status"`   // Workflow status name, e.g. "In Review"
+	Category string `json:"category"` // Status category: "new", "indeterminate" or "done"
+	Missing  bool   `json:"missing"`  // Jira has no such issue, or the token can't see it
+
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// GetJiraIssue retrieves a cached Jira issue of an instance
+func (c *PRCache) GetJiraIssue(baseURL, key string) (*JiraIssue, bool) {
+	cacheKey := c.getEntryKey(c.generateCacheKey("jira", baseURL, key), "jira")
+
+	var entry CacheEntry[JiraIssue]
+	if err := c.loadCacheEntry(cacheKey, &entry); err != nil {
+		return nil, false
+	}
+
+	if !countLookup(&entry) {
+		c.removeCacheEntry(cacheKey)
+		return nil, false
+	}
+
+	return &entry.Data, true
+}
+
+// SetJiraIssue caches a Jira issue of an instance with TTL
+func (c *PRCache) SetJiraIssue(baseURL string, issue *JiraIssue, ttl time.Duration) error {
+	cacheKey := c.getEntryKey(c.generateCacheKey("jira", baseURL, issue.Key), "jira")
+
+	entry := CacheEntry[JiraIssue]{
+		Data:      *issue,
+		Timestamp: time.Now(),
+		TTL:       ttl,
+	}
+
+	return c.saveCacheEntry(cacheKey, &entry, ttl)
+}
+
+// InsightsDay holds one day of an insights tab's aggregates. Counts are -1
+// when they weren't recorded that day.
+type InsightsDay struct {
+	Date      string        `json:"date"`       // YYYY-MM-DD in local time
+	Open      int           `json:"open"`       // Open PRs at the last refresh of the day
+	Merged    int           `json:"merged"`     // PRs merged that day
+	MedianAge time.Duration `json:"median_age"` // Median age of the open PRs listed
+}
+
+// GetInsightsHistory retrieves the recorded days of an insights scope, oldest first
+func (c *PRCache) GetInsightsHistory(scopeKey string) ([]InsightsDay, bool) {
+	key := c.getEntryKey(c.generateCacheKey("insights", scopeKey), "insights")
+
+	var entry CacheEntry[[]InsightsDay]
+	if err := c.loadCacheEntry(key, &entry); err != nil {
+		return nil, false
+	}
+
+	if !countLookup(&entry) {
+		c.removeCacheEntry(key)
+		return nil, false
+	}
+
+	return entry.Data, true
+}
+
+// SetInsightsHistory stores the recorded days of an insights scope. Each
+// write extends the TTL, so history survives as long as the tab is used.
+func (c *PRCache) SetInsightsHistory(scopeKey string, days []InsightsDay, ttl time.Duration) error {
+	key := c.getEntryKey(c.generateCacheKey("insights", scopeKey), "insights")
+
+	entry := CacheEntry[[]InsightsDay]{
+		Data:      days,
+		Timestamp: time.Now(),
+		TTL:       ttl,
+	}
+
+	return c.saveCacheEntry(key, &entry, ttl)
+}
+
+// GetViewerLogin retrieves the cached login of the user a token belongs to
+func (c *PRCache) GetViewerLogin(token string) (string, bool) {
+	key := c.getEntryKey(c.generateCacheKey("viewer", token), "viewer")
+
+	var entry CacheEntry[string]
+	if err := c.loadCacheEntry(key, &entry); err != nil {
+		return "", false
+	}
+
+	if !countLookup(&entry) {
+		c.removeCacheEntry(key)
+		return "", false
+	}
+
+	return entry.Data, true
+}
+
+// SetViewerLogin caches the login of the user a token belongs to. The file
+// name is derived from a hash of the token; the token itself isn't stored.
+func (c *PRCache) SetViewerLogin(token, login string, ttl time.Duration) error {
+	key := c.getEntryKey(c.generateCacheKey("viewer", token), "viewer")
+
+	entry := CacheEntry[string]{
+		Data:      login,
+		Timestamp: time.Now(),
+		TTL:       ttl,
+	}
+
+	return c.saveCacheEntry(key, &entry, ttl)
+}
+
+// ConditionalResponse is a response stored with its validators, replayed
+// when GitHub answers a conditional request with 304 Not Modified
+type ConditionalResponse struct {
+	ETag         string      `json:"etag"`
+	LastModified string      `json:"last_modified"`
+	Header       http.Header `json:"header"`
+	Body         []byte      `json:"body"`
+}
+
+// GetConditionalResponse retrieves the stored response for a request URL.
+// credential separates users, as responses depend on what they can see.
+func (c *PRCache) GetConditionalResponse(credential, url string) (*ConditionalResponse, bool) {
+	key := c.getEntryKey(c.generateCacheKey("conditional", credential, url), "conditional")
+
+	var entry CacheEntry[ConditionalResponse]
+	if err := c.loadCacheEntry(key, &entry); err != nil {
+		return nil, false
+	}
+
+	if !countLookup(&entry) {
+		c.removeCacheEntry(key)
+		return nil, false
+	}
+
+	return &entry.Data, true
+}
+
+// SetConditionalResponse stores a response and its validators for a request
+// URL. The file name is derived from a hash of the credential, which isn't stored.
+func (c *PRCache) SetConditionalResponse(credential, url string, resp *ConditionalResponse, ttl time.Duration) error {
+	key := c.getEntryKey(c.generateCacheKey("conditional", credential, url), "conditional")
+
+	entry := CacheEntry[ConditionalResponse]{
+		Data:      *resp,
+		Timestamp: time.Now(),
+		TTL:       ttl,
+	}
+
+	return c.saveCacheEntry(key, &entry, ttl)
+}
+
+// GenerateFetcherKey creates a cache key for a specific fetcher configuration
+func (c *PRCache) GenerateFetcherKey(fetcherType string, params ...string) string {
+	allParams := append([]string{fetcherType}, params...)
+	return c.generateCacheKey(allParams...)
+}
+
+// CleanExpiredEntries removes expired cache entries
+func (c *PRCache) CleanExpiredEntries(ctx context.Context) error {
+	return c.backend.Clean(ctx, entryExpired)
+}
+
+// GetCacheStats returns the number of cache entries and their total size
+func (c *PRCache) GetCacheStats() (int, int64, error) {
+	return c.backend.Stats()
 }
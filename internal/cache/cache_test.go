@@ -2,6 +2,7 @@ package cache
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"path/filepath"
 	"testing"
@@ -145,6 +146,160 @@ func TestEnhancedPRDataCaching(t *testing.T) {
 	}
 }
 
+func TestRepoMetadataCaching(t *testing.T) {
+	cache := createTestCache(t)
+
+	metadata := &RepoMetadata{
+		FullName:      "org/service",
+		Language:      "Go",
+		DefaultBranch: "main",
+		OpenPRs:       12,
+		Topics:        []string{"backend", "payments"},
+	}
+
+	// Cache miss initially
+	if _, found := cache.GetRepoMetadata("org/service"); found {
+		t.Error("Expected cache miss for uncached repo")
+	}
+
+	if err := cache.SetRepoMetadata(metadata, time.Hour); err != nil {
+		t.Fatalf("SetRepoMetadata() error = %v", err)
+	}
+
+	cached, found := cache.GetRepoMetadata("org/service")
+	if !found {
+		t.Fatal("Expected cache hit but got cache miss")
+	}
+	if cached.Language != "Go" || cached.OpenPRs != 12 || len(cached.Topics) != 2 {
+		t.Errorf("Unexpected cached metadata: %+v", cached)
+	}
+
+	// Other repos don't share the entry
+	if _, found := cache.GetRepoMetadata("org/other"); found {
+		t.Error("Expected cache miss for a different repo")
+	}
+}
+
+func TestUserProfileCaching(t *testing.T) {
+	cache := createTestCache(t)
+
+	if _, found := cache.GetUserProfile("alice"); found {
+		t.Error("Expected cache miss for uncached user")
+	}
+
+	profile := &UserProfile{Login: "alice", Name: "Alice", Location: "Berlin"}
+	if err := cache.SetUserProfile(profile, time.Hour); err != nil {
+		t.Fatalf("SetUserProfile() error = %v", err)
+	}
+
+	cached, found := cache.GetUserProfile("alice")
+	if !found {
+		t.Fatal("Expected cache hit but got cache miss")
+	}
+	if cached.Name != "Alice" || cached.Location != "Berlin" {
+		t.Errorf("Unexpected cached profile: %+v", cached)
+	}
+}
+
+func TestViewerLoginCaching(t *testing.T) {
+	cache := createTestCache(t)
+
+	if _, found := cache.GetViewerLogin("token-a"); found {
+		t.Error("Expected cache miss for unknown token")
+	}
+	if err := cache.SetViewerLogin("token-a", "alice", time.Hour); err != nil {
+		t.Fatalf("SetViewerLogin() error = %v", err)
+	}
+
+	if login, found := cache.GetViewerLogin("token-a"); !found || login != "alice" {
+		t.Errorf("Expected alice, got %q (found %v)", login, found)
+	}
+	if _, found := cache.GetViewerLogin("token-b"); found {
+		t.Error("Expected another token not to share the cached login")
+	}
+}
+
+func TestTeamMembersCaching(t *testing.T) {
+	cache := createTestCache(t)
+
+	if _, found := cache.GetTeamMembers("myorg", "platform"); found {
+		t.Error("Expected cache miss for unknown team")
+	}
+	if err := cache.SetTeamMembers("myorg", "platform", []string{"alice", "bob"}, time.Hour); err != nil {
+		t.Fatalf("SetTeamMembers() error = %v", err)
+	}
+
+	if members, found := cache.GetTeamMembers("myorg", "platform"); !found || len(members) != 2 {
+		t.Errorf("Expected two members, got %v (found %v)", members, found)
+	}
+	if _, found := cache.GetTeamMembers("other", "platform"); found {
+		t.Error("Expected a team of another organization not to share the entry")
+	}
+}
+
+func TestRepoLabelsCaching(t *testing.T) {
+	cache := createTestCache(t)
+
+	if _, found := cache.GetRepoLabels("org/api"); found {
+		t.Error("Expected cache miss for an unseen repo")
+	}
+	if err := cache.SetRepoLabels("org/api", []string{"bug", "needs-triage"}, time.Hour); err != nil {
+		t.Fatalf("SetRepoLabels() error = %v", err)
+	}
+
+	if labels, found := cache.GetRepoLabels("org/api"); !found || len(labels) != 2 {
+		t.Errorf("Expected two labels, got %v (found %v)", labels, found)
+	}
+	if _, found := cache.GetRepoLabels("org/web"); found {
+		t.Error("Expected another repo not to share the entry")
+	}
+}
+
+func TestConditionalResponseCaching(t *testing.T) {
+	cache := createTestCache(t)
+	url := "https://api.github.com/repos/org/api/pulls?per_page=100"
+
+	if _, found := cache.GetConditionalResponse("token-a", url); found {
+		t.Error("Expected cache miss for an unseen URL")
+	}
+	stored := &ConditionalResponse{
+		ETag:   `W/"abc"`,
+		Header: http.Header{"Link": {`<https://api.github.com/x?page=2>; rel="next"`}},
+		Body:   []byte(`[{"number": 1}]`),
+	}
+	if err := cache.SetConditionalResponse("token-a", url, stored, time.Hour); err != nil {
+		t.Fatalf("SetConditionalResponse() error = %v", err)
+	}
+
+	got, found := cache.GetConditionalResponse("token-a", url)
+	if !found || got.ETag != stored.ETag || string(got.Body) != string(stored.Body) || got.Header.Get("Link") == "" {
+		t.Errorf("Expected the stored response back, got %+v (found %v)", got, found)
+	}
+	if _, found := cache.GetConditionalResponse("token-b", url); found {
+		t.Error("Expected another credential not to share the stored response")
+	}
+}
+
+func TestInsightsHistoryCaching(t *testing.T) {
+	cache := createTestCache(t)
+
+	if _, found := cache.GetInsightsHistory("org:acme"); found {
+		t.Error("Expected cache miss for an unrecorded scope")
+	}
+	days := []InsightsDay{
+		{Date: "2024-03-01", Open: -1, Merged: 4},
+		{Date: "2024-03-02", Open: 12, Merged: 3, MedianAge: 36 * time.Hour},
+	}
+	if err := cache.SetInsightsHistory("org:acme", days, time.Hour); err != nil {
+		t.Fatalf("SetInsightsHistory() error = %v", err)
+	}
+
+	got, found := cache.GetInsightsHistory("org:acme")
+	if !found || len(got) != 2 || got[1] != days[1] || got[0].Open != -1 {
+		t.Errorf("Expected the recorded days back, got %+v (found %v)", got, found)
+	}
+}
+
 func TestExpiredCacheCleanup(t *testing.T) {
 	cache := createTestCache(t)
 
@@ -168,6 +323,40 @@ func TestExpiredCacheCleanup(t *testing.T) {
 	if _, found := cache.GetPRList(cacheKey); found {
 		t.Error("Expected cache miss due to expiration but got cache hit")
 	}
+
+	// The expired list is kept, in case the refresh replacing it fails
+	if prs, _, found := cache.GetStalePRList(cacheKey); !found || len(prs) != 1 {
+		t.Errorf("Expected the expired list to still be stored, got %v", prs)
+	}
+}
+
+func TestGetStalePRList(t *testing.T) {
+	cache := createTestCache(t)
+
+	if _, _, found := cache.GetStalePRList("missing-key"); found {
+		t.Error("Expected miss for a key that was never cached")
+	}
+
+	testPRs := []*github.PullRequest{
+		{Number: github.Int(1), Title: github.String("Old PR")},
+	}
+	before := time.Now()
+	if err := cache.SetPRList("stale-key", testPRs, 10*time.Millisecond); err != nil {
+		t.Fatalf("SetPRList() error = %v", err)
+	}
+	time.Sleep(20 * time.Millisecond)
+
+	// Expired entries are still returned, with the time they were cached
+	prs, cachedAt, found := cache.GetStalePRList("stale-key")
+	if !found {
+		t.Fatal("Expected stale entry to be returned")
+	}
+	if len(prs) != 1 || prs[0].GetTitle() != "Old PR" {
+		t.Errorf("Unexpected stale PRs: %v", prs)
+	}
+	if cachedAt.Before(before) || cachedAt.After(time.Now()) {
+		t.Errorf("Unexpected cache timestamp %v", cachedAt)
+	}
 }
 
 func TestGenerateFetcherKey(t *testing.T) {
@@ -274,3 +463,24 @@ func TestGetCacheStats(t *testing.T) {
 		t.Errorf("Expected cache size to increase from %d, got %d", initialSize, size)
 	}
 }
+
+func TestLookupStats(t *testing.T) {
+	cache := createTestCache(t)
+	hits, misses := LookupStats()
+
+	cache.GetViewerLogin("token-a")
+	if err := cache.SetViewerLogin("token-a", "alice", time.Hour); err != nil {
+		t.Fatalf("SetViewerLogin() error = %v", err)
+	}
+	cache.GetViewerLogin("token-a")
+	if err := cache.SetViewerLogin("token-b", "bob", -time.Minute); err != nil {
+		t.Fatalf("SetViewerLogin() error = %v", err)
+	}
+	cache.GetViewerLogin("token-b") // Expired
+	cache.GetStalePRList("missing-key")
+
+	afterHits, afterMisses := LookupStats()
+	if afterHits-hits != 1 || afterMisses-misses != 3 {
+		t.Errorf("Expected 1 hit and 3 misses, got %d and %d", afterHits-hits, afterMisses-misses)
+	}
+}
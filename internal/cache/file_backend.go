@@ -0,0 +1,121 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileBackend stores each entry in its own file under a directory, the
+// default for a single user
+type FileBackend struct {
+	cacheDir string
+}
+
+// NewFileBackend creates a file backend, creating its directory
+func NewFileBackend(cacheDir string) (*FileBackend, error) {
+	if err := os.MkdirAll(cacheDir, 0750); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	return &FileBackend{cacheDir: cacheDir}, nil
+}
+
+// getCachePath returns the full path for a cache file
+func (b *FileBackend) getCachePath(key string) string {
+	return filepath.Join(b.cacheDir, key+".cache")
+}
+
+// isValidCachePath validates that the path is within the cache directory to prevent directory traversal
+func (b *FileBackend) isValidCachePath(path string) bool {
+	cleanPath := filepath.Clean(path)
+	cleanCacheDir := filepath.Clean(b.cacheDir)
+
+	// Check if the path is within the cache directory
+	return strings.HasPrefix(cleanPath, cleanCacheDir+string(filepath.Separator))
+}
+
+// Get reads an entry's file. Files carry no retention; expired entries stay
+// until Clean removes them.
+func (b *FileBackend) Get(key string) ([]byte, error) {
+	path := b.getCachePath(key)
+	if !b.isValidCachePath(path) {
+		return nil, fmt.Errorf("invalid cache path: %s", path)
+	}
+
+	// #nosec G304 - path is validated above to prevent directory traversal
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+// Set writes an entry's file
+func (b *FileBackend) Set(key string, value []byte, _ time.Duration) error {
+	path := b.getCachePath(key)
+	if !b.isValidCachePath(path) {
+		return fmt.Errorf("invalid cache path: %s", path)
+	}
+
+	if err := WriteFile(path, value, 0640); err != nil {
+		return fmt.Errorf("failed to create cache file: %w", err)
+	}
+	return nil
+}
+
+// Delete removes an entry's file
+func (b *FileBackend) Delete(key string) error {
+	path := b.getCachePath(key)
+	if !b.isValidCachePath(path) {
+		return fmt.Errorf("invalid cache path: %s", path)
+	}
+	return os.Remove(path)
+}
+
+// Clean removes the files of expired entries
+func (b *FileBackend) Clean(ctx context.Context, expired func([]byte) bool) error {
+	files, err := filepath.Glob(filepath.Join(b.cacheDir, "*.cache"))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		// #nosec G304 - globbed from the cache directory
+		if data, err := os.ReadFile(file); err == nil && expired(data) {
+			os.Remove(file) // #nosec G104 - Ignore errors - file cleanup is best effort
+		}
+	}
+
+	return nil
+}
+
+// Stats counts the cache files and their total size
+func (b *FileBackend) Stats() (int, int64, error) {
+	files, err := filepath.Glob(filepath.Join(b.cacheDir, "*.cache"))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var totalSize int64
+	for _, file := range files {
+		if info, err := os.Stat(file); err == nil {
+			totalSize += info.Size()
+		}
+	}
+
+	return len(files), totalSize, nil
+}
+
+// Close does nothing; files are closed after each access
+func (b *FileBackend) Close() error {
+	return nil
+}
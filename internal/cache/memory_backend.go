@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryItem is an entry held by the memory backend
+type memoryItem struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// MemoryBackend keeps entries in the process, so nothing outlives it.
+// Useful on read-only filesystems and in tests.
+type MemoryBackend struct {
+	mu    sync.Mutex
+	items map[string]memoryItem
+}
+
+// NewMemoryBackend creates an empty memory backend
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{items: make(map[string]memoryItem)}
+}
+
+// Get returns a copy of an entry still within its retention
+func (b *MemoryBackend) Get(key string) ([]byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	item, exists := b.items[key]
+	if !exists || time.Now().After(item.expiresAt) {
+		delete(b.items, key)
+		return nil, ErrNotFound
+	}
+	return append([]byte(nil), item.value...), nil
+}
+
+// Set stores a copy of an entry
+func (b *MemoryBackend) Set(key string, value []byte, retention time.Duration) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.items[key] = memoryItem{value: append([]byte(nil), value...), expiresAt: time.Now().Add(retention)}
+	return nil
+}
+
+// Delete removes an entry
+func (b *MemoryBackend) Delete(key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.items, key)
+	return nil
+}
+
+// Clean removes entries past their retention or expired
+func (b *MemoryBackend) Clean(ctx context.Context, expired func([]byte) bool) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	for key, item := range b.items {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if now.After(item.expiresAt) || expired(item.value) {
+			delete(b.items, key)
+		}
+	}
+	return nil
+}
+
+// Stats counts the entries and their total size
+func (b *MemoryBackend) Stats() (int, int64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var totalSize int64
+	for _, item := range b.items {
+		totalSize += int64(len(item.value))
+	}
+	return len(b.items), totalSize, nil
+}
+
+// Close does nothing; entries go with the process
+func (b *MemoryBackend) Close() error {
+	return nil
+}
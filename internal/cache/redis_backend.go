@@ -0,0 +1,117 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// defaultRedisKeyPrefix namespaces keys when the config doesn't say
+const defaultRedisKeyPrefix = "pr-compass:"
+
+// redisTimeout bounds each call, so an unreachable server slows a refresh
+// down rather than hanging it
+const redisTimeout = 5 * time.Second
+
+// RedisBackend keeps entries on a Redis server, so a team's dashboards
+// share one set of API calls. Redis expires entries past their retention.
+type RedisBackend struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisBackend connects to the server at url, e.g.
+// redis://:password@cache.internal:6379/0, checking it answers
+func NewRedisBackend(url, prefix string) (*RedisBackend, error) {
+	options, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cache.url: %w", err)
+	}
+	if prefix == "" {
+		prefix = defaultRedisKeyPrefix
+	}
+
+	client := redis.NewClient(options)
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close() // #nosec G104 - the ping error is the one to report
+		return nil, fmt.Errorf("failed to reach redis at %s: %w", options.Addr, err)
+	}
+	return &RedisBackend{client: client, prefix: prefix}, nil
+}
+
+// Get reads an entry
+func (b *RedisBackend) Get(key string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	value, err := b.client.Get(ctx, b.prefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrNotFound
+	}
+	return value, err
+}
+
+// Set writes an entry, expiring it after retention
+func (b *RedisBackend) Set(key string, value []byte, retention time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	return b.client.Set(ctx, b.prefix+key, value, retention).Err()
+}
+
+// Delete removes an entry
+func (b *RedisBackend) Delete(key string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	return b.client.Del(ctx, b.prefix+key).Err()
+}
+
+// Clean removes expired entries; Redis drops entries past their retention itself
+func (b *RedisBackend) Clean(ctx context.Context, expired func([]byte) bool) error {
+	iter := b.client.Scan(ctx, 0, b.prefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		value, err := b.client.Get(ctx, iter.Val()).Bytes()
+		if errors.Is(err, redis.Nil) {
+			continue // Expired meanwhile
+		}
+		if err != nil {
+			return err
+		}
+		if expired(value) {
+			if err := b.client.Del(ctx, iter.Val()).Err(); err != nil {
+				return err
+			}
+		}
+	}
+	return iter.Err()
+}
+
+// Stats counts the entries under the key prefix and their total size
+func (b *RedisBackend) Stats() (int, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	var count int
+	var totalSize int64
+	iter := b.client.Scan(ctx, 0, b.prefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		size, err := b.client.StrLen(ctx, iter.Val()).Result()
+		if err != nil {
+			return 0, 0, err
+		}
+		count++
+		totalSize += size
+	}
+	return count, totalSize, iter.Err()
+}
+
+// Close closes the connections to the server
+func (b *RedisBackend) Close() error {
+	return b.client.Close()
+}
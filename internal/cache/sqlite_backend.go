@@ -0,0 +1,114 @@
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // Registers the sqlite3 driver
+)
+
+// SQLiteBackend keeps entries in one database file, which several
+// processes on a machine can share, e.g. on a shared host
+type SQLiteBackend struct {
+	db *sql.DB
+}
+
+// NewSQLiteBackend opens or creates the database at path. The driver needs
+// cgo; builds without it reject the backend in BackendConfig.Validate.
+func NewSQLiteBackend(path string) (*SQLiteBackend, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	// WAL lets readers proceed while another process writes
+	db, err := sql.Open("sqlite3", "file:"+path+"?_busy_timeout=5000&_journal_mode=WAL")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cache database: %w", err)
+	}
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS cache_entries (
+		key        TEXT PRIMARY KEY,
+		value      BLOB NOT NULL,
+		expires_at INTEGER NOT NULL
+	)`); err != nil {
+		db.Close() // #nosec G104 - the create error is the one to report
+		return nil, fmt.Errorf("failed to open cache database %s: %w", path, err)
+	}
+	return &SQLiteBackend{db: db}, nil
+}
+
+// Get reads an entry still within its retention
+func (b *SQLiteBackend) Get(key string) ([]byte, error) {
+	var value []byte
+	err := b.db.QueryRow(`SELECT value FROM cache_entries WHERE key = ? AND expires_at > ?`, key, time.Now().Unix()).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return value, err
+}
+
+// Set inserts or replaces an entry
+func (b *SQLiteBackend) Set(key string, value []byte, retention time.Duration) error {
+	_, err := b.db.Exec(`INSERT INTO cache_entries (key, value, expires_at) VALUES (?, ?, ?)
+		ON CONFLICT(key) DO UPDATE SET value = excluded.value, expires_at = excluded.expires_at`,
+		key, value, time.Now().Add(retention).Unix())
+	return err
+}
+
+// Delete removes an entry
+func (b *SQLiteBackend) Delete(key string) error {
+	_, err := b.db.Exec(`DELETE FROM cache_entries WHERE key = ?`, key)
+	return err
+}
+
+// Clean removes entries past their retention, then entries expired
+func (b *SQLiteBackend) Clean(ctx context.Context, expired func([]byte) bool) error {
+	if _, err := b.db.ExecContext(ctx, `DELETE FROM cache_entries WHERE expires_at <= ?`, time.Now().Unix()); err != nil {
+		return err
+	}
+
+	rows, err := b.db.QueryContext(ctx, `SELECT key, value FROM cache_entries`)
+	if err != nil {
+		return err
+	}
+	var expiredKeys []string
+	for rows.Next() {
+		var key string
+		var value []byte
+		if err := rows.Scan(&key, &value); err != nil {
+			rows.Close() // #nosec G104 - the scan error is the one to report
+			return err
+		}
+		if expired(value) {
+			expiredKeys = append(expiredKeys, key)
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+
+	// Deleted after reading, so writes don't wait on the open query
+	for _, key := range expiredKeys {
+		if _, err := b.db.ExecContext(ctx, `DELETE FROM cache_entries WHERE key = ?`, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stats counts the entries and their total size
+func (b *SQLiteBackend) Stats() (int, int64, error) {
+	var count int
+	var totalSize int64
+	err := b.db.QueryRow(`SELECT COUNT(*), COALESCE(SUM(LENGTH(value)), 0) FROM cache_entries`).Scan(&count, &totalSize)
+	return count, totalSize, err
+}
+
+// Close closes the database
+func (b *SQLiteBackend) Close() error {
+	return b.db.Close()
+}
@@ -0,0 +1,7 @@
+//go:build cgo
+
+package cache
+
+// sqliteAvailable reports whether the sqlite driver is compiled in; it
+// needs cgo
+const sqliteAvailable = true
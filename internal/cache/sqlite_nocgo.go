@@ -0,0 +1,7 @@
+//go:build !cgo
+
+package cache
+
+// sqliteAvailable reports whether the sqlite driver is compiled in; it
+// needs cgo, which this build doesn't have (e.g. the Docker image)
+const sqliteAvailable = false
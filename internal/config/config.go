@@ -24,19 +24,45 @@ type Config struct {
 	// Configuration mode
 	Mode string `mapstructure:"mode"` // "repos", "organization", "teams", "search", "topics"
 
+	// Code host: "github" (default), "gitlab" or "gitea" (also "forgejo").
+	// GitLab supports the repos mode (project paths) and organization mode
+	// (a group path); Gitea the repos and organization modes.
+	Provider  string `mapstructure:"provider"`
+	GitLabURL string `mapstructure:"gitlab_url"` // Self-managed GitLab base URL (default: https://gitlab.com)
+	GiteaURL  string `mapstructure:"gitea_url"`  // Gitea or Forgejo base URL (required for gitea)
+
 	// Filtering options
 	ExcludeBots    bool     `mapstructure:"exclude_bots"`    // Exclude renovate, dependabot, etc.
 	ExcludeAuthors []string `mapstructure:"exclude_authors"` // Custom authors to exclude
 	ExcludeTitles  []string `mapstructure:"exclude_titles"`  // Title patterns to exclude
 	IncludeDrafts  bool     `mapstructure:"include_drafts"`  // Include draft PRs (default: true)
 
+	// Planning scope: only PRs in this milestone ("none" for PRs without
+	// one), and only PRs on this Projects board, in this Status column if set
+	Milestone     string `mapstructure:"milestone"`
+	Project       string `mapstructure:"project"`
+	ProjectStatus string `mapstructure:"project_status"`
+
 	// UI/Performance options
 	RefreshIntervalMinutes int `mapstructure:"refresh_interval_minutes"` // Auto-refresh interval (default: 5)
 	MaxPRs                 int `mapstructure:"max_prs"`                  // Maximum number of PRs to fetch (default: 50)
+	MaxPages               int `mapstructure:"max_pages"`                // Pages of 100 open PRs listed per repository (default: 3)
+
+	// GitHub Enterprise Server endpoints (default: github.com)
+	GitHubBaseURL   string `mapstructure:"github_base_url"`
+	GitHubUploadURL string `mapstructure:"github_upload_url"`
+
+	// Environment variable holding this profile's token, e.g. to use a work
+	// account in one profile; unset uses GITHUB_TOKEN, GH_TOKEN or gh
+	TokenEnv string `mapstructure:"token_env"`
+
+	// Review guidance options
+	ReviewSizeBudget int  `mapstructure:"review_size_budget"` // Changed lines before a PR is flagged for splitting (0 disables)
+	RequireIssueLink bool `mapstructure:"require_issue_link"` // Flag PRs that don't reference an issue or ticket
 }
 
 func LoadConfig() (*Config, error) {
-	return LoadConfigFromPath(getConfigFilePath())
+	return LoadConfigFromPath(ConfigFilePath())
 }
 
 func LoadConfigFromPath(configPath string) (*Config, error) {
@@ -89,7 +115,8 @@ func ConfigExists() bool {
 	return err == nil
 }
 
-func getConfigFilePath() string {
+// defaultConfigFilePath returns ~/.prcompass_config.yaml, the default profile's file
+func defaultConfigFilePath() string {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
 		fmt.Printf("Unable to determine user home directory: %v\n", err)
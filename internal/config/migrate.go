@@ -0,0 +1,143 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// legacyTokenFiles are where PR Pilot, PR Compass's former name, kept tokens,
+// relative to the home directory
+var legacyTokenFiles = []string{".prpilot_token", filepath.Join(".config", "pr-pilot", "token")}
+
+// tabKeys move into the tab of a migrated single-tab configuration; every
+// other setting applies to all tabs and stays at the top
+var tabKeys = map[string]bool{
+	"mode": true, "provider": true, "gitlab_url": true, "gitea_url": true,
+	"repos": true, "organization": true, "teams": true, "search_query": true, "topics": true, "topic_org": true,
+	"exclude_bots": true, "exclude_authors": true, "exclude_titles": true, "include_drafts": true,
+	"milestone": true, "project": true, "project_status": true, "milestone_column": true, "project_column": true, "ticket_column": true,
+	"slack_webhook_env": true, "slack_events": true,
+	"max_prs": true, "max_pages": true, "stack_columns": true, "assignee_column": true, "label_column": true, "policy_column": true, "insights": true,
+	"history": true, "history_days": true,
+}
+
+// Migration reports what migrating a PR Pilot setup changed
+type Migration struct {
+	From    string
+	To      string
+	Changes []string
+}
+
+// MigrateLegacy converts a PR Pilot configuration at ~/.prpilot_config.yaml
+// into ~/.prcompass_config.yaml, leaving the original in place. It does
+// nothing, returning nil, once a PR Compass configuration exists or when
+// there's nothing to migrate.
+func MigrateLegacy() (*Migration, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	to := defaultConfigFilePath()
+	if _, err := os.Stat(to); err == nil {
+		return nil, nil
+	}
+	from := filepath.Join(homeDir, ".prpilot_config.yaml")
+	// #nosec G304 - fixed path in the user's home directory
+	data, err := os.ReadFile(from)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", from, err)
+	}
+
+	converted, changes, err := ConvertToMultiTab(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s: %w", from, err)
+	}
+	if err := os.WriteFile(to, converted, 0600); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", to, err)
+	}
+
+	migration := &Migration{From: from, To: to}
+	migration.Changes = append([]string{fmt.Sprintf("Copied %s to %s; the original is left in place", from, to)}, changes...)
+	for _, name := range legacyTokenFiles {
+		path := filepath.Join(homeDir, name)
+		if _, err := os.Stat(path); err == nil {
+			migration.Changes = append(migration.Changes, fmt.Sprintf(
+				"Found a token in %s, which PR Compass doesn't read: export it as GITHUB_TOKEN or run `gh auth login`. It was not copied.", path))
+		}
+	}
+	return migration, nil
+}
+
+// ConvertToMultiTab moves the settings of a single-tab configuration into
+// a tab named Main, keeping comments and key order. Configurations that
+// already have tabs are returned unchanged.
+func ConvertToMultiTab(data []byte) ([]byte, []string, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, nil, err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, nil, fmt.Errorf("expected a mapping of settings")
+	}
+	root := doc.Content[0]
+	if len(root.Content) > 0 && root.HeadComment == "" {
+		// The file's opening comment stays at the top rather than moving with the first setting
+		root.HeadComment, root.Content[0].HeadComment = root.Content[0].HeadComment, ""
+	}
+
+	var global, tab []*yaml.Node
+	var moved []string
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		if key.Value == "tabs" {
+			return data, []string{"Kept its tabs as they were"}, nil
+		}
+		if tabKeys[key.Value] {
+			tab = append(tab, key, value)
+			moved = append(moved, key.Value)
+		} else {
+			global = append(global, key, value)
+		}
+	}
+
+	// Single-tab configurations leave drafts out unless asked, tabs include them
+	if !slices.Contains(moved, "include_drafts") {
+		tab = append(tab,
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "include_drafts"},
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "false"})
+	}
+
+	name := []*yaml.Node{
+		{Kind: yaml.ScalarNode, Tag: "!!str", Value: "name"},
+		{Kind: yaml.ScalarNode, Tag: "!!str", Value: "Main"},
+	}
+	tabs := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq", Content: []*yaml.Node{
+		{Kind: yaml.MappingNode, Tag: "!!map", Content: append(name, tab...)},
+	}}
+	root.Content = append(global, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "tabs"}, tabs)
+
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return nil, nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	changes := []string{"Converted to the multi-tab format with one tab, Main"}
+	if len(moved) > 0 {
+		changes = append(changes, "Moved into the Main tab: "+strings.Join(moved, ", "))
+	}
+	return out.Bytes(), changes, nil
+}
@@ -0,0 +1,87 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestMigrateLegacy tests carrying a PR Pilot configuration over
+func TestMigrateLegacy(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	if migration, err := MigrateLegacy(); migration != nil || err != nil {
+		t.Fatalf("Expected nothing to migrate, got %v (%v)", migration, err)
+	}
+
+	legacyYAML := `# Team repos
+mode: repos
+repos:
+  - org/api # the API
+exclude_bots: true
+refresh_interval_minutes: 3
+github_base_url: https://ghe.example.com/api/v3/
+`
+	if err := os.WriteFile(filepath.Join(home, ".prpilot_config.yaml"), []byte(legacyYAML), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(home, ".prpilot_token"), []byte("ghp_secret"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	migration, err := MigrateLegacy()
+	if err != nil || migration == nil {
+		t.Fatalf("MigrateLegacy() = %v, %v", migration, err)
+	}
+	report := strings.Join(migration.Changes, "\n")
+	for _, want := range []string{"Moved into the Main tab: mode, repos, exclude_bots", ".prpilot_token", "not copied"} {
+		if !strings.Contains(report, want) {
+			t.Errorf("Expected the report to mention %q, got:\n%s", want, report)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(home, ".prcompass_config.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "ghp_secret") {
+		t.Error("Expected the token to stay out of the configuration")
+	}
+	want := `# Team repos
+refresh_interval_minutes: 3
+github_base_url: https://ghe.example.com/api/v3/
+tabs:
+  - name: Main
+    mode: repos
+    repos:
+      - org/api # the API
+    exclude_bots: true
+    include_drafts: false
+`
+	if string(data) != want {
+		t.Errorf("Expected converted config:\n%s\ngot:\n%s", want, data)
+	}
+	cfg, err := LoadConfig()
+	if err != nil || cfg.GitHubBaseURL != "https://ghe.example.com/api/v3/" {
+		t.Errorf("Expected the migrated config to load, got %+v (%v)", cfg, err)
+	}
+
+	// Once migrated, PR Compass's own file wins
+	if migration, err := MigrateLegacy(); migration != nil || err != nil {
+		t.Errorf("Expected no second migration, got %v (%v)", migration, err)
+	}
+}
+
+// TestConvertLegacyConfigWithTabs tests that multi-tab configurations are copied as is
+func TestConvertLegacyConfigWithTabs(t *testing.T) {
+	legacyYAML := "tabs:\n  - name: Mine\n    mode: search\n"
+	converted, _, err := ConvertToMultiTab([]byte(legacyYAML))
+	if err != nil || string(converted) != legacyYAML {
+		t.Errorf("Expected tabs unchanged, got %q (%v)", converted, err)
+	}
+	if _, _, err := ConvertToMultiTab([]byte("- not a mapping\n")); err == nil {
+		t.Error("Expected an error for a config that isn't a mapping")
+	}
+}
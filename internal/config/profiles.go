@@ -0,0 +1,104 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DefaultProfile names the configuration at ~/.prcompass_config.yaml
+const DefaultProfile = "default"
+
+// profileNamePattern keeps profile names usable as file names
+var profileNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+// activeProfile is the profile configuration is read from; empty means the default
+var activeProfile string
+
+// ProfilesDir returns the directory named profiles are stored in,
+// ~/.config/pr-compass/profiles
+func ProfilesDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".config", "pr-compass", "profiles"), nil
+}
+
+// ProfilePath returns the configuration file of a named profile
+func ProfilePath(name string) (string, error) {
+	if !profileNamePattern.MatchString(name) {
+		return "", fmt.Errorf("invalid profile name %q: use letters, digits, - and _", name)
+	}
+	dir, err := ProfilesDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+".yaml"), nil
+}
+
+// UseProfile reads configuration from a named profile from now on. The
+// default profile, or an empty name, goes back to ~/.prcompass_config.yaml.
+func UseProfile(name string) error {
+	if name == "" || name == DefaultProfile {
+		activeProfile = ""
+		return nil
+	}
+	if _, err := ProfilePath(name); err != nil {
+		return err
+	}
+	activeProfile = name
+	return nil
+}
+
+// ActiveProfile returns the profile configuration is read from
+func ActiveProfile() string {
+	if activeProfile == "" {
+		return DefaultProfile
+	}
+	return activeProfile
+}
+
+// ListProfiles returns the profiles that have a configuration file, the
+// default first if it exists, then named profiles alphabetically
+func ListProfiles() ([]string, error) {
+	var profiles []string
+	if _, err := os.Stat(defaultConfigFilePath()); err == nil {
+		profiles = append(profiles, DefaultProfile)
+	}
+
+	dir, err := ProfilesDir()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read profiles: %w", err)
+	}
+
+	var named []string
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".yaml")
+		if ok && !entry.IsDir() && name != DefaultProfile && profileNamePattern.MatchString(name) {
+			named = append(named, name)
+		}
+	}
+	sort.Strings(named)
+	return append(profiles, named...), nil
+}
+
+// ConfigFilePath returns the configuration file of the active profile
+func ConfigFilePath() string {
+	if activeProfile == "" {
+		return defaultConfigFilePath()
+	}
+	path, err := ProfilePath(activeProfile)
+	if err != nil {
+		fmt.Printf("Unable to locate profile %s: %v\n", activeProfile, err)
+		os.Exit(1)
+	}
+	return path
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestProfiles tests listing profiles and reading configuration from one
+func TestProfiles(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	defer UseProfile("") // #nosec G104 - resets to the default
+
+	dir := filepath.Join(home, ".config", "pr-compass", "profiles")
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"work.yaml", "oss.yaml", "notes.txt", "bad name.yaml"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("mode: search\nsearch_query: is:pr\ntoken_env: WORK_TOKEN\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	profiles, err := ListProfiles()
+	if err != nil || !reflect.DeepEqual(profiles, []string{"oss", "work"}) {
+		t.Errorf("Expected oss and work without a default config, got %v (%v)", profiles, err)
+	}
+	if err := os.WriteFile(filepath.Join(home, ".prcompass_config.yaml"), []byte("mode: repos\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if profiles, _ := ListProfiles(); len(profiles) != 3 || profiles[0] != DefaultProfile {
+		t.Errorf("Expected the default profile first, got %v", profiles)
+	}
+
+	if err := UseProfile("work"); err != nil {
+		t.Fatalf("UseProfile() error = %v", err)
+	}
+	cfg, err := LoadConfig()
+	if err != nil || cfg.TokenEnv != "WORK_TOKEN" || ActiveProfile() != "work" {
+		t.Errorf("Expected the work profile's config, got %+v (%v)", cfg, err)
+	}
+
+	if err := UseProfile("../escape"); err == nil {
+		t.Error("Expected a profile name with a path to be rejected")
+	}
+	if err := UseProfile(DefaultProfile); err != nil || ConfigFilePath() != filepath.Join(home, ".prcompass_config.yaml") {
+		t.Errorf("Expected the default profile to read ~/.prcompass_config.yaml, got %s (%v)", ConfigFilePath(), err)
+	}
+}
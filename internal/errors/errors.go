@@ -9,11 +9,29 @@ import (
 // Authentication errors
 var (
 	ErrAuthTokenInvalid     = errors.New("GitHub token format is invalid - check that your token starts with 'ghp_', 'gho_', 'ghu_', or 'ghs_' and is complete")
-	ErrAuthTokenMissing     = errors.New("no GitHub token found - set GITHUB_TOKEN environment variable or use 'gh auth login'")
+	ErrAuthTokenMissing     = errors.New("no GitHub token found - set the GITHUB_TOKEN or GH_TOKEN environment variable, use 'gh auth login', or run with --public to browse public repos read-only")
+	ErrAuthTokenMissingCI   = errors.New("no GitHub token found in CI - pass one to the job as GITHUB_TOKEN or GH_TOKEN (in GitHub Actions: env: GITHUB_TOKEN: ${{ secrets.GITHUB_TOKEN }})")
 	ErrAuthStorageFailed    = errors.New("failed to store authentication token - check file permissions in your home directory")
 	ErrAuthPermissionDenied = errors.New("GitHub API permission denied - ensure your token has 'repo' and 'read:org' scopes")
 )
 
+// Access errors, for telling why GitHub refused a token apart from the
+// resource not existing; match them with errors.Is
+var (
+	ErrSSORequired     = errors.New("organization SAML single sign-on hasn't authorized the token")
+	ErrTokenPermission = errors.New("the token's permissions don't cover the resource")
+)
+
+// accessError is a user-facing error that also matches one of the access
+// error sentinels
+type accessError struct {
+	err  error
+	kind error
+}
+
+func (e *accessError) Error() string   { return e.err.Error() }
+func (e *accessError) Unwrap() []error { return []error{e.err, e.kind} }
+
 // Configuration errors
 func NewConfigNotFoundError(configPath string) error {
 	return fmt.Errorf("configuration file not found: %s - create it or copy example_config.yaml to get started", configPath)
@@ -59,6 +77,37 @@ func NewGitHubForbiddenError(resource string, cause error) error {
 	return errors.New(msg)
 }
 
+// NewGitHubSSOError explains that an organization enforcing SAML single
+// sign-on hasn't authorized the token; authorizeURL is where to do that
+func NewGitHubSSOError(resource, authorizeURL string, cause error) error {
+	msg := fmt.Sprintf("%s belongs to an organization using SAML single sign-on, which hasn't authorized your token - authorize it at %s", resource, authorizeURL)
+	if cause != nil {
+		return &accessError{err: fmt.Errorf("%s: %w", msg, cause), kind: ErrSSORequired}
+	}
+	return &accessError{err: errors.New(msg), kind: ErrSSORequired}
+}
+
+// NewFineGrainedTokenError explains that a fine-grained personal access
+// token can't read a resource because of the repositories or permissions it
+// was granted, not because the resource is missing
+func NewFineGrainedTokenError(resource string, cause error) error {
+	msg := fmt.Sprintf("your fine-grained token can't read %s - add the repository to the token's repository access and grant it read access to pull requests, or use a classic token", resource)
+	if cause != nil {
+		return &accessError{err: fmt.Errorf("%s: %w", msg, cause), kind: ErrTokenPermission}
+	}
+	return &accessError{err: errors.New(msg), kind: ErrTokenPermission}
+}
+
+// NewIntegrationTokenError explains that a GitHub App or Actions token can't
+// read a resource because of the permissions it was granted
+func NewIntegrationTokenError(resource string, cause error) error {
+	msg := fmt.Sprintf("the GitHub App or Actions token can't read %s - grant it access to the repository with the pull-requests: read permission", resource)
+	if cause != nil {
+		return &accessError{err: fmt.Errorf("%s: %w", msg, cause), kind: ErrTokenPermission}
+	}
+	return &accessError{err: errors.New(msg), kind: ErrTokenPermission}
+}
+
 func NewGitHubUnknownError(statusCode int, cause error) error {
 	msg := fmt.Sprintf("unexpected GitHub API error (HTTP %d) - please try again later", statusCode)
 	if cause != nil {
@@ -67,6 +116,14 @@ func NewGitHubUnknownError(statusCode int, cause error) error {
 	return errors.New(msg)
 }
 
+func NewMergeRejectedError(resource, reason string, cause error) error {
+	msg := fmt.Sprintf("cannot merge %s: %s", resource, reason)
+	if cause != nil {
+		return fmt.Errorf("%s: %w", msg, cause)
+	}
+	return errors.New(msg)
+}
+
 // Context errors
 func NewTimeoutError(operation string, cause error) error {
 	msg := fmt.Sprintf("operation timed out: %s - try again with a more specific query or check your network connection", operation)
@@ -109,7 +166,7 @@ func NewGitHubErrorFromHTTPStatus(statusCode int, resource string, cause error)
 	case http.StatusForbidden:
 		return NewGitHubForbiddenError(resource, cause)
 	case http.StatusUnauthorized:
-		return fmt.Errorf("GitHub API permission denied - ensure your token has 'repo' and 'read:org' scopes: %w", cause)
+		return fmt.Errorf("GitHub rejected the token - it may have expired or been revoked; run 'pr-compass doctor' to check it: %w", cause)
 	case http.StatusTooManyRequests:
 		return NewGitHubRateLimitError("", cause)
 	default:
@@ -0,0 +1,248 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bjess9/pr-compass/internal/errors"
+	"github.com/google/go-github/v55/github"
+)
+
+// ApprovePullRequest submits an APPROVE review on the given pull request
+func ApprovePullRequest(ctx context.Context, token string, pr *github.PullRequest) error {
+	client, err := NewClient(token)
+	if err != nil {
+		return err
+	}
+	return approvePullRequest(ctx, client, pr)
+}
+
+// approvePullRequest submits an APPROVE review using the provided client
+func approvePullRequest(ctx context.Context, client *github.Client, pr *github.PullRequest) error {
+	return submitReview(ctx, client, pr, "APPROVE", "")
+}
+
+// SubmitReview submits a review on the given pull request. event is APPROVE,
+// REQUEST_CHANGES or COMMENT; GitHub requires a body for the latter two.
+func SubmitReview(ctx context.Context, token string, pr *github.PullRequest, event, body string) error {
+	client, err := NewClient(token)
+	if err != nil {
+		return err
+	}
+	return submitReview(ctx, client, pr, event, body)
+}
+
+// submitReview submits a review using the provided client
+func submitReview(ctx context.Context, client *github.Client, pr *github.PullRequest, event, body string) error {
+	owner, repo, err := prCoordinates(pr)
+	if err != nil {
+		return err
+	}
+
+	review := &github.PullRequestReviewRequest{
+		Event: github.String(event),
+	}
+	if body != "" {
+		review.Body = github.String(body)
+	}
+
+	_, resp, err := client.PullRequests.CreateReview(ctx, owner, repo, pr.GetNumber(), review)
+	if err != nil {
+		return wrapActionError(resp, fmt.Sprintf("%s/%s#%d", owner, repo, pr.GetNumber()), err)
+	}
+	return nil
+}
+
+// CommentOnPullRequest posts a general (conversation) comment on the given
+// pull request
+func CommentOnPullRequest(ctx context.Context, token string, pr *github.PullRequest, body string) error {
+	client, err := NewClient(token)
+	if err != nil {
+		return err
+	}
+	return commentOnPullRequest(ctx, client, pr, body)
+}
+
+// commentOnPullRequest posts a comment using the provided client. PR
+// conversation comments are issue comments in the GitHub API.
+func commentOnPullRequest(ctx context.Context, client *github.Client, pr *github.PullRequest, body string) error {
+	owner, repo, err := prCoordinates(pr)
+	if err != nil {
+		return err
+	}
+
+	comment := &github.IssueComment{Body: github.String(body)}
+	_, resp, err := client.Issues.CreateComment(ctx, owner, repo, pr.GetNumber(), comment)
+	if err != nil {
+		return wrapActionError(resp, fmt.Sprintf("%s/%s#%d", owner, repo, pr.GetNumber()), err)
+	}
+	return nil
+}
+
+// MergeMethods are the merge strategies GitHub supports, in picker order
+var MergeMethods = []string{"merge", "squash", "rebase"}
+
+// MergePullRequest merges the given pull request with the chosen method
+// ("merge", "squash" or "rebase")
+func MergePullRequest(ctx context.Context, token string, pr *github.PullRequest, method string) error {
+	client, err := NewClient(token)
+	if err != nil {
+		return err
+	}
+	return mergePullRequest(ctx, client, pr, method)
+}
+
+// mergePullRequest merges a pull request using the provided client. The head
+// SHA we displayed is sent along so GitHub refuses if new commits arrived.
+func mergePullRequest(ctx context.Context, client *github.Client, pr *github.PullRequest, method string) error {
+	owner, repo, err := prCoordinates(pr)
+	if err != nil {
+		return err
+	}
+	resource := fmt.Sprintf("%s/%s#%d", owner, repo, pr.GetNumber())
+
+	options := &github.PullRequestOptions{
+		MergeMethod: method,
+		SHA:         pr.GetHead().GetSHA(),
+	}
+	result, resp, err := client.PullRequests.Merge(ctx, owner, repo, pr.GetNumber(), "", options)
+	if err != nil {
+		return wrapMergeError(resp, resource, err)
+	}
+	if !result.GetMerged() {
+		return errors.NewMergeRejectedError(resource, result.GetMessage(), nil)
+	}
+	return nil
+}
+
+// UpdatePullRequestBranch merges the base branch into a PR's head branch,
+// like the "Update branch" button. GitHub does this in the background.
+func UpdatePullRequestBranch(ctx context.Context, token string, pr *github.PullRequest) error {
+	client, err := NewClient(token)
+	if err != nil {
+		return err
+	}
+	return updatePullRequestBranch(ctx, client, pr)
+}
+
+// updatePullRequestBranch updates a PR's branch using the provided client.
+// The head SHA we displayed is sent along so GitHub refuses if new commits
+// arrived.
+func updatePullRequestBranch(ctx context.Context, client *github.Client, pr *github.PullRequest) error {
+	owner, repo, err := prCoordinates(pr)
+	if err != nil {
+		return err
+	}
+	resource := fmt.Sprintf("%s/%s#%d", owner, repo, pr.GetNumber())
+
+	options := &github.PullRequestBranchUpdateOptions{}
+	if sha := pr.GetHead().GetSHA(); sha != "" {
+		options.ExpectedHeadSHA = github.String(sha)
+	}
+	_, resp, err := client.PullRequests.UpdateBranch(ctx, owner, repo, pr.GetNumber(), options)
+	if _, accepted := err.(*github.AcceptedError); accepted {
+		// The update was scheduled, which is all the endpoint ever reports
+		return nil
+	}
+	if err != nil {
+		// e.g. conflicts with the base, or no new commits on it
+		if apiErr, ok := err.(*github.ErrorResponse); ok && resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && apiErr.Message != "" {
+			return fmt.Errorf("%s: %s", resource, apiErr.Message)
+		}
+		return wrapActionError(resp, resource, err)
+	}
+	return nil
+}
+
+// wrapMergeError explains why GitHub refused a merge, keeping its reason
+// (failing required checks, missing reviews, conflicts) for the status bar
+func wrapMergeError(resp *github.Response, resource string, err error) error {
+	if resp == nil || resp.Response == nil {
+		return wrapActionError(resp, resource, err)
+	}
+
+	reason := ""
+	if apiErr, ok := err.(*github.ErrorResponse); ok {
+		reason = apiErr.Message
+	}
+	switch resp.StatusCode {
+	case http.StatusMethodNotAllowed:
+		// Branch protection or an unmergeable state
+		if reason == "" {
+			reason = "not mergeable - check branch protection and required reviews"
+		}
+		return errors.NewMergeRejectedError(resource, reason, err)
+	case http.StatusConflict:
+		return errors.NewMergeRejectedError(resource, "head branch changed or conflicts with the base - refresh and try again", err)
+	case http.StatusUnprocessableEntity:
+		// e.g. a merge method the repository doesn't allow
+		if reason == "" {
+			reason = "merge request was rejected"
+		}
+		return errors.NewMergeRejectedError(resource, reason, err)
+	default:
+		return wrapActionError(resp, resource, err)
+	}
+}
+
+// prCoordinates extracts the owner and repository name a PR belongs to
+func prCoordinates(pr *github.PullRequest) (string, string, error) {
+	if pr == nil || pr.GetBase() == nil || pr.GetBase().GetRepo() == nil {
+		return "", "", fmt.Errorf("PR base repository is missing")
+	}
+
+	repo := pr.GetBase().GetRepo()
+	owner := repo.GetOwner().GetLogin()
+	name := repo.GetName()
+	if owner == "" || name == "" {
+		return "", "", errors.NewRepositoryInvalidError(repo.GetFullName(), nil)
+	}
+	return owner, name, nil
+}
+
+// GitHub's messages for requests the token's grant doesn't cover
+const (
+	samlEnforcementMessage   = "Resource protected by organization SAML enforcement"
+	fineGrainedDeniedMessage = "Resource not accessible by personal access token"
+	integrationDeniedMessage = "Resource not accessible by integration"
+)
+
+// wrapActionError converts a failed request into a user-facing error
+func wrapActionError(resp *github.Response, resource string, err error) error {
+	if accessErr := tokenAccessError(resp, resource, err); accessErr != nil {
+		return accessErr
+	}
+	if resp != nil && resp.Response != nil {
+		return errors.NewGitHubErrorFromHTTPStatus(resp.StatusCode, resource, err)
+	}
+	return errors.NewGitHubNetworkError(err)
+}
+
+// tokenAccessError explains a 403 caused by how the token was granted access:
+// SAML single sign-on not authorizing it, or a fine-grained or app token whose
+// permissions leave the resource out. It returns nil for other failures.
+func tokenAccessError(resp *github.Response, resource string, err error) error {
+	if resp == nil || resp.Response == nil || resp.StatusCode != http.StatusForbidden {
+		return nil
+	}
+	if authorizeURL := ssoAuthorizeURL(resp); authorizeURL != "" {
+		return errors.NewGitHubSSOError(resource, authorizeURL, err)
+	}
+
+	var message string
+	if errResp, ok := err.(*github.ErrorResponse); ok {
+		message = errResp.Message
+	}
+	switch {
+	case strings.HasPrefix(message, samlEnforcementMessage):
+		// Without the header's link, classic tokens are authorized from the token list
+		return errors.NewGitHubSSOError(resource, WebURL()+"settings/tokens", err)
+	case strings.HasPrefix(message, fineGrainedDeniedMessage):
+		return errors.NewFineGrainedTokenError(resource, err)
+	case strings.HasPrefix(message, integrationDeniedMessage):
+		return errors.NewIntegrationTokenError(resource, err)
+	}
+	return nil
+}
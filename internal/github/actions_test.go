@@ -0,0 +1,206 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+// newTestClient returns a client pointed at a local test server
+func newTestClient(t *testing.T, handler http.Handler) *gh.Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := gh.NewClient(nil)
+	baseURL, _ := url.Parse(server.URL + "/")
+	client.BaseURL = baseURL
+	return client
+}
+
+func actionTestPR() *gh.PullRequest {
+	return &gh.PullRequest{
+		Number: gh.Int(12),
+		Base: &gh.PullRequestBranch{
+			Repo: &gh.Repository{
+				Name:     gh.String("api"),
+				FullName: gh.String("org/api"),
+				Owner:    &gh.User{Login: gh.String("org")},
+			},
+		},
+	}
+}
+
+func TestApprovePullRequest(t *testing.T) {
+	var gotEvent string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/repos/org/api/pulls/12/reviews" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body struct {
+			Event string `json:"event"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		gotEvent = body.Event
+		w.Write([]byte(`{"id": 1, "state": "APPROVED"}`))
+	}))
+
+	if err := approvePullRequest(context.Background(), client, actionTestPR()); err != nil {
+		t.Fatalf("approvePullRequest() returned error: %v", err)
+	}
+	if gotEvent != "APPROVE" {
+		t.Errorf("Expected APPROVE event, got %q", gotEvent)
+	}
+}
+
+func TestApprovePullRequest_Errors(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message": "forbidden"}`))
+	}))
+
+	if err := approvePullRequest(context.Background(), client, actionTestPR()); err == nil {
+		t.Error("Expected error for forbidden response")
+	}
+
+	if err := approvePullRequest(context.Background(), client, &gh.PullRequest{Number: gh.Int(1)}); err == nil {
+		t.Error("Expected error for PR without base repository")
+	}
+}
+
+func TestSubmitReview(t *testing.T) {
+	var got struct {
+		Event string `json:"event"`
+		Body  string `json:"body"`
+	}
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/repos/org/api/pulls/12/reviews" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		w.Write([]byte(`{"id": 1, "state": "CHANGES_REQUESTED"}`))
+	}))
+
+	if err := submitReview(context.Background(), client, actionTestPR(), "REQUEST_CHANGES", "Needs a test"); err != nil {
+		t.Fatalf("submitReview() returned error: %v", err)
+	}
+	if got.Event != "REQUEST_CHANGES" || got.Body != "Needs a test" {
+		t.Errorf("Expected the event and body to be sent, got %+v", got)
+	}
+}
+
+func TestCommentOnPullRequest(t *testing.T) {
+	var gotBody string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/repos/org/api/issues/12/comments" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body struct {
+			Body string `json:"body"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		gotBody = body.Body
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": 1}`))
+	}))
+
+	if err := commentOnPullRequest(context.Background(), client, actionTestPR(), "Looks good\nOne nit"); err != nil {
+		t.Fatalf("commentOnPullRequest() returned error: %v", err)
+	}
+	if gotBody != "Looks good\nOne nit" {
+		t.Errorf("Expected comment body to be sent, got %q", gotBody)
+	}
+
+	if err := commentOnPullRequest(context.Background(), client, &gh.PullRequest{Number: gh.Int(1)}, "hi"); err == nil {
+		t.Error("Expected error for PR without base repository")
+	}
+}
+
+func TestMergePullRequest(t *testing.T) {
+	var body struct {
+		MergeMethod string `json:"merge_method"`
+		SHA         string `json:"sha"`
+	}
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/repos/org/api/pulls/12/merge" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{"merged": true, "message": "Pull Request successfully merged"}`))
+	}))
+
+	pr := actionTestPR()
+	pr.Head = &gh.PullRequestBranch{SHA: gh.String("abc123")}
+	if err := mergePullRequest(context.Background(), client, pr, "squash"); err != nil {
+		t.Fatalf("mergePullRequest() returned error: %v", err)
+	}
+	if body.MergeMethod != "squash" || body.SHA != "abc123" {
+		t.Errorf("Expected squash merge pinned to abc123, got %+v", body)
+	}
+}
+
+func TestMergePullRequest_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		response string
+		want     string
+	}{
+		{"branch protection", http.StatusMethodNotAllowed, `{"message": "Required status check \"build\" is expected."}`, `cannot merge org/api#12: Required status check "build" is expected.`},
+		{"head moved", http.StatusConflict, `{"message": "Head branch was modified."}`, "cannot merge org/api#12: head branch changed"},
+		{"method not allowed", http.StatusUnprocessableEntity, `{"message": "Squash merges are not allowed on this repository."}`, "cannot merge org/api#12: Squash merges are not allowed"},
+		{"forbidden", http.StatusForbidden, `{"message": "forbidden"}`, "access denied to org/api#12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.response))
+			}))
+
+			err := mergePullRequest(context.Background(), client, actionTestPR(), "squash")
+			if err == nil || !strings.HasPrefix(err.Error(), tt.want) {
+				t.Errorf("Expected error starting with %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestUpdatePullRequestBranch(t *testing.T) {
+	var body struct {
+		ExpectedHeadSHA string `json:"expected_head_sha"`
+	}
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/repos/org/api/pulls/12/update-branch" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"message": "Updating pull request branch.", "url": "https://github.com/org/api/pull/12"}`))
+	}))
+
+	pr := actionTestPR()
+	pr.Head = &gh.PullRequestBranch{SHA: gh.String("abc123")}
+	if err := updatePullRequestBranch(context.Background(), client, pr); err != nil {
+		t.Fatalf("updatePullRequestBranch() returned error: %v", err)
+	}
+	if body.ExpectedHeadSHA != "abc123" {
+		t.Errorf("Expected the update pinned to abc123, got %+v", body)
+	}
+
+	conflicted := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"message": "merge conflict between base and head"}`))
+	}))
+	err := updatePullRequestBranch(context.Background(), conflicted, pr)
+	if err == nil || err.Error() != "org/api#12: merge conflict between base and head" {
+		t.Errorf("Expected GitHub's reason, got %v", err)
+	}
+}
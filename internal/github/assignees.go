@@ -0,0 +1,37 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// SetAssigned assigns a user to a PR, or unassigns them, and returns the
+// PR's assignees afterwards
+func SetAssigned(ctx context.Context, token string, pr *github.PullRequest, login string, assigned bool) ([]*github.User, error) {
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return setAssigned(ctx, client, pr, login, assigned)
+}
+
+// setAssigned changes the assignment using the provided client. PRs share
+// their assignees with the issue behind them.
+func setAssigned(ctx context.Context, client *github.Client, pr *github.PullRequest, login string, assigned bool) ([]*github.User, error) {
+	owner, repo, err := prCoordinates(pr)
+	if err != nil {
+		return nil, err
+	}
+
+	change := client.Issues.RemoveAssignees
+	if assigned {
+		change = client.Issues.AddAssignees
+	}
+	issue, resp, err := change(ctx, owner, repo, pr.GetNumber(), []string{login})
+	if err != nil {
+		return nil, wrapActionError(resp, fmt.Sprintf("%s/%s#%d", owner, repo, pr.GetNumber()), err)
+	}
+	return issue.Assignees, nil
+}
@@ -0,0 +1,49 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// TestSetAssigned tests assigning and unassigning a user on a PR's issue
+func TestSetAssigned(t *testing.T) {
+	var methods []string
+	var body struct {
+		Assignees []string `json:"assignees"`
+	}
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/org/api/issues/12/assignees" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		methods = append(methods, r.Method)
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"number": 12, "assignees": [{"login": "bob"}, {"login": "alice"}]}`))
+			return
+		}
+		w.Write([]byte(`{"number": 12, "assignees": [{"login": "bob"}]}`))
+	}))
+
+	assignees, err := setAssigned(context.Background(), client, actionTestPR(), "alice", true)
+	if err != nil {
+		t.Fatalf("setAssigned(true) returned error: %v", err)
+	}
+	if !reflect.DeepEqual(body.Assignees, []string{"alice"}) || len(assignees) != 2 {
+		t.Errorf("Expected alice assigned, sent %+v and got %d assignees", body, len(assignees))
+	}
+
+	assignees, err = setAssigned(context.Background(), client, actionTestPR(), "alice", false)
+	if err != nil {
+		t.Fatalf("setAssigned(false) returned error: %v", err)
+	}
+	if len(assignees) != 1 || assignees[0].GetLogin() != "bob" {
+		t.Errorf("Expected only bob left assigned, got %+v", assignees)
+	}
+	if !reflect.DeepEqual(methods, []string{http.MethodPost, http.MethodDelete}) {
+		t.Errorf("Expected POST then DELETE, got %v", methods)
+	}
+}
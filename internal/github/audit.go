@@ -0,0 +1,105 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// AuditDetails holds the extra per-PR data needed for compliance audits
+type AuditDetails struct {
+	Reviews         []*github.PullRequestReview // All submitted reviews
+	CommitAuthors   []string                    // Logins of everyone who authored a commit on the PR
+	BranchProtected bool                        // Whether the base branch has required status checks
+	RequiredChecks  []string                    // Status check contexts required by branch protection
+	ReportedChecks  []string                    // Check run and status contexts reported on the head commit
+}
+
+// FetchAuditDetails fetches reviews, commit authors and required check information for a PR
+func FetchAuditDetails(ctx context.Context, token string, pr *github.PullRequest) (*AuditDetails, error) {
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return fetchAuditDetails(ctx, client, pr)
+}
+
+// fetchAuditDetails fetches audit details using the provided client
+func fetchAuditDetails(ctx context.Context, client *github.Client, pr *github.PullRequest) (*AuditDetails, error) {
+	owner, repo, err := prCoordinates(pr)
+	if err != nil {
+		return nil, err
+	}
+	number := pr.GetNumber()
+	resource := fmt.Sprintf("%s/%s#%d", owner, repo, number)
+
+	details := &AuditDetails{}
+
+	reviews, resp, err := client.PullRequests.ListReviews(ctx, owner, repo, number, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, wrapActionError(resp, resource, err)
+	}
+	details.Reviews = reviews
+
+	commits, resp, err := client.PullRequests.ListCommits(ctx, owner, repo, number, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, wrapActionError(resp, resource, err)
+	}
+	seenAuthors := make(map[string]bool)
+	for _, commit := range commits {
+		login := commit.GetAuthor().GetLogin()
+		if login != "" && !seenAuthors[login] {
+			seenAuthors[login] = true
+			details.CommitAuthors = append(details.CommitAuthors, login)
+		}
+	}
+
+	// Branch protection needs admin access on many repos - treat failures as "unknown/unprotected"
+	if base := pr.GetBase().GetRef(); base != "" {
+		checks, _, err := client.Repositories.GetRequiredStatusChecks(ctx, owner, repo, base)
+		if err == nil && checks != nil {
+			details.BranchProtected = true
+			details.RequiredChecks = append(details.RequiredChecks, checks.Contexts...)
+			for _, check := range checks.Checks {
+				if check != nil && check.Context != "" && !sliceContains(details.RequiredChecks, check.Context) {
+					details.RequiredChecks = append(details.RequiredChecks, check.Context)
+				}
+			}
+		}
+	}
+
+	if sha := pr.GetHead().GetSHA(); sha != "" && details.BranchProtected {
+		seenChecks := make(map[string]bool)
+		runs, _, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, sha, &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}})
+		if err == nil && runs != nil {
+			for _, run := range runs.CheckRuns {
+				if name := run.GetName(); name != "" && !seenChecks[name] {
+					seenChecks[name] = true
+					details.ReportedChecks = append(details.ReportedChecks, name)
+				}
+			}
+		}
+		status, _, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, sha, nil)
+		if err == nil && status != nil {
+			for _, s := range status.Statuses {
+				if name := s.GetContext(); name != "" && !seenChecks[name] {
+					seenChecks[name] = true
+					details.ReportedChecks = append(details.ReportedChecks, name)
+				}
+			}
+		}
+	}
+
+	return details, nil
+}
+
+// sliceContains reports whether a slice contains the given value
+func sliceContains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
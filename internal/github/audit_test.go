@@ -0,0 +1,76 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+func TestFetchAuditDetails(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/org/api/pulls/12/reviews", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"user": {"login": "bob"}, "state": "APPROVED"}]`))
+	})
+	mux.HandleFunc("/repos/org/api/pulls/12/commits", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"author": {"login": "alice"}}, {"author": {"login": "alice"}}, {"author": {"login": "carol"}}]`))
+	})
+	mux.HandleFunc("/repos/org/api/branches/main/protection/required_status_checks", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"strict": true, "contexts": ["build"], "checks": [{"context": "build"}, {"context": "lint"}]}`))
+	})
+	mux.HandleFunc("/repos/org/api/commits/abc123/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"total_count": 1, "check_runs": [{"name": "build"}]}`))
+	})
+	mux.HandleFunc("/repos/org/api/commits/abc123/status", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"statuses": [{"context": "ci/legacy"}]}`))
+	})
+	client := newTestClient(t, mux)
+
+	pr := actionTestPR()
+	pr.Base.Ref = gh.String("main")
+	pr.Head = &gh.PullRequestBranch{SHA: gh.String("abc123")}
+
+	details, err := fetchAuditDetails(context.Background(), client, pr)
+	if err != nil {
+		t.Fatalf("fetchAuditDetails() returned error: %v", err)
+	}
+
+	if len(details.Reviews) != 1 {
+		t.Errorf("Expected 1 review, got %d", len(details.Reviews))
+	}
+	if len(details.CommitAuthors) != 2 {
+		t.Errorf("Expected 2 distinct commit authors, got %v", details.CommitAuthors)
+	}
+	if !details.BranchProtected || len(details.RequiredChecks) != 2 {
+		t.Errorf("Expected protected branch with 2 required checks, got %+v", details)
+	}
+	if len(details.ReportedChecks) != 2 {
+		t.Errorf("Expected check run and status contexts, got %v", details.ReportedChecks)
+	}
+}
+
+func TestFetchAuditDetails_UnprotectedBranch(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/org/api/pulls/12/reviews", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	})
+	mux.HandleFunc("/repos/org/api/pulls/12/commits", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	})
+	mux.HandleFunc("/repos/org/api/branches/main/protection/required_status_checks", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	client := newTestClient(t, mux)
+
+	pr := actionTestPR()
+	pr.Base.Ref = gh.String("main")
+
+	details, err := fetchAuditDetails(context.Background(), client, pr)
+	if err != nil {
+		t.Fatalf("fetchAuditDetails() returned error: %v", err)
+	}
+	if details.BranchProtected {
+		t.Error("Expected branch to be treated as unprotected on 404")
+	}
+}
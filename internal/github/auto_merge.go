@@ -0,0 +1,44 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v55/github"
+)
+
+const (
+	enableAutoMergeMutation  = `mutation($id: ID!, $method: PullRequestMergeMethod!) { enablePullRequestAutoMerge(input: {pullRequestId: $id, mergeMethod: $method}) { clientMutationId } }`
+	disableAutoMergeMutation = `mutation($id: ID!) { disablePullRequestAutoMerge(input: {pullRequestId: $id}) { clientMutationId } }`
+)
+
+// SetAutoMerge arms auto-merge on a PR with the given method ("merge",
+// "squash" or "rebase"), so GitHub merges it once its requirements pass.
+// An empty method disables auto-merge.
+func SetAutoMerge(ctx context.Context, token string, pr *github.PullRequest, method string) error {
+	client, err := NewClient(token)
+	if err != nil {
+		return err
+	}
+	return setAutoMerge(ctx, client, pr, method)
+}
+
+// setAutoMerge enables or disables auto-merge using the provided client
+func setAutoMerge(ctx context.Context, client *github.Client, pr *github.PullRequest, method string) error {
+	owner, repo, err := prCoordinates(pr)
+	if err != nil {
+		return err
+	}
+	resource := fmt.Sprintf("%s/%s#%d", owner, repo, pr.GetNumber())
+
+	if method == "" {
+		return mutatePullRequest(ctx, client, resource, pr, disableAutoMergeMutation, nil)
+	}
+	if !sliceContains(MergeMethods, method) {
+		return fmt.Errorf("unknown merge method %q", method)
+	}
+	return mutatePullRequest(ctx, client, resource, pr, enableAutoMergeMutation, map[string]interface{}{
+		"method": strings.ToUpper(method),
+	})
+}
@@ -0,0 +1,54 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestSetAutoMerge tests enabling auto-merge with a method and disabling it
+func TestSetAutoMerge(t *testing.T) {
+	type request struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables"`
+	}
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body request
+		json.NewDecoder(r.Body).Decode(&body)
+		requests = append(requests, body)
+		w.Write([]byte(`{"data": {}}`))
+	}))
+	defer server.Close()
+	t.Cleanup(func() { _ = SetEnterpriseURLs("", "") })
+	if err := SetEnterpriseURLs(server.URL, ""); err != nil {
+		t.Fatalf("SetEnterpriseURLs() failed: %v", err)
+	}
+
+	pr := actionTestPR()
+	pr.NodeID = gh.String("PR_kwDO")
+	if err := SetAutoMerge(context.Background(), "fake-token", pr, "squash"); err != nil {
+		t.Fatalf("SetAutoMerge(squash) returned error: %v", err)
+	}
+	if err := SetAutoMerge(context.Background(), "fake-token", pr, ""); err != nil {
+		t.Fatalf("SetAutoMerge() returned error: %v", err)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("Expected two mutations, got %d", len(requests))
+	}
+	if enable := requests[0]; !strings.Contains(enable.Query, "enablePullRequestAutoMerge") || enable.Variables["method"] != "SQUASH" || enable.Variables["id"] != "PR_kwDO" {
+		t.Errorf("Expected auto-merge enabled with SQUASH, got %+v", enable)
+	}
+	if !strings.Contains(requests[1].Query, "disablePullRequestAutoMerge") {
+		t.Errorf("Expected auto-merge disabled, got %q", requests[1].Query)
+	}
+
+	if err := SetAutoMerge(context.Background(), "fake-token", pr, "fast-forward"); err == nil || len(requests) != 2 {
+		t.Error("Expected an unknown method to be rejected without a request")
+	}
+}
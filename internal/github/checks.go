@@ -0,0 +1,117 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// CheckRun is a check run or commit status reported on a PR's head commit
+type CheckRun struct {
+	Name        string
+	Status      string // queued, in_progress or completed
+	Conclusion  string // success, failure, neutral, skipped, ...; empty until completed
+	StartedAt   time.Time
+	CompletedAt time.Time // Zero until completed
+	URL         string    // Details page; may be empty
+}
+
+// Failed reports whether the check completed with a conclusion that blocks the PR
+func (c CheckRun) Failed() bool {
+	return c.Status == "completed" && failedConclusions[c.Conclusion]
+}
+
+// Duration returns how long the check ran, or has been running as of now
+func (c CheckRun) Duration(now time.Time) time.Duration {
+	if c.StartedAt.IsZero() {
+		return 0
+	}
+	end := c.CompletedAt
+	if end.IsZero() {
+		end = now
+	}
+	return end.Sub(c.StartedAt)
+}
+
+// FetchCheckRuns lists every check run and commit status on a PR's head
+// commit, failures first
+func FetchCheckRuns(ctx context.Context, token string, pr *github.PullRequest) ([]CheckRun, error) {
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return fetchCheckRuns(ctx, client, pr)
+}
+
+// fetchCheckRuns fetches check runs and statuses using the provided client
+func fetchCheckRuns(ctx context.Context, client *github.Client, pr *github.PullRequest) ([]CheckRun, error) {
+	owner, repo, err := prCoordinates(pr)
+	if err != nil {
+		return nil, err
+	}
+	sha := pr.GetHead().GetSHA()
+	if sha == "" {
+		return nil, fmt.Errorf("%s/%s#%d has no head commit", owner, repo, pr.GetNumber())
+	}
+	resource := fmt.Sprintf("%s/%s@%.7s", owner, repo, sha)
+
+	var checks []CheckRun
+	opts := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		runs, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, sha, opts)
+		if err != nil {
+			return nil, wrapActionError(resp, resource, err)
+		}
+		for _, run := range runs.CheckRuns {
+			checks = append(checks, CheckRun{
+				Name:        run.GetName(),
+				Status:      run.GetStatus(),
+				Conclusion:  run.GetConclusion(),
+				StartedAt:   run.GetStartedAt().Time,
+				CompletedAt: run.GetCompletedAt().Time,
+				URL:         run.GetHTMLURL(),
+			})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	combined, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, sha, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, wrapActionError(resp, resource, err)
+	}
+	for _, status := range combined.Statuses {
+		check := CheckRun{
+			Name:      status.GetContext(),
+			Status:    "completed",
+			StartedAt: status.GetCreatedAt().Time,
+			URL:       status.GetTargetURL(),
+		}
+		// Statuses have states rather than conclusions; map them onto check runs'
+		switch status.GetState() {
+		case "pending":
+			check.Status = "in_progress"
+		case "error":
+			check.Conclusion = "failure"
+		default:
+			check.Conclusion = status.GetState()
+		}
+		if check.Status == "completed" {
+			check.CompletedAt = status.GetUpdatedAt().Time
+		}
+		checks = append(checks, check)
+	}
+
+	sort.SliceStable(checks, func(i, j int) bool {
+		if checks[i].Failed() != checks[j].Failed() {
+			return checks[i].Failed()
+		}
+		return checks[i].Name < checks[j].Name
+	})
+	return checks, nil
+}
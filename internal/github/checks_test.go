@@ -0,0 +1,56 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+func TestFetchCheckRuns(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/org/api/commits/abc123/check-runs":
+			w.Write([]byte(`{"total_count": 3, "check_runs": [
+				{"name": "lint", "status": "completed", "conclusion": "success", "started_at": "2024-03-01T10:00:00Z", "completed_at": "2024-03-01T10:01:30Z"},
+				{"name": "test", "status": "completed", "conclusion": "failure", "html_url": "https://github.com/org/api/runs/7", "started_at": "2024-03-01T10:00:00Z", "completed_at": "2024-03-01T10:04:00Z"},
+				{"name": "e2e", "status": "in_progress", "started_at": "2024-03-01T10:00:00Z"}
+			]}`))
+		case "/repos/org/api/commits/abc123/status":
+			w.Write([]byte(`{"state": "failure", "statuses": [
+				{"context": "ci/legacy", "state": "error", "target_url": "https://ci.example.com/9", "created_at": "2024-03-01T10:00:00Z", "updated_at": "2024-03-01T10:02:00Z"}
+			]}`))
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+	}))
+
+	pr := actionTestPR()
+	pr.Head = &gh.PullRequestBranch{SHA: gh.String("abc123")}
+	checks, err := fetchCheckRuns(context.Background(), client, pr)
+	if err != nil {
+		t.Fatalf("fetchCheckRuns() returned error: %v", err)
+	}
+	var names []string
+	for _, check := range checks {
+		names = append(names, check.Name)
+	}
+	if len(checks) != 4 || names[0] != "ci/legacy" || names[1] != "test" || names[2] != "e2e" {
+		t.Fatalf("Expected failures first, then by name, got %v", names)
+	}
+	if !checks[0].Failed() || checks[0].Duration(time.Now()) != 2*time.Minute || checks[0].URL != "https://ci.example.com/9" {
+		t.Errorf("Expected the errored status as a failed check, got %+v", checks[0])
+	}
+	if checks[1].URL != "https://github.com/org/api/runs/7" || checks[1].Duration(time.Now()) != 4*time.Minute {
+		t.Errorf("Expected the failed run's URL and duration, got %+v", checks[1])
+	}
+	if checks[2].Failed() || !checks[2].CompletedAt.IsZero() {
+		t.Errorf("Expected the running check not to count as failed, got %+v", checks[2])
+	}
+
+	if _, err := fetchCheckRuns(context.Background(), client, actionTestPR()); err == nil {
+		t.Error("Expected an error for a PR without a head commit")
+	}
+}
@@ -2,17 +2,120 @@ package github
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-github/v55/github"
 	"golang.org/x/oauth2"
 )
 
+// UnauthenticatedRequestsPerHour is GitHub's API budget for clients without a token
+const UnauthenticatedRequestsPerHour = 60
+
+// defaultWebURL is where PRs live on github.com
+const defaultWebURL = "https://github.com/"
+
+// Endpoints of a GitHub Enterprise Server instance; empty means github.com
+var (
+	endpointsMu sync.RWMutex
+	baseURL     *url.URL
+	uploadURL   *url.URL
+)
+
+// transport carries the requests of every client; nil uses the default
+var transport http.RoundTripper
+
+// SetEnterpriseURLs points every client created afterwards at a GitHub
+// Enterprise Server instance. base is the instance or its API root
+// (https://ghe.example.com or https://ghe.example.com/api/v3/); upload
+// defaults to the instance's upload endpoint. An empty base restores github.com.
+func SetEnterpriseURLs(base, upload string) error {
+	endpointsMu.Lock()
+	defer endpointsMu.Unlock()
+
+	if strings.TrimSpace(base) == "" {
+		if strings.TrimSpace(upload) != "" {
+			return fmt.Errorf("github_upload_url needs github_base_url")
+		}
+		baseURL, uploadURL = nil, nil
+		return nil
+	}
+
+	parsed, err := url.Parse(strings.TrimSpace(base))
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return fmt.Errorf("invalid github_base_url %q - use e.g. https://ghe.example.com/api/v3/", base)
+	}
+	if strings.TrimSpace(upload) == "" {
+		upload = parsed.Scheme + "://" + parsed.Host + "/api/uploads/"
+	}
+
+	// go-github normalizes the paths (adding /api/v3/ and /api/uploads/)
+	client, err := github.NewClient(nil).WithEnterpriseURLs(parsed.String(), strings.TrimSpace(upload))
+	if err != nil {
+		return fmt.Errorf("invalid GitHub Enterprise URLs: %w", err)
+	}
+	baseURL, uploadURL = client.BaseURL, client.UploadURL
+	return nil
+}
+
+// WebURL returns the root of the GitHub web UI, e.g. "https://github.com/"
+func WebURL() string {
+	endpointsMu.RLock()
+	defer endpointsMu.RUnlock()
+
+	if baseURL == nil {
+		return defaultWebURL
+	}
+	return baseURL.Scheme + "://" + baseURL.Host + "/"
+}
+
+// UseTransport sends the requests of every client created afterwards through
+// rt, e.g. a Recorder. nil restores the default transport.
+func UseTransport(rt http.RoundTripper) {
+	endpointsMu.Lock()
+	defer endpointsMu.Unlock()
+	transport = rt
+}
+
+// NewClient creates a GitHub client for the token. An empty token creates an
+// unauthenticated client that can only read public repositories.
 func NewClient(token string) (*github.Client, error) {
-	ts := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: token},
-	)
-	tc := oauth2.NewClient(context.Background(), ts)
-	client := github.NewClient(tc)
+	endpointsMu.RLock()
+	defer endpointsMu.RUnlock()
+
+	// Every response reports the remaining quota, which ObservedRateLimit
+	// exposes; list requests can be conditional, see WithConditionalCache
+	httpClient := &http.Client{Transport: rateLimitObserver{next: conditionalTransport{next: transport}}}
+
+	var client *github.Client
+	if token == "" {
+		client = github.NewClient(httpClient)
+	} else {
+		ts := oauth2.StaticTokenSource(
+			&oauth2.Token{AccessToken: token},
+		)
+		ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
+		tc := oauth2.NewClient(ctx, ts)
+		client = github.NewClient(tc)
+	}
+
+	if baseURL != nil {
+		base, upload := *baseURL, *uploadURL
+		client.BaseURL, client.UploadURL = &base, &upload
+	}
 
 	return client, nil
 }
+
+// prListCacheTTL returns how long fetched PR lists stay cached. Unauthenticated
+// clients cache much longer to stretch their small hourly budget.
+func prListCacheTTL(token string) time.Duration {
+	if token == "" {
+		return 30 * time.Minute
+	}
+	return 5 * time.Minute
+}
@@ -1,7 +1,12 @@
 package github
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 )
 
 // TestNewClient tests the GitHub client creation function
@@ -79,3 +84,75 @@ func TestNewClientIntegration(t *testing.T) {
 		t.Error("Client should have Teams service")
 	}
 }
+
+// TestPRListCacheTTL tests that unauthenticated clients cache PR lists longer
+func TestPRListCacheTTL(t *testing.T) {
+	if got := prListCacheTTL("ghp_token"); got != 5*time.Minute {
+		t.Errorf("authenticated TTL = %v, want 5m", got)
+	}
+	if got := prListCacheTTL(""); got != 30*time.Minute {
+		t.Errorf("unauthenticated TTL = %v, want 30m", got)
+	}
+}
+
+// TestNewClientUnauthenticated tests that an empty token sends no Authorization header
+func TestNewClientUnauthenticated(t *testing.T) {
+	var authHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeader = r.Header.Get("Authorization")
+		w.Write([]byte(`{"full_name":"o/r"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("")
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+
+	if _, _, err := client.Repositories.Get(context.Background(), "o", "r"); err != nil {
+		t.Fatalf("Repositories.Get() failed: %v", err)
+	}
+	if authHeader != "" {
+		t.Errorf("Authorization header = %q, want none", authHeader)
+	}
+}
+
+// TestSetEnterpriseURLs tests that clients follow a GitHub Enterprise Server instance
+func TestSetEnterpriseURLs(t *testing.T) {
+	t.Cleanup(func() { _ = SetEnterpriseURLs("", "") })
+
+	if err := SetEnterpriseURLs("https://ghe.example.com", ""); err != nil {
+		t.Fatalf("SetEnterpriseURLs() failed: %v", err)
+	}
+	client, _ := NewClient("token")
+	if got := client.BaseURL.String(); got != "https://ghe.example.com/api/v3/" {
+		t.Errorf("BaseURL = %q, want the instance's API root", got)
+	}
+	if got := client.UploadURL.String(); got != "https://ghe.example.com/api/uploads/" {
+		t.Errorf("UploadURL = %q, want the instance's upload endpoint", got)
+	}
+	if got := WebURL(); got != "https://ghe.example.com/" {
+		t.Errorf("WebURL() = %q, want the instance root", got)
+	}
+
+	if err := SetEnterpriseURLs("", ""); err != nil {
+		t.Fatalf("SetEnterpriseURLs() reset failed: %v", err)
+	}
+	client, _ = NewClient("token")
+	if got := client.BaseURL.String(); got != "https://api.github.com/" {
+		t.Errorf("BaseURL after reset = %q, want github.com", got)
+	}
+	if got := WebURL(); got != "https://github.com/" {
+		t.Errorf("WebURL() after reset = %q, want github.com", got)
+	}
+
+	for _, tt := range []struct{ base, upload string }{
+		{"ghe.example.com", ""},
+		{"", "https://ghe.example.com/api/uploads/"},
+	} {
+		if err := SetEnterpriseURLs(tt.base, tt.upload); err == nil {
+			t.Errorf("SetEnterpriseURLs(%q, %q) should fail", tt.base, tt.upload)
+		}
+	}
+}
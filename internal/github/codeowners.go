@@ -0,0 +1,325 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// codeownersLocations are the paths GitHub reads CODEOWNERS from, in priority order
+var codeownersLocations = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// maxCoverageFiles caps how many changed files are checked against CODEOWNERS,
+// keeping huge PRs to a few requests
+const maxCoverageFiles = 300
+
+// CodeownersRule is one pattern line of a CODEOWNERS file
+type CodeownersRule struct {
+	Pattern string
+	Owners  []string // "@user", "@org/team" or an email; empty means explicitly unowned
+	match   *regexp.Regexp
+}
+
+// Codeowners is a parsed CODEOWNERS file. Later rules take precedence.
+type Codeowners []CodeownersRule
+
+// ParseCodeowners parses CODEOWNERS content, skipping comments and blank lines
+func ParseCodeowners(content string) Codeowners {
+	var rules Codeowners
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if i := strings.Index(line, " #"); i >= 0 {
+			line = strings.TrimSpace(line[:i])
+		}
+		fields := strings.Fields(line)
+		rules = append(rules, CodeownersRule{
+			Pattern: fields[0],
+			Owners:  fields[1:],
+			match:   codeownersPattern(fields[0]),
+		})
+	}
+	return rules
+}
+
+// Owners returns the owners of a repository path; the last matching rule wins
+func (c Codeowners) Owners(filePath string) []string {
+	for i := len(c) - 1; i >= 0; i-- {
+		if c[i].match.MatchString(filePath) {
+			return c[i].Owners
+		}
+	}
+	return nil
+}
+
+// codeownersPattern converts a gitignore-style CODEOWNERS pattern to a regexp.
+// Patterns with a leading or inner slash are anchored at the repository root;
+// others match at any depth. A match on a directory covers everything under
+// it, except that a trailing "/*" only covers the directory's direct files.
+func codeownersPattern(pattern string) *regexp.Regexp {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	trimmed := strings.Trim(pattern, "/")
+	if trimmed == "" {
+		return regexp.MustCompile(".*")
+	}
+	anchored := strings.HasPrefix(pattern, "/") || strings.Contains(trimmed, "/")
+
+	var expr strings.Builder
+	if anchored {
+		expr.WriteString("^")
+	} else {
+		expr.WriteString("^(?:.*/)?")
+	}
+	for i := 0; i < len(trimmed); i++ {
+		switch {
+		case strings.HasPrefix(trimmed[i:], "**/"):
+			expr.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(trimmed[i:], "**"):
+			expr.WriteString(".*")
+			i++
+		case trimmed[i] == '*':
+			expr.WriteString("[^/]*")
+		case trimmed[i] == '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(trimmed[i : i+1]))
+		}
+	}
+	switch {
+	case dirOnly:
+		expr.WriteString("/.*$")
+	case strings.HasSuffix(trimmed, "/*"):
+		expr.WriteString("$")
+	default:
+		expr.WriteString("(?:/.*)?$")
+	}
+	return regexp.MustCompile(expr.String())
+}
+
+// PathCoverage pairs a changed path with its owners and the approvals from them
+type PathCoverage struct {
+	Path       string
+	Owners     []string
+	ApprovedBy []string // Current approvers who own the path
+}
+
+// ReviewCoverage maps a PR's current approvals onto the code they own
+type ReviewCoverage struct {
+	HasCodeowners   bool
+	Approvers       []string // Reviewers whose latest verdict is an approval
+	Paths           []PathCoverage
+	Truncated       bool     // More files changed than were checked
+	UnresolvedTeams []string // Owning teams whose members couldn't be listed
+}
+
+// Uncovered returns the owned paths that no owner has approved
+func (c *ReviewCoverage) Uncovered() []PathCoverage {
+	var uncovered []PathCoverage
+	for _, p := range c.Paths {
+		if len(p.Owners) > 0 && len(p.ApprovedBy) == 0 {
+			uncovered = append(uncovered, p)
+		}
+	}
+	return uncovered
+}
+
+// ApproverDirs returns, for each approver, the directories of changed paths
+// they own. Approvers who own none of the changes map to an empty list.
+func (c *ReviewCoverage) ApproverDirs() map[string][]string {
+	dirs := make(map[string][]string, len(c.Approvers))
+	for _, approver := range c.Approvers {
+		dirs[approver] = nil
+	}
+	for _, p := range c.Paths {
+		dir := path.Dir(p.Path) + "/"
+		if dir == "./" {
+			dir = "/"
+		}
+		for _, approver := range p.ApprovedBy {
+			if !sliceContains(dirs[approver], dir) {
+				dirs[approver] = append(dirs[approver], dir)
+			}
+		}
+	}
+	for approver := range dirs {
+		sort.Strings(dirs[approver])
+	}
+	return dirs
+}
+
+// CurrentApprovers returns reviewers whose latest approving or blocking
+// review is an approval. Comments don't change a reviewer's verdict.
+func CurrentApprovers(reviews []ReviewEvent) []string {
+	verdicts := make(map[string]bool)
+	for _, review := range reviews {
+		switch review.State {
+		case "APPROVED":
+			verdicts[review.Reviewer] = true
+		case "CHANGES_REQUESTED", "DISMISSED":
+			verdicts[review.Reviewer] = false
+		}
+	}
+	var approvers []string
+	for reviewer, approved := range verdicts {
+		if approved {
+			approvers = append(approvers, reviewer)
+		}
+	}
+	sort.Strings(approvers)
+	return approvers
+}
+
+// computeCoverage matches approvers against the owners of each changed path.
+// teamMembers maps "org/team" to member logins.
+func computeCoverage(rules Codeowners, files, approvers []string, teamMembers map[string][]string) *ReviewCoverage {
+	coverage := &ReviewCoverage{HasCodeowners: rules != nil, Approvers: approvers}
+	for _, file := range files {
+		owners := rules.Owners(file)
+		entry := PathCoverage{Path: file, Owners: owners}
+		for _, approver := range approvers {
+			for _, owner := range owners {
+				if ownedBy(owner, approver, teamMembers) {
+					entry.ApprovedBy = append(entry.ApprovedBy, approver)
+					break
+				}
+			}
+		}
+		coverage.Paths = append(coverage.Paths, entry)
+	}
+	return coverage
+}
+
+// ownedBy reports whether a CODEOWNERS owner entry includes the given login.
+// Email owners can't be matched to a login.
+func ownedBy(owner, login string, teamMembers map[string][]string) bool {
+	name, ok := strings.CutPrefix(owner, "@")
+	if !ok {
+		return false
+	}
+	if !strings.Contains(name, "/") {
+		return strings.EqualFold(name, login)
+	}
+	for _, member := range teamMembers[strings.ToLower(name)] {
+		if strings.EqualFold(member, login) {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchReviewCoverage loads CODEOWNERS from the base branch, the changed
+// files and the members of owning teams, then maps approvers onto them
+func fetchReviewCoverage(ctx context.Context, client *github.Client, owner, repo string, pr *github.PullRequest, approvers []string) (*ReviewCoverage, error) {
+	resource := fmt.Sprintf("%s/%s#%d", owner, repo, pr.GetNumber())
+
+	rules, err := fetchCodeowners(ctx, client, owner, repo, pr.GetBase().GetRef())
+	if err != nil {
+		return nil, err
+	}
+	if rules == nil {
+		return &ReviewCoverage{Approvers: approvers}, nil
+	}
+
+	var files []string
+	truncated := false
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		page, resp, err := client.PullRequests.ListFiles(ctx, owner, repo, pr.GetNumber(), opts)
+		if err != nil {
+			return nil, wrapActionError(resp, resource, err)
+		}
+		for _, file := range page {
+			files = append(files, file.GetFilename())
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		if len(files) >= maxCoverageFiles {
+			truncated = true
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	// Team membership only matters when someone has approved
+	teamMembers := make(map[string][]string)
+	var unresolved []string
+	if len(approvers) > 0 {
+		for _, file := range files {
+			for _, teamOwner := range rules.Owners(file) {
+				team := strings.ToLower(strings.TrimPrefix(teamOwner, "@"))
+				org, slug, isTeam := strings.Cut(team, "/")
+				if !strings.HasPrefix(teamOwner, "@") || !isTeam {
+					continue
+				}
+				if _, seen := teamMembers[team]; seen || sliceContains(unresolved, teamOwner) {
+					continue
+				}
+				members, err := fetchTeamMembers(ctx, client, org, slug)
+				if err != nil {
+					// Listing members needs read:org; count the team as unknown
+					unresolved = append(unresolved, teamOwner)
+					continue
+				}
+				teamMembers[team] = members
+			}
+		}
+	}
+
+	coverage := computeCoverage(rules, files, approvers, teamMembers)
+	coverage.Truncated = truncated
+	coverage.UnresolvedTeams = unresolved
+	return coverage, nil
+}
+
+// fetchCodeowners returns the CODEOWNERS rules at ref, or nil when the
+// repository has none
+func fetchCodeowners(ctx context.Context, client *github.Client, owner, repo, ref string) (Codeowners, error) {
+	for _, location := range codeownersLocations {
+		file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, location, &github.RepositoryContentGetOptions{Ref: ref})
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return nil, wrapActionError(resp, fmt.Sprintf("%s/%s/%s", owner, repo, location), err)
+		}
+		content, err := file.GetContent()
+		if err != nil {
+			return nil, err
+		}
+		rules := ParseCodeowners(content)
+		if rules == nil {
+			rules = Codeowners{}
+		}
+		return rules, nil
+	}
+	return nil, nil
+}
+
+// fetchTeamMembers lists the logins of a team's members
+func fetchTeamMembers(ctx context.Context, client *github.Client, org, slug string) ([]string, error) {
+	var members []string
+	opts := &github.TeamListTeamMembersOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		users, resp, err := client.Teams.ListTeamMembersBySlug(ctx, org, slug, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			members = append(members, user.GetLogin())
+		}
+		if resp.NextPage == 0 {
+			return members, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
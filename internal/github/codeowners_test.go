@@ -0,0 +1,120 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+func TestCodeownersOwners(t *testing.T) {
+	rules := ParseCodeowners(`# Default owners
+*       @org/core
+
+*.md    @docs-team-lead   # docs anywhere
+/api/   @alice
+web/**/*.ts @org/frontend
+/docs/* @bob
+/vendor/
+`)
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"main.go", []string{"@org/core"}},
+		{"README.md", []string{"@docs-team-lead"}},
+		{"api/handlers/user.go", []string{"@alice"}},
+		{"pkg/api/client.go", []string{"@org/core"}}, // /api/ is anchored at the root
+		{"web/src/app.ts", []string{"@org/frontend"}},
+		{"web/app.ts", []string{"@org/frontend"}},
+		{"docs/guide.txt", []string{"@bob"}},
+		{"docs/deep/guide.txt", []string{"@org/core"}}, // /docs/* covers direct files only
+		{"vendor/lib/lib.go", []string{}},              // Explicitly unowned
+	}
+	for _, tt := range tests {
+		if got := rules.Owners(tt.path); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Owners(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestCurrentApprovers(t *testing.T) {
+	now := time.Now()
+	reviews := []ReviewEvent{
+		{Reviewer: "alice", State: "APPROVED", SubmittedAt: now.Add(-3 * time.Hour)},
+		{Reviewer: "alice", State: "COMMENTED", SubmittedAt: now.Add(-2 * time.Hour)},
+		{Reviewer: "bob", State: "APPROVED", SubmittedAt: now.Add(-2 * time.Hour)},
+		{Reviewer: "bob", State: "CHANGES_REQUESTED", SubmittedAt: now.Add(-time.Hour)},
+		{Reviewer: "carol", State: "COMMENTED", SubmittedAt: now},
+	}
+	if got := CurrentApprovers(reviews); !reflect.DeepEqual(got, []string{"alice"}) {
+		t.Errorf("Expected alice as the only current approver, got %v", got)
+	}
+}
+
+func TestComputeCoverage(t *testing.T) {
+	rules := ParseCodeowners("*.go @org/backend\n/web/ @dave\nREADME.md\n")
+	files := []string{"api/server.go", "main.go", "web/index.html", "README.md"}
+	teams := map[string][]string{"org/backend": {"Erin"}}
+
+	coverage := computeCoverage(rules, files, []string{"dave", "erin", "zed"}, teams)
+
+	uncovered := coverage.Uncovered()
+	if len(uncovered) != 0 {
+		t.Errorf("Expected every owned path approved, got %+v", uncovered)
+	}
+	want := map[string][]string{"dave": {"web/"}, "erin": {"/", "api/"}, "zed": nil}
+	if got := coverage.ApproverDirs(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ApproverDirs() = %v, want %v", got, want)
+	}
+
+	// Without dave, the web change lacks an owner's approval
+	coverage = computeCoverage(rules, files, []string{"erin"}, teams)
+	uncovered = coverage.Uncovered()
+	if len(uncovered) != 1 || uncovered[0].Path != "web/index.html" {
+		t.Errorf("Expected web/index.html uncovered, got %+v", uncovered)
+	}
+}
+
+func TestFetchReviewCoverage(t *testing.T) {
+	codeowners := base64.StdEncoding.EncodeToString([]byte("*.go @org/backend\n/docs/ @alice\n"))
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/org/api/contents/.github/CODEOWNERS":
+			w.WriteHeader(http.StatusNotFound)
+		case "/repos/org/api/contents/CODEOWNERS":
+			if ref := r.URL.Query().Get("ref"); ref != "main" {
+				t.Errorf("Expected CODEOWNERS from the base branch, got ref %q", ref)
+			}
+			fmt.Fprintf(w, `{"type": "file", "encoding": "base64", "content": %q}`, codeowners)
+		case "/repos/org/api/pulls/12/files":
+			w.Write([]byte(`[{"filename": "server.go"}, {"filename": "docs/setup.md"}]`))
+		case "/orgs/org/teams/backend/members":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+	}))
+	pr := actionTestPR()
+	pr.Base.Ref = gh.String("main")
+
+	coverage, err := fetchReviewCoverage(context.Background(), client, "org", "api", pr, []string{"alice"})
+	if err != nil {
+		t.Fatalf("fetchReviewCoverage() returned error: %v", err)
+	}
+	if !coverage.HasCodeowners || len(coverage.Paths) != 2 {
+		t.Fatalf("Expected both changed files checked, got %+v", coverage)
+	}
+	if uncovered := coverage.Uncovered(); len(uncovered) != 1 || uncovered[0].Path != "server.go" {
+		t.Errorf("Expected server.go uncovered, got %+v", uncovered)
+	}
+	if !reflect.DeepEqual(coverage.UnresolvedTeams, []string{"@org/backend"}) {
+		t.Errorf("Expected unreadable team reported, got %v", coverage.UnresolvedTeams)
+	}
+}
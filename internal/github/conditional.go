@@ -0,0 +1,129 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+)
+
+// conditionalCacheTTL is how long list pages and their validators are kept
+// for revalidation. A page older than this is fetched in full again.
+const conditionalCacheTTL = 24 * time.Hour
+
+// conditionalCacheKey carries the cache holding list pages in a context
+type conditionalCacheKey struct{}
+
+// WithConditionalCache makes list requests under ctx conditional: pages are
+// stored in prCache with their ETag and Last-Modified, and sent again with
+// If-None-Match and If-Modified-Since. GitHub answers unchanged pages with
+// 304 Not Modified, which doesn't count against the rate limit, and the
+// stored page is returned in its place.
+func WithConditionalCache(ctx context.Context, prCache *cache.PRCache) context.Context {
+	if prCache == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, conditionalCacheKey{}, prCache)
+}
+
+// isListEndpoint reports whether a request path lists PRs or the
+// repositories they're fetched from
+func isListEndpoint(path string) bool {
+	for _, suffix := range []string{"/pulls", "/repos", "/search/issues", "/search/repositories"} {
+		if strings.HasSuffix(path, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// conditionalTransport is an http.RoundTripper sending list requests
+// conditionally when their context carries a cache
+type conditionalTransport struct {
+	next http.RoundTripper
+}
+
+// RoundTrip revalidates a stored list page, or stores a freshly fetched one
+func (t conditionalTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	prCache, _ := req.Context().Value(conditionalCacheKey{}).(*cache.PRCache)
+	if prCache == nil || req.Method != http.MethodGet || !isListEndpoint(req.URL.Path) {
+		return next.RoundTrip(req)
+	}
+
+	// Pages differ by what the caller may see, so they're kept per credential
+	credential := req.Header.Get("Authorization")
+	url := req.URL.String()
+	stored, found := prCache.GetConditionalResponse(credential, url)
+	if found {
+		req = req.Clone(req.Context())
+		if stored.ETag != "" {
+			req.Header.Set("If-None-Match", stored.ETag)
+		}
+		if stored.LastModified != "" {
+			req.Header.Set("If-Modified-Since", stored.LastModified)
+		}
+	}
+
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if found && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		return replayStoredResponse(resp, stored), nil
+	}
+
+	etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	if resp.StatusCode != http.StatusOK || (etag == "" && lastModified == "") {
+		return resp, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	_ = prCache.SetConditionalResponse(credential, url, &cache.ConditionalResponse{
+		ETag:         etag,
+		LastModified: lastModified,
+		Header:       resp.Header.Clone(),
+		Body:         body,
+	}, conditionalCacheTTL) // ignore cache errors
+	return resp, nil
+}
+
+// replayStoredResponse turns a 304 into the stored page. Headers of the 304,
+// like the current rate limit, replace the stored ones.
+func replayStoredResponse(notModified *http.Response, stored *cache.ConditionalResponse) *http.Response {
+	header := stored.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	for key, values := range notModified.Header {
+		header[key] = values
+	}
+	header.Set("Content-Length", strconv.Itoa(len(stored.Body)))
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         notModified.Proto,
+		ProtoMajor:    notModified.ProtoMajor,
+		ProtoMinor:    notModified.ProtoMinor,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(stored.Body)),
+		ContentLength: int64(len(stored.Body)),
+		Request:       notModified.Request,
+	}
+}
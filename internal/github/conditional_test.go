@@ -0,0 +1,71 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestConditionalListRequests tests that unchanged list pages are revalidated
+// with their ETag and served from the cache on 304
+func TestConditionalListRequests(t *testing.T) {
+	var full, notModified int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/org/api/pulls":
+			w.Header().Set("X-Ratelimit-Remaining", "4999")
+			if r.Header.Get("If-None-Match") == `W/"v1"` {
+				notModified++
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			full++
+			w.Header().Set("ETag", `W/"v1"`)
+			w.Write([]byte(`[{"number": 7, "title": "Add retries"}]`))
+		case "/repos/org/api/pulls/7":
+			if r.Header.Get("If-None-Match") != "" {
+				t.Error("Expected only list requests to be conditional")
+			}
+			w.Header().Set("ETag", `W/"pr"`)
+			w.Write([]byte(`{"number": 7}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	prCache, err := cache.NewPRCacheWithDir(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewPRCacheWithDir() error = %v", err)
+	}
+	client := gh.NewClient(&http.Client{Transport: conditionalTransport{}})
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+
+	ctx := WithConditionalCache(context.Background(), prCache)
+	for i := 0; i < 3; i++ {
+		prs, resp, err := client.PullRequests.List(ctx, "org", "api", nil)
+		if err != nil || len(prs) != 1 || prs[0].GetTitle() != "Add retries" {
+			t.Fatalf("List() #%d = %v, %v", i+1, prs, err)
+		}
+		if resp.Rate.Remaining != 4999 {
+			t.Errorf("Expected the current rate limit headers, got %+v", resp.Rate)
+		}
+	}
+	if full != 1 || notModified != 2 {
+		t.Errorf("Expected 1 full response then 2 revalidations, got %d and %d", full, notModified)
+	}
+
+	// Only list endpoints, and only with a cache in the context
+	if _, _, err := client.PullRequests.Get(ctx, "org", "api", 7); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if _, _, err := client.PullRequests.Get(ctx, "org", "api", 7); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if _, _, err := client.PullRequests.List(context.Background(), "org", "api", nil); err != nil || full != 2 {
+		t.Errorf("Expected an unconditional request without a cache, got %d full responses (%v)", full, err)
+	}
+}
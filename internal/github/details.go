@@ -0,0 +1,155 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// ReviewEvent is one submitted review in a PR's review timeline
+type ReviewEvent struct {
+	Reviewer    string
+	State       string // APPROVED, CHANGES_REQUESTED, COMMENTED, DISMISSED
+	Body        string
+	SubmittedAt time.Time
+}
+
+// PRDetails holds the review context shown in the PR detail pane. The
+// description comes with the PR list itself.
+type PRDetails struct {
+	Reviews            []ReviewEvent // Oldest first
+	RequestedReviewers []string
+	RequestedTeams     []string
+	Coverage           *ReviewCoverage // Approvals mapped onto CODEOWNERS; nil if CoverageErr is set
+	CoverageErr        error           // Coverage is best-effort and doesn't fail the rest
+	FailingChecks      []FailingCheck  // Failed check runs and commit statuses on the head commit
+	ChecksErr          error           // Checks are best-effort too
+	MissingChecks      []string        // Contexts branch protection requires that never reported
+	RequiredChecksErr  error           // Required checks are best-effort as well
+	FetchedAt          time.Time
+}
+
+// FailingCheck is a check run or commit status that failed on a PR's head commit
+type FailingCheck struct {
+	Name string
+	URL  string // Where the failure's logs live; may be empty
+}
+
+// failedConclusions are the check run conclusions that block a PR
+var failedConclusions = map[string]bool{
+	"failure":         true,
+	"timed_out":       true,
+	"cancelled":       true,
+	"action_required": true,
+}
+
+// FetchPRDetails fetches the review timeline and requested reviewers of a PR,
+// and checks the current approvals against its CODEOWNERS
+func FetchPRDetails(ctx context.Context, token string, pr *github.PullRequest) (*PRDetails, error) {
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return fetchPRDetails(ctx, client, pr)
+}
+
+// fetchPRDetails fetches PR details using the provided client
+func fetchPRDetails(ctx context.Context, client *github.Client, pr *github.PullRequest) (*PRDetails, error) {
+	owner, repo, err := prCoordinates(pr)
+	if err != nil {
+		return nil, err
+	}
+	resource := fmt.Sprintf("%s/%s#%d", owner, repo, pr.GetNumber())
+
+	details := &PRDetails{FetchedAt: time.Now()}
+
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		reviews, resp, err := client.PullRequests.ListReviews(ctx, owner, repo, pr.GetNumber(), opts)
+		if err != nil {
+			return nil, wrapActionError(resp, resource, err)
+		}
+		for _, review := range reviews {
+			// Pending reviews are drafts only their author can see
+			if review.GetState() == "PENDING" {
+				continue
+			}
+			details.Reviews = append(details.Reviews, ReviewEvent{
+				Reviewer:    review.GetUser().GetLogin(),
+				State:       review.GetState(),
+				Body:        review.GetBody(),
+				SubmittedAt: review.GetSubmittedAt().Time,
+			})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	sort.SliceStable(details.Reviews, func(i, j int) bool {
+		return details.Reviews[i].SubmittedAt.Before(details.Reviews[j].SubmittedAt)
+	})
+
+	// The list payload can be minutes old, so ask for the current requests
+	reviewers, resp, err := client.PullRequests.ListReviewers(ctx, owner, repo, pr.GetNumber(), nil)
+	if err != nil {
+		return nil, wrapActionError(resp, resource, err)
+	}
+	for _, user := range reviewers.Users {
+		details.RequestedReviewers = append(details.RequestedReviewers, user.GetLogin())
+	}
+	for _, team := range reviewers.Teams {
+		details.RequestedTeams = append(details.RequestedTeams, team.GetSlug())
+	}
+
+	details.Coverage, details.CoverageErr = fetchReviewCoverage(ctx, client, owner, repo, pr, CurrentApprovers(details.Reviews))
+	var reported []string
+	if sha := pr.GetHead().GetSHA(); sha != "" {
+		details.FailingChecks, reported, details.ChecksErr = fetchHeadChecks(ctx, client, owner, repo, sha)
+	}
+	if base := pr.GetBase().GetRef(); base != "" && details.ChecksErr == nil {
+		required, err := fetchRequiredChecks(ctx, client, owner, repo, base)
+		if err != nil {
+			details.RequiredChecksErr = err
+		} else {
+			details.MissingChecks = MissingChecks(required, reported)
+		}
+	}
+
+	return details, nil
+}
+
+// fetchHeadChecks lists the latest check runs and commit statuses of a
+// commit that failed, check runs first, along with the names of every check
+// and status that reported
+func fetchHeadChecks(ctx context.Context, client *github.Client, owner, repo, sha string) ([]FailingCheck, []string, error) {
+	resource := fmt.Sprintf("%s/%s@%.7s", owner, repo, sha)
+
+	var failing []FailingCheck
+	var reported []string
+	runs, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, sha, &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}})
+	if err != nil {
+		return nil, nil, wrapActionError(resp, resource, err)
+	}
+	for _, run := range runs.CheckRuns {
+		reported = append(reported, run.GetName())
+		if run.GetStatus() == "completed" && failedConclusions[run.GetConclusion()] {
+			failing = append(failing, FailingCheck{Name: run.GetName(), URL: run.GetHTMLURL()})
+		}
+	}
+
+	combined, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, sha, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, nil, wrapActionError(resp, resource, err)
+	}
+	for _, status := range combined.Statuses {
+		reported = append(reported, status.GetContext())
+		if status.GetState() == "failure" || status.GetState() == "error" {
+			failing = append(failing, FailingCheck{Name: status.GetContext(), URL: status.GetTargetURL()})
+		}
+	}
+	return failing, reported, nil
+}
@@ -0,0 +1,110 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+func TestFetchPRDetails(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The repository has no CODEOWNERS file
+		if strings.HasPrefix(r.URL.Path, "/repos/org/api/contents/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.URL.Path {
+		case "/repos/org/api/pulls/12/reviews":
+			w.Write([]byte(`[
+				{"user": {"login": "carol"}, "state": "APPROVED", "submitted_at": "2024-03-02T10:00:00Z"},
+				{"user": {"login": "bob"}, "state": "CHANGES_REQUESTED", "body": "Needs tests", "submitted_at": "2024-03-01T10:00:00Z"},
+				{"user": {"login": "dave"}, "state": "PENDING"}
+			]`))
+		case "/repos/org/api/pulls/12/requested_reviewers":
+			w.Write([]byte(`{"users": [{"login": "erin"}], "teams": [{"slug": "platform"}]}`))
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+	}))
+
+	details, err := fetchPRDetails(context.Background(), client, actionTestPR())
+	if err != nil {
+		t.Fatalf("fetchPRDetails() returned error: %v", err)
+	}
+	if len(details.Reviews) != 2 || details.Reviews[0].Reviewer != "bob" || details.Reviews[0].Body != "Needs tests" {
+		t.Errorf("Expected submitted reviews oldest first, got %+v", details.Reviews)
+	}
+	if len(details.RequestedReviewers) != 1 || details.RequestedReviewers[0] != "erin" {
+		t.Errorf("Expected requested reviewer erin, got %v", details.RequestedReviewers)
+	}
+	if len(details.RequestedTeams) != 1 || details.RequestedTeams[0] != "platform" {
+		t.Errorf("Expected requested team platform, got %v", details.RequestedTeams)
+	}
+	if details.CoverageErr != nil || details.Coverage == nil || details.Coverage.HasCodeowners {
+		t.Errorf("Expected coverage without CODEOWNERS, got %+v (%v)", details.Coverage, details.CoverageErr)
+	}
+	if got := details.Coverage.Approvers; len(got) != 1 || got[0] != "carol" {
+		t.Errorf("Expected carol as the only approver, got %v", got)
+	}
+}
+
+func TestFetchPRDetails_NotFound(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	if _, err := fetchPRDetails(context.Background(), client, actionTestPR()); err == nil {
+		t.Fatal("Expected error for missing PR")
+	}
+}
+
+// TestFetchPRDetails_Replayed tests enhancement against a recorded session
+func TestFetchPRDetails_Replayed(t *testing.T) {
+	replayFixtures(t, "pr_details")
+	pr := &gh.PullRequest{
+		Number: gh.Int(42),
+		Head:   &gh.PullRequestBranch{SHA: gh.String("8f3c2b1a9d4e5f60718293a4b5c6d7e8f9012345")},
+		Base: &gh.PullRequestBranch{Ref: gh.String("main"), Repo: &gh.Repository{
+			Name:  gh.String("api"),
+			Owner: &gh.User{Login: gh.String("octo-org")},
+		}},
+	}
+
+	details, err := FetchPRDetails(context.Background(), "fake-token", pr)
+	if err != nil {
+		t.Fatalf("FetchPRDetails() failed: %v", err)
+	}
+
+	// Pending reviews are hidden and the rest sorted oldest first
+	if len(details.Reviews) != 2 || details.Reviews[0].Reviewer != "bob" || details.Reviews[1].State != "APPROVED" {
+		t.Errorf("Expected bob's comment then carol's approval, got %+v", details.Reviews)
+	}
+	if strings.Join(details.RequestedReviewers, ",") != "erin" || strings.Join(details.RequestedTeams, ",") != "platform" {
+		t.Errorf("Expected erin and platform requested, got %v %v", details.RequestedReviewers, details.RequestedTeams)
+	}
+
+	// CODEOWNERS falls back to the repo root; docs aren't covered by carol
+	if details.CoverageErr != nil || !details.Coverage.HasCodeowners {
+		t.Fatalf("Expected CODEOWNERS coverage, got %v", details.CoverageErr)
+	}
+	uncovered := details.Coverage.Uncovered()
+	if len(uncovered) != 1 || uncovered[0].Path != "README.md" {
+		t.Errorf("Expected only README.md uncovered, got %+v", uncovered)
+	}
+
+	// Failed check runs come before failed commit statuses
+	if details.ChecksErr != nil || len(details.FailingChecks) != 2 {
+		t.Fatalf("Expected 2 failing checks, got %+v (%v)", details.FailingChecks, details.ChecksErr)
+	}
+	if details.FailingChecks[0].Name != "e2e-chrome" || details.FailingChecks[1].Name != "security/snyk" || details.FailingChecks[1].URL == "" {
+		t.Errorf("Unexpected failing checks: %+v", details.FailingChecks)
+	}
+
+	// build failed and ci/jenkins passed, but the CLA check never reported
+	if details.RequiredChecksErr != nil || strings.Join(details.MissingChecks, ",") != "license/cla" {
+		t.Errorf("Expected license/cla missing, got %v (%v)", details.MissingChecks, details.RequiredChecksErr)
+	}
+}
@@ -0,0 +1,75 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// maxDiffFiles caps the files fetched for the diff viewer, which is meant
+// for PRs small enough to review in the terminal
+const maxDiffFiles = 300
+
+// DiffFile is one changed file of a PR with its unified diff hunks
+type DiffFile struct {
+	Filename         string
+	PreviousFilename string // Set for renames
+	Status           string // added, removed, modified, renamed, copied, changed
+	Additions        int
+	Deletions        int
+	Patch            string // "" for binary files and diffs too large for the API
+}
+
+// PRDiff holds the changed files of a PR for the diff viewer
+type PRDiff struct {
+	Files     []DiffFile
+	Truncated bool // More than maxDiffFiles files changed
+	FetchedAt time.Time
+}
+
+// FetchPRDiff fetches the changed files of a PR with their patches
+func FetchPRDiff(ctx context.Context, token string, pr *github.PullRequest) (*PRDiff, error) {
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return fetchPRDiff(ctx, client, pr)
+}
+
+// fetchPRDiff lists the PR's files using the provided client
+func fetchPRDiff(ctx context.Context, client *github.Client, pr *github.PullRequest) (*PRDiff, error) {
+	owner, repo, err := prCoordinates(pr)
+	if err != nil {
+		return nil, err
+	}
+	resource := fmt.Sprintf("%s/%s#%d", owner, repo, pr.GetNumber())
+
+	diff := &PRDiff{FetchedAt: time.Now()}
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		page, resp, err := client.PullRequests.ListFiles(ctx, owner, repo, pr.GetNumber(), opts)
+		if err != nil {
+			return nil, wrapActionError(resp, resource, err)
+		}
+		for _, file := range page {
+			diff.Files = append(diff.Files, DiffFile{
+				Filename:         file.GetFilename(),
+				PreviousFilename: file.GetPreviousFilename(),
+				Status:           file.GetStatus(),
+				Additions:        file.GetAdditions(),
+				Deletions:        file.GetDeletions(),
+				Patch:            file.GetPatch(),
+			})
+		}
+		if resp.NextPage == 0 {
+			return diff, nil
+		}
+		if len(diff.Files) >= maxDiffFiles {
+			diff.Truncated = true
+			return diff, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
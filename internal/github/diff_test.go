@@ -0,0 +1,60 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// TestFetchPRDiff tests listing a PR's files with their patches
+func TestFetchPRDiff(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/org/api/pulls/12/files" {
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+		w.Write([]byte(`[
+			{"filename": "retry.go", "status": "modified", "additions": 2, "deletions": 1, "patch": "@@ -1,2 +1,3 @@\n-a\n+b\n+c"},
+			{"filename": "logo.png", "previous_filename": "old.png", "status": "renamed"}
+		]`))
+	}))
+
+	diff, err := fetchPRDiff(context.Background(), client, actionTestPR())
+	if err != nil {
+		t.Fatalf("fetchPRDiff() returned error: %v", err)
+	}
+	if len(diff.Files) != 2 || diff.Truncated || diff.FetchedAt.IsZero() {
+		t.Fatalf("Expected two files, got %+v", diff)
+	}
+	if file := diff.Files[0]; file.Filename != "retry.go" || file.Additions != 2 || file.Deletions != 1 || file.Patch == "" {
+		t.Errorf("Unexpected first file %+v", file)
+	}
+	if file := diff.Files[1]; file.PreviousFilename != "old.png" || file.Patch != "" {
+		t.Errorf("Expected a renamed binary file without patch, got %+v", file)
+	}
+}
+
+// TestFetchPRDiff_Truncated tests that huge PRs stop after maxDiffFiles files
+func TestFetchPRDiff_Truncated(t *testing.T) {
+	pages := 0
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/repos/org/api/pulls/12/files?page=%d>; rel="next"`, pages+1))
+		w.Write([]byte("["))
+		for i := 0; i < 100; i++ {
+			if i > 0 {
+				w.Write([]byte(","))
+			}
+			fmt.Fprintf(w, `{"filename": "f%d-%d.go"}`, pages, i)
+		}
+		w.Write([]byte("]"))
+	}))
+
+	diff, err := fetchPRDiff(context.Background(), client, actionTestPR())
+	if err != nil {
+		t.Fatalf("fetchPRDiff() returned error: %v", err)
+	}
+	if !diff.Truncated || len(diff.Files) != maxDiffFiles || pages != 3 {
+		t.Errorf("Expected %d files after 3 pages, got %d after %d (truncated %v)", maxDiffFiles, len(diff.Files), pages, diff.Truncated)
+	}
+}
@@ -0,0 +1,32 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// draftMutations switch a PR's draft state; the REST API can't
+var draftMutations = map[bool]string{
+	true:  `mutation($id: ID!) { convertPullRequestToDraft(input: {pullRequestId: $id}) { pullRequest { isDraft } } }`,
+	false: `mutation($id: ID!) { markPullRequestReadyForReview(input: {pullRequestId: $id}) { pullRequest { isDraft } } }`,
+}
+
+// SetPullRequestDraft converts a PR to a draft, or marks a draft PR ready for review
+func SetPullRequestDraft(ctx context.Context, token string, pr *github.PullRequest, draft bool) error {
+	client, err := NewClient(token)
+	if err != nil {
+		return err
+	}
+	return setPullRequestDraft(ctx, client, pr, draft)
+}
+
+// setPullRequestDraft switches the draft state using the provided client
+func setPullRequestDraft(ctx context.Context, client *github.Client, pr *github.PullRequest, draft bool) error {
+	owner, repo, err := prCoordinates(pr)
+	if err != nil {
+		return err
+	}
+	return mutatePullRequest(ctx, client, fmt.Sprintf("%s/%s#%d", owner, repo, pr.GetNumber()), pr, draftMutations[draft], nil)
+}
@@ -0,0 +1,58 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestSetPullRequestDraft tests both mutations and GraphQL errors
+func TestSetPullRequestDraft(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.Variables["id"] != "PR_kwDO" {
+			t.Errorf("Expected the PR's node ID, got %v", body.Variables)
+		}
+		queries = append(queries, body.Query)
+		if len(queries) == 3 {
+			w.Write([]byte(`{"errors": [{"message": "Resource not accessible by integration"}]}`))
+			return
+		}
+		w.Write([]byte(`{"data": {}}`))
+	}))
+	defer server.Close()
+	t.Cleanup(func() { _ = SetEnterpriseURLs("", "") })
+	if err := SetEnterpriseURLs(server.URL, ""); err != nil {
+		t.Fatalf("SetEnterpriseURLs() failed: %v", err)
+	}
+
+	pr := actionTestPR()
+	pr.NodeID = gh.String("PR_kwDO")
+	for _, draft := range []bool{true, false} {
+		if err := SetPullRequestDraft(context.Background(), "fake-token", pr, draft); err != nil {
+			t.Fatalf("SetPullRequestDraft(%v) returned error: %v", draft, err)
+		}
+	}
+	if len(queries) != 2 || !strings.Contains(queries[0], "convertPullRequestToDraft") || !strings.Contains(queries[1], "markPullRequestReadyForReview") {
+		t.Errorf("Expected convert then ready mutations, got %v", queries)
+	}
+
+	err := SetPullRequestDraft(context.Background(), "fake-token", pr, true)
+	if err == nil || !strings.Contains(err.Error(), "org/api#12: Resource not accessible") {
+		t.Errorf("Expected the GraphQL error, got %v", err)
+	}
+
+	if err := SetPullRequestDraft(context.Background(), "fake-token", actionTestPR(), true); err == nil {
+		t.Error("Expected an error for a PR without a node ID")
+	}
+}
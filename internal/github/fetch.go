@@ -19,6 +19,90 @@ type PRFilter struct {
 	ExcludeAuthors []string // Authors to exclude (e.g., "renovate[bot]", "dependabot[bot]")
 	ExcludeTitles  []string // Title patterns to exclude (e.g., "chore(deps)", "Update")
 	IncludeDrafts  bool     // Whether to include draft PRs
+	Milestone      string   // Only PRs in this milestone; "none" for PRs without one
+}
+
+const (
+	// prListPageSize is how many open PRs are listed per request
+	prListPageSize = 100
+
+	// defaultMaxPages is how many pages of open PRs are listed per repository,
+	// or of search results in search mode, unless max_pages says otherwise
+	defaultMaxPages = 3
+
+	// SearchResultsKey counts a search mode tab's results in its PRCounts,
+	// since search lists PRs rather than repositories
+	SearchResultsKey = "search results"
+
+	// AllPRs as a config's MaxPRs keeps every PR the page depth lists, for
+	// callers that show them a page at a time
+	AllPRs = -1
+)
+
+// RepoPRCount compares the open PRs listed for a repository with how many it has
+type RepoPRCount struct {
+	Open    int   // Open PRs in the repository
+	Fetched int   // Open PRs listed, before filtering; fewer than Open when max_pages cut the listing short
+	Err     error // Why the repository's PRs couldn't be listed; Open and Fetched are zero
+}
+
+// Truncated reports whether the page depth left some of the repository's open PRs unlisted
+func (c RepoPRCount) Truncated() bool {
+	return c.Fetched < c.Open
+}
+
+// PRCounts holds open PR counts keyed by repository full name ("owner/repo")
+type PRCounts map[string]RepoPRCount
+
+// Truncated reports whether the page depth left any repository's open PRs unlisted
+func (c PRCounts) Truncated() bool {
+	for _, count := range c {
+		if count.Truncated() {
+			return true
+		}
+	}
+	return false
+}
+
+// Fetched totals the open PRs listed across repositories, before filtering
+func (c PRCounts) Fetched() int {
+	fetched := 0
+	for _, count := range c {
+		fetched += count.Fetched
+	}
+	return fetched
+}
+
+// Failed returns the repositories whose PRs couldn't be listed, sorted by name
+func (c PRCounts) Failed() []string {
+	var failed []string
+	for repo, count := range c {
+		if count.Err != nil {
+			failed = append(failed, repo)
+		}
+	}
+	sort.Strings(failed)
+	return failed
+}
+
+// MaxPages returns the configured page depth per repository. Other
+// providers list to the same depth.
+func MaxPages(cfg *config.Config) int {
+	if cfg.MaxPages > 0 {
+		return cfg.MaxPages
+	}
+	return defaultMaxPages
+}
+
+// NoRepositoriesError reports that a tab's scope (teams, topics or organization)
+// resolved to zero repositories, which usually points at a config mistake
+type NoRepositoriesError struct {
+	Mode    string   // Fetch mode whose scope was empty
+	Sources []string // Teams, topics or organization that resolved to nothing
+}
+
+func (e *NoRepositoriesError) Error() string {
+	return fmt.Sprintf("%s %s resolved to 0 repositories - check your configuration", e.Mode, strings.Join(e.Sources, ", "))
 }
 
 // DefaultFilter returns a sensible default filter that excludes common bots
@@ -75,106 +159,166 @@ func shouldExcludePR(pr *github.PullRequest, filter *PRFilter) bool {
 		}
 	}
 
+	if filter.Milestone != "" && !InMilestone(pr, filter.Milestone) {
+		return true
+	}
+
 	return false
 }
 
+// InMilestone reports whether a PR is in the milestone with this title, or
+// has no milestone when the title is "none"
+func InMilestone(pr *github.PullRequest, milestone string) bool {
+	if strings.EqualFold(milestone, "none") {
+		return pr.GetMilestone() == nil
+	}
+	return strings.EqualFold(pr.GetMilestone().GetTitle(), milestone)
+}
+
 // FetchPRsFromConfig fetches PRs based on the configuration mode
 func FetchPRsFromConfig(ctx context.Context, cfg *config.Config, token string) ([]*github.PullRequest, error) {
+	prs, _, err := FetchPRsWithCounts(ctx, cfg, token)
+	return prs, err
+}
+
+// FetchPRsWithCounts fetches PRs like FetchPRsFromConfig and also returns
+// how many open PRs each listed repository has. Search mode doesn't list
+// repositories, so it counts its results under SearchResultsKey.
+func FetchPRsWithCounts(ctx context.Context, cfg *config.Config, token string) ([]*github.PullRequest, PRCounts, error) {
+	prs, counts, err := listPRsWithCounts(ctx, cfg, token)
+	if err != nil {
+		return nil, nil, err
+	}
+	return LimitPRs(cfg, prs), counts, nil
+}
+
+// listPRsWithCounts lists every PR the page depth reaches, before the PR
+// limit is applied
+func listPRsWithCounts(ctx context.Context, cfg *config.Config, token string) ([]*github.PullRequest, PRCounts, error) {
 	client, err := NewClient(token)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Create filter based on config
 	filter := createFilterFromConfig(cfg)
+	pages := MaxPages(cfg)
 
 	// Fetch PRs directly based on mode - no need for complex strategy pattern
 	var prs []*github.PullRequest
+	counts := PRCounts{}
 	switch cfg.Mode {
 	case "repos":
-		prs, err = fetchOpenPRsWithFilter(ctx, client, cfg.Repos, filter)
+		prs, counts, err = fetchOpenPRsWithFilter(ctx, client, cfg.Repos, filter, pages)
 	case "organization":
-		prs, err = fetchPRsFromOrganizationWithFilter(ctx, client, cfg.Organization, filter)
+		prs, counts, err = fetchPRsFromOrganizationWithFilter(ctx, client, cfg.Organization, filter, pages)
 	case "teams":
-		prs, err = fetchPRsFromTeamsWithFilter(ctx, client, cfg.Organization, cfg.Teams, filter)
+		prs, counts, err = fetchPRsFromTeamsWithFilter(ctx, client, cfg.Organization, cfg.Teams, filter, pages)
 	case "search":
-		prs, err = fetchPRsFromSearchWithFilter(ctx, client, cfg.SearchQuery, filter)
+		prs, counts, err = fetchPRsFromSearchWithFilter(ctx, client, cfg.SearchQuery, filter, pages)
 	case "topics":
-		prs, err = fetchPRsFromTopicsWithFilter(ctx, client, cfg.TopicOrg, cfg.Topics, filter)
+		prs, counts, err = fetchPRsFromTopicsWithFilter(ctx, client, cfg.TopicOrg, cfg.Topics, filter, pages)
 	default:
 		// fallback to repo mode
-		prs, err = fetchOpenPRsWithFilter(ctx, client, cfg.Repos, filter)
+		prs, counts, err = fetchOpenPRsWithFilter(ctx, client, cfg.Repos, filter, pages)
 	}
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	// Apply global PR limit
+	if cfg.Project != "" {
+		if prs, err = onProjectBoard(ctx, client, prs, cfg.Project, cfg.ProjectStatus); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return prs, counts, nil
+}
+
+// LimitPRs applies the configured PR limit, keeping the most recently updated
+// PRs; AllPRs keeps them all
+func LimitPRs(cfg *config.Config, prs []*github.PullRequest) []*github.PullRequest {
 	maxPRs := cfg.MaxPRs
 	if maxPRs == 0 {
 		maxPRs = 50 // Default limit
 	}
 
-	if len(prs) > maxPRs {
+	if maxPRs > 0 && len(prs) > maxPRs {
 		// Sort by updated time (most recent first) and take the top N
 		sort.Slice(prs, func(i, j int) bool {
 			return prs[i].GetUpdatedAt().Time.After(prs[j].GetUpdatedAt().Time)
 		})
 		prs = prs[:maxPRs]
 	}
+	return prs
+}
 
-	return prs, nil
+// FilterPRs drops PRs excluded by the configuration's bot, author, title and
+// draft settings. Other providers use it to filter the same way.
+func FilterPRs(cfg *config.Config, prs []*github.PullRequest) []*github.PullRequest {
+	filter := createFilterFromConfig(cfg)
+	kept := make([]*github.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		if !shouldExcludePR(pr, filter) {
+			kept = append(kept, pr)
+		}
+	}
+	return kept
 }
 
 // FetchPRsFromConfigWithCache fetches PRs using caching for improved performance
 func FetchPRsFromConfigWithCache(ctx context.Context, cfg *config.Config, token string, prCache *cache.PRCache) ([]*github.PullRequest, error) {
+	prs, _, err := FetchPRsWithCountsCached(ctx, cfg, token, prCache)
+	return prs, err
+}
+
+// FetchPRsWithCountsCached fetches PRs and open PR counts like
+// FetchPRsWithCounts, serving the PR list from the cache when it is fresh.
+// The list is cached before the PR limit is applied, so any limit can be
+// served from it. Counts aren't cached, so they are nil on a cache hit.
+func FetchPRsWithCountsCached(ctx context.Context, cfg *config.Config, token string, prCache *cache.PRCache) ([]*github.PullRequest, PRCounts, error) {
 	// Try cache first if available
 	if prCache != nil {
 		filterKey := generateCacheKey(cfg)
 		cacheKey := prCache.GenerateFetcherKey(cfg.Mode, filterKey)
 		if cachedPRs, found := prCache.GetPRList(cacheKey); found {
 			// Apply PR limit to cached data too
-			maxPRs := cfg.MaxPRs
-			if maxPRs == 0 {
-				maxPRs = 50
-			}
-			if len(cachedPRs) > maxPRs {
-				cachedPRs = cachedPRs[:maxPRs]
-			}
-			return cachedPRs, nil
+			return LimitPRs(cfg, cachedPRs), nil, nil
 		}
 	}
 
-	// Cache miss or no cache - fetch fresh data
-	prs, err := FetchPRsFromConfig(ctx, cfg, token)
+	// Cache miss or no cache - fetch fresh data, revalidating unchanged pages
+	prs, counts, err := listPRsWithCounts(WithConditionalCache(ctx, prCache), cfg, token)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Store in cache if available
 	if prCache != nil {
 		filterKey := generateCacheKey(cfg)
 		cacheKey := prCache.GenerateFetcherKey(cfg.Mode, filterKey)
-		cacheTTL := 5 * time.Minute
+		cacheTTL := prListCacheTTL(token)
 		_ = prCache.SetPRList(cacheKey, prs, cacheTTL) // ignore cache errors
 	}
 
-	// Apply global PR limit
-	maxPRs := cfg.MaxPRs
-	if maxPRs == 0 {
-		maxPRs = 50 // Default limit
-	}
+	// Apply global PR limit to a copy, leaving the cached list whole
+	return LimitPRs(cfg, append([]*github.PullRequest(nil), prs...)), counts, nil
+}
 
-	if len(prs) > maxPRs {
-		// Sort by updated time (most recent first) and take the top N
-		sort.Slice(prs, func(i, j int) bool {
-			return prs[i].GetUpdatedAt().Time.After(prs[j].GetUpdatedAt().Time)
-		})
-		prs = prs[:maxPRs]
+// CachedPRsFromConfig returns the last cached PR list for the configuration,
+// even if it has expired, along with when it was cached. It never calls the API.
+func CachedPRsFromConfig(cfg *config.Config, prCache *cache.PRCache) ([]*github.PullRequest, time.Time, bool) {
+	if prCache == nil {
+		return nil, time.Time{}, false
 	}
 
-	return prs, nil
+	cacheKey := prCache.GenerateFetcherKey(cfg.Mode, generateCacheKey(cfg))
+	prs, cachedAt, found := prCache.GetStalePRList(cacheKey)
+	if !found {
+		return nil, time.Time{}, false
+	}
+	return LimitPRs(cfg, prs), cachedAt, true
 }
 
 // FetchPRsFromConfigOptimized fetches PRs using optimizations (Cache + Rate Limiting)
@@ -207,6 +351,19 @@ func generateCacheKey(cfg *config.Config) string {
 	}
 	parts = append(parts, cfg.ExcludeAuthors...)
 	parts = append(parts, cfg.ExcludeTitles...)
+	if cfg.Milestone != "" {
+		parts = append(parts, "milestone-"+cfg.Milestone)
+	}
+	if cfg.Project != "" {
+		parts = append(parts, "project-"+cfg.Project, "status-"+cfg.ProjectStatus)
+	}
+	if cfg.MaxPages > 0 {
+		parts = append(parts, fmt.Sprintf("pages-%d", cfg.MaxPages))
+	}
+	if web := WebURL(); web != defaultWebURL {
+		// Same-named repos on another instance are different repos
+		parts = append(parts, web)
+	}
 
 	return fmt.Sprintf("%s:%s", cfg.Mode, strings.Join(parts, ","))
 }
@@ -241,6 +398,7 @@ func createFilterFromConfig(cfg *config.Config) *PRFilter {
 	filter.ExcludeAuthors = append(filter.ExcludeAuthors, cfg.ExcludeAuthors...)
 	filter.ExcludeTitles = append(filter.ExcludeTitles, cfg.ExcludeTitles...)
 	filter.IncludeDrafts = cfg.IncludeDrafts
+	filter.Milestone = cfg.Milestone
 
 	return filter
 }
@@ -251,14 +409,18 @@ func FetchOpenPRsWithFilter(ctx context.Context, repos []string, token string, f
 	if err != nil {
 		return nil, err
 	}
-	return fetchOpenPRsWithFilter(ctx, client, repos, filter)
+	prs, _, err := fetchOpenPRsWithFilter(ctx, client, repos, filter, defaultMaxPages)
+	return prs, err
 }
 
-// fetchOpenPRsWithFilter fetches PRs with filtering options (used by ReposFetcher)
-func fetchOpenPRsWithFilter(ctx context.Context, client *github.Client, repos []string, filter *PRFilter) ([]*github.PullRequest, error) {
+// fetchOpenPRsWithFilter lists up to maxPages pages of open PRs per repository
+// with filtering options, counting each repository's open PRs
+func fetchOpenPRsWithFilter(ctx context.Context, client *github.Client, repos []string, filter *PRFilter, maxPages int) ([]*github.PullRequest, PRCounts, error) {
 	type repoResult struct {
-		prs []*github.PullRequest
-		err error
+		repo  string
+		prs   []*github.PullRequest
+		count RepoPRCount
+		err   error
 	}
 
 	const maxConcurrent = 15
@@ -267,11 +429,21 @@ func fetchOpenPRsWithFilter(ctx context.Context, client *github.Client, repos []
 
 	var wg sync.WaitGroup
 
-	for _, repoFullName := range repos {
+	for i, repoFullName := range repos {
 		wg.Add(1)
-		go func(repo string) {
+		go func(repo string, delay time.Duration) {
 			defer wg.Done()
 
+			// Stagger the start of each repo's requests under a ramp
+			if delay > 0 {
+				select {
+				case <-ctx.Done():
+					results <- repoResult{err: ctx.Err()}
+					return
+				case <-time.After(delay):
+				}
+			}
+
 			select {
 			case <-ctx.Done():
 				results <- repoResult{err: ctx.Err()}
@@ -282,7 +454,7 @@ func fetchOpenPRsWithFilter(ctx context.Context, client *github.Client, repos []
 
 			parts := strings.Split(repo, "/")
 			if len(parts) != 2 {
-				results <- repoResult{err: errors.NewRepositoryInvalidError(repo, nil)}
+				results <- repoResult{repo: repo, err: errors.NewRepositoryInvalidError(repo, nil)}
 				return
 			}
 			owner, repoName := parts[0], parts[1]
@@ -291,11 +463,12 @@ func fetchOpenPRsWithFilter(ctx context.Context, client *github.Client, repos []
 				State:       "open",
 				Sort:        "updated",
 				Direction:   "desc",
-				ListOptions: github.ListOptions{PerPage: 50},
+				ListOptions: github.ListOptions{PerPage: prListPageSize},
 			}
 
 			var repoPRs []*github.PullRequest
-			for {
+			var count RepoPRCount
+			for page := 1; ; page++ {
 				select {
 				case <-ctx.Done():
 					results <- repoResult{err: ctx.Err()}
@@ -305,24 +478,39 @@ func fetchOpenPRsWithFilter(ctx context.Context, client *github.Client, repos []
 
 				prs, resp, err := client.PullRequests.List(ctx, owner, repoName, opts)
 				if err != nil {
-					results <- repoResult{err: fmt.Errorf("failed to fetch PRs from %s: %w", repo, err)}
+					if ctx.Err() != nil {
+						results <- repoResult{err: ctx.Err()}
+						return
+					}
+					results <- repoResult{repo: repo, err: wrapActionError(resp, repo, err)}
 					return
 				}
 
+				count.Fetched += len(prs)
 				for _, pr := range prs {
 					if !shouldExcludePR(pr, filter) {
 						repoPRs = append(repoPRs, pr)
 					}
 				}
 
-				if resp.NextPage == 0 || len(repoPRs) >= 10 {
+				if resp.NextPage == 0 {
+					count.Open = count.Fetched
+					break
+				}
+				if page >= maxPages {
+					// Count what the page depth leaves out; the last page is a fair estimate if that fails
+					open, _, err := countOpenPRs(ctx, client, owner, repoName)
+					if err != nil {
+						open = resp.LastPage * prListPageSize
+					}
+					count.Open = open
 					break
 				}
 				opts.Page = resp.NextPage
 			}
 
-			results <- repoResult{prs: repoPRs}
-		}(repoFullName)
+			results <- repoResult{repo: repo, prs: repoPRs, count: count}
+		}(repoFullName, rampDelay(ctx, i, len(repos)))
 	}
 
 	go func() {
@@ -345,26 +533,45 @@ func fetchOpenPRsWithFilter(ctx context.Context, client *github.Client, repos []
 	}()
 
 	var allPRs []*github.PullRequest
+	counts := PRCounts{}
 	for result := range results {
 		if result.err != nil {
-			// Skip failed repos - could log the error if needed
+			// A failed repo doesn't fail the tab; its error is counted so the
+			// tab can warn about it. Cancellation has no repo to warn about.
+			if result.repo != "" {
+				counts[result.repo] = RepoPRCount{Err: result.err}
+			}
 			continue
 		}
 		allPRs = append(allPRs, result.prs...)
+		counts[result.repo] = result.count
 	}
 
-	// Note: Could log summary if repos were skipped, but skipping for now
-	// Note: Could check if we successfully fetched from repositories, but allowing empty results
-
 	sort.Slice(allPRs, func(i, j int) bool {
 		return allPRs[i].GetUpdatedAt().Time.After(allPRs[j].GetUpdatedAt().Time)
 	})
 
-	return allPRs, nil
+	return allPRs, counts, nil
+}
+
+// countOpenPRs counts a repository's open PRs from the last page of a
+// 1-per-page listing, costing a single request
+func countOpenPRs(ctx context.Context, client *github.Client, owner, repo string) (int, *github.Response, error) {
+	prs, resp, err := client.PullRequests.List(ctx, owner, repo, &github.PullRequestListOptions{
+		State:       "open",
+		ListOptions: github.ListOptions{PerPage: 1},
+	})
+	if err != nil {
+		return 0, resp, err
+	}
+	if resp.LastPage > 0 {
+		return resp.LastPage, resp, nil
+	}
+	return len(prs), resp, nil
 }
 
 // fetchPRsFromOrganizationWithFilter fetches PRs from an organization (used by OrganizationFetcher)
-func fetchPRsFromOrganizationWithFilter(ctx context.Context, client *github.Client, org string, filter *PRFilter) ([]*github.PullRequest, error) {
+func fetchPRsFromOrganizationWithFilter(ctx context.Context, client *github.Client, org string, filter *PRFilter, maxPages int) ([]*github.PullRequest, PRCounts, error) {
 	opts := &github.RepositoryListByOrgOptions{
 		ListOptions: github.ListOptions{PerPage: 100},
 		Type:        "all",
@@ -375,7 +582,10 @@ func fetchPRsFromOrganizationWithFilter(ctx context.Context, client *github.Clie
 	for {
 		repos, resp, err := client.Repositories.ListByOrg(ctx, org, opts)
 		if err != nil {
-			return nil, fmt.Errorf("failed to list repositories for org %s: %w", org, err)
+			if accessErr := tokenAccessError(resp, "organization "+org, err); accessErr != nil {
+				return nil, nil, accessErr
+			}
+			return nil, nil, fmt.Errorf("failed to list repositories for org %s: %w", org, err)
 		}
 
 		for _, repo := range repos {
@@ -393,19 +603,30 @@ func fetchPRsFromOrganizationWithFilter(ctx context.Context, client *github.Clie
 		opts.Page = resp.NextPage
 	}
 
-	return fetchOpenPRsWithFilter(ctx, client, allRepos, filter)
+	if len(allRepos) == 0 {
+		return nil, nil, &NoRepositoriesError{Mode: "organization", Sources: []string{org}}
+	}
+
+	return fetchOpenPRsWithFilter(ctx, client, allRepos, filter, maxPages)
 }
 
 // fetchPRsFromTeamsWithFilter fetches PRs from team repositories (used by TeamsFetcher)
-func fetchPRsFromTeamsWithFilter(ctx context.Context, client *github.Client, org string, teams []string, filter *PRFilter) ([]*github.PullRequest, error) {
+func fetchPRsFromTeamsWithFilter(ctx context.Context, client *github.Client, org string, teams []string, filter *PRFilter, maxPages int) ([]*github.PullRequest, PRCounts, error) {
 	repoSet := make(map[string]bool)
+	var emptyTeams []string
 
 	for _, teamSlug := range teams {
 		opts := &github.ListOptions{PerPage: 100}
+		teamRepos := 0
 
 		for {
 			repos, resp, err := client.Teams.ListTeamReposBySlug(ctx, org, teamSlug, opts)
 			if err != nil {
+				// The organization's single sign-on or the token's grant
+				// blocks every team alike, so say so instead of listing none
+				if accessErr := tokenAccessError(resp, "team "+org+"/"+teamSlug, err); accessErr != nil {
+					return nil, nil, accessErr
+				}
 				// Break out of pagination loop, but continue with next team
 				break
 			}
@@ -416,6 +637,7 @@ func fetchPRsFromTeamsWithFilter(ctx context.Context, client *github.Client, org
 				}
 				repoName := fmt.Sprintf("%s/%s", org, repo.GetName())
 				repoSet[repoName] = true
+				teamRepos++
 			}
 
 			if resp.NextPage == 0 {
@@ -423,6 +645,10 @@ func fetchPRsFromTeamsWithFilter(ctx context.Context, client *github.Client, org
 			}
 			opts.Page = resp.NextPage
 		}
+
+		if teamRepos == 0 {
+			emptyTeams = append(emptyTeams, teamSlug)
+		}
 	}
 
 	var allRepos []string
@@ -431,14 +657,16 @@ func fetchPRsFromTeamsWithFilter(ctx context.Context, client *github.Client, org
 	}
 
 	if len(allRepos) == 0 {
-		return []*github.PullRequest{}, nil
+		return nil, nil, &NoRepositoriesError{Mode: "teams", Sources: emptyTeams}
 	}
 
-	return fetchOpenPRsWithFilter(ctx, client, allRepos, filter)
+	return fetchOpenPRsWithFilter(ctx, client, allRepos, filter, maxPages)
 }
 
-// fetchPRsFromSearchWithFilter uses GitHub search API (used by SearchFetcher)
-func fetchPRsFromSearchWithFilter(ctx context.Context, client *github.Client, query string, filter *PRFilter) ([]*github.PullRequest, error) {
+// fetchPRsFromSearchWithFilter lists up to maxPages pages of search results
+// using the GitHub search API (used by SearchFetcher), counting the results
+// under SearchResultsKey
+func fetchPRsFromSearchWithFilter(ctx context.Context, client *github.Client, query string, filter *PRFilter, maxPages int) ([]*github.PullRequest, PRCounts, error) {
 	if !strings.Contains(query, "is:pr") {
 		query += " is:pr"
 	}
@@ -461,12 +689,15 @@ func fetchPRsFromSearchWithFilter(ctx context.Context, client *github.Client, qu
 	}
 
 	var allPRs []*github.PullRequest
+	var count RepoPRCount
 
-	for {
+	for page := 1; ; page++ {
 		result, resp, err := client.Search.Issues(ctx, query, opts)
 		if err != nil {
-			return nil, errors.NewGitHubUnknownError(0, fmt.Errorf("search query failed: %w", err))
+			return nil, nil, errors.NewGitHubUnknownError(0, fmt.Errorf("search query failed: %w", err))
 		}
+		count.Open = result.GetTotal()
+		count.Fetched += len(result.Issues)
 
 		type prResult struct {
 			pr  *github.PullRequest
@@ -538,7 +769,11 @@ func fetchPRsFromSearchWithFilter(ctx context.Context, client *github.Client, qu
 			}
 		}
 
-		if resp.NextPage == 0 || len(allPRs) >= 200 {
+		if resp.NextPage == 0 {
+			count.Open = count.Fetched
+			break
+		}
+		if page >= maxPages {
 			break
 		}
 		opts.Page = resp.NextPage
@@ -548,11 +783,11 @@ func fetchPRsFromSearchWithFilter(ctx context.Context, client *github.Client, qu
 		return allPRs[i].GetUpdatedAt().Time.After(allPRs[j].GetUpdatedAt().Time)
 	})
 
-	return allPRs, nil
+	return allPRs, PRCounts{SearchResultsKey: count}, nil
 }
 
 // fetchPRsFromTopicsWithFilter fetches PRs from repositories with topics (used by TopicsFetcher)
-func fetchPRsFromTopicsWithFilter(ctx context.Context, client *github.Client, org string, topics []string, filter *PRFilter) ([]*github.PullRequest, error) {
+func fetchPRsFromTopicsWithFilter(ctx context.Context, client *github.Client, org string, topics []string, filter *PRFilter, maxPages int) ([]*github.PullRequest, PRCounts, error) {
 	repoSet := make(map[string]bool)
 
 	for _, topic := range topics {
@@ -567,7 +802,7 @@ func fetchPRsFromTopicsWithFilter(ctx context.Context, client *github.Client, or
 		for {
 			result, resp, err := client.Search.Repositories(ctx, query, opts)
 			if err != nil {
-				return nil, fmt.Errorf("failed to search repositories with topic %s: %w", topic, err)
+				return nil, nil, fmt.Errorf("failed to search repositories with topic %s: %w", topic, err)
 			}
 
 			for _, repo := range result.Repositories {
@@ -594,8 +829,8 @@ func fetchPRsFromTopicsWithFilter(ctx context.Context, client *github.Client, or
 	}
 
 	if len(allRepos) == 0 {
-		return []*github.PullRequest{}, nil
+		return nil, nil, &NoRepositoriesError{Mode: "topics", Sources: topics}
 	}
 
-	return fetchOpenPRsWithFilter(ctx, client, allRepos, filter)
+	return fetchOpenPRsWithFilter(ctx, client, allRepos, filter, maxPages)
 }
@@ -0,0 +1,283 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// SummaryBatchSize caps how many PRs one GraphQL query summarizes, keeping
+// each query well inside GitHub's node and complexity limits
+const SummaryBatchSize = 25
+
+// PRSummary is the review, check and size state of a PR that the PR list
+// payload lacks
+type PRSummary struct {
+	ReviewDecision string        // APPROVED, CHANGES_REQUESTED, REVIEW_REQUIRED; "" without required reviews
+	Reviews        []ReviewEvent // Oldest first, without pending reviews
+	ChecksState    string        // Rollup of checks and statuses: SUCCESS, FAILURE, ERROR, PENDING, EXPECTED; "" without any
+	ReportedChecks []string      // Names of the check runs and status contexts on the head commit
+	Mergeable      string        // MERGEABLE, CONFLICTING or UNKNOWN
+	MergeState     string        // BEHIND, BLOCKED, CLEAN, DIRTY, UNSTABLE, ...; why it can or can't merge
+	Additions      int
+	Deletions      int
+	ChangedFiles   int
+	Comments       int
+	ReviewComments int
+}
+
+// prSummaryFragment selects everything a PRSummary needs
+const prSummaryFragment = `fragment summary on PullRequest {
+  additions
+  deletions
+  changedFiles
+  mergeable
+  mergeStateStatus
+  reviewDecision
+  comments { totalCount }
+  reviews(first: 100) {
+    nodes { state submittedAt author { login } comments { totalCount } }
+  }
+  commits(last: 1) {
+    nodes { commit { statusCheckRollup {
+      state
+      contexts(first: 100) {
+        nodes { __typename ... on CheckRun { name } ... on StatusContext { context } }
+      }
+    } } }
+  }
+}`
+
+// graphQLPullRequest mirrors prSummaryFragment
+type graphQLPullRequest struct {
+	Additions      int    `json:"additions"`
+	Deletions      int    `json:"deletions"`
+	ChangedFiles   int    `json:"changedFiles"`
+	Mergeable      string `json:"mergeable"`
+	MergeState     string `json:"mergeStateStatus"`
+	ReviewDecision string `json:"reviewDecision"`
+	Comments       struct {
+		TotalCount int `json:"totalCount"`
+	} `json:"comments"`
+	Reviews struct {
+		Nodes []struct {
+			State       string            `json:"state"`
+			SubmittedAt *github.Timestamp `json:"submittedAt"`
+			Author      *struct {
+				Login string `json:"login"`
+			} `json:"author"`
+			Comments struct {
+				TotalCount int `json:"totalCount"`
+			} `json:"comments"`
+		} `json:"nodes"`
+	} `json:"reviews"`
+	Commits struct {
+		Nodes []struct {
+			Commit struct {
+				StatusCheckRollup *struct {
+					State    string `json:"state"`
+					Contexts struct {
+						Nodes []struct {
+							Name    string `json:"name"`    // CheckRun
+							Context string `json:"context"` // StatusContext
+						} `json:"nodes"`
+					} `json:"contexts"`
+				} `json:"statusCheckRollup"`
+			} `json:"commit"`
+		} `json:"nodes"`
+	} `json:"commits"`
+}
+
+// graphQLSummaryResponse holds one aliased repository lookup per PR
+type graphQLSummaryResponse struct {
+	Data map[string]*struct {
+		PullRequest *graphQLPullRequest `json:"pullRequest"`
+	} `json:"data"`
+	Errors []struct {
+		Message string        `json:"message"`
+		Path    []interface{} `json:"path"`
+	} `json:"errors"`
+}
+
+// FetchPRSummaries summarizes PRs with one GraphQL query per
+// SummaryBatchSize PRs, instead of several REST requests per PR. Results
+// are aligned with prs; a PR that couldn't be summarized gets an error.
+func FetchPRSummaries(ctx context.Context, token string, prs []*github.PullRequest) ([]*PRSummary, []error) {
+	summaries := make([]*PRSummary, len(prs))
+	errs := make([]error, len(prs))
+
+	client, err := NewClient(token)
+	if err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+		return summaries, errs
+	}
+
+	for start := 0; start < len(prs); start += SummaryBatchSize {
+		end := min(start+SummaryBatchSize, len(prs))
+		fetchPRSummaryBatch(ctx, client, prs[start:end], summaries[start:end], errs[start:end])
+	}
+	return summaries, errs
+}
+
+// fetchPRSummaryBatch summarizes a batch of PRs with a single query, filling
+// in summaries and errs at the PRs' positions
+func fetchPRSummaryBatch(ctx context.Context, client *github.Client, prs []*github.PullRequest, summaries []*PRSummary, errs []error) {
+	var params, lookups []string
+	variables := make(map[string]interface{})
+	aliases := make(map[string]int)
+	for i, pr := range prs {
+		owner, repo, err := prCoordinates(pr)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		alias := fmt.Sprintf("pr%d", i)
+		aliases[alias] = i
+		params = append(params, fmt.Sprintf("$owner%d: String!, $name%d: String!, $number%d: Int!", i, i, i))
+		lookups = append(lookups, fmt.Sprintf("  %s: repository(owner: $owner%d, name: $name%d) { pullRequest(number: $number%d) { ...summary } }", alias, i, i, i))
+		variables[fmt.Sprintf("owner%d", i)] = owner
+		variables[fmt.Sprintf("name%d", i)] = repo
+		variables[fmt.Sprintf("number%d", i)] = pr.GetNumber()
+	}
+	if len(aliases) == 0 {
+		return
+	}
+
+	query := fmt.Sprintf("query(%s) {\n%s\n}\n%s", strings.Join(params, ", "), strings.Join(lookups, "\n"), prSummaryFragment)
+	req, err := client.NewRequest("POST", graphQLURL(), map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err == nil {
+		var out graphQLSummaryResponse
+		_, err = client.Do(ctx, req, &out)
+		if err == nil {
+			applyPRSummaries(prs, &out, aliases, summaries, errs)
+			return
+		}
+	}
+	for _, i := range aliases {
+		errs[i] = fmt.Errorf("GraphQL query failed: %w", err)
+	}
+}
+
+// applyPRSummaries converts a query response into summaries, attributing
+// GraphQL errors to the PRs whose lookups they concern
+func applyPRSummaries(prs []*github.PullRequest, out *graphQLSummaryResponse, aliases map[string]int, summaries []*PRSummary, errs []error) {
+	for _, gqlErr := range out.Errors {
+		if len(gqlErr.Path) == 0 {
+			continue
+		}
+		if alias, ok := gqlErr.Path[0].(string); ok {
+			if i, known := aliases[alias]; known && errs[i] == nil {
+				errs[i] = fmt.Errorf("GraphQL: %s", gqlErr.Message)
+			}
+		}
+	}
+
+	for alias, i := range aliases {
+		if errs[i] != nil {
+			continue
+		}
+		lookup := out.Data[alias]
+		if lookup == nil || lookup.PullRequest == nil {
+			errs[i] = fmt.Errorf("PR #%d not found", prs[i].GetNumber())
+			continue
+		}
+		summaries[i] = newPRSummary(lookup.PullRequest)
+	}
+}
+
+// newPRSummary flattens a GraphQL pull request
+func newPRSummary(pr *graphQLPullRequest) *PRSummary {
+	summary := &PRSummary{
+		ReviewDecision: pr.ReviewDecision,
+		Mergeable:      pr.Mergeable,
+		MergeState:     pr.MergeState,
+		Additions:      pr.Additions,
+		Deletions:      pr.Deletions,
+		ChangedFiles:   pr.ChangedFiles,
+		Comments:       pr.Comments.TotalCount,
+	}
+
+	for _, review := range pr.Reviews.Nodes {
+		summary.ReviewComments += review.Comments.TotalCount
+		// Pending reviews are drafts only their author can see
+		if review.State == "PENDING" {
+			continue
+		}
+		event := ReviewEvent{State: review.State}
+		if review.Author != nil {
+			event.Reviewer = review.Author.Login // Deleted accounts have no author
+		}
+		if review.SubmittedAt != nil {
+			event.SubmittedAt = review.SubmittedAt.Time
+		}
+		summary.Reviews = append(summary.Reviews, event)
+	}
+
+	if commits := pr.Commits.Nodes; len(commits) > 0 && commits[0].Commit.StatusCheckRollup != nil {
+		rollup := commits[0].Commit.StatusCheckRollup
+		summary.ChecksState = rollup.State
+		for _, node := range rollup.Contexts.Nodes {
+			name := node.Name
+			if name == "" {
+				name = node.Context
+			}
+			if name != "" {
+				summary.ReportedChecks = append(summary.ReportedChecks, name)
+			}
+		}
+	}
+	return summary
+}
+
+// graphQLURL returns the GraphQL endpoint of github.com or the configured
+// Enterprise Server instance
+func graphQLURL() string {
+	endpointsMu.RLock()
+	defer endpointsMu.RUnlock()
+
+	if baseURL == nil {
+		return "https://api.github.com/graphql"
+	}
+	return baseURL.Scheme + "://" + baseURL.Host + "/api/graphql"
+}
+
+// mutatePullRequest runs a GraphQL mutation taking the PR's node ID as $id,
+// plus any other variables
+func mutatePullRequest(ctx context.Context, client *github.Client, resource string, pr *github.PullRequest, mutation string, variables map[string]interface{}) error {
+	if pr.GetNodeID() == "" {
+		return fmt.Errorf("%s has no GraphQL node ID", resource)
+	}
+	vars := map[string]interface{}{"id": pr.GetNodeID()}
+	for name, value := range variables {
+		vars[name] = value
+	}
+
+	req, err := client.NewRequest("POST", graphQLURL(), map[string]interface{}{
+		"query":     mutation,
+		"variables": vars,
+	})
+	if err != nil {
+		return err
+	}
+	var out struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	resp, err := client.Do(ctx, req, &out)
+	if err != nil {
+		return wrapActionError(resp, resource, err)
+	}
+	// GraphQL reports refusals, like lacking permission, in the body
+	if len(out.Errors) > 0 {
+		return fmt.Errorf("%s: %s", resource, out.Errors[0].Message)
+	}
+	return nil
+}
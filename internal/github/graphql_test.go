@@ -0,0 +1,95 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+func summaryTestPR(repo string, number int) *gh.PullRequest {
+	owner, name, _ := strings.Cut(repo, "/")
+	return &gh.PullRequest{
+		Number: gh.Int(number),
+		Base: &gh.PullRequestBranch{Repo: &gh.Repository{
+			Name:  gh.String(name),
+			Owner: &gh.User{Login: gh.String(owner)},
+		}},
+	}
+}
+
+// TestFetchPRSummaries tests summarizing a page of PRs from one recorded query
+func TestFetchPRSummaries(t *testing.T) {
+	replayFixtures(t, "pr_summaries")
+	prs := []*gh.PullRequest{summaryTestPR("octo-org/api", 42), summaryTestPR("octo-org/web", 7), summaryTestPR("octo-org/api", 999)}
+
+	summaries, errs := FetchPRSummaries(context.Background(), "fake-token", prs)
+
+	if errs[0] != nil || errs[1] != nil {
+		t.Fatalf("Expected the first two PRs to be summarized, got %v", errs)
+	}
+	api := summaries[0]
+	if api.ReviewDecision != "APPROVED" || api.ChecksState != "SUCCESS" || api.Mergeable != "MERGEABLE" {
+		t.Errorf("Unexpected api#42 state: %+v", api)
+	}
+	if api.Additions != 120 || api.Deletions != 14 || api.ChangedFiles != 4 || api.Comments != 3 || api.ReviewComments != 3 {
+		t.Errorf("Unexpected api#42 counts: %+v", api)
+	}
+	if len(api.Reviews) != 2 || api.Reviews[1].Reviewer != "carol" {
+		t.Errorf("Expected pending reviews to be dropped, got %+v", api.Reviews)
+	}
+	if strings.Join(api.ReportedChecks, ",") != "build,ci/jenkins" {
+		t.Errorf("Expected check run and status names reported, got %v", api.ReportedChecks)
+	}
+	if web := summaries[1]; web.ReviewDecision != "" || web.Mergeable != "CONFLICTING" || web.ChecksState != "FAILURE" {
+		t.Errorf("Unexpected web#7 state: %+v", web)
+	}
+
+	if summaries[2] != nil || errs[2] == nil || !strings.Contains(errs[2].Error(), "Could not resolve") {
+		t.Errorf("Expected the missing PR to carry GitHub's error, got %v", errs[2])
+	}
+}
+
+// TestFetchPRSummaries_Batching tests one query per page, with PRs passed as variables
+func TestFetchPRSummaries_Batching(t *testing.T) {
+	var queries []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/graphql" {
+			t.Errorf("Expected the Enterprise Server GraphQL endpoint, got %s", r.URL.Path)
+		}
+		var body struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		queries = append(queries, body.Variables)
+		w.Write([]byte(`{"data": {}}`))
+	}))
+	defer server.Close()
+	t.Cleanup(func() { _ = SetEnterpriseURLs("", "") })
+	if err := SetEnterpriseURLs(server.URL, ""); err != nil {
+		t.Fatalf("SetEnterpriseURLs() failed: %v", err)
+	}
+
+	var prs []*gh.PullRequest
+	for i := 1; i <= SummaryBatchSize+1; i++ {
+		prs = append(prs, summaryTestPR("octo-org/api", i))
+	}
+	prs = append(prs, &gh.PullRequest{Number: gh.Int(99)}) // No repository
+
+	_, errs := FetchPRSummaries(context.Background(), "fake-token", prs)
+
+	if len(queries) != 2 {
+		t.Fatalf("Expected 2 queries for %d PRs, got %d", len(prs), len(queries))
+	}
+	if queries[0]["owner0"] != "octo-org" || queries[0]["number0"] != float64(1) || len(queries[1]) != 3 {
+		t.Errorf("Unexpected query variables: %v", queries)
+	}
+	if errs[0] == nil || errs[len(errs)-1] == nil {
+		t.Error("Expected PRs missing from the response and PRs without a repository to fail")
+	}
+}
@@ -0,0 +1,129 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// FetchClosedPRs returns PRs matching a search query for closed PRs, merged
+// and closed unmerged alike, most recently closed first and at most limit
+func FetchClosedPRs(ctx context.Context, token string, query string, limit int) ([]*github.PullRequest, error) {
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return fetchClosedPRs(ctx, client, query, limit)
+}
+
+// FetchMergedPRs returns PRs matching a search query for merged PRs, most
+// recently merged first and at most limit
+func FetchMergedPRs(ctx context.Context, token string, query string, limit int) ([]*github.PullRequest, error) {
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return fetchMergedPRs(ctx, client, query, limit)
+}
+
+// fetchMergedPRs searches merged PRs using the provided client
+func fetchMergedPRs(ctx context.Context, client *github.Client, query string, limit int) ([]*github.PullRequest, error) {
+	prs, err := searchClosedPRs(ctx, client, query+" is:merged", true, limit)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(prs, func(i, j int) bool {
+		return prs[i].GetMergedAt().After(prs[j].GetMergedAt().Time)
+	})
+	return prs[:min(len(prs), limit)], nil
+}
+
+// fetchClosedPRs searches closed PRs using the provided client. Search
+// results are issues that don't say whether a PR merged, so merged and
+// unmerged PRs are searched separately rather than fetching each PR.
+func fetchClosedPRs(ctx context.Context, client *github.Client, query string, limit int) ([]*github.PullRequest, error) {
+	var prs []*github.PullRequest
+	for _, merged := range []bool{true, false} {
+		qualifier := "is:unmerged"
+		if merged {
+			qualifier = "is:merged"
+		}
+		found, err := searchClosedPRs(ctx, client, query+" "+qualifier, merged, limit)
+		if err != nil {
+			return nil, err
+		}
+		prs = append(prs, found...)
+	}
+
+	sort.SliceStable(prs, func(i, j int) bool {
+		return prs[i].GetClosedAt().After(prs[j].GetClosedAt().Time)
+	})
+	return prs[:min(len(prs), limit)], nil
+}
+
+// searchClosedPRs pages through a search for closed PRs that all merged or
+// all didn't, up to limit results
+func searchClosedPRs(ctx context.Context, client *github.Client, query string, merged bool, limit int) ([]*github.PullRequest, error) {
+	opts := &github.SearchOptions{
+		Sort:        "updated",
+		Order:       "desc",
+		ListOptions: github.ListOptions{PerPage: min(limit, 100)},
+	}
+
+	var prs []*github.PullRequest
+	for {
+		result, resp, err := client.Search.Issues(ctx, query, opts)
+		if err != nil {
+			return nil, wrapActionError(resp, fmt.Sprintf("search %q", query), err)
+		}
+		for _, issue := range result.Issues {
+			if issue.IsPullRequest() {
+				prs = append(prs, closedPRFromIssue(issue, merged))
+			}
+		}
+		if resp.NextPage == 0 || len(prs) >= limit {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return prs, nil
+}
+
+// closedPRFromIssue builds a closed PR from its search result. A merged PR
+// closes when it merges, so its close time is its merge time.
+func closedPRFromIssue(issue *github.Issue, merged bool) *github.PullRequest {
+	pr := &github.PullRequest{
+		Number:    issue.Number,
+		Title:     issue.Title,
+		Body:      issue.Body,
+		State:     github.String("closed"),
+		User:      issue.User,
+		Labels:    issue.Labels,
+		Assignees: issue.Assignees,
+		Milestone: issue.Milestone,
+		Comments:  issue.Comments,
+		HTMLURL:   issue.HTMLURL,
+		CreatedAt: issue.CreatedAt,
+		UpdatedAt: issue.UpdatedAt,
+		ClosedAt:  issue.ClosedAt,
+		Merged:    github.Bool(merged),
+	}
+	if merged {
+		pr.MergedAt = issue.ClosedAt
+	}
+
+	// The repository URL ends in ".../repos/{owner}/{name}"
+	parts := strings.Split(issue.GetRepositoryURL(), "/")
+	if len(parts) >= 2 {
+		owner, name := parts[len(parts)-2], parts[len(parts)-1]
+		pr.Base = &github.PullRequestBranch{Repo: &github.Repository{
+			Name:     github.String(name),
+			FullName: github.String(owner + "/" + name),
+			Owner:    &github.User{Login: github.String(owner)},
+		}}
+	}
+	return pr
+}
@@ -0,0 +1,79 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestFetchClosedPRs(t *testing.T) {
+	var queries []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query().Get("q")
+		queries = append(queries, query)
+		if strings.HasSuffix(query, "is:merged") {
+			w.Write([]byte(`{"total_count": 1, "items": [
+				{"number": 12, "title": "Add retries", "user": {"login": "alice"}, "closed_at": "2024-05-01T12:00:00Z",
+				 "repository_url": "https://api.github.com/repos/org/api", "pull_request": {}}
+			]}`))
+			return
+		}
+		w.Write([]byte(`{"total_count": 2, "items": [
+			{"number": 7, "title": "Drop cache", "closed_at": "2024-05-02T12:00:00Z",
+			 "repository_url": "https://api.github.com/repos/org/web", "pull_request": {}},
+			{"number": 8, "title": "An issue", "closed_at": "2024-05-03T12:00:00Z",
+			 "repository_url": "https://api.github.com/repos/org/web"}
+		]}`))
+	})
+	client := newTestClient(t, mux)
+
+	prs, err := fetchClosedPRs(context.Background(), client, "is:pr is:closed org:org", 10)
+	if err != nil {
+		t.Fatalf("fetchClosedPRs() returned error: %v", err)
+	}
+	if len(queries) != 2 || queries[0] != "is:pr is:closed org:org is:merged" || queries[1] != "is:pr is:closed org:org is:unmerged" {
+		t.Errorf("Expected a merged and an unmerged search, got %q", queries)
+	}
+	if len(prs) != 2 {
+		t.Fatalf("Expected the 2 PRs without the issue, got %d", len(prs))
+	}
+
+	closed, merged := prs[0], prs[1]
+	if closed.GetNumber() != 7 || closed.GetMerged() || closed.GetBase().GetRepo().GetFullName() != "org/web" {
+		t.Errorf("Expected the PR closed last first and unmerged, got %+v", closed)
+	}
+	if merged.GetNumber() != 12 || !merged.GetMerged() || !merged.GetMergedAt().Equal(merged.GetClosedAt()) || merged.GetState() != "closed" {
+		t.Errorf("Expected a merged PR merged when it closed, got %+v", merged)
+	}
+	if owner, name, err := prCoordinates(merged); err != nil || owner != "org" || name != "api" {
+		t.Errorf("Expected the PR's repo from its repository URL, got %s/%s (%v)", owner, name, err)
+	}
+
+	if prs, _ := fetchClosedPRs(context.Background(), client, "is:pr is:closed", 1); len(prs) != 1 {
+		t.Errorf("Expected the limit to apply, got %d PRs", len(prs))
+	}
+}
+
+func TestFetchMergedPRs(t *testing.T) {
+	var query string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("q")
+		w.Write([]byte(`{"total_count": 2, "items": [
+			{"number": 1, "closed_at": "2024-05-01T12:00:00Z", "repository_url": "https://api.github.com/repos/org/api", "pull_request": {}},
+			{"number": 2, "closed_at": "2024-05-02T12:00:00Z", "repository_url": "https://api.github.com/repos/org/api", "pull_request": {}}
+		]}`))
+	}))
+
+	prs, err := fetchMergedPRs(context.Background(), client, "is:pr repo:org/api", 10)
+	if err != nil {
+		t.Fatalf("fetchMergedPRs() returned error: %v", err)
+	}
+	if query != "is:pr repo:org/api is:merged" {
+		t.Errorf("Expected a search for merged PRs, got %q", query)
+	}
+	if len(prs) != 2 || prs[0].GetNumber() != 2 || !prs[0].GetMerged() {
+		t.Errorf("Expected merged PRs, last merged first, got %d", len(prs))
+	}
+}
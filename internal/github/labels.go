@@ -0,0 +1,105 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/google/go-github/v55/github"
+)
+
+// repoLabelsTTL is how long a repository's labels stay cached - new labels
+// are rare
+const repoLabelsTTL = 6 * time.Hour
+
+// FetchRepoLabels returns the label names of a repository ("owner/name"),
+// served from the cache when available
+func FetchRepoLabels(ctx context.Context, token, repoFullName string, prCache *cache.PRCache) ([]string, error) {
+	if prCache != nil {
+		if labels, found := prCache.GetRepoLabels(repoFullName); found {
+			return labels, nil
+		}
+	}
+
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := fetchRepoLabels(ctx, client, repoFullName)
+	if err != nil {
+		return nil, fmt.Errorf("labels of %s: %w", repoFullName, err)
+	}
+
+	if prCache != nil {
+		_ = prCache.SetRepoLabels(repoFullName, labels, repoLabelsTTL) // ignore cache errors
+	}
+	return labels, nil
+}
+
+// fetchRepoLabels lists every label of a repository using the provided client
+func fetchRepoLabels(ctx context.Context, client *github.Client, repoFullName string) ([]string, error) {
+	owner, repo, ok := strings.Cut(repoFullName, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository %q", repoFullName)
+	}
+
+	var labels []string
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		page, resp, err := client.Issues.ListLabels(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, label := range page {
+			labels = append(labels, label.GetName())
+		}
+		if resp.NextPage == 0 {
+			return labels, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// UpdateLabels adds and removes labels on a PR and returns its labels afterwards
+func UpdateLabels(ctx context.Context, token string, pr *github.PullRequest, add, remove []string) ([]*github.Label, error) {
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return updateLabels(ctx, client, pr, add, remove)
+}
+
+// updateLabels changes the labels using the provided client. PRs share their
+// labels with the issue behind them.
+func updateLabels(ctx context.Context, client *github.Client, pr *github.PullRequest, add, remove []string) ([]*github.Label, error) {
+	owner, repo, err := prCoordinates(pr)
+	if err != nil {
+		return nil, err
+	}
+	resource := fmt.Sprintf("%s/%s#%d", owner, repo, pr.GetNumber())
+
+	for _, label := range remove {
+		resp, err := client.Issues.RemoveLabelForIssue(ctx, owner, repo, pr.GetNumber(), label)
+		if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+			// A 404 means someone removed the label already
+			return nil, wrapActionError(resp, resource, err)
+		}
+	}
+
+	if len(add) > 0 {
+		labels, resp, err := client.Issues.AddLabelsToIssue(ctx, owner, repo, pr.GetNumber(), add)
+		if err != nil {
+			return nil, wrapActionError(resp, resource, err)
+		}
+		return labels, nil
+	}
+	labels, resp, err := client.Issues.ListLabelsByIssue(ctx, owner, repo, pr.GetNumber(), &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, wrapActionError(resp, resource, err)
+	}
+	return labels, nil
+}
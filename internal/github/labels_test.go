@@ -0,0 +1,81 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// TestFetchRepoLabels tests listing a repository's labels across pages
+func TestFetchRepoLabels(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/org/api/labels" {
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("page") == "2" {
+			w.Write([]byte(`[{"name": "needs-triage"}]`))
+			return
+		}
+		w.Header().Set("Link", `<https://api.github.com/repos/org/api/labels?page=2>; rel="next"`)
+		w.Write([]byte(`[{"name": "bug"}, {"name": "feature"}]`))
+	}))
+
+	labels, err := fetchRepoLabels(context.Background(), client, "org/api")
+	if err != nil {
+		t.Fatalf("fetchRepoLabels() returned error: %v", err)
+	}
+	if !reflect.DeepEqual(labels, []string{"bug", "feature", "needs-triage"}) {
+		t.Errorf("Expected labels from both pages, got %v", labels)
+	}
+	if _, err := fetchRepoLabels(context.Background(), client, "api"); err == nil {
+		t.Error("Expected an error for a repository without owner")
+	}
+}
+
+// TestUpdateLabels tests removing and adding labels through the Issues API
+func TestUpdateLabels(t *testing.T) {
+	var requests []string
+	var added []string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case http.MethodDelete:
+			if r.URL.Path == "/repos/org/api/issues/12/labels/gone" {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"message": "Label does not exist"}`))
+				return
+			}
+			w.Write([]byte(`[]`))
+		case http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&added)
+			w.Write([]byte(`[{"name": "bug"}, {"name": "needs-triage"}]`))
+		default:
+			w.Write([]byte(`[{"name": "bug"}]`))
+		}
+	}))
+
+	labels, err := updateLabels(context.Background(), client, actionTestPR(), []string{"needs-triage"}, []string{"wontfix", "gone"})
+	if err != nil {
+		t.Fatalf("updateLabels() returned error: %v", err)
+	}
+	expected := []string{
+		"DELETE /repos/org/api/issues/12/labels/wontfix",
+		"DELETE /repos/org/api/issues/12/labels/gone",
+		"POST /repos/org/api/issues/12/labels",
+	}
+	if !reflect.DeepEqual(requests, expected) || !reflect.DeepEqual(added, []string{"needs-triage"}) {
+		t.Errorf("Expected removals then one addition, got %v adding %v", requests, added)
+	}
+	if len(labels) != 2 {
+		t.Errorf("Expected the PR's labels afterwards, got %d", len(labels))
+	}
+
+	// Removing only lists what is left
+	requests = nil
+	labels, err = updateLabels(context.Background(), client, actionTestPR(), nil, []string{"needs-triage"})
+	if err != nil || len(labels) != 1 || requests[len(requests)-1] != "GET /repos/org/api/issues/12/labels" {
+		t.Errorf("Expected the remaining labels listed, got %v (%v) after %v", labels, err, requests)
+	}
+}
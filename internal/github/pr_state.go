@@ -0,0 +1,32 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// FetchPullRequest fetches a pull request's current state, e.g. to learn
+// whether one that left a list was merged or closed
+func FetchPullRequest(ctx context.Context, token string, pr *github.PullRequest) (*github.PullRequest, error) {
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return fetchPullRequest(ctx, client, pr)
+}
+
+// fetchPullRequest fetches a pull request using the provided client
+func fetchPullRequest(ctx context.Context, client *github.Client, pr *github.PullRequest) (*github.PullRequest, error) {
+	owner, repo, err := prCoordinates(pr)
+	if err != nil {
+		return nil, err
+	}
+
+	current, resp, err := client.PullRequests.Get(ctx, owner, repo, pr.GetNumber())
+	if err != nil {
+		return nil, wrapActionError(resp, fmt.Sprintf("%s/%s#%d", owner, repo, pr.GetNumber()), err)
+	}
+	return current, nil
+}
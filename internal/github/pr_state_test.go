@@ -0,0 +1,31 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestFetchPullRequest(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/org/api/pulls/12" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"number": 12, "state": "closed", "merged": true, "closed_at": "2024-05-01T12:00:00Z"}`))
+	}))
+
+	pr, err := fetchPullRequest(context.Background(), client, actionTestPR())
+	if err != nil {
+		t.Fatalf("fetchPullRequest() error = %v", err)
+	}
+	if pr.GetState() != "closed" || !pr.GetMerged() || pr.GetClosedAt().IsZero() {
+		t.Errorf("Expected a merged PR, got %+v", pr)
+	}
+
+	missing := actionTestPR()
+	missing.Number = nil
+	if _, err := fetchPullRequest(context.Background(), client, missing); err == nil {
+		t.Error("Expected an error for a PR that doesn't exist")
+	}
+}
@@ -0,0 +1,164 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// projectItemsPerPR caps how many Projects boards are read per PR
+const projectItemsPerPR = 10
+
+// ProjectItem is a PR's card on a GitHub Projects board
+type ProjectItem struct {
+	Project string // Board title
+	Status  string // The card's Status field, i.e. its board column; "" if unset
+}
+
+// String describes the card as "Board: Status", or just the board without a status
+func (i ProjectItem) String() string {
+	if i.Status == "" {
+		return i.Project
+	}
+	return i.Project + ": " + i.Status
+}
+
+// OnBoard reports whether any of a PR's cards is on the board with this
+// title, in the Status column status unless that is empty
+func OnBoard(items []ProjectItem, project, status string) bool {
+	for _, item := range items {
+		if strings.EqualFold(item.Project, project) && (status == "" || strings.EqualFold(item.Status, status)) {
+			return true
+		}
+	}
+	return false
+}
+
+// projectItemsFragment selects a PR's cards with their Status field
+var projectItemsFragment = fmt.Sprintf(`fragment projects on PullRequest {
+  projectItems(first: %d) {
+    nodes {
+      project { title }
+      fieldValueByName(name: "Status") { ... on ProjectV2ItemFieldSingleSelectValue { name } }
+    }
+  }
+}`, projectItemsPerPR)
+
+// graphQLProjectItemsResponse holds one aliased repository lookup per PR
+type graphQLProjectItemsResponse struct {
+	Data map[string]*struct {
+		PullRequest *struct {
+			ProjectItems struct {
+				Nodes []struct {
+					Project struct {
+						Title string `json:"title"`
+					} `json:"project"`
+					Status *struct {
+						Name string `json:"name"`
+					} `json:"fieldValueByName"`
+				} `json:"nodes"`
+			} `json:"projectItems"`
+		} `json:"pullRequest"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// FetchProjectItems looks up the Projects boards each PR is on, with one
+// GraphQL query per SummaryBatchSize PRs. Results are aligned with prs.
+// Classic tokens need the read:project scope.
+func FetchProjectItems(ctx context.Context, token string, prs []*github.PullRequest) ([][]ProjectItem, error) {
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return fetchProjectItems(ctx, client, prs)
+}
+
+// fetchProjectItems looks up project cards using the provided client
+func fetchProjectItems(ctx context.Context, client *github.Client, prs []*github.PullRequest) ([][]ProjectItem, error) {
+	items := make([][]ProjectItem, len(prs))
+	for start := 0; start < len(prs); start += SummaryBatchSize {
+		end := min(start+SummaryBatchSize, len(prs))
+		if err := fetchProjectItemsBatch(ctx, client, prs[start:end], items[start:end]); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// fetchProjectItemsBatch looks up a batch of PRs' cards with a single query,
+// filling in items at the PRs' positions
+func fetchProjectItemsBatch(ctx context.Context, client *github.Client, prs []*github.PullRequest, items [][]ProjectItem) error {
+	var params, lookups []string
+	variables := make(map[string]interface{})
+	for i, pr := range prs {
+		owner, repo, err := prCoordinates(pr)
+		if err != nil {
+			continue // Not on GitHub, so on no board
+		}
+		params = append(params, fmt.Sprintf("$owner%d: String!, $name%d: String!, $number%d: Int!", i, i, i))
+		lookups = append(lookups, fmt.Sprintf("  pr%d: repository(owner: $owner%d, name: $name%d) { pullRequest(number: $number%d) { ...projects } }", i, i, i, i))
+		variables[fmt.Sprintf("owner%d", i)] = owner
+		variables[fmt.Sprintf("name%d", i)] = repo
+		variables[fmt.Sprintf("number%d", i)] = pr.GetNumber()
+	}
+	if len(lookups) == 0 {
+		return nil
+	}
+
+	query := fmt.Sprintf("query(%s) {\n%s\n}\n%s", strings.Join(params, ", "), strings.Join(lookups, "\n"), projectItemsFragment)
+	req, err := client.NewRequest("POST", graphQLURL(), map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return err
+	}
+	var out graphQLProjectItemsResponse
+	resp, err := client.Do(ctx, req, &out)
+	if err != nil {
+		return wrapActionError(resp, "project boards", err)
+	}
+	// A token that can't read projects fails the whole query
+	if len(out.Errors) > 0 {
+		if strings.Contains(out.Errors[0].Message, "read:project") {
+			return fmt.Errorf("reading Projects boards needs the read:project scope - run 'gh auth refresh -s read:project': %s", out.Errors[0].Message)
+		}
+		return fmt.Errorf("GraphQL: %s", out.Errors[0].Message)
+	}
+
+	for i := range prs {
+		lookup := out.Data[fmt.Sprintf("pr%d", i)]
+		if lookup == nil || lookup.PullRequest == nil {
+			continue
+		}
+		for _, node := range lookup.PullRequest.ProjectItems.Nodes {
+			item := ProjectItem{Project: node.Project.Title}
+			if node.Status != nil {
+				item.Status = node.Status.Name
+			}
+			items[i] = append(items[i], item)
+		}
+	}
+	return nil
+}
+
+// onProjectBoard keeps the PRs with a card on the board, in the Status
+// column status unless that is empty
+func onProjectBoard(ctx context.Context, client *github.Client, prs []*github.PullRequest, project, status string) ([]*github.PullRequest, error) {
+	items, err := fetchProjectItems(ctx, client, prs)
+	if err != nil {
+		return nil, err
+	}
+	var kept []*github.PullRequest
+	for i, pr := range prs {
+		if OnBoard(items[i], project, status) {
+			kept = append(kept, pr)
+		}
+	}
+	return kept, nil
+}
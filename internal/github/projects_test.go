@@ -0,0 +1,67 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+// projectsTestServer answers project item queries with body, pointing the
+// GraphQL endpoint at it for the test
+func projectsTestServer(t *testing.T, body string) {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { _ = SetEnterpriseURLs("", "") })
+	if err := SetEnterpriseURLs(server.URL, ""); err != nil {
+		t.Fatalf("SetEnterpriseURLs() failed: %v", err)
+	}
+}
+
+// TestFetchProjectItems tests reading each PR's cards and filtering by board
+func TestFetchProjectItems(t *testing.T) {
+	projectsTestServer(t, `{"data": {
+		"pr0": {"pullRequest": {"projectItems": {"nodes": [
+			{"project": {"title": "Roadmap"}, "fieldValueByName": {"name": "In Review"}},
+			{"project": {"title": "Triage"}, "fieldValueByName": null}
+		]}}},
+		"pr1": {"pullRequest": {"projectItems": {"nodes": []}}}
+	}}`)
+	prs := []*gh.PullRequest{summaryTestPR("octo-org/api", 1), summaryTestPR("octo-org/api", 2)}
+
+	items, err := FetchProjectItems(context.Background(), "fake-token", prs)
+	if err != nil {
+		t.Fatalf("FetchProjectItems() failed: %v", err)
+	}
+	if len(items[0]) != 2 || items[0][0].String() != "Roadmap: In Review" || items[0][1].String() != "Triage" || len(items[1]) != 0 {
+		t.Errorf("Unexpected items: %+v", items)
+	}
+
+	if !OnBoard(items[0], "roadmap", "") || !OnBoard(items[0], "Roadmap", "in review") || OnBoard(items[0], "Roadmap", "Done") {
+		t.Error("Expected board and status to match case-insensitively")
+	}
+
+	client, _ := NewClient("fake-token")
+	kept, err := onProjectBoard(context.Background(), client, prs, "Roadmap", "In Review")
+	if err != nil || len(kept) != 1 || kept[0].GetNumber() != 1 {
+		t.Errorf("Expected only the PR in the column, got %d PRs (%v)", len(kept), err)
+	}
+}
+
+// TestFetchProjectItems_MissingScope tests explaining a token without read:project
+func TestFetchProjectItems_MissingScope(t *testing.T) {
+	projectsTestServer(t, `{"data": {"pr0": {"pullRequest": null}}, "errors": [
+		{"message": "Your token has not been granted the required scopes to execute this query. The 'title' field requires one of the following scopes: ['read:project']"}
+	]}`)
+
+	_, err := FetchProjectItems(context.Background(), "fake-token", []*gh.PullRequest{summaryTestPR("octo-org/api", 1)})
+	if err == nil || !strings.Contains(err.Error(), "gh auth refresh -s read:project") {
+		t.Errorf("Expected the missing scope to be explained, got %v", err)
+	}
+}
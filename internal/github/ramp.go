@@ -0,0 +1,33 @@
+package github
+
+import (
+	"context"
+	"time"
+)
+
+// maxRampSpan caps how long a ramp holds back the last repo, so long repo
+// lists still finish within request timeouts
+const maxRampSpan = 10 * time.Second
+
+// requestRampKey is the context key of the per-repo request ramp
+type requestRampKey struct{}
+
+// WithRequestRamp returns a context under which fetches start each repo's
+// requests interval after the previous repo's, instead of all at once, to stay
+// clear of GitHub's secondary rate limits. Zero or less fires them together.
+func WithRequestRamp(ctx context.Context, interval time.Duration) context.Context {
+	return context.WithValue(ctx, requestRampKey{}, interval)
+}
+
+// rampDelay returns how long to hold back the i-th of n repos under the
+// context's ramp, squeezing the interval when the ramp would exceed maxRampSpan
+func rampDelay(ctx context.Context, i, n int) time.Duration {
+	interval, _ := ctx.Value(requestRampKey{}).(time.Duration)
+	if interval <= 0 || n < 2 {
+		return 0
+	}
+	if span := maxRampSpan / time.Duration(n-1); interval > span {
+		interval = span
+	}
+	return time.Duration(i) * interval
+}
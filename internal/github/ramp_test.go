@@ -0,0 +1,59 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestRampDelay tests staggering repos and capping the ramp's span
+func TestRampDelay(t *testing.T) {
+	ctx := WithRequestRamp(context.Background(), 100*time.Millisecond)
+	if got := rampDelay(ctx, 3, 10); got != 300*time.Millisecond {
+		t.Errorf("rampDelay(3 of 10) = %v, want 300ms", got)
+	}
+	if got := rampDelay(ctx, 0, 10); got != 0 {
+		t.Errorf("Expected the first repo to start right away, got %v", got)
+	}
+	// 201 repos at 100ms would take 20s; the ramp squeezes into maxRampSpan
+	if got := rampDelay(ctx, 200, 201); got != maxRampSpan {
+		t.Errorf("Expected the last repo to wait at most %v, got %v", maxRampSpan, got)
+	}
+	if got := rampDelay(context.Background(), 3, 10); got != 0 {
+		t.Errorf("Expected no delay without a ramp, got %v", got)
+	}
+}
+
+// TestFetchOpenPRsWithFilter_Ramp tests that a ramp spaces out repo requests
+func TestFetchOpenPRsWithFilter_Ramp(t *testing.T) {
+	var mu sync.Mutex
+	var started []time.Time
+	mux := http.NewServeMux()
+	repos := []string{"org/a", "org/b", "org/c"}
+	for _, repo := range repos {
+		mux.HandleFunc("/repos/"+repo+"/pulls", func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			started = append(started, time.Now())
+			mu.Unlock()
+			fmt.Fprint(w, `[]`)
+		})
+	}
+	client := newTestClient(t, mux)
+
+	ctx := WithRequestRamp(context.Background(), 40*time.Millisecond)
+	if _, _, err := fetchOpenPRsWithFilter(ctx, client, repos, DefaultFilter(), 1); err != nil {
+		t.Fatalf("fetchOpenPRsWithFilter() returned error: %v", err)
+	}
+
+	sort.Slice(started, func(i, j int) bool { return started[i].Before(started[j]) })
+	if len(started) != 3 {
+		t.Fatalf("Expected 3 repo requests, got %d", len(started))
+	}
+	if spread := started[2].Sub(started[0]); spread < 70*time.Millisecond {
+		t.Errorf("Expected requests spread over ~80ms, got %v", spread)
+	}
+}
@@ -0,0 +1,89 @@
+package github
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimit is an API quota as GitHub last reported it in response headers
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time // When the quota window resets
+}
+
+// Quotas observed per resource ("core", "graphql", "search", ...)
+var (
+	rateLimitsMu sync.RWMutex
+	rateLimits   = make(map[string]RateLimit)
+)
+
+// ObservedRateLimit returns the quota GitHub last reported for a resource,
+// and false before any response for it has been seen
+func ObservedRateLimit(resource string) (RateLimit, bool) {
+	rateLimitsMu.RLock()
+	defer rateLimitsMu.RUnlock()
+
+	limit, ok := rateLimits[resource]
+	return limit, ok
+}
+
+// ObservedRateLimits returns the quotas GitHub last reported, by resource
+func ObservedRateLimits() map[string]RateLimit {
+	rateLimitsMu.RLock()
+	defer rateLimitsMu.RUnlock()
+
+	limits := make(map[string]RateLimit, len(rateLimits))
+	for resource, limit := range rateLimits {
+		limits[resource] = limit
+	}
+	return limits
+}
+
+// recordRateLimit stores the quota of a resource
+func recordRateLimit(resource string, limit RateLimit) {
+	rateLimitsMu.Lock()
+	defer rateLimitsMu.Unlock()
+	rateLimits[resource] = limit
+}
+
+// rateLimitObserver is an http.RoundTripper noting the quota headers of
+// every response, so callers can back off before requests start failing
+type rateLimitObserver struct {
+	next http.RoundTripper
+}
+
+// RoundTrip sends the request and records the response's quota headers
+func (o rateLimitObserver) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := o.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	resp, err := next.RoundTrip(req)
+	if err == nil {
+		observeRateLimit(resp.Header)
+	}
+	return resp, err
+}
+
+// observeRateLimit records the quota described by response headers, if any.
+// Responses without a resource header predate it and count as "core".
+func observeRateLimit(header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("X-Ratelimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(header.Get("X-Ratelimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+	limit, _ := strconv.Atoi(header.Get("X-Ratelimit-Limit"))
+
+	resource := header.Get("X-Ratelimit-Resource")
+	if resource == "" {
+		resource = "core"
+	}
+	recordRateLimit(resource, RateLimit{Limit: limit, Remaining: remaining, Reset: time.Unix(reset, 0)})
+}
@@ -0,0 +1,54 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestObservedRateLimit tests that clients record the quota headers of responses per resource
+func TestObservedRateLimit(t *testing.T) {
+	reset := time.Now().Add(30 * time.Minute).Truncate(time.Second)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Ratelimit-Limit", "5000")
+		w.Header().Set("X-Ratelimit-Remaining", "42")
+		w.Header().Set("X-Ratelimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		w.Header().Set("X-Ratelimit-Resource", "graphql")
+		w.Write([]byte(`{"data": {}}`))
+	}))
+	defer server.Close()
+	t.Cleanup(func() { _ = SetEnterpriseURLs("", "") })
+	if err := SetEnterpriseURLs(server.URL, ""); err != nil {
+		t.Fatalf("SetEnterpriseURLs() failed: %v", err)
+	}
+
+	FetchPRSummaries(context.Background(), "fake-token", []*gh.PullRequest{summaryTestPR("octo-org/api", 1)})
+
+	limit, ok := ObservedRateLimit("graphql")
+	if !ok {
+		t.Fatal("Expected the GraphQL quota to be observed")
+	}
+	if limit.Remaining != 42 || limit.Limit != 5000 || !limit.Reset.Equal(reset) {
+		t.Errorf("Unexpected quota %+v, expected 42/5000 resetting at %v", limit, reset)
+	}
+}
+
+// TestObserveRateLimitDefaults tests that responses without a resource count as core and incomplete headers are ignored
+func TestObserveRateLimitDefaults(t *testing.T) {
+	t.Cleanup(func() { recordRateLimit("core", RateLimit{}) })
+
+	observeRateLimit(http.Header{"X-Ratelimit-Remaining": {"7"}, "X-Ratelimit-Reset": {"1700000000"}})
+	if limit, ok := ObservedRateLimit("core"); !ok || limit.Remaining != 7 || limit.Reset.Unix() != 1700000000 {
+		t.Errorf("Expected core quota of 7, got %+v (%v)", limit, ok)
+	}
+
+	observeRateLimit(http.Header{"X-Ratelimit-Remaining": {"3"}})
+	if limit, _ := ObservedRateLimit("core"); limit.Remaining != 7 {
+		t.Errorf("Expected headers without a reset to be ignored, got %+v", limit)
+	}
+}
@@ -0,0 +1,68 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestRecorderRoundTrip tests recording a session and replaying it offline
+func TestRecorderRoundTrip(t *testing.T) {
+	server := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			t.Error("Expected the recorded request to be authenticated")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.Write([]byte(`{"full_name":"octo-org/api","open_issues_count":4}`))
+	})).BaseURL.String()
+	t.Cleanup(func() { UseTransport(nil); _ = SetEnterpriseURLs("", "") })
+	if err := SetEnterpriseURLs(server, ""); err != nil {
+		t.Fatalf("SetEnterpriseURLs() failed: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "session.json")
+	recorder := NewRecorder(path, nil)
+	UseTransport(recorder)
+	client, _ := NewClient("secret-token")
+	if _, _, err := client.Repositories.Get(context.Background(), "octo-org", "api"); err != nil {
+		t.Fatalf("Recording failed: %v", err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+
+	data, _ := os.ReadFile(path)
+	if strings.Contains(string(data), "secret") {
+		t.Errorf("Expected tokens and cookies to stay out of fixtures, got %s", data)
+	}
+
+	// Replay against github.com with the recording server gone
+	_ = SetEnterpriseURLs("", "")
+	replayer, err := LoadFixtures(path)
+	if err != nil {
+		t.Fatalf("LoadFixtures() failed: %v", err)
+	}
+	UseTransport(replayer)
+	client, _ = NewClient("")
+	repo, _, err := client.Repositories.Get(context.Background(), "octo-org", "api")
+	if err != nil || repo.GetOpenIssuesCount() != 4 {
+		t.Fatalf("Expected the recorded repo, got %+v (err %v)", repo, err)
+	}
+
+	if _, _, err := client.Repositories.Get(context.Background(), "octo-org", "web"); err == nil || !strings.Contains(err.Error(), "no recorded response") {
+		t.Errorf("Expected unrecorded requests to fail, got %v", err)
+	}
+}
+
+// TestRequestURI tests that fixtures match regardless of host and query order
+func TestRequestURI(t *testing.T) {
+	a, _ := http.NewRequest("GET", "https://api.github.com/repos/o/r/pulls?state=open&per_page=100", nil)
+	b, _ := http.NewRequest("GET", "https://ghe.example.com/api/v3/repos/o/r/pulls?per_page=100&state=open", nil)
+	if requestURI(a.URL) != requestURI(b.URL) {
+		t.Errorf("Expected %q and %q to match", requestURI(a.URL), requestURI(b.URL))
+	}
+}
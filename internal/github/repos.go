@@ -0,0 +1,70 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/google/go-github/v55/github"
+)
+
+// repoMetadataTTL is how long repository metadata stays cached - it rarely changes
+const repoMetadataTTL = 6 * time.Hour
+
+// FetchRepoMetadata returns language, default branch, open PR count and topics
+// for a repository, served from the cache when available
+func FetchRepoMetadata(ctx context.Context, token string, repoFullName string, prCache *cache.PRCache) (*cache.RepoMetadata, error) {
+	if prCache != nil {
+		if metadata, found := prCache.GetRepoMetadata(repoFullName); found {
+			return metadata, nil
+		}
+	}
+
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := fetchRepoMetadata(ctx, client, repoFullName)
+	if err != nil {
+		return nil, err
+	}
+
+	if prCache != nil {
+		_ = prCache.SetRepoMetadata(metadata, repoMetadataTTL) // ignore cache errors
+	}
+	return metadata, nil
+}
+
+// fetchRepoMetadata fetches repository metadata using the provided client
+func fetchRepoMetadata(ctx context.Context, client *github.Client, repoFullName string) (*cache.RepoMetadata, error) {
+	parts := strings.Split(repoFullName, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid repository name: %s - use 'owner/repo'", repoFullName)
+	}
+	owner, name := parts[0], parts[1]
+
+	repo, resp, err := client.Repositories.Get(ctx, owner, name)
+	if err != nil {
+		return nil, wrapActionError(resp, repoFullName, err)
+	}
+
+	// open_issues_count includes issues, so count open PRs separately
+	openPRs, resp, err := countOpenPRs(ctx, client, owner, name)
+	if err != nil {
+		return nil, wrapActionError(resp, repoFullName, err)
+	}
+
+	return &cache.RepoMetadata{
+		FullName:      repo.GetFullName(),
+		Description:   repo.GetDescription(),
+		Language:      repo.GetLanguage(),
+		DefaultBranch: repo.GetDefaultBranch(),
+		OpenPRs:       openPRs,
+		Topics:        repo.Topics,
+		Archived:      repo.GetArchived(),
+		FetchedAt:     time.Now(),
+	}, nil
+}
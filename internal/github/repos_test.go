@@ -0,0 +1,62 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestFetchRepoMetadata(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/org/api", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"full_name": "org/api", "language": "Go", "default_branch": "trunk", "topics": ["backend", "grpc"]}`))
+	})
+	mux.HandleFunc("/repos/org/api/pulls", func(w http.ResponseWriter, r *http.Request) {
+		// Last page of a 1-per-page listing equals the number of open PRs
+		w.Header().Set("Link", fmt.Sprintf(`<%s?page=17&per_page=1>; rel="last"`, r.URL.Path))
+		w.Write([]byte(`[{"number": 1}]`))
+	})
+	client := newTestClient(t, mux)
+
+	metadata, err := fetchRepoMetadata(context.Background(), client, "org/api")
+	if err != nil {
+		t.Fatalf("fetchRepoMetadata() returned error: %v", err)
+	}
+
+	if metadata.Language != "Go" || metadata.DefaultBranch != "trunk" {
+		t.Errorf("Unexpected metadata: %+v", metadata)
+	}
+	if metadata.OpenPRs != 17 {
+		t.Errorf("Expected 17 open PRs, got %d", metadata.OpenPRs)
+	}
+	if strings.Join(metadata.Topics, ",") != "backend,grpc" {
+		t.Errorf("Expected topics backend,grpc, got %v", metadata.Topics)
+	}
+}
+
+func TestFetchRepoMetadata_SinglePage(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/org/tiny", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"full_name": "org/tiny"}`))
+	})
+	mux.HandleFunc("/repos/org/tiny/pulls", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	})
+	client := newTestClient(t, mux)
+
+	metadata, err := fetchRepoMetadata(context.Background(), client, "org/tiny")
+	if err != nil {
+		t.Fatalf("fetchRepoMetadata() returned error: %v", err)
+	}
+	if metadata.OpenPRs != 0 {
+		t.Errorf("Expected 0 open PRs, got %d", metadata.OpenPRs)
+	}
+}
+
+func TestFetchRepoMetadata_InvalidName(t *testing.T) {
+	if _, err := fetchRepoMetadata(context.Background(), nil, "not-a-repo"); err == nil {
+		t.Error("Expected error for repository name without owner")
+	}
+}
@@ -0,0 +1,89 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// branchProtectionSummary mirrors the protection summary in the branch
+// endpoint's payload, which unlike the branch protection endpoint only
+// needs read access
+type branchProtectionSummary struct {
+	Protection *struct {
+		RequiredStatusChecks *struct {
+			EnforcementLevel string   `json:"enforcement_level"`
+			Contexts         []string `json:"contexts"`
+			Checks           []struct {
+				Context string `json:"context"`
+			} `json:"checks"`
+		} `json:"required_status_checks"`
+	} `json:"protection"`
+}
+
+// FetchRequiredChecks returns the status check contexts that branch
+// protection requires before a PR into branch can merge. repo is
+// "owner/name"; unprotected branches require none.
+func FetchRequiredChecks(ctx context.Context, token, repo, branch string) ([]string, error) {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok || owner == "" || name == "" {
+		return nil, fmt.Errorf("invalid repository %q, expected owner/name", repo)
+	}
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return fetchRequiredChecks(ctx, client, owner, name, branch)
+}
+
+// fetchRequiredChecks fetches required check contexts using the provided client
+func fetchRequiredChecks(ctx context.Context, client *github.Client, owner, repo, branch string) ([]string, error) {
+	resource := fmt.Sprintf("%s/%s@%s", owner, repo, branch)
+
+	// go-github's Branch drops the protection summary, so decode it ourselves
+	req, err := client.NewRequest("GET", fmt.Sprintf("repos/%s/%s/branches/%s", owner, repo, url.PathEscape(branch)), nil)
+	if err != nil {
+		return nil, err
+	}
+	var summary branchProtectionSummary
+	resp, err := client.Do(ctx, req, &summary)
+	if err != nil {
+		return nil, wrapActionError(resp, resource, err)
+	}
+
+	if summary.Protection == nil || summary.Protection.RequiredStatusChecks == nil {
+		return nil, nil
+	}
+	checks := summary.Protection.RequiredStatusChecks
+	if checks.EnforcementLevel == "off" {
+		return nil, nil
+	}
+
+	var required []string
+	for _, context := range checks.Contexts {
+		if context != "" && !sliceContains(required, context) {
+			required = append(required, context)
+		}
+	}
+	for _, check := range checks.Checks {
+		if check.Context != "" && !sliceContains(required, check.Context) {
+			required = append(required, check.Context)
+		}
+	}
+	return required, nil
+}
+
+// MissingChecks returns the required contexts that never reported on a
+// commit, in the order they're required. Failing checks have reported.
+func MissingChecks(required, reported []string) []string {
+	var missing []string
+	for _, context := range required {
+		if !sliceContains(reported, context) {
+			missing = append(missing, context)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,53 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestFetchRequiredChecks(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/org/api/branches/main":
+			w.Write([]byte(`{"name": "main", "protected": true, "protection": {"required_status_checks": {
+				"enforcement_level": "everyone",
+				"contexts": ["build", "lint"],
+				"checks": [{"context": "build"}, {"context": "lint"}, {"context": "license/cla"}]
+			}}}`))
+		case "/repos/org/api/branches/release/v2":
+			w.Write([]byte(`{"name": "release/v2", "protected": true, "protection": {"required_status_checks": {"enforcement_level": "off", "contexts": ["build"]}}}`))
+		case "/repos/org/api/branches/feature":
+			w.Write([]byte(`{"name": "feature", "protected": false}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	required, err := fetchRequiredChecks(context.Background(), client, "org", "api", "main")
+	if err != nil || strings.Join(required, ",") != "build,lint,license/cla" {
+		t.Errorf("Expected deduplicated required checks, got %v (%v)", required, err)
+	}
+
+	for _, branch := range []string{"release/v2", "feature"} {
+		required, err := fetchRequiredChecks(context.Background(), client, "org", "api", branch)
+		if err != nil || len(required) != 0 {
+			t.Errorf("%s: expected no required checks, got %v (%v)", branch, required, err)
+		}
+	}
+
+	if _, err := fetchRequiredChecks(context.Background(), client, "org", "api", "gone"); err == nil {
+		t.Error("Expected error for missing branch")
+	}
+}
+
+func TestMissingChecks(t *testing.T) {
+	missing := MissingChecks([]string{"build", "lint", "license/cla"}, []string{"lint", "deploy-preview", "build"})
+	if strings.Join(missing, ",") != "license/cla" {
+		t.Errorf("Expected license/cla missing, got %v", missing)
+	}
+	if missing := MissingChecks(nil, []string{"build"}); len(missing) != 0 {
+		t.Errorf("Expected nothing missing without required checks, got %v", missing)
+	}
+}
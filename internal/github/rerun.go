@@ -0,0 +1,76 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// RerunFailedChecks asks GitHub to run the failed checks on a PR's head
+// commit again and returns how many re-runs it requested. Failed GitHub
+// Actions workflow runs re-run their failed jobs; other apps' failed check
+// runs are re-requested. Commit statuses can't be re-run through the API.
+func RerunFailedChecks(ctx context.Context, token string, pr *github.PullRequest) (int, error) {
+	client, err := NewClient(token)
+	if err != nil {
+		return 0, err
+	}
+	return rerunFailedChecks(ctx, client, pr)
+}
+
+// rerunFailedChecks re-runs failed checks using the provided client
+func rerunFailedChecks(ctx context.Context, client *github.Client, pr *github.PullRequest) (int, error) {
+	owner, repo, err := prCoordinates(pr)
+	if err != nil {
+		return 0, err
+	}
+	sha := pr.GetHead().GetSHA()
+	if sha == "" {
+		return 0, fmt.Errorf("%s/%s#%d has no head commit", owner, repo, pr.GetNumber())
+	}
+	resource := fmt.Sprintf("%s/%s@%.7s", owner, repo, sha)
+
+	// Workflow runs first, so their check runs aren't re-requested one by one
+	rerun := 0
+	rerunSuites := make(map[int64]bool)
+	workflowRuns, resp, err := client.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, &github.ListWorkflowRunsOptions{
+		HeadSHA:     sha,
+		ListOptions: github.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		return 0, wrapActionError(resp, resource, err)
+	}
+	for _, run := range workflowRuns.WorkflowRuns {
+		if run.GetStatus() != "completed" || !failedConclusions[run.GetConclusion()] {
+			continue
+		}
+		if resp, err := client.Actions.RerunFailedJobsByID(ctx, owner, repo, run.GetID()); err != nil {
+			return rerun, wrapActionError(resp, fmt.Sprintf("%s/%s workflow %q", owner, repo, run.GetName()), err)
+		}
+		rerunSuites[run.GetCheckSuiteID()] = true
+		rerun++
+	}
+
+	opts := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		runs, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, sha, opts)
+		if err != nil {
+			return rerun, wrapActionError(resp, resource, err)
+		}
+		for _, run := range runs.CheckRuns {
+			if run.GetStatus() != "completed" || !failedConclusions[run.GetConclusion()] || rerunSuites[run.GetCheckSuite().GetID()] {
+				continue
+			}
+			if resp, err := client.Checks.ReRequestCheckRun(ctx, owner, repo, run.GetID()); err != nil {
+				return rerun, wrapActionError(resp, fmt.Sprintf("%s/%s check %q", owner, repo, run.GetName()), err)
+			}
+			rerun++
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return rerun, nil
+}
@@ -0,0 +1,64 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+func TestRerunFailedChecks(t *testing.T) {
+	var rerun []string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/org/api/actions/runs":
+			if r.URL.Query().Get("head_sha") != "abc123" {
+				t.Errorf("Expected runs filtered by head SHA, got %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"total_count": 2, "workflow_runs": [
+				{"id": 41, "name": "CI", "status": "completed", "conclusion": "failure", "check_suite_id": 900},
+				{"id": 42, "name": "Docs", "status": "completed", "conclusion": "success", "check_suite_id": 901}
+			]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/org/api/commits/abc123/check-runs":
+			w.Write([]byte(`{"total_count": 3, "check_runs": [
+				{"id": 7, "name": "test", "status": "completed", "conclusion": "failure", "check_suite": {"id": 900}},
+				{"id": 8, "name": "external-scan", "status": "completed", "conclusion": "timed_out", "check_suite": {"id": 950}},
+				{"id": 9, "name": "lint", "status": "completed", "conclusion": "success", "check_suite": {"id": 900}}
+			]}`))
+		case r.Method == http.MethodPost:
+			rerun = append(rerun, r.URL.Path)
+			w.WriteHeader(http.StatusCreated)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	pr := actionTestPR()
+	pr.Head = &gh.PullRequestBranch{SHA: gh.String("abc123")}
+	count, err := rerunFailedChecks(context.Background(), client, pr)
+	if err != nil {
+		t.Fatalf("rerunFailedChecks() returned error: %v", err)
+	}
+	want := "/repos/org/api/actions/runs/41/rerun-failed-jobs,/repos/org/api/check-runs/8/rerequest"
+	if count != 2 || strings.Join(rerun, ",") != want {
+		t.Errorf("Expected the failed workflow and the other app's check re-run, got %d: %v", count, rerun)
+	}
+}
+
+func TestRerunFailedChecks_Forbidden(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte(`{"total_count": 1, "workflow_runs": [{"id": 41, "name": "CI", "status": "completed", "conclusion": "failure"}]}`))
+	}))
+
+	pr := actionTestPR()
+	pr.Head = &gh.PullRequestBranch{SHA: gh.String("abc123")}
+	if _, err := rerunFailedChecks(context.Background(), client, pr); err == nil || !strings.Contains(err.Error(), "CI") {
+		t.Errorf("Expected the refused workflow named in the error, got %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// maxReviewerCandidates caps how many members or teams are listed for the
+// reviewer picker, keeping very large orgs to a few requests
+const maxReviewerCandidates = 500
+
+// ReviewerCandidates are the users and teams that can be asked to review PRs
+// in a repository
+type ReviewerCandidates struct {
+	Users []string // Logins, sorted
+	Teams []string // Team slugs, sorted; empty for user-owned repositories
+}
+
+// FetchReviewerCandidates lists the members and teams of the organization
+// owning the PR's repository. For user-owned repositories it falls back to
+// the repository's collaborators.
+func FetchReviewerCandidates(ctx context.Context, token string, pr *github.PullRequest) (*ReviewerCandidates, error) {
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return fetchReviewerCandidates(ctx, client, pr)
+}
+
+// fetchReviewerCandidates lists reviewer candidates using the provided client
+func fetchReviewerCandidates(ctx context.Context, client *github.Client, pr *github.PullRequest) (*ReviewerCandidates, error) {
+	owner, repo, err := prCoordinates(pr)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := &ReviewerCandidates{}
+	opts := &github.ListMembersOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for len(candidates.Users) < maxReviewerCandidates {
+		members, resp, err := client.Organizations.ListMembers(ctx, owner, opts)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				// Not an organization
+				return fetchCollaborators(ctx, client, owner, repo)
+			}
+			return nil, wrapActionError(resp, owner, err)
+		}
+		for _, member := range members {
+			candidates.Users = append(candidates.Users, member.GetLogin())
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	teamOpts := &github.ListOptions{PerPage: 100}
+	for len(candidates.Teams) < maxReviewerCandidates {
+		teams, resp, err := client.Teams.ListTeams(ctx, owner, teamOpts)
+		if err != nil {
+			// Listing teams needs read:org; members alone still work
+			break
+		}
+		for _, team := range teams {
+			candidates.Teams = append(candidates.Teams, team.GetSlug())
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		teamOpts.Page = resp.NextPage
+	}
+
+	sort.Strings(candidates.Users)
+	sort.Strings(candidates.Teams)
+	return candidates, nil
+}
+
+// fetchCollaborators lists a user-owned repository's collaborators as reviewer candidates
+func fetchCollaborators(ctx context.Context, client *github.Client, owner, repo string) (*ReviewerCandidates, error) {
+	candidates := &ReviewerCandidates{}
+	opts := &github.ListCollaboratorsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for len(candidates.Users) < maxReviewerCandidates {
+		users, resp, err := client.Repositories.ListCollaborators(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, wrapActionError(resp, owner+"/"+repo, err)
+		}
+		for _, user := range users {
+			candidates.Users = append(candidates.Users, user.GetLogin())
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	sort.Strings(candidates.Users)
+	return candidates, nil
+}
+
+// RequestReviewers asks users and teams to review the given pull request and
+// returns the PR with its updated review requests
+func RequestReviewers(ctx context.Context, token string, pr *github.PullRequest, users, teams []string) (*github.PullRequest, error) {
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return requestReviewers(ctx, client, pr, users, teams)
+}
+
+// requestReviewers requests reviews using the provided client
+func requestReviewers(ctx context.Context, client *github.Client, pr *github.PullRequest, users, teams []string) (*github.PullRequest, error) {
+	owner, repo, err := prCoordinates(pr)
+	if err != nil {
+		return nil, err
+	}
+
+	request := github.ReviewersRequest{Reviewers: users, TeamReviewers: teams}
+	updated, resp, err := client.PullRequests.RequestReviewers(ctx, owner, repo, pr.GetNumber(), request)
+	if err != nil {
+		return nil, wrapActionError(resp, fmt.Sprintf("%s/%s#%d", owner, repo, pr.GetNumber()), err)
+	}
+	return updated, nil
+}
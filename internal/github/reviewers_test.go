@@ -0,0 +1,77 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestFetchReviewerCandidates(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/orgs/org/members":
+			w.Write([]byte(`[{"login": "dave"}, {"login": "alice"}]`))
+		case "/orgs/org/teams":
+			w.Write([]byte(`[{"slug": "platform"}, {"slug": "frontend"}]`))
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+	}))
+
+	candidates, err := fetchReviewerCandidates(context.Background(), client, actionTestPR())
+	if err != nil {
+		t.Fatalf("fetchReviewerCandidates() returned error: %v", err)
+	}
+	if !reflect.DeepEqual(candidates.Users, []string{"alice", "dave"}) || !reflect.DeepEqual(candidates.Teams, []string{"frontend", "platform"}) {
+		t.Errorf("Expected sorted members and teams, got %+v", candidates)
+	}
+}
+
+func TestFetchReviewerCandidates_UserRepo(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/orgs/org/members":
+			w.WriteHeader(http.StatusNotFound)
+		case "/repos/org/api/collaborators":
+			w.Write([]byte(`[{"login": "erin"}]`))
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+	}))
+
+	candidates, err := fetchReviewerCandidates(context.Background(), client, actionTestPR())
+	if err != nil {
+		t.Fatalf("fetchReviewerCandidates() returned error: %v", err)
+	}
+	if !reflect.DeepEqual(candidates.Users, []string{"erin"}) || len(candidates.Teams) != 0 {
+		t.Errorf("Expected collaborators without teams, got %+v", candidates)
+	}
+}
+
+func TestRequestReviewers(t *testing.T) {
+	var body struct {
+		Reviewers     []string `json:"reviewers"`
+		TeamReviewers []string `json:"team_reviewers"`
+	}
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/repos/org/api/pulls/12/requested_reviewers" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"number": 12, "requested_reviewers": [{"login": "alice"}], "requested_teams": [{"slug": "platform"}]}`))
+	}))
+
+	updated, err := requestReviewers(context.Background(), client, actionTestPR(), []string{"alice"}, []string{"platform"})
+	if err != nil {
+		t.Fatalf("requestReviewers() returned error: %v", err)
+	}
+	if !reflect.DeepEqual(body.Reviewers, []string{"alice"}) || !reflect.DeepEqual(body.TeamReviewers, []string{"platform"}) {
+		t.Errorf("Expected users and teams in request, got %+v", body)
+	}
+	if len(updated.RequestedReviewers) != 1 || len(updated.RequestedTeams) != 1 {
+		t.Errorf("Expected updated review requests, got %+v", updated)
+	}
+}
@@ -0,0 +1,178 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// tokenExpirationLayout is how GitHub reports when a token expires
+const tokenExpirationLayout = "2006-01-02 15:04:05 MST"
+
+// TokenInfo is what GitHub reports about a token and its quotas
+type TokenInfo struct {
+	Login string
+
+	// Scopes of a classic token. ScopesListed is false for fine-grained and
+	// app tokens, whose permissions GitHub doesn't report.
+	Scopes       []string
+	ScopesListed bool
+
+	Expires time.Time // Zero when the token doesn't expire
+
+	Core    RateLimit
+	GraphQL RateLimit
+	Search  RateLimit
+}
+
+// HasScope reports whether a classic token grants scope, directly or through
+// a broader scope that includes it
+func (t *TokenInfo) HasScope(scope string) bool {
+	implied := map[string][]string{
+		"read:org":     {"read:org", "write:org", "admin:org"},
+		"repo":         {"repo"},
+		"public_repo":  {"public_repo", "repo"},
+		"read:project": {"read:project", "project"},
+	}[scope]
+	if implied == nil {
+		implied = []string{scope}
+	}
+	for _, have := range t.Scopes {
+		for _, want := range implied {
+			if have == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// InspectToken reports whose token it is, its scopes, expiry and remaining
+// quotas. A rejected token gets an error saying so instead of GitHub's
+// "Bad credentials".
+func InspectToken(ctx context.Context, token string) (*TokenInfo, error) {
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return inspectToken(ctx, client)
+}
+
+// inspectToken inspects the token of the provided client
+func inspectToken(ctx context.Context, client *github.Client) (*TokenInfo, error) {
+	user, resp, err := client.Users.Get(ctx, "")
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusUnauthorized {
+			return nil, fmt.Errorf("GitHub rejected the token (Bad credentials) - it has expired or been revoked; create a new one at %ssettings/tokens or run 'gh auth refresh'", WebURL())
+		}
+		return nil, wrapActionError(resp, "current user", err)
+	}
+
+	info := &TokenInfo{Login: user.GetLogin()}
+	if scopes := resp.Header.Values("X-OAuth-Scopes"); len(scopes) > 0 {
+		info.ScopesListed = true
+		for _, scope := range strings.Split(scopes[0], ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				info.Scopes = append(info.Scopes, scope)
+			}
+		}
+	}
+	if expires, err := time.Parse(tokenExpirationLayout, resp.Header.Get("GitHub-Authentication-Token-Expiration")); err == nil {
+		info.Expires = expires
+	}
+
+	limits, resp, err := client.RateLimits(ctx)
+	if err != nil {
+		return nil, wrapActionError(resp, "rate limit", err)
+	}
+	info.Core = rateLimitOf(limits.Core)
+	info.GraphQL = rateLimitOf(limits.GraphQL)
+	info.Search = rateLimitOf(limits.Search)
+	return info, nil
+}
+
+// rateLimitOf converts a go-github quota, which is nil if GitHub left it out
+func rateLimitOf(rate *github.Rate) RateLimit {
+	if rate == nil {
+		return RateLimit{}
+	}
+	return RateLimit{Limit: rate.Limit, Remaining: rate.Remaining, Reset: rate.Reset.Time}
+}
+
+// ssoAuthorizeURL returns where to authorize the token for an organization's
+// SAML single sign-on when GitHub refused a request for lack of it, or ""
+func ssoAuthorizeURL(resp *github.Response) string {
+	if resp == nil || resp.Response == nil || resp.StatusCode != http.StatusForbidden {
+		return ""
+	}
+	sso := resp.Header.Get("X-GitHub-SSO") // "required; url=https://github.com/orgs/acme/sso?authorization_request=..."
+	if !strings.HasPrefix(sso, "required") {
+		return ""
+	}
+	_, authorizeURL, _ := strings.Cut(sso, "url=")
+	return strings.TrimSpace(authorizeURL)
+}
+
+// CheckRepository confirms a repository ("owner/name") exists and the token
+// can read it
+func CheckRepository(ctx context.Context, token string, repoFullName string) error {
+	client, err := NewClient(token)
+	if err != nil {
+		return err
+	}
+	return checkRepository(ctx, client, repoFullName)
+}
+
+// checkRepository looks a repository up using the provided client
+func checkRepository(ctx context.Context, client *github.Client, repoFullName string) error {
+	owner, name, ok := strings.Cut(repoFullName, "/")
+	if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return fmt.Errorf("invalid repository name: %s - use 'owner/repo'", repoFullName)
+	}
+	_, resp, err := client.Repositories.Get(ctx, owner, name)
+	if err != nil {
+		return wrapActionError(resp, repoFullName, err)
+	}
+	return nil
+}
+
+// CheckOrganization confirms an organization exists and the token can see it
+func CheckOrganization(ctx context.Context, token string, org string) error {
+	client, err := NewClient(token)
+	if err != nil {
+		return err
+	}
+	return checkOrganization(ctx, client, org)
+}
+
+// checkOrganization looks an organization up using the provided client
+func checkOrganization(ctx context.Context, client *github.Client, org string) error {
+	_, resp, err := client.Organizations.Get(ctx, org)
+	if err != nil {
+		return wrapActionError(resp, "organization "+org, err)
+	}
+	return nil
+}
+
+// CheckTeam confirms a team exists in an organization and the token can
+// see it, which takes read:org for private teams
+func CheckTeam(ctx context.Context, token string, org, slug string) error {
+	client, err := NewClient(token)
+	if err != nil {
+		return err
+	}
+	return checkTeam(ctx, client, org, slug)
+}
+
+// checkTeam looks a team up using the provided client
+func checkTeam(ctx context.Context, client *github.Client, org, slug string) error {
+	_, resp, err := client.Teams.GetTeamBySlug(ctx, org, slug)
+	if err != nil {
+		return wrapActionError(resp, fmt.Sprintf("team %s/%s", org, slug), err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestScopeChecks(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/acme/api", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"full_name": "acme/api"}`))
+	})
+	mux.HandleFunc("/orgs/acme", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"login": "acme"}`))
+	})
+	mux.HandleFunc("/orgs/acme/teams/backend", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"slug": "backend"}`))
+	})
+	client := newTestClient(t, mux)
+	ctx := context.Background()
+
+	if err := checkRepository(ctx, client, "acme/api"); err != nil {
+		t.Errorf("checkRepository() error = %v", err)
+	}
+	for _, repo := range []string{"acme/missing", "acme", "acme/api/extra"} {
+		if err := checkRepository(ctx, client, repo); err == nil {
+			t.Errorf("Expected checkRepository(%q) to fail", repo)
+		}
+	}
+
+	if err := checkOrganization(ctx, client, "acme"); err != nil {
+		t.Errorf("checkOrganization() error = %v", err)
+	}
+	if err := checkOrganization(ctx, client, "nobody"); err == nil {
+		t.Error("Expected an unknown organization to fail")
+	}
+
+	if err := checkTeam(ctx, client, "acme", "backend"); err != nil {
+		t.Errorf("checkTeam() error = %v", err)
+	}
+	if err := checkTeam(ctx, client, "acme", "frontend"); err == nil {
+		t.Error("Expected an unknown team to fail")
+	}
+}
+
+func TestInspectToken(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-OAuth-Scopes", "repo, write:org")
+		w.Header().Set("GitHub-Authentication-Token-Expiration", "2026-11-01 08:00:00 UTC")
+		w.Write([]byte(`{"login": "alice"}`))
+	})
+	mux.HandleFunc("/rate_limit", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"resources": {"core": {"limit": 5000, "remaining": 4200, "reset": 1790000000},
+			"graphql": {"limit": 5000, "remaining": 12, "reset": 1790000000}}}`))
+	})
+	info, err := inspectToken(context.Background(), newTestClient(t, mux))
+	if err != nil {
+		t.Fatalf("inspectToken() error = %v", err)
+	}
+	if info.Login != "alice" || !info.ScopesListed || !info.HasScope("repo") || !info.HasScope("read:org") || info.HasScope("workflow") {
+		t.Errorf("Unexpected token info %+v", info)
+	}
+	if info.Expires.Format("2006-01-02") != "2026-11-01" {
+		t.Errorf("Expected the expiry to be read, got %v", info.Expires)
+	}
+	if info.Core.Remaining != 4200 || info.GraphQL.Remaining != 12 || info.Search.Limit != 0 {
+		t.Errorf("Expected the quotas to be read, got %+v %+v %+v", info.Core, info.GraphQL, info.Search)
+	}
+}
+
+func TestInspectToken_Rejected(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"message": "Bad credentials"}`))
+	}))
+	if _, err := inspectToken(context.Background(), client); err == nil || !strings.Contains(err.Error(), "expired or been revoked") {
+		t.Errorf("Expected a rejected token to be explained, got %v", err)
+	}
+}
+
+func TestScopeChecks_SSO(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-GitHub-SSO", "required; url=https://github.com/orgs/acme/sso?authorization_request=abc")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message": "Resource protected by organization SAML enforcement."}`))
+	}))
+	err := checkOrganization(context.Background(), client, "acme")
+	if err == nil || !strings.Contains(err.Error(), "authorize it at https://github.com/orgs/acme/sso?authorization_request=abc") {
+		t.Errorf("Expected the SSO authorization link, got %v", err)
+	}
+}
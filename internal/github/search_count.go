@@ -0,0 +1,27 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// CountSearchResults returns how many issues and PRs match a search query,
+// using a single request however many there are
+func CountSearchResults(ctx context.Context, token string, query string) (int, error) {
+	client, err := NewClient(token)
+	if err != nil {
+		return 0, err
+	}
+	return countSearchResults(ctx, client, query)
+}
+
+// countSearchResults counts search matches using the provided client
+func countSearchResults(ctx context.Context, client *github.Client, query string) (int, error) {
+	result, resp, err := client.Search.Issues(ctx, query, &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 1}})
+	if err != nil {
+		return 0, wrapActionError(resp, fmt.Sprintf("search %q", query), err)
+	}
+	return result.GetTotal(), nil
+}
@@ -0,0 +1,28 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestCountSearchResults(t *testing.T) {
+	var query string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("q")
+		if r.URL.Query().Get("per_page") != "1" {
+			t.Errorf("Expected a single-result page, got %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"total_count": 37, "items": [{"number": 1}]}`))
+	})
+	client := newTestClient(t, mux)
+
+	count, err := countSearchResults(context.Background(), client, "is:pr is:merged org:acme")
+	if err != nil {
+		t.Fatalf("countSearchResults() returned error: %v", err)
+	}
+	if count != 37 || query != "is:pr is:merged org:acme" {
+		t.Errorf("Expected 37 results for the query, got %d for %q", count, query)
+	}
+}
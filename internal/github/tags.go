@@ -0,0 +1,39 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// FetchTagDate returns when the commit a tag points to was committed in a
+// repo ("owner/name"), e.g. to list what merged since a release
+func FetchTagDate(ctx context.Context, token, repo, tag string) (time.Time, error) {
+	client, err := NewClient(token)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return fetchTagDate(ctx, client, repo, tag)
+}
+
+// fetchTagDate looks up a tag's commit date using the provided client. The
+// commits API resolves tag names, annotated or not, in a single request.
+func fetchTagDate(ctx context.Context, client *github.Client, repo, tag string) (time.Time, error) {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok || owner == "" || name == "" {
+		return time.Time{}, fmt.Errorf("invalid repository %q, expected owner/name", repo)
+	}
+
+	commit, resp, err := client.Repositories.GetCommit(ctx, owner, name, "refs/tags/"+tag, nil)
+	if err != nil {
+		return time.Time{}, wrapActionError(resp, fmt.Sprintf("tag %s in %s", tag, repo), err)
+	}
+	date := commit.GetCommit().GetCommitter().GetDate().Time
+	if date.IsZero() {
+		return time.Time{}, fmt.Errorf("tag %s in %s has no commit date", tag, repo)
+	}
+	return date, nil
+}
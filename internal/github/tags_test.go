@@ -0,0 +1,31 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestFetchTagDate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/org/api/commits/refs/tags/v1.2.0", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"sha": "abc", "commit": {"committer": {"date": "2024-05-01T12:00:00Z"}}}`))
+	})
+	client := newTestClient(t, mux)
+
+	date, err := fetchTagDate(context.Background(), client, "org/api", "v1.2.0")
+	if err != nil {
+		t.Fatalf("fetchTagDate() returned error: %v", err)
+	}
+	if want := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC); !date.Equal(want) {
+		t.Errorf("Expected the tag's commit date %v, got %v", want, date)
+	}
+
+	if _, err := fetchTagDate(context.Background(), client, "org/api", "v9"); err == nil {
+		t.Error("Expected an error for a missing tag")
+	}
+	if _, err := fetchTagDate(context.Background(), client, "api", "v1.2.0"); err == nil {
+		t.Error("Expected an error for a repo without owner")
+	}
+}
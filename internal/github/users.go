@@ -0,0 +1,145 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/google/go-github/v55/github"
+)
+
+// userProfileTTL is how long user profiles stay cached - they rarely change
+const userProfileTTL = 24 * time.Hour
+
+// teamMembersTTL is how long team memberships stay cached; people join and
+// leave teams more often than they change profiles
+const teamMembersTTL = 6 * time.Hour
+
+// FetchUserProfile returns the public profile of a GitHub user, served from
+// the cache when available
+func FetchUserProfile(ctx context.Context, token string, login string, prCache *cache.PRCache) (*cache.UserProfile, error) {
+	if prCache != nil {
+		if profile, found := prCache.GetUserProfile(login); found {
+			return profile, nil
+		}
+	}
+
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := fetchUserProfile(ctx, client, login)
+	if err != nil {
+		return nil, err
+	}
+
+	if prCache != nil {
+		_ = prCache.SetUserProfile(profile, userProfileTTL) // ignore cache errors
+	}
+	return profile, nil
+}
+
+// fetchUserProfile fetches a user profile using the provided client
+func fetchUserProfile(ctx context.Context, client *github.Client, login string) (*cache.UserProfile, error) {
+	if login == "" {
+		return nil, fmt.Errorf("invalid user login: empty")
+	}
+
+	user, resp, err := client.Users.Get(ctx, login)
+	if err != nil {
+		return nil, wrapActionError(resp, login, err)
+	}
+
+	return &cache.UserProfile{
+		Login:     user.GetLogin(),
+		Name:      user.GetName(),
+		Location:  user.GetLocation(),
+		Company:   user.GetCompany(),
+		FetchedAt: time.Now(),
+	}, nil
+}
+
+// FetchViewerLogin returns the login of the user the token belongs to,
+// served from the cache when available
+func FetchViewerLogin(ctx context.Context, token string, prCache *cache.PRCache) (string, error) {
+	if token == "" {
+		return "", fmt.Errorf("no token: cannot identify the current user")
+	}
+	if prCache != nil {
+		if login, found := prCache.GetViewerLogin(token); found {
+			return login, nil
+		}
+	}
+
+	client, err := NewClient(token)
+	if err != nil {
+		return "", err
+	}
+
+	user, resp, err := client.Users.Get(ctx, "")
+	if err != nil {
+		return "", wrapActionError(resp, "current user", err)
+	}
+
+	if prCache != nil {
+		_ = prCache.SetViewerLogin(token, user.GetLogin(), userProfileTTL) // ignore cache errors
+	}
+	return user.GetLogin(), nil
+}
+
+// FetchViewerTeams returns the teams the token's user belongs to, as
+// "org/slug". Listing them needs the read:org scope.
+func FetchViewerTeams(ctx context.Context, token string) ([]string, error) {
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return fetchViewerTeams(ctx, client)
+}
+
+// fetchViewerTeams lists the current user's teams using the provided client
+func fetchViewerTeams(ctx context.Context, client *github.Client) ([]string, error) {
+	var teams []string
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		page, resp, err := client.Teams.ListUserTeams(ctx, opts)
+		if err != nil {
+			return nil, wrapActionError(resp, "your teams", err)
+		}
+		for _, team := range page {
+			teams = append(teams, team.GetOrganization().GetLogin()+"/"+team.GetSlug())
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return teams, nil
+}
+
+// FetchTeamMembers returns the logins of an organization team's members,
+// served from the cache when available. Listing them needs the read:org scope.
+func FetchTeamMembers(ctx context.Context, token, org, slug string, prCache *cache.PRCache) ([]string, error) {
+	if prCache != nil {
+		if members, found := prCache.GetTeamMembers(org, slug); found {
+			return members, nil
+		}
+	}
+
+	client, err := NewClient(token)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := fetchTeamMembers(ctx, client, org, slug)
+	if err != nil {
+		return nil, fmt.Errorf("team %s/%s: %w", org, slug, err)
+	}
+
+	if prCache != nil {
+		_ = prCache.SetTeamMembers(org, slug, members, teamMembersTTL) // ignore cache errors
+	}
+	return members, nil
+}
@@ -0,0 +1,88 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+)
+
+func TestFetchUserProfile(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/users/alice", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"login": "alice", "name": "Alice A.", "location": "Berlin, Germany", "company": "@org"}`))
+	})
+	client := newTestClient(t, mux)
+
+	profile, err := fetchUserProfile(context.Background(), client, "alice")
+	if err != nil {
+		t.Fatalf("fetchUserProfile() returned error: %v", err)
+	}
+	if profile.Name != "Alice A." || profile.Location != "Berlin, Germany" || profile.Company != "@org" {
+		t.Errorf("Unexpected profile: %+v", profile)
+	}
+}
+
+func TestFetchUserProfile_NotFound(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/users/ghost", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "Not Found"}`))
+	})
+	client := newTestClient(t, mux)
+
+	if _, err := fetchUserProfile(context.Background(), client, "ghost"); err == nil {
+		t.Error("Expected error for unknown user")
+	}
+}
+
+func TestFetchViewerLogin_Cached(t *testing.T) {
+	prCache, err := cache.NewPRCacheWithDir(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create cache: %v", err)
+	}
+	if err := prCache.SetViewerLogin("test-token", "alice", time.Hour); err != nil {
+		t.Fatalf("SetViewerLogin() error = %v", err)
+	}
+
+	login, err := FetchViewerLogin(context.Background(), "test-token", prCache)
+	if err != nil || login != "alice" {
+		t.Errorf("FetchViewerLogin() = %q, %v; want alice from the cache", login, err)
+	}
+	if _, err := FetchViewerLogin(context.Background(), "", prCache); err == nil {
+		t.Error("Expected an error without a token")
+	}
+}
+
+func TestFetchViewerTeams(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/user/teams", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"slug": "backend", "organization": {"login": "myorg"}}, {"slug": "sre", "organization": {"login": "other"}}]`))
+	})
+	client := newTestClient(t, mux)
+
+	teams, err := fetchViewerTeams(context.Background(), client)
+	if err != nil {
+		t.Fatalf("fetchViewerTeams() returned error: %v", err)
+	}
+	if len(teams) != 2 || teams[0] != "myorg/backend" || teams[1] != "other/sre" {
+		t.Errorf("Unexpected teams: %v", teams)
+	}
+}
+
+func TestFetchTeamMembers_Cached(t *testing.T) {
+	prCache, err := cache.NewPRCacheWithDir(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create cache: %v", err)
+	}
+	if err := prCache.SetTeamMembers("myorg", "platform", []string{"alice", "bob"}, time.Hour); err != nil {
+		t.Fatalf("SetTeamMembers() error = %v", err)
+	}
+
+	members, err := FetchTeamMembers(context.Background(), "test-token", "myorg", "platform", prCache)
+	if err != nil || len(members) != 2 || members[0] != "alice" {
+		t.Errorf("FetchTeamMembers() = %v, %v; want alice and bob from the cache", members, err)
+	}
+}
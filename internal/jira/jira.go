@@ -0,0 +1,179 @@
+// Package jira resolves Jira ticket keys found in PRs to their issue status.
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+)
+
+// IssueTTL is how long an issue's status is cached; tickets move less often
+// than PRs refresh
+const IssueTTL = 15 * time.Minute
+
+// projectKeyPattern matches a Jira project key such as ABC or OPS2
+var projectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,9}$`)
+
+// Config connects PR Compass to a Jira instance. The API token comes from
+// the JIRA_TOKEN environment variable.
+type Config struct {
+	// Base URL, e.g. https://acme.atlassian.net or https://jira.example.com
+	URL string `mapstructure:"url" yaml:"url,omitempty"`
+
+	// Account email for Jira Cloud, which authenticates with email and API
+	// token; unset sends the token as a Server/Data Center personal access token
+	Email string `mapstructure:"email" yaml:"email,omitempty"`
+
+	// Project keys to resolve; unset resolves every key that looks like a ticket
+	Projects []string `mapstructure:"projects" yaml:"projects,omitempty"`
+}
+
+// Enabled reports whether a Jira instance is configured
+func (c Config) Enabled() bool {
+	return c.URL != ""
+}
+
+// Validate checks the URL and project keys without connecting
+func (c Config) Validate() error {
+	if !c.Enabled() {
+		if c.Email != "" || len(c.Projects) > 0 {
+			return fmt.Errorf("jira.url is required to resolve tickets")
+		}
+		return nil
+	}
+	parsed, err := url.Parse(c.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("jira.url must be an http(s) URL, got %q", c.URL)
+	}
+	for _, project := range c.Projects {
+		if !projectKeyPattern.MatchString(project) {
+			return fmt.Errorf("jira.projects: %q isn't a Jira project key such as ABC", project)
+		}
+	}
+	return nil
+}
+
+// Tracks reports whether a ticket key belongs to one of the configured
+// projects, or to any project when none are configured
+func (c Config) Tracks(key string) bool {
+	if len(c.Projects) == 0 {
+		return true
+	}
+	project, _, _ := strings.Cut(key, "-")
+	for _, candidate := range c.Projects {
+		if strings.EqualFold(candidate, project) {
+			return true
+		}
+	}
+	return false
+}
+
+// Client reads issues from the Jira REST API (v2, which Cloud and
+// Server/Data Center both serve)
+type Client struct {
+	config  Config
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+// NewClient creates a client for the configured instance
+func NewClient(cfg Config, token string) *Client {
+	return &Client{
+		config:  cfg,
+		baseURL: strings.TrimRight(strings.TrimSpace(cfg.URL), "/"),
+		token:   token,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Tracks reports whether the client resolves this ticket key
+func (c *Client) Tracks(key string) bool {
+	return c.config.Tracks(key)
+}
+
+// FetchIssue returns an issue's summary and status, served from the cache
+// when available. Issues Jira doesn't know are returned as Missing rather
+// than failing, and cached too.
+func (c *Client) FetchIssue(ctx context.Context, key string, prCache *cache.PRCache) (*cache.JiraIssue, error) {
+	if prCache != nil {
+		if issue, found := prCache.GetJiraIssue(c.baseURL, key); found {
+			return issue, nil
+		}
+	}
+
+	issue, err := c.fetchIssue(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if prCache != nil {
+		_ = prCache.SetJiraIssue(c.baseURL, issue, IssueTTL) // ignore cache errors
+	}
+	return issue, nil
+}
+
+// fetchIssue reads one issue's summary and status from the API
+func (c *Client) fetchIssue(ctx context.Context, key string) (*cache.JiraIssue, error) {
+	endpoint := fmt.Sprintf("%s/rest/api/2/issue/%s?fields=summary,status", c.baseURL, url.PathEscape(key))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	switch {
+	case c.token == "":
+	case c.config.Email != "":
+		req.SetBasicAuth(c.config.Email, c.token)
+	default:
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Jira request for %s failed: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		// Also what Jira answers for issues the token can't browse
+		return &cache.JiraIssue{Key: key, Missing: true, FetchedAt: time.Now()}, nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, fmt.Errorf("Jira rejected the token for %s - check JIRA_TOKEN, and jira.email on Jira Cloud", key)
+	case http.StatusTooManyRequests:
+		return nil, fmt.Errorf("Jira rate limit exceeded while fetching %s - try again later", key)
+	default:
+		return nil, fmt.Errorf("Jira returned %s for %s", resp.Status, key)
+	}
+
+	var body struct {
+		Fields struct {
+			Summary string `json:"summary"`
+			Status  struct {
+				Name           string `json:"name"`
+				StatusCategory struct {
+					Key string `json:"key"`
+				} `json:"statusCategory"`
+			} `json:"status"`
+		} `json:"fields"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("Jira response for %s: %w", key, err)
+	}
+	return &cache.JiraIssue{
+		Key:       key, // As referenced, even if the issue moved to another project
+		Summary:   body.Fields.Summary,
+		Status:    body.Fields.Status.Name,
+		Category:  body.Fields.Status.StatusCategory.Key,
+		FetchedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,91 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+)
+
+const testIssue = `{"key": "ABC-12", "fields": {"summary": "Retry uploads",
+  "status": {"name": "In Review", "statusCategory": {"key": "indeterminate"}}}}`
+
+func TestFetchIssue(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if user, token, ok := r.BasicAuth(); !ok || user != "me@acme.com" || token != "secret" {
+			t.Errorf("Expected Jira Cloud basic auth, got %q", r.Header.Get("Authorization"))
+		}
+		switch r.URL.Path {
+		case "/rest/api/2/issue/ABC-12":
+			w.Write([]byte(testIssue))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{URL: server.URL + "/", Email: "me@acme.com"}, "secret")
+	prCache := cache.NewPRCacheWithBackend(cache.NewMemoryBackend())
+
+	issue, err := client.FetchIssue(context.Background(), "ABC-12", prCache)
+	if err != nil {
+		t.Fatalf("FetchIssue() returned error: %v", err)
+	}
+	if issue.Status != "In Review" || issue.Category != "indeterminate" || issue.Summary != "Retry uploads" {
+		t.Errorf("Unexpected issue: %+v", issue)
+	}
+	if _, err := client.FetchIssue(context.Background(), "ABC-12", prCache); err != nil || requests != 1 {
+		t.Errorf("Expected the second lookup to come from the cache, got %d requests (%v)", requests, err)
+	}
+
+	missing, err := client.FetchIssue(context.Background(), "ABC-99", prCache)
+	if err != nil || !missing.Missing {
+		t.Errorf("Expected an unknown issue to be missing, got %+v (%v)", missing, err)
+	}
+}
+
+func TestFetchIssue_RejectedToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			t.Errorf("Expected a personal access token, got %q", r.Header.Get("Authorization"))
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	_, err := NewClient(Config{URL: server.URL}, "secret").FetchIssue(context.Background(), "ABC-12", nil)
+	if err == nil || !strings.Contains(err.Error(), "JIRA_TOKEN") {
+		t.Errorf("Expected the error to point at JIRA_TOKEN, got %v", err)
+	}
+}
+
+func TestConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		config  Config
+		wantErr string
+	}{
+		{"unset", Config{}, ""},
+		{"cloud", Config{URL: "https://acme.atlassian.net", Email: "me@acme.com", Projects: []string{"ABC", "OPS2"}}, ""},
+		{"no url", Config{Projects: []string{"ABC"}}, "jira.url is required"},
+		{"bad url", Config{URL: "acme.atlassian.net"}, "http(s) URL"},
+		{"bad project", Config{URL: "https://jira.example.com", Projects: []string{"abc"}}, "project key"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.wantErr == "" && err != nil || tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)) {
+				t.Errorf("Validate() = %v, want %q", err, tc.wantErr)
+			}
+		})
+	}
+
+	scoped := Config{URL: "https://jira.example.com", Projects: []string{"ABC"}}
+	if !scoped.Tracks("ABC-1") || scoped.Tracks("HTTP-2") || !(Config{}).Tracks("HTTP-2") {
+		t.Error("Expected only keys of the configured projects to be tracked")
+	}
+}
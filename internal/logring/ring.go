@@ -0,0 +1,132 @@
+package logring
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Entry is one log record kept in the ring
+type Entry struct {
+	Time    time.Time
+	Level   slog.Level
+	Message string
+	Attrs   string // key=value pairs, space-separated
+}
+
+// String formats the entry as a single log line
+func (e Entry) String() string {
+	line := fmt.Sprintf("%s %-5s %s", e.Time.Format("15:04:05"), e.Level, e.Message)
+	if e.Attrs != "" {
+		line += " " + e.Attrs
+	}
+	return line
+}
+
+// ring holds the most recent entries, shared by a handler and the handlers
+// derived from it
+type ring struct {
+	mu       sync.Mutex
+	capacity int
+	entries  []Entry
+}
+
+// Handler is a slog handler keeping the most recent records in memory, so
+// they can be shown without a log file. Every level is kept; readers filter.
+type Handler struct {
+	ring   *ring
+	attrs  string // Attributes added with WithAttrs, already formatted
+	prefix string // Group prefix for attribute keys, e.g. "fetch."
+}
+
+// New creates a handler keeping at most capacity records
+func New(capacity int) *Handler {
+	return &Handler{ring: &ring{capacity: capacity}}
+}
+
+// Enabled reports that every level is kept
+func (h *Handler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+// Handle adds a record to the ring, dropping the oldest one when full
+func (h *Handler) Handle(_ context.Context, record slog.Record) error {
+	attrs := []string{}
+	if h.attrs != "" {
+		attrs = append(attrs, h.attrs)
+	}
+	record.Attrs(func(attr slog.Attr) bool {
+		attrs = appendAttr(attrs, h.prefix, attr)
+		return true
+	})
+	entry := Entry{Time: record.Time, Level: record.Level, Message: record.Message, Attrs: strings.Join(attrs, " ")}
+
+	h.ring.mu.Lock()
+	defer h.ring.mu.Unlock()
+	h.ring.entries = append(h.ring.entries, entry)
+	if len(h.ring.entries) > h.ring.capacity {
+		h.ring.entries = h.ring.entries[len(h.ring.entries)-h.ring.capacity:]
+	}
+	return nil
+}
+
+// WithAttrs returns a handler adding attrs to every record, sharing the ring
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	formatted := []string{}
+	if h.attrs != "" {
+		formatted = append(formatted, h.attrs)
+	}
+	for _, attr := range attrs {
+		formatted = appendAttr(formatted, h.prefix, attr)
+	}
+	return &Handler{ring: h.ring, attrs: strings.Join(formatted, " "), prefix: h.prefix}
+}
+
+// WithGroup returns a handler prefixing later attribute keys with name
+func (h *Handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &Handler{ring: h.ring, attrs: h.attrs, prefix: h.prefix + name + "."}
+}
+
+// Entries returns the kept records at or above level, oldest first
+func (h *Handler) Entries(level slog.Level) []Entry {
+	h.ring.mu.Lock()
+	defer h.ring.mu.Unlock()
+
+	var entries []Entry
+	for _, entry := range h.ring.entries {
+		if entry.Level >= level {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// appendAttr formats an attribute as key=value, flattening groups
+func appendAttr(attrs []string, prefix string, attr slog.Attr) []string {
+	attr.Value = attr.Value.Resolve()
+	if attr.Equal(slog.Attr{}) {
+		return attrs
+	}
+	if attr.Value.Kind() == slog.KindGroup {
+		groupPrefix := prefix
+		if attr.Key != "" {
+			groupPrefix += attr.Key + "."
+		}
+		for _, member := range attr.Value.Group() {
+			attrs = appendAttr(attrs, groupPrefix, member)
+		}
+		return attrs
+	}
+
+	value := attr.Value.String()
+	if value == "" || strings.ContainsAny(value, " \t\n\"=") {
+		value = fmt.Sprintf("%q", value)
+	}
+	return append(attrs, prefix+attr.Key+"="+value)
+}
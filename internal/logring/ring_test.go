@@ -0,0 +1,37 @@
+package logring
+
+import (
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// TestHandlerKeepsRecentEntries tests formatting, level filtering and
+// dropping the oldest entries once the ring is full
+func TestHandlerKeepsRecentEntries(t *testing.T) {
+	handler := New(3)
+	logger := slog.New(handler).With("tab", "Main")
+
+	logger.Debug("enhancing", "prs", 12)
+	logger.Info("fetched", "prs", 40)
+	logger.Warn("fetch failed", "err", "rate limit exceeded")
+	logger.WithGroup("pr").Error("enhancement failed", "number", 432)
+
+	entries := handler.Entries(slog.LevelDebug)
+	if len(entries) != 3 || entries[0].Message != "fetched" {
+		t.Fatalf("Expected the 3 newest entries, got %+v", entries)
+	}
+	if got := entries[1].Attrs; got != `tab=Main err="rate limit exceeded"` {
+		t.Errorf("Expected quoted attrs, got %s", got)
+	}
+	if got := entries[2].Attrs; got != "tab=Main pr.number=432" {
+		t.Errorf("Expected grouped attrs, got %s", got)
+	}
+	if !strings.Contains(entries[2].String(), "ERROR enhancement failed tab=Main") {
+		t.Errorf("Unexpected line: %s", entries[2])
+	}
+
+	if warnings := handler.Entries(slog.LevelWarn); len(warnings) != 2 {
+		t.Errorf("Expected 2 entries at warn or above, got %d", len(warnings))
+	}
+}
@@ -0,0 +1,326 @@
+// Package metrics serves PR Compass's state over HTTP, as Prometheus
+// metrics for scraping and as a health check, for instances left running on
+// a shared box.
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/github"
+)
+
+// Config turns on the HTTP endpoint
+type Config struct {
+	// Address to listen on, e.g. 127.0.0.1:9464 or :9464 for every interface
+	Listen string `mapstructure:"listen" yaml:"listen,omitempty"`
+}
+
+// Enabled reports whether the endpoint is configured
+func (c *Config) Enabled() bool {
+	return c != nil && c.Listen != ""
+}
+
+// Validate checks the listen address without binding it
+func (c *Config) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+	if _, _, err := net.SplitHostPort(c.Listen); err != nil {
+		return fmt.Errorf("metrics.listen must be host:port such as 127.0.0.1:9464, got %q", c.Listen)
+	}
+	return nil
+}
+
+// tabMetrics is what's known about one tab
+type tabMetrics struct {
+	openPRs   int
+	loaded    bool // openPRs is known
+	fetches   int
+	failures  int
+	totalTime time.Duration
+	lastTime  time.Duration
+	lastAt    time.Time
+	lastErr   error
+}
+
+// Collector gathers per tab metrics as the app runs. Rate limits and cache
+// lookups are read when scraped. It is safe for concurrent use, and a nil
+// Collector ignores everything.
+type Collector struct {
+	mu        sync.Mutex
+	startedAt time.Time
+	tabs      map[string]*tabMetrics
+}
+
+// NewCollector creates an empty collector
+func NewCollector() *Collector {
+	return &Collector{startedAt: time.Now(), tabs: make(map[string]*tabMetrics)}
+}
+
+// tab returns a tab's metrics, adding them on first use; callers hold mu
+func (c *Collector) tab(name string) *tabMetrics {
+	if c.tabs[name] == nil {
+		c.tabs[name] = &tabMetrics{}
+	}
+	return c.tabs[name]
+}
+
+// SetOpenPRs records how many open PRs a tab lists
+func (c *Collector) SetOpenPRs(tab string, count int) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := c.tab(tab)
+	t.openPRs, t.loaded = count, true
+}
+
+// ObserveFetch records how long fetching a tab's PRs took and whether it failed
+func (c *Collector) ObserveFetch(tab string, took time.Duration, err error) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := c.tab(tab)
+	t.fetches++
+	if err != nil {
+		t.failures++
+	}
+	t.totalTime += took
+	t.lastTime, t.lastAt, t.lastErr = took, time.Now(), err
+}
+
+// Retain drops the metrics of tabs not named, once they are closed or renamed
+func (c *Collector) Retain(tabs []string) {
+	if c == nil {
+		return
+	}
+	keep := make(map[string]bool, len(tabs))
+	for _, name := range tabs {
+		keep[name] = true
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for name := range c.tabs {
+		if !keep[name] {
+			delete(c.tabs, name)
+		}
+	}
+}
+
+// tabNames returns the tabs known, sorted; callers hold mu
+func (c *Collector) tabNames() []string {
+	names := make([]string, 0, len(c.tabs))
+	for name := range c.tabs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// labelValue escapes a label value for the Prometheus text format
+func labelValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+// metricWriter writes metric families in the Prometheus text format
+type metricWriter struct {
+	w io.Writer
+}
+
+// family writes the HELP and TYPE lines of a metric
+func (mw metricWriter) family(name, kind, help string) {
+	fmt.Fprintf(mw.w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+// sample writes a value, with one label if label is set
+func (mw metricWriter) sample(name, label, value string, v float64) {
+	if label != "" {
+		fmt.Fprintf(mw.w, "%s{%s=\"%s\"} %g\n", name, label, labelValue(value), v)
+		return
+	}
+	fmt.Fprintf(mw.w, "%s %g\n", name, v)
+}
+
+// WriteText writes every metric in the Prometheus text exposition format
+func (c *Collector) WriteText(w io.Writer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	mw := metricWriter{w}
+	names := c.tabNames()
+
+	mw.family("pr_compass_uptime_seconds", "gauge", "Seconds since PR Compass started.")
+	mw.sample("pr_compass_uptime_seconds", "", "", time.Since(c.startedAt).Seconds())
+
+	mw.family("pr_compass_open_prs", "gauge", "Open PRs listed per tab.")
+	for _, name := range names {
+		if c.tabs[name].loaded {
+			mw.sample("pr_compass_open_prs", "tab", name, float64(c.tabs[name].openPRs))
+		}
+	}
+
+	mw.family("pr_compass_fetches_total", "counter", "PR list fetches per tab.")
+	for _, name := range names {
+		mw.sample("pr_compass_fetches_total", "tab", name, float64(c.tabs[name].fetches))
+	}
+	mw.family("pr_compass_fetch_failures_total", "counter", "PR list fetches per tab that failed.")
+	for _, name := range names {
+		mw.sample("pr_compass_fetch_failures_total", "tab", name, float64(c.tabs[name].failures))
+	}
+	mw.family("pr_compass_fetch_duration_seconds", "summary", "Time taken to fetch each tab's PR list.")
+	for _, name := range names {
+		mw.sample("pr_compass_fetch_duration_seconds_sum", "tab", name, c.tabs[name].totalTime.Seconds())
+		mw.sample("pr_compass_fetch_duration_seconds_count", "tab", name, float64(c.tabs[name].fetches))
+	}
+	mw.family("pr_compass_last_fetch_duration_seconds", "gauge", "Time taken by each tab's latest fetch.")
+	for _, name := range names {
+		if c.tabs[name].fetches > 0 {
+			mw.sample("pr_compass_last_fetch_duration_seconds", "tab", name, c.tabs[name].lastTime.Seconds())
+		}
+	}
+	mw.family("pr_compass_last_fetch_timestamp_seconds", "gauge", "Unix time of each tab's latest fetch.")
+	for _, name := range names {
+		if c.tabs[name].fetches > 0 {
+			mw.sample("pr_compass_last_fetch_timestamp_seconds", "tab", name, float64(c.tabs[name].lastAt.Unix()))
+		}
+	}
+
+	limits := github.ObservedRateLimits()
+	resources := make([]string, 0, len(limits))
+	for resource := range limits {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+	mw.family("pr_compass_rate_limit_remaining", "gauge", "GitHub API requests left in the quota window, per resource.")
+	for _, resource := range resources {
+		mw.sample("pr_compass_rate_limit_remaining", "resource", resource, float64(limits[resource].Remaining))
+	}
+	mw.family("pr_compass_rate_limit_limit", "gauge", "GitHub API requests allowed per quota window, per resource.")
+	for _, resource := range resources {
+		mw.sample("pr_compass_rate_limit_limit", "resource", resource, float64(limits[resource].Limit))
+	}
+	mw.family("pr_compass_rate_limit_reset_timestamp_seconds", "gauge", "Unix time each GitHub API quota resets.")
+	for _, resource := range resources {
+		mw.sample("pr_compass_rate_limit_reset_timestamp_seconds", "resource", resource, float64(limits[resource].Reset.Unix()))
+	}
+
+	hits, misses := cache.LookupStats()
+	mw.family("pr_compass_cache_hits_total", "counter", "Cache lookups that found a fresh entry.")
+	mw.sample("pr_compass_cache_hits_total", "", "", float64(hits))
+	mw.family("pr_compass_cache_misses_total", "counter", "Cache lookups that found nothing or an expired entry.")
+	mw.sample("pr_compass_cache_misses_total", "", "", float64(misses))
+	if hits+misses > 0 {
+		mw.family("pr_compass_cache_hit_ratio", "gauge", "Share of cache lookups that were hits.")
+		mw.sample("pr_compass_cache_hit_ratio", "", "", float64(hits)/float64(hits+misses))
+	}
+}
+
+// TabHealth is a tab's state in the health check
+type TabHealth struct {
+	Name      string     `json:"name"`
+	OpenPRs   *int       `json:"open_prs,omitempty"` // Unset until the tab loads
+	LastFetch *time.Time `json:"last_fetch,omitempty"`
+	Error     string     `json:"error,omitempty"` // Why the latest fetch failed
+}
+
+// Health is the health check response
+type Health struct {
+	Status        string      `json:"status"` // "ok", or "failing" when every tab's latest fetch failed
+	UptimeSeconds int64       `json:"uptime_seconds"`
+	Tabs          []TabHealth `json:"tabs"`
+}
+
+// Health reports each tab's latest fetch. PR Compass is failing when it has
+// fetched and every tab's latest fetch failed, e.g. with a revoked token.
+func (c *Collector) Health() Health {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	health := Health{Status: "ok", UptimeSeconds: int64(time.Since(c.startedAt).Seconds()), Tabs: []TabHealth{}}
+	fetched, failing := 0, 0
+	for _, name := range c.tabNames() {
+		t := c.tabs[name]
+		tab := TabHealth{Name: name}
+		if t.loaded {
+			tab.OpenPRs = &t.openPRs
+		}
+		if t.fetches > 0 {
+			lastAt := t.lastAt
+			tab.LastFetch = &lastAt
+			fetched++
+		}
+		if t.lastErr != nil {
+			tab.Error = t.lastErr.Error()
+			failing++
+		}
+		health.Tabs = append(health.Tabs, tab)
+	}
+	if fetched > 0 && failing == fetched {
+		health.Status = "failing"
+	}
+	return health
+}
+
+// Handler serves /metrics in the Prometheus text format and /healthz as
+// JSON, answering 503 while failing
+func (c *Collector) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		c.WriteText(w)
+	})
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		health := c.Health()
+		w.Header().Set("Content-Type", "application/json")
+		if health.Status != "ok" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(health) // #nosec G104 - the client went away
+	})
+	return mux
+}
+
+// Server is the running HTTP endpoint
+type Server struct {
+	server *http.Server
+	addr   net.Addr
+}
+
+// Serve starts serving the collector on the configured address. It returns
+// once the address is bound, so a port in use is reported straight away.
+func Serve(cfg Config, c *Collector) (*Server, error) {
+	listener, err := net.Listen("tcp", cfg.Listen)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", cfg.Listen, err)
+	}
+	server := &http.Server{Handler: c.Handler(), ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			listener.Close() // #nosec G104 - already failed
+		}
+	}()
+	return &Server{server: server, addr: listener.Addr()}, nil
+}
+
+// Addr returns the address the server listens on
+func (s *Server) Addr() net.Addr {
+	return s.addr
+}
+
+// Close stops the server, letting scrapes underway finish until ctx ends
+func (s *Server) Close(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
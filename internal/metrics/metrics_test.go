@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConfigValidate(t *testing.T) {
+	for _, listen := range []string{"", "127.0.0.1:9464", ":9464"} {
+		if err := (&Config{Listen: listen}).Validate(); err != nil {
+			t.Errorf("Validate(%q) = %v", listen, err)
+		}
+	}
+	if err := (&Config{Listen: "9464"}).Validate(); err == nil {
+		t.Error("Expected an address without a port to be rejected")
+	}
+	var unset *Config
+	if unset.Enabled() || unset.Validate() != nil {
+		t.Error("Expected a missing metrics section to be off and valid")
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	c := NewCollector()
+	c.ObserveFetch("Team \"A\"", 2*time.Second, nil)
+	c.ObserveFetch("Team \"A\"", time.Second, errors.New("boom"))
+	c.SetOpenPRs("Team \"A\"", 12)
+	c.ObserveFetch("Closed", time.Second, nil)
+	c.Retain([]string{"Team \"A\""})
+
+	var out strings.Builder
+	c.WriteText(&out)
+	text := out.String()
+	for _, want := range []string{
+		"# TYPE pr_compass_open_prs gauge\n",
+		`pr_compass_open_prs{tab="Team \"A\""} 12`,
+		`pr_compass_fetches_total{tab="Team \"A\""} 2`,
+		`pr_compass_fetch_failures_total{tab="Team \"A\""} 1`,
+		`pr_compass_fetch_duration_seconds_sum{tab="Team \"A\""} 3`,
+		`pr_compass_last_fetch_duration_seconds{tab="Team \"A\""} 1`,
+		"pr_compass_cache_hits_total ",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected %q in metrics, got:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "Closed") {
+		t.Errorf("Expected tabs not retained to be dropped, got:\n%s", text)
+	}
+}
+
+func TestHealth(t *testing.T) {
+	c := NewCollector()
+	if c.Health().Status != "ok" {
+		t.Error("Expected ok before any fetch")
+	}
+	c.ObserveFetch("A", time.Second, errors.New("bad credentials"))
+	c.ObserveFetch("B", time.Second, nil)
+	c.SetOpenPRs("B", 3)
+	health := c.Health()
+	if health.Status != "ok" || len(health.Tabs) != 2 || health.Tabs[0].Error != "bad credentials" || *health.Tabs[1].OpenPRs != 3 {
+		t.Errorf("Expected ok while a tab fetches, got %+v", health)
+	}
+	c.ObserveFetch("B", time.Second, errors.New("bad credentials"))
+	if c.Health().Status != "failing" {
+		t.Error("Expected failing once every tab's latest fetch failed")
+	}
+}
+
+func TestServe(t *testing.T) {
+	c := NewCollector()
+	c.ObserveFetch("A", time.Second, errors.New("bad credentials"))
+	server, err := Serve(Config{Listen: "127.0.0.1:0"}, c)
+	if err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+	defer server.Close(context.Background())
+	base := "http://" + server.Addr().String()
+
+	resp, err := http.Get(base + "/metrics")
+	if err != nil {
+		t.Fatalf("GET /metrics error = %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/plain") || !strings.Contains(string(body), `pr_compass_fetches_total{tab="A"} 1`) {
+		t.Errorf("Unexpected /metrics response %s:\n%s", resp.Header.Get("Content-Type"), body)
+	}
+
+	resp, err = http.Get(base + "/healthz")
+	if err != nil {
+		t.Fatalf("GET /healthz error = %v", err)
+	}
+	defer resp.Body.Close()
+	var health Health
+	if err := json.NewDecoder(resp.Body).Decode(&health); err != nil || resp.StatusCode != http.StatusServiceUnavailable || health.Status != "failing" {
+		t.Errorf("Expected 503 failing, got %d %+v (%v)", resp.StatusCode, health, err)
+	}
+
+	if _, err := Serve(Config{Listen: server.Addr().String()}, c); err == nil {
+		t.Error("Expected an error for an address in use")
+	}
+}
@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/config"
+	"github.com/bjess9/pr-compass/internal/github"
+	gh "github.com/google/go-github/v55/github"
+)
+
+const (
+	giteaPageSize      = 50 // Gitea's default MAX_RESPONSE_ITEMS
+	giteaCacheTTL      = 5 * time.Minute
+	giteaMaxConcurrent = 5 // Repositories fetched in parallel
+)
+
+// giteaDraftPrefixes are Gitea's default WORK_IN_PROGRESS_PREFIXES, which
+// mark a pull request as a draft on versions without a draft field
+var giteaDraftPrefixes = []string{"WIP:", "[WIP]"}
+
+// giteaProvider fetches open pull requests from the Gitea REST API (v1),
+// which Forgejo serves too
+type giteaProvider struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+func newGiteaProvider(baseURL, token string) *giteaProvider {
+	baseURL = strings.TrimRight(strings.TrimSpace(baseURL), "/")
+	return &giteaProvider{baseURL: baseURL, token: token, client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+func (p *giteaProvider) Name() string { return Gitea }
+
+func (p *giteaProvider) FetchPRs(ctx context.Context, cfg *config.Config, prCache *cache.PRCache) ([]*gh.PullRequest, github.PRCounts, error) {
+	if prCache != nil {
+		if cached, found := prCache.GetPRList(p.cacheKey(cfg, prCache)); found {
+			return github.LimitPRs(cfg, cached), nil, nil
+		}
+	}
+
+	repos := cfg.Repos
+	if cfg.Mode == "organization" {
+		var err error
+		if repos, err = p.fetchOrgRepos(ctx, cfg.Organization); err != nil {
+			return nil, nil, err
+		}
+	}
+	prs, counts, err := p.fetchRepos(ctx, repos, github.MaxPages(cfg)*giteaPageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	prs = github.FilterPRs(cfg, prs)
+
+	if prCache != nil {
+		// Cached before the PR limit, so any limit can be served from it
+		_ = prCache.SetPRList(p.cacheKey(cfg, prCache), prs, giteaCacheTTL) // ignore cache errors
+	}
+	return github.LimitPRs(cfg, append([]*gh.PullRequest(nil), prs...)), counts, nil
+}
+
+func (p *giteaProvider) CachedPRs(cfg *config.Config, prCache *cache.PRCache) ([]*gh.PullRequest, time.Time, bool) {
+	if prCache == nil {
+		return nil, time.Time{}, false
+	}
+	prs, cachedAt, found := prCache.GetStalePRList(p.cacheKey(cfg, prCache))
+	if !found {
+		return nil, time.Time{}, false
+	}
+	return github.LimitPRs(cfg, prs), cachedAt, true
+}
+
+// cacheKey identifies the configuration's pull request list in the cache
+func (p *giteaProvider) cacheKey(cfg *config.Config, prCache *cache.PRCache) string {
+	scope := cfg.Organization
+	if cfg.Mode != "organization" {
+		scope = strings.Join(cfg.Repos, ",")
+	}
+	return prCache.GenerateFetcherKey("gitea:"+cfg.Mode, p.baseURL, scope,
+		strconv.FormatBool(cfg.ExcludeBots), strconv.FormatBool(cfg.IncludeDrafts),
+		strings.Join(cfg.ExcludeAuthors, ","), strings.Join(cfg.ExcludeTitles, ","), cfg.Milestone,
+		strconv.Itoa(github.MaxPages(cfg)))
+}
+
+// fetchOrgRepos lists the full names of an organization's repositories that
+// aren't archived. Gitea has no endpoint listing an organization's pull
+// requests, so they are fetched per repository.
+func (p *giteaProvider) fetchOrgRepos(ctx context.Context, org string) ([]string, error) {
+	var names []string
+	for page := 1; ; page++ {
+		var repos []struct {
+			FullName string `json:"full_name"`
+			Archived bool   `json:"archived"`
+		}
+		path := fmt.Sprintf("orgs/%s/repos", url.PathEscape(org))
+		if _, err := p.get(ctx, path, org, page, &repos); err != nil {
+			return nil, err
+		}
+		for _, repo := range repos {
+			if !repo.Archived {
+				names = append(names, repo.FullName)
+			}
+		}
+		if len(repos) < giteaPageSize {
+			return names, nil
+		}
+	}
+}
+
+// fetchRepos fetches open pull requests from several repositories in
+// parallel, counting each repository's open pull requests
+func (p *giteaProvider) fetchRepos(ctx context.Context, repos []string, limit int) ([]*gh.PullRequest, github.PRCounts, error) {
+	type repoResult struct {
+		repo  string
+		prs   []*gh.PullRequest
+		count github.RepoPRCount
+		err   error
+	}
+
+	semaphore := make(chan struct{}, giteaMaxConcurrent)
+	results := make(chan repoResult, len(repos))
+	var wg sync.WaitGroup
+	for _, repo := range repos {
+		wg.Add(1)
+		go func(repo string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			prs, count, err := p.fetchPullRequests(ctx, repo, limit)
+			results <- repoResult{repo: repo, prs: prs, count: count, err: err}
+		}(repo)
+	}
+	wg.Wait()
+	close(results)
+
+	var all []*gh.PullRequest
+	counts := github.PRCounts{}
+	for result := range results {
+		if result.err != nil {
+			return nil, nil, result.err
+		}
+		all = append(all, result.prs...)
+		counts[result.repo] = result.count
+	}
+	return all, counts, nil
+}
+
+// fetchPullRequests pages through a repository's open pull requests, most
+// recently updated first, up to limit. The count comes from Gitea's
+// X-Total-Count header when it sends one.
+func (p *giteaProvider) fetchPullRequests(ctx context.Context, repo string, limit int) ([]*gh.PullRequest, github.RepoPRCount, error) {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, github.RepoPRCount{}, fmt.Errorf("Gitea repository %q should be owner/name", repo)
+	}
+
+	var prs []*gh.PullRequest
+	var count github.RepoPRCount
+	complete := false
+	for page := 1; !complete && len(prs) < limit; page++ {
+		var pulls []giteaPullRequest
+		path := fmt.Sprintf("repos/%s/%s/pulls?state=open&sort=recentupdate", url.PathEscape(owner), url.PathEscape(name))
+		resp, err := p.get(ctx, path, repo, page, &pulls)
+		if err != nil {
+			return nil, count, err
+		}
+		for _, pull := range pulls {
+			prs = append(prs, pull.toPullRequest())
+		}
+		if total, err := strconv.Atoi(resp.Header.Get("X-Total-Count")); err == nil {
+			count.Open = total
+		}
+		complete = len(pulls) < giteaPageSize
+	}
+
+	count.Fetched = len(prs)
+	if complete || count.Open < count.Fetched {
+		count.Open = count.Fetched // Everything was listed, or Gitea sent no total
+	}
+	return prs, count, nil
+}
+
+// get fetches one page of an API path and decodes it. scope names the
+// repository or organization in errors.
+func (p *giteaProvider) get(ctx context.Context, path, scope string, page int, into any) (*http.Response, error) {
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+	endpoint := fmt.Sprintf("%s/api/v1/%s%slimit=%d&page=%d", p.baseURL, path, separator, giteaPageSize, page)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if p.token != "" {
+		req.Header.Set("Authorization", "token "+p.token)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Gitea request for %s failed: %w", scope, err)
+	}
+	return resp, decodeGiteaResponse(resp, scope, into)
+}
+
+// decodeGiteaResponse decodes a successful response and explains failures
+func decodeGiteaResponse(resp *http.Response, scope string, into any) error {
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return json.NewDecoder(resp.Body).Decode(into)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("Gitea rejected the token for %s - check GITEA_TOKEN (needs read access to repositories)", scope)
+	case http.StatusNotFound:
+		return fmt.Errorf("Gitea repository or organization %q not found - private ones need GITEA_TOKEN", scope)
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("Gitea rate limit exceeded while fetching %s - try again later", scope)
+	default:
+		return fmt.Errorf("Gitea returned %s for %s", resp.Status, scope)
+	}
+}
+
+// giteaUser is the user object embedded in pull requests
+type giteaUser struct {
+	Login string `json:"login"`
+}
+
+// giteaBranch is a pull request's head or base
+type giteaBranch struct {
+	Ref  string `json:"ref"`
+	SHA  string `json:"sha"`
+	Repo *struct {
+		Name     string    `json:"name"`
+		FullName string    `json:"full_name"`
+		HTMLURL  string    `json:"html_url"`
+		Owner    giteaUser `json:"owner"`
+	} `json:"repo"`
+}
+
+// giteaPullRequest holds the pull request fields PR Compass displays
+type giteaPullRequest struct {
+	Number             int         `json:"number"`
+	Title              string      `json:"title"`
+	Body               string      `json:"body"`
+	Draft              bool        `json:"draft"`
+	HTMLURL            string      `json:"html_url"`
+	User               giteaUser   `json:"user"`
+	RequestedReviewers []giteaUser `json:"requested_reviewers"`
+	Labels             []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	Milestone *struct {
+		Title string `json:"title"`
+	} `json:"milestone"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+	Mergeable bool        `json:"mergeable"`
+	Comments  int         `json:"comments"`
+	Head      giteaBranch `json:"head"`
+	Base      giteaBranch `json:"base"`
+}
+
+// toPullRequest maps a Gitea pull request onto the fields the table reads.
+// Gitea only reports mergeable, so a PR that isn't counts as conflicting.
+func (pull giteaPullRequest) toPullRequest() *gh.PullRequest {
+	draft := pull.Draft
+	for _, prefix := range giteaDraftPrefixes {
+		if strings.HasPrefix(strings.ToUpper(pull.Title), prefix) {
+			draft = true
+		}
+	}
+	mergeableState := "dirty"
+	if pull.Mergeable {
+		mergeableState = "clean"
+	}
+
+	pr := &gh.PullRequest{
+		Number:         gh.Int(pull.Number),
+		Title:          gh.String(pull.Title),
+		Body:           gh.String(pull.Body),
+		State:          gh.String("open"),
+		Draft:          gh.Bool(draft),
+		HTMLURL:        gh.String(pull.HTMLURL),
+		User:           &gh.User{Login: gh.String(pull.User.Login)},
+		CreatedAt:      &gh.Timestamp{Time: pull.CreatedAt},
+		UpdatedAt:      &gh.Timestamp{Time: pull.UpdatedAt},
+		Comments:       gh.Int(pull.Comments),
+		MergeableState: gh.String(mergeableState),
+		Head:           &gh.PullRequestBranch{Ref: gh.String(pull.Head.Ref), SHA: gh.String(pull.Head.SHA)},
+		Base:           &gh.PullRequestBranch{Ref: gh.String(pull.Base.Ref)},
+	}
+	if repo := pull.Base.Repo; repo != nil {
+		pr.Base.Repo = &gh.Repository{
+			Name:     gh.String(repo.Name),
+			FullName: gh.String(repo.FullName),
+			HTMLURL:  gh.String(repo.HTMLURL),
+			Owner:    &gh.User{Login: gh.String(repo.Owner.Login)},
+		}
+	}
+	for _, reviewer := range pull.RequestedReviewers {
+		pr.RequestedReviewers = append(pr.RequestedReviewers, &gh.User{Login: gh.String(reviewer.Login)})
+	}
+	for _, label := range pull.Labels {
+		pr.Labels = append(pr.Labels, &gh.Label{Name: gh.String(label.Name)})
+	}
+	if pull.Milestone != nil {
+		pr.Milestone = &gh.Milestone{Title: gh.String(pull.Milestone.Title)}
+	}
+	return pr
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/config"
+)
+
+const (
+	testGiteaPull = `{"number": 7, "title": "Add login", "body": "Closes #3", "mergeable": false,
+   "html_url": "https://git.example.com/team/api/pulls/7",
+   "user": {"login": "alice"}, "requested_reviewers": [{"login": "bob"}], "labels": [{"name": "backend"}],
+   "created_at": "2026-01-02T10:00:00+01:00", "updated_at": "2026-01-03T10:00:00Z", "comments": 4,
+   "head": {"ref": "login", "sha": "abc123"},
+   "base": {"ref": "main", "repo": {"name": "api", "full_name": "team/api", "html_url": "https://git.example.com/team/api", "owner": {"login": "team"}}}}`
+	testGiteaWIPPull = `{"number": 8, "title": "WIP: spike", "mergeable": true,
+   "user": {"login": "renovate-bot"}, "base": {"ref": "main", "repo": {"full_name": "team/api"}}}`
+)
+
+func TestGiteaFetchPRs(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/repos/team/api/pulls" || r.URL.Query().Get("state") != "open" {
+			t.Errorf("Unexpected request %s", r.URL.String())
+		}
+		if r.Header.Get("Authorization") != "token secret" {
+			t.Errorf("Expected the token header, got %q", r.Header.Get("Authorization"))
+		}
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		w.Header().Set("X-Total-Count", fmt.Sprint(giteaPageSize+1))
+		if page == "1" {
+			w.Write([]byte("[" + strings.TrimSuffix(strings.Repeat(testGiteaPull+",", giteaPageSize), ",") + "]"))
+			return
+		}
+		w.Write([]byte("[" + testGiteaWIPPull + "]"))
+	}))
+	defer server.Close()
+
+	p := newGiteaProvider(server.URL+"/", "secret")
+	cfg := &config.Config{Provider: Gitea, Mode: "repos", Repos: []string{"team/api"}, IncludeDrafts: true, MaxPRs: 100}
+	prs, counts, err := p.FetchPRs(context.Background(), cfg, nil)
+	if err != nil {
+		t.Fatalf("FetchPRs() returned error: %v", err)
+	}
+	if strings.Join(pages, ",") != "1,2" {
+		t.Errorf("Expected both pages to be fetched, got %v", pages)
+	}
+	if count := counts["team/api"]; count.Open != giteaPageSize+1 || count.Fetched != giteaPageSize+1 {
+		t.Errorf("Expected the repository's count, got %+v", count)
+	}
+	if len(prs) != giteaPageSize+1 {
+		t.Fatalf("Expected %d pull requests, got %d", giteaPageSize+1, len(prs))
+	}
+
+	pr := prs[0]
+	if pr.GetNumber() != 7 || pr.GetUser().GetLogin() != "alice" || pr.GetComments() != 4 || pr.GetHead().GetSHA() != "abc123" {
+		t.Errorf("Unexpected mapping: %+v", pr)
+	}
+	repo := pr.GetBase().GetRepo()
+	if repo.GetFullName() != "team/api" || repo.GetName() != "api" || repo.GetOwner().GetLogin() != "team" {
+		t.Errorf("Expected the base repository, got %q (%q, owner %q)", repo.GetFullName(), repo.GetName(), repo.GetOwner().GetLogin())
+	}
+	if pr.GetMergeableState() != "dirty" || len(pr.RequestedReviewers) != 1 || pr.Labels[0].GetName() != "backend" {
+		t.Errorf("Expected conflicts, reviewers and labels to be mapped, got %+v", pr)
+	}
+	if wip := prs[len(prs)-1]; !wip.GetDraft() || wip.GetMergeableState() != "clean" {
+		t.Error("Expected a WIP pull request to be a draft")
+	}
+
+	// Filters apply as for GitHub tabs
+	cfg.ExcludeBots = true
+	prs, _, err = p.FetchPRs(context.Background(), cfg, nil)
+	if err != nil {
+		t.Fatalf("FetchPRs() returned error: %v", err)
+	}
+	if len(prs) != giteaPageSize {
+		t.Errorf("Expected the bot's pull request to be excluded, got %d", len(prs))
+	}
+}
+
+func TestGiteaFetchPRs_Organization(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/orgs/team/repos":
+			w.Write([]byte(`[{"full_name": "team/api"}, {"full_name": "team/old", "archived": true}]`))
+		case "/api/v1/repos/team/api/pulls":
+			w.Write([]byte("[" + testGiteaPull + "]"))
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	p := newGiteaProvider(server.URL, "")
+	prs, counts, err := p.FetchPRs(context.Background(), &config.Config{Provider: Gitea, Mode: "organization", Organization: "team", MaxPRs: 50}, nil)
+	if err != nil {
+		t.Fatalf("FetchPRs() returned error: %v", err)
+	}
+	if len(prs) != 1 || len(counts) != 1 {
+		t.Errorf("Expected the pull requests of unarchived repositories, got %d from %d", len(prs), len(counts))
+	}
+}
+
+func TestGiteaFetchPRs_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	p := newGiteaProvider(server.URL, "")
+	_, _, err := p.FetchPRs(context.Background(), &config.Config{Provider: Gitea, Mode: "repos", Repos: []string{"team/missing"}}, nil)
+	if err == nil || !strings.Contains(err.Error(), "GITEA_TOKEN") {
+		t.Errorf("Expected a not found error mentioning GITEA_TOKEN, got %v", err)
+	}
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/config"
+	"github.com/bjess9/pr-compass/internal/github"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// githubProvider fetches pull requests through internal/github
+type githubProvider struct {
+	token string
+}
+
+func (p *githubProvider) Name() string { return GitHub }
+
+func (p *githubProvider) FetchPRs(ctx context.Context, cfg *config.Config, prCache *cache.PRCache) ([]*gh.PullRequest, github.PRCounts, error) {
+	if prCache != nil {
+		return github.FetchPRsWithCountsCached(ctx, cfg, p.token, prCache)
+	}
+	return github.FetchPRsWithCounts(ctx, cfg, p.token)
+}
+
+func (p *githubProvider) CachedPRs(cfg *config.Config, prCache *cache.PRCache) ([]*gh.PullRequest, time.Time, bool) {
+	return github.CachedPRsFromConfig(cfg, prCache)
+}
@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/config"
+	"github.com/bjess9/pr-compass/internal/github"
+	gh "github.com/google/go-github/v55/github"
+)
+
+const (
+	defaultGitLabURL    = "https://gitlab.com"
+	gitlabPageSize      = 100
+	gitlabCacheTTL      = 5 * time.Minute
+	gitlabMaxConcurrent = 5 // Projects fetched in parallel in repos mode
+)
+
+// gitlabProvider fetches open merge requests from the GitLab REST API (v4)
+type gitlabProvider struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+func newGitLabProvider(baseURL, token string) *gitlabProvider {
+	baseURL = strings.TrimRight(strings.TrimSpace(baseURL), "/")
+	if baseURL == "" {
+		baseURL = defaultGitLabURL
+	}
+	return &gitlabProvider{baseURL: baseURL, token: token, client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+func (p *gitlabProvider) Name() string { return GitLab }
+
+func (p *gitlabProvider) FetchPRs(ctx context.Context, cfg *config.Config, prCache *cache.PRCache) ([]*gh.PullRequest, github.PRCounts, error) {
+	if prCache != nil {
+		if cached, found := prCache.GetPRList(p.cacheKey(cfg, prCache)); found {
+			return github.LimitPRs(cfg, cached), nil, nil
+		}
+	}
+
+	var prs []*gh.PullRequest
+	counts := github.PRCounts{}
+	var err error
+	switch cfg.Mode {
+	case "organization":
+		// Group listings aren't counted per project
+		prs, _, err = p.fetchMergeRequests(ctx, "groups", cfg.Organization, github.MaxPages(cfg)*gitlabPageSize)
+	default:
+		prs, counts, err = p.fetchProjects(ctx, cfg.Repos, github.MaxPages(cfg)*gitlabPageSize)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	prs = github.FilterPRs(cfg, prs)
+
+	if prCache != nil {
+		// Cached before the PR limit, so any limit can be served from it
+		_ = prCache.SetPRList(p.cacheKey(cfg, prCache), prs, gitlabCacheTTL) // ignore cache errors
+	}
+	return github.LimitPRs(cfg, append([]*gh.PullRequest(nil), prs...)), counts, nil
+}
+
+func (p *gitlabProvider) CachedPRs(cfg *config.Config, prCache *cache.PRCache) ([]*gh.PullRequest, time.Time, bool) {
+	if prCache == nil {
+		return nil, time.Time{}, false
+	}
+	prs, cachedAt, found := prCache.GetStalePRList(p.cacheKey(cfg, prCache))
+	if !found {
+		return nil, time.Time{}, false
+	}
+	return github.LimitPRs(cfg, prs), cachedAt, true
+}
+
+// cacheKey identifies the configuration's merge request list in the cache
+func (p *gitlabProvider) cacheKey(cfg *config.Config, prCache *cache.PRCache) string {
+	scope := cfg.Organization
+	if cfg.Mode != "organization" {
+		scope = strings.Join(cfg.Repos, ",")
+	}
+	return prCache.GenerateFetcherKey("gitlab:"+cfg.Mode, p.baseURL, scope,
+		strconv.FormatBool(cfg.ExcludeBots), strconv.FormatBool(cfg.IncludeDrafts),
+		strings.Join(cfg.ExcludeAuthors, ","), strings.Join(cfg.ExcludeTitles, ","), cfg.Milestone,
+		strconv.Itoa(github.MaxPages(cfg)))
+}
+
+// fetchProjects fetches open merge requests from several projects in
+// parallel, counting each project's open merge requests
+func (p *gitlabProvider) fetchProjects(ctx context.Context, projects []string, limit int) ([]*gh.PullRequest, github.PRCounts, error) {
+	type projectResult struct {
+		project string
+		prs     []*gh.PullRequest
+		count   github.RepoPRCount
+		err     error
+	}
+
+	semaphore := make(chan struct{}, gitlabMaxConcurrent)
+	results := make(chan projectResult, len(projects))
+	var wg sync.WaitGroup
+	for _, project := range projects {
+		wg.Add(1)
+		go func(project string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			prs, count, err := p.fetchMergeRequests(ctx, "projects", project, limit)
+			results <- projectResult{project: project, prs: prs, count: count, err: err}
+		}(project)
+	}
+	wg.Wait()
+	close(results)
+
+	var all []*gh.PullRequest
+	counts := github.PRCounts{}
+	for result := range results {
+		if result.err != nil {
+			return nil, nil, result.err
+		}
+		all = append(all, result.prs...)
+		counts[result.project] = result.count
+	}
+	return all, counts, nil
+}
+
+// fetchMergeRequests pages through the open merge requests of a project or
+// group (including subgroups), most recently updated first, up to limit.
+// The count comes from GitLab's X-Total header when it sends one.
+func (p *gitlabProvider) fetchMergeRequests(ctx context.Context, kind, path string, limit int) ([]*gh.PullRequest, github.RepoPRCount, error) {
+	var prs []*gh.PullRequest
+	var count github.RepoPRCount
+	page := "1"
+	for page != "" && len(prs) < limit {
+		endpoint := fmt.Sprintf("%s/api/v4/%s/%s/merge_requests?state=opened&order_by=updated_at&sort=desc&per_page=%d&page=%s",
+			p.baseURL, kind, url.PathEscape(path), gitlabPageSize, page)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return nil, count, err
+		}
+		if p.token != "" {
+			req.Header.Set("PRIVATE-TOKEN", p.token)
+		}
+
+		resp, err := p.client.Do(req)
+		if err != nil {
+			return nil, count, fmt.Errorf("GitLab request for %s failed: %w", path, err)
+		}
+		var mergeRequests []gitlabMergeRequest
+		err = decodeGitLabResponse(resp, path, &mergeRequests)
+		if err != nil {
+			return nil, count, err
+		}
+		for _, mr := range mergeRequests {
+			prs = append(prs, mr.toPullRequest())
+		}
+		if total, err := strconv.Atoi(resp.Header.Get("X-Total")); err == nil {
+			count.Open = total
+		}
+		page = resp.Header.Get("X-Next-Page")
+	}
+
+	count.Fetched = len(prs)
+	if page == "" || count.Open < count.Fetched {
+		count.Open = count.Fetched // Everything was listed, or GitLab sent no total
+	}
+	return prs, count, nil
+}
+
+// decodeGitLabResponse decodes a successful response and explains failures
+func decodeGitLabResponse(resp *http.Response, path string, into any) error {
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return json.NewDecoder(resp.Body).Decode(into)
+	case http.StatusUnauthorized:
+		return fmt.Errorf("GitLab rejected the token for %s - check GITLAB_TOKEN (needs read_api)", path)
+	case http.StatusNotFound:
+		return fmt.Errorf("GitLab project or group %q not found - private ones need GITLAB_TOKEN", path)
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("GitLab rate limit exceeded while fetching %s - try again later", path)
+	default:
+		return fmt.Errorf("GitLab returned %s for %s", resp.Status, path)
+	}
+}
+
+// gitlabUser is the user object embedded in merge requests
+type gitlabUser struct {
+	Username string `json:"username"`
+}
+
+// gitlabMergeRequest holds the merge request fields PR Compass displays
+type gitlabMergeRequest struct {
+	IID            int          `json:"iid"`
+	Title          string       `json:"title"`
+	Description    string       `json:"description"`
+	Draft          bool         `json:"draft"`
+	WorkInProgress bool         `json:"work_in_progress"`
+	WebURL         string       `json:"web_url"`
+	Author         gitlabUser   `json:"author"`
+	Reviewers      []gitlabUser `json:"reviewers"`
+	Labels         []string     `json:"labels"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+	SourceBranch   string       `json:"source_branch"`
+	TargetBranch   string       `json:"target_branch"`
+	SHA            string       `json:"sha"`
+	HasConflicts   bool         `json:"has_conflicts"`
+	MergeStatus    string       `json:"merge_status"`
+	UserNotesCount int          `json:"user_notes_count"`
+	References     struct {
+		Full string `json:"full"` // "group/project!12"
+	} `json:"references"`
+	Milestone *struct {
+		Title string `json:"title"`
+	} `json:"milestone"`
+}
+
+// toPullRequest maps a merge request onto the pull request fields the table reads
+func (mr gitlabMergeRequest) toPullRequest() *gh.PullRequest {
+	projectPath, _, _ := strings.Cut(mr.References.Full, "!")
+	namespace, name := "", projectPath
+	if i := strings.LastIndex(projectPath, "/"); i >= 0 {
+		namespace, name = projectPath[:i], projectPath[i+1:]
+	}
+	projectURL, _, _ := strings.Cut(mr.WebURL, "/-/merge_requests/")
+
+	mergeableState := ""
+	switch {
+	case mr.HasConflicts || mr.MergeStatus == "cannot_be_merged":
+		mergeableState = "dirty"
+	case mr.MergeStatus == "can_be_merged":
+		mergeableState = "clean"
+	}
+
+	pr := &gh.PullRequest{
+		Number:         gh.Int(mr.IID),
+		Title:          gh.String(mr.Title),
+		Body:           gh.String(mr.Description),
+		State:          gh.String("open"),
+		Draft:          gh.Bool(mr.Draft || mr.WorkInProgress),
+		HTMLURL:        gh.String(mr.WebURL),
+		User:           &gh.User{Login: gh.String(mr.Author.Username)},
+		CreatedAt:      &gh.Timestamp{Time: mr.CreatedAt},
+		UpdatedAt:      &gh.Timestamp{Time: mr.UpdatedAt},
+		Comments:       gh.Int(mr.UserNotesCount),
+		MergeableState: gh.String(mergeableState),
+		Head:           &gh.PullRequestBranch{Ref: gh.String(mr.SourceBranch), SHA: gh.String(mr.SHA)},
+		Base: &gh.PullRequestBranch{
+			Ref: gh.String(mr.TargetBranch),
+			Repo: &gh.Repository{
+				Name:     gh.String(name),
+				FullName: gh.String(projectPath),
+				HTMLURL:  gh.String(projectURL),
+				Owner:    &gh.User{Login: gh.String(namespace)},
+			},
+		},
+	}
+	for _, reviewer := range mr.Reviewers {
+		pr.RequestedReviewers = append(pr.RequestedReviewers, &gh.User{Login: gh.String(reviewer.Username)})
+	}
+	for _, label := range mr.Labels {
+		pr.Labels = append(pr.Labels, &gh.Label{Name: gh.String(label)})
+	}
+	if mr.Milestone != nil {
+		pr.Milestone = &gh.Milestone{Title: gh.String(mr.Milestone.Title)}
+	}
+	return pr
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/config"
+)
+
+const (
+	testMergeRequest = `{"iid": 12, "title": "Add login", "description": "Closes #3", "draft": false,
+   "web_url": "https://gitlab.example.com/team/sub/api/-/merge_requests/12",
+   "author": {"username": "alice"}, "reviewers": [{"username": "bob"}], "labels": ["backend"],
+   "created_at": "2026-01-02T10:00:00Z", "updated_at": "2026-01-03T10:00:00Z",
+   "source_branch": "login", "target_branch": "main", "sha": "abc123",
+   "has_conflicts": true, "merge_status": "cannot_be_merged", "user_notes_count": 4,
+   "references": {"full": "team/sub/api!12"}}`
+	testDraftMergeRequest = `{"iid": 13, "title": "Draft: spike", "work_in_progress": true,
+   "web_url": "https://gitlab.example.com/team/sub/api/-/merge_requests/13",
+   "author": {"username": "renovate-bot"}, "references": {"full": "team/sub/api!13"}}`
+	testMergeRequests = "[" + testMergeRequest + "," + testDraftMergeRequest + "]"
+)
+
+func TestGitLabFetchPRs(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/v4/projects/team%2Fsub%2Fapi/merge_requests" {
+			t.Errorf("Unexpected request %s", r.URL.EscapedPath())
+		}
+		if r.Header.Get("PRIVATE-TOKEN") != "secret" {
+			t.Errorf("Expected the token header, got %q", r.Header.Get("PRIVATE-TOKEN"))
+		}
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		w.Header().Set("X-Total", "2")
+		if page == "1" {
+			w.Header().Set("X-Next-Page", "2")
+			w.Write([]byte("[" + testMergeRequest + "]"))
+			return
+		}
+		w.Write([]byte("[" + testDraftMergeRequest + "]"))
+	}))
+	defer server.Close()
+
+	p := newGitLabProvider(server.URL+"/", "secret")
+	cfg := &config.Config{Provider: GitLab, Mode: "repos", Repos: []string{"team/sub/api"}, IncludeDrafts: true, MaxPRs: 50}
+	prs, counts, err := p.FetchPRs(context.Background(), cfg, nil)
+	if err != nil {
+		t.Fatalf("FetchPRs() returned error: %v", err)
+	}
+	if count := counts["team/sub/api"]; count.Open != 2 || count.Fetched != 2 {
+		t.Errorf("Expected the project's count from X-Total, got %+v", count)
+	}
+	if strings.Join(pages, ",") != "1,2" {
+		t.Errorf("Expected both pages to be fetched, got %v", pages)
+	}
+	if len(prs) != 2 {
+		t.Fatalf("Expected 2 merge requests, got %d", len(prs))
+	}
+
+	pr := prs[0]
+	if pr.GetNumber() != 12 || pr.GetUser().GetLogin() != "alice" || pr.GetComments() != 4 {
+		t.Errorf("Unexpected mapping: %+v", pr)
+	}
+	repo := pr.GetBase().GetRepo()
+	if repo.GetFullName() != "team/sub/api" || repo.GetName() != "api" || repo.GetOwner().GetLogin() != "team/sub" {
+		t.Errorf("Expected the project path as repository, got %q (%q, owner %q)", repo.GetFullName(), repo.GetName(), repo.GetOwner().GetLogin())
+	}
+	if repo.GetHTMLURL() != "https://gitlab.example.com/team/sub/api" {
+		t.Errorf("Expected the project URL, got %q", repo.GetHTMLURL())
+	}
+	if pr.GetMergeableState() != "dirty" || len(pr.RequestedReviewers) != 1 || pr.Labels[0].GetName() != "backend" {
+		t.Errorf("Expected conflicts, reviewers and labels to be mapped, got %+v", pr)
+	}
+	if !prs[1].GetDraft() {
+		t.Error("Expected a work-in-progress merge request to be a draft")
+	}
+
+	// Filters apply as for GitHub tabs
+	cfg.ExcludeBots = true
+	prs, _, err = p.FetchPRs(context.Background(), cfg, nil)
+	if err != nil {
+		t.Fatalf("FetchPRs() returned error: %v", err)
+	}
+	if len(prs) != 1 || prs[0].GetNumber() != 12 {
+		t.Errorf("Expected the bot's merge request to be excluded, got %d", len(prs))
+	}
+}
+
+func TestGitLabFetchPRs_Group(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/v4/groups/team/merge_requests" || r.URL.Query().Get("state") != "opened" {
+			t.Errorf("Unexpected request %s", r.URL.String())
+		}
+		w.Write([]byte(testMergeRequests))
+	}))
+	defer server.Close()
+
+	p := newGitLabProvider(server.URL, "")
+	prs, _, err := p.FetchPRs(context.Background(), &config.Config{Provider: GitLab, Mode: "organization", Organization: "team", IncludeDrafts: true, MaxPRs: 1}, nil)
+	if err != nil {
+		t.Fatalf("FetchPRs() returned error: %v", err)
+	}
+	if len(prs) != 1 {
+		t.Errorf("Expected max_prs to limit the list, got %d", len(prs))
+	}
+}
+
+func TestGitLabFetchPRs_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	p := newGitLabProvider(server.URL, "")
+	_, _, err := p.FetchPRs(context.Background(), &config.Config{Provider: GitLab, Mode: "repos", Repos: []string{"team/missing"}}, nil)
+	if err == nil || !strings.Contains(err.Error(), "GITLAB_TOKEN") {
+		t.Errorf("Expected a not found error mentioning GITLAB_TOKEN, got %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+// Package provider abstracts the code hosts PR Compass lists pull requests
+// from. Every provider returns go-github pull requests so the table, filters
+// and reports work unchanged; GitLab merge requests and Gitea pull requests
+// are mapped onto them.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/auth"
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/config"
+	"github.com/bjess9/pr-compass/internal/errors"
+	"github.com/bjess9/pr-compass/internal/github"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// Provider names accepted in the provider config option
+const (
+	GitHub = "github"
+	GitLab = "gitlab"
+	Gitea  = "gitea"
+
+	// forgejo is accepted for Gitea, whose API Forgejo serves
+	forgejo = "forgejo"
+)
+
+// Provider fetches open pull requests for a configuration
+type Provider interface {
+	// Name returns the provider name, e.g. "github"
+	Name() string
+
+	// FetchPRs returns open pull requests, served from prCache when it is
+	// fresh, and how many open PRs each listed repository has (nil when
+	// served from the cache). prCache may be nil.
+	FetchPRs(ctx context.Context, cfg *config.Config, prCache *cache.PRCache) ([]*gh.PullRequest, github.PRCounts, error)
+
+	// CachedPRs returns the last cached list even if it has expired, along
+	// with when it was cached. It never calls the API.
+	CachedPRs(cfg *config.Config, prCache *cache.PRCache) ([]*gh.PullRequest, time.Time, bool)
+}
+
+// Name returns the normalized provider name of a configuration; unset means GitHub
+func Name(cfg *config.Config) string {
+	switch name := strings.ToLower(strings.TrimSpace(cfg.Provider)); name {
+	case "":
+		return GitHub
+	case forgejo:
+		return Gitea
+	default:
+		return name
+	}
+}
+
+// New returns the provider for a configuration. githubToken may be empty in
+// public read-only mode; GitLab and Gitea read their own tokens from
+// GITLAB_TOKEN and GITEA_TOKEN.
+func New(cfg *config.Config, githubToken string) (Provider, error) {
+	if cfg.Project != "" && Name(cfg) != GitHub {
+		return nil, errors.NewConfigInvalidError(fmt.Errorf("project scopes a tab to a GitHub Projects board, which provider %s doesn't have", Name(cfg)))
+	}
+	switch Name(cfg) {
+	case GitHub:
+		return &githubProvider{token: githubToken}, nil
+	case GitLab:
+		if cfg.Mode != "repos" && cfg.Mode != "organization" {
+			return nil, errors.NewConfigInvalidError(fmt.Errorf("provider gitlab supports the repos (projects) and organization (group) modes, not %q", cfg.Mode))
+		}
+		return newGitLabProvider(cfg.GitLabURL, auth.GitLabToken()), nil
+	case Gitea:
+		if cfg.Mode != "repos" && cfg.Mode != "organization" {
+			return nil, errors.NewConfigInvalidError(fmt.Errorf("provider gitea supports the repos and organization modes, not %q", cfg.Mode))
+		}
+		if strings.TrimSpace(cfg.GiteaURL) == "" {
+			return nil, errors.NewConfigInvalidError(fmt.Errorf("provider gitea needs gitea_url, the base URL of the instance"))
+		}
+		return newGiteaProvider(cfg.GiteaURL, auth.GiteaToken()), nil
+	default:
+		return nil, errors.NewConfigInvalidError(fmt.Errorf("unknown provider %q (use github, gitlab or gitea)", cfg.Provider))
+	}
+}
+
+// FetchPRs fetches open pull requests with the configuration's provider
+func FetchPRs(ctx context.Context, cfg *config.Config, githubToken string, prCache *cache.PRCache) ([]*gh.PullRequest, error) {
+	prs, _, err := FetchPRsWithCounts(ctx, cfg, githubToken, prCache)
+	return prs, err
+}
+
+// FetchPRsWithCounts fetches open pull requests and per-repository open PR
+// counts with the configuration's provider
+func FetchPRsWithCounts(ctx context.Context, cfg *config.Config, githubToken string, prCache *cache.PRCache) ([]*gh.PullRequest, github.PRCounts, error) {
+	p, err := New(cfg, githubToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p.FetchPRs(ctx, cfg, prCache)
+}
+
+// CachedPRs returns the configuration's last cached pull requests without calling any API
+func CachedPRs(cfg *config.Config, prCache *cache.PRCache) ([]*gh.PullRequest, time.Time, bool) {
+	p, err := New(cfg, "")
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+	return p.CachedPRs(cfg, prCache)
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/config"
+)
+
+func TestName(t *testing.T) {
+	tests := map[string]string{"": GitHub, "github": GitHub, " GitLab ": GitLab, "gitea": Gitea, "Forgejo": Gitea}
+	for value, expected := range tests {
+		if got := Name(&config.Config{Provider: value}); got != expected {
+			t.Errorf("Name(%q) = %q, expected %q", value, got, expected)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.Config
+		want    string
+		wantErr bool
+	}{
+		{name: "default", cfg: config.Config{Mode: "search"}, want: GitHub},
+		{name: "gitlab projects", cfg: config.Config{Provider: "gitlab", Mode: "repos"}, want: GitLab},
+		{name: "gitlab group", cfg: config.Config{Provider: "gitlab", Mode: "organization"}, want: GitLab},
+		{name: "gitlab search", cfg: config.Config{Provider: "gitlab", Mode: "search"}, wantErr: true},
+		{name: "gitea repos", cfg: config.Config{Provider: "gitea", Mode: "repos", GiteaURL: "https://git.example.com"}, want: Gitea},
+		{name: "forgejo organization", cfg: config.Config{Provider: "forgejo", Mode: "organization", GiteaURL: "https://codeberg.org"}, want: Gitea},
+		{name: "gitea without url", cfg: config.Config{Provider: "gitea", Mode: "repos"}, wantErr: true},
+		{name: "gitea teams", cfg: config.Config{Provider: "gitea", Mode: "teams", GiteaURL: "https://git.example.com"}, wantErr: true},
+		{name: "unknown", cfg: config.Config{Provider: "bitbucket", Mode: "repos"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := New(&tt.cfg, "token")
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected an error, got provider %q", p.Name())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+			if p.Name() != tt.want {
+				t.Errorf("Expected provider %q, got %q", tt.want, p.Name())
+			}
+		})
+	}
+}
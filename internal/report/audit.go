@@ -0,0 +1,269 @@
+package report
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/config"
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/provider"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// Risk identifies a compliance-relevant characteristic of an open PR
+type Risk string
+
+const (
+	RiskNoReviews             Risk = "no_reviews"              // Nobody has reviewed the PR yet
+	RiskSelfApproved          Risk = "self_approved"           // Every approval came from the author or a co-committer
+	RiskRequiredChecksMissing Risk = "required_checks_missing" // Branch protection requires checks that never reported
+)
+
+// AuditEntry is one row of the audit report
+type AuditEntry struct {
+	Repository    string    `json:"repository"`
+	Number        int       `json:"number"`
+	Title         string    `json:"title"`
+	Author        string    `json:"author"`
+	URL           string    `json:"url"`
+	BaseBranch    string    `json:"base_branch"`
+	CreatedAt     time.Time `json:"created_at"`
+	Risks         []Risk    `json:"risks"`
+	MissingChecks []string  `json:"missing_checks,omitempty"`
+}
+
+// DetailsFetcher fetches audit details for a single PR
+type DetailsFetcher func(ctx context.Context, pr *gh.PullRequest) (*github.AuditDetails, error)
+
+// EvaluateRisks applies the audit rules to a PR and its details
+func EvaluateRisks(pr *gh.PullRequest, details *github.AuditDetails) AuditEntry {
+	entry := AuditEntry{
+		Number:     pr.GetNumber(),
+		Title:      pr.GetTitle(),
+		Author:     pr.GetUser().GetLogin(),
+		URL:        pr.GetHTMLURL(),
+		BaseBranch: pr.GetBase().GetRef(),
+		CreatedAt:  pr.GetCreatedAt().Time,
+		Risks:      []Risk{},
+	}
+	if pr.GetBase() != nil && pr.GetBase().GetRepo() != nil {
+		entry.Repository = pr.GetBase().GetRepo().GetFullName()
+	}
+
+	if details == nil {
+		return entry
+	}
+
+	// Ignore comment-only reviews - they neither approve nor block
+	var decisive []*gh.PullRequestReview
+	for _, review := range details.Reviews {
+		if state := review.GetState(); state == "APPROVED" || state == "CHANGES_REQUESTED" {
+			decisive = append(decisive, review)
+		}
+	}
+	if len(decisive) == 0 {
+		entry.Risks = append(entry.Risks, RiskNoReviews)
+	}
+
+	if isSelfApproved(entry.Author, details) {
+		entry.Risks = append(entry.Risks, RiskSelfApproved)
+	}
+
+	if details.BranchProtected {
+		reported := make(map[string]bool)
+		for _, check := range details.ReportedChecks {
+			reported[check] = true
+		}
+		for _, required := range details.RequiredChecks {
+			if !reported[required] {
+				entry.MissingChecks = append(entry.MissingChecks, required)
+			}
+		}
+		if len(entry.MissingChecks) > 0 {
+			entry.Risks = append(entry.Risks, RiskRequiredChecksMissing)
+		}
+	}
+
+	return entry
+}
+
+// isSelfApproved reports whether a PR has approvals and all of them came from
+// the author or someone who also committed to the PR
+func isSelfApproved(author string, details *github.AuditDetails) bool {
+	involved := map[string]bool{strings.ToLower(author): true}
+	for _, login := range details.CommitAuthors {
+		involved[strings.ToLower(login)] = true
+	}
+
+	approvals := 0
+	for _, review := range details.Reviews {
+		if review.GetState() != "APPROVED" {
+			continue
+		}
+		approvals++
+		if !involved[strings.ToLower(review.GetUser().GetLogin())] {
+			return false
+		}
+	}
+	return approvals > 0
+}
+
+// BuildAudit evaluates every PR and returns entries that carry at least one risk,
+// ordered by repository and PR number
+func BuildAudit(ctx context.Context, prs []*gh.PullRequest, fetch DetailsFetcher) ([]AuditEntry, error) {
+	const maxConcurrent = 5
+	semaphore := make(chan struct{}, maxConcurrent)
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		entries  []AuditEntry
+		firstErr error
+	)
+
+	for _, pr := range prs {
+		wg.Add(1)
+		go func(pr *gh.PullRequest) {
+			defer wg.Done()
+
+			select {
+			case <-ctx.Done():
+				return
+			case semaphore <- struct{}{}:
+			}
+			defer func() { <-semaphore }()
+
+			details, err := fetch(ctx, pr)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			if entry := EvaluateRisks(pr, details); len(entry.Risks) > 0 {
+				entries = append(entries, entry)
+			}
+		}(pr)
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	// Partial failures are tolerated, but an audit where every PR failed is an error
+	if firstErr != nil && len(entries) == 0 {
+		return nil, firstErr
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Repository != entries[j].Repository {
+			return entries[i].Repository < entries[j].Repository
+		}
+		return entries[i].Number < entries[j].Number
+	})
+
+	return entries, nil
+}
+
+// RunAudit fetches open PRs for each configuration, audits them and writes the report
+func RunAudit(ctx context.Context, token string, cfgs []*config.Config, format string, w io.Writer) error {
+	seen := make(map[string]bool)
+	var prs []*gh.PullRequest
+	for _, cfg := range cfgs {
+		// Audit details (reviews, commit authors, branch protection) come from GitHub
+		if provider.Name(cfg) != provider.GitHub {
+			continue
+		}
+		fetched, err := github.FetchPRsFromConfig(ctx, cfg, token)
+		var emptyScope *github.NoRepositoriesError
+		if errors.As(err, &emptyScope) {
+			continue // Nothing to audit in a scope without repositories
+		}
+		if err != nil {
+			return err
+		}
+		for _, pr := range fetched {
+			key := pr.GetHTMLURL()
+			if key == "" || !seen[key] {
+				seen[key] = true
+				prs = append(prs, pr)
+			}
+		}
+	}
+
+	entries, err := BuildAudit(ctx, prs, func(ctx context.Context, pr *gh.PullRequest) (*github.AuditDetails, error) {
+		return github.FetchAuditDetails(ctx, token, pr)
+	})
+	if err != nil {
+		return err
+	}
+
+	return Write(w, format, entries)
+}
+
+// Write renders audit entries in the requested format ("csv" or "json")
+func Write(w io.Writer, format string, entries []AuditEntry) error {
+	switch format {
+	case "json":
+		return WriteJSON(w, entries)
+	case "csv", "":
+		return WriteCSV(w, entries)
+	default:
+		return fmt.Errorf("unsupported report format: %s - use 'csv' or 'json'", format)
+	}
+}
+
+// WriteJSON writes audit entries as an indented JSON array
+func WriteJSON(w io.Writer, entries []AuditEntry) error {
+	if entries == nil {
+		entries = []AuditEntry{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(entries)
+}
+
+// WriteCSV writes audit entries as CSV with a header row
+func WriteCSV(w io.Writer, entries []AuditEntry) error {
+	writer := csv.NewWriter(w)
+	header := []string{"repository", "number", "title", "author", "base_branch", "created_at", "risks", "missing_checks", "url"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		risks := make([]string, len(entry.Risks))
+		for i, risk := range entry.Risks {
+			risks[i] = string(risk)
+		}
+		record := []string{
+			entry.Repository,
+			strconv.Itoa(entry.Number),
+			entry.Title,
+			entry.Author,
+			entry.BaseBranch,
+			entry.CreatedAt.UTC().Format(time.RFC3339),
+			strings.Join(risks, ";"),
+			strings.Join(entry.MissingChecks, ";"),
+			entry.URL,
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,160 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	gh "github.com/google/go-github/v55/github"
+)
+
+func auditTestPR(number int, author string) *gh.PullRequest {
+	return &gh.PullRequest{
+		Number:  gh.Int(number),
+		Title:   gh.String(fmt.Sprintf("PR %d", number)),
+		User:    &gh.User{Login: gh.String(author)},
+		HTMLURL: gh.String(fmt.Sprintf("https://github.com/org/api/pull/%d", number)),
+		Base: &gh.PullRequestBranch{
+			Ref:  gh.String("main"),
+			Repo: &gh.Repository{FullName: gh.String("org/api")},
+		},
+	}
+}
+
+func review(user, state string) *gh.PullRequestReview {
+	return &gh.PullRequestReview{User: &gh.User{Login: gh.String(user)}, State: gh.String(state)}
+}
+
+func TestEvaluateRisks(t *testing.T) {
+	tests := []struct {
+		name     string
+		details  *github.AuditDetails
+		expected []Risk
+	}{
+		{
+			name:     "no reviews at all",
+			details:  &github.AuditDetails{},
+			expected: []Risk{RiskNoReviews},
+		},
+		{
+			name:     "comment-only reviews still count as unreviewed",
+			details:  &github.AuditDetails{Reviews: []*gh.PullRequestReview{review("bob", "COMMENTED")}},
+			expected: []Risk{RiskNoReviews},
+		},
+		{
+			name: "approved by independent reviewer",
+			details: &github.AuditDetails{
+				Reviews:       []*gh.PullRequestReview{review("bob", "APPROVED")},
+				CommitAuthors: []string{"alice"},
+			},
+			expected: []Risk{},
+		},
+		{
+			name: "approved only by a co-committer",
+			details: &github.AuditDetails{
+				Reviews:       []*gh.PullRequestReview{review("carol", "APPROVED")},
+				CommitAuthors: []string{"alice", "carol"},
+			},
+			expected: []Risk{RiskSelfApproved},
+		},
+		{
+			name: "required checks never reported",
+			details: &github.AuditDetails{
+				Reviews:         []*gh.PullRequestReview{review("bob", "APPROVED")},
+				BranchProtected: true,
+				RequiredChecks:  []string{"build", "lint"},
+				ReportedChecks:  []string{"build"},
+			},
+			expected: []Risk{RiskRequiredChecksMissing},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := EvaluateRisks(auditTestPR(1, "alice"), tt.details)
+			if fmt.Sprint(entry.Risks) != fmt.Sprint(tt.expected) {
+				t.Errorf("Expected risks %v, got %v", tt.expected, entry.Risks)
+			}
+			if entry.Repository != "org/api" || entry.BaseBranch != "main" {
+				t.Errorf("Unexpected entry metadata: %+v", entry)
+			}
+		})
+	}
+}
+
+func TestEvaluateRisks_MissingChecksListed(t *testing.T) {
+	details := &github.AuditDetails{
+		BranchProtected: true,
+		RequiredChecks:  []string{"build", "lint"},
+	}
+	entry := EvaluateRisks(auditTestPR(1, "alice"), details)
+	if strings.Join(entry.MissingChecks, ",") != "build,lint" {
+		t.Errorf("Expected both checks missing, got %v", entry.MissingChecks)
+	}
+}
+
+func TestBuildAudit(t *testing.T) {
+	prs := []*gh.PullRequest{auditTestPR(3, "alice"), auditTestPR(1, "alice"), auditTestPR(2, "alice")}
+
+	fetch := func(ctx context.Context, pr *gh.PullRequest) (*github.AuditDetails, error) {
+		if pr.GetNumber() == 2 {
+			// Well-reviewed PR should be left out of the report
+			return &github.AuditDetails{Reviews: []*gh.PullRequestReview{review("bob", "APPROVED")}}, nil
+		}
+		return &github.AuditDetails{}, nil
+	}
+
+	entries, err := BuildAudit(context.Background(), prs, fetch)
+	if err != nil {
+		t.Fatalf("BuildAudit() returned error: %v", err)
+	}
+	if len(entries) != 2 || entries[0].Number != 1 || entries[1].Number != 3 {
+		t.Errorf("Expected risky PRs #1 and #3 in order, got %+v", entries)
+	}
+}
+
+func TestBuildAudit_AllFailures(t *testing.T) {
+	fetch := func(ctx context.Context, pr *gh.PullRequest) (*github.AuditDetails, error) {
+		return nil, fmt.Errorf("boom")
+	}
+	if _, err := BuildAudit(context.Background(), []*gh.PullRequest{auditTestPR(1, "a")}, fetch); err == nil {
+		t.Error("Expected error when every PR fails")
+	}
+}
+
+func TestWriteFormats(t *testing.T) {
+	entries := []AuditEntry{EvaluateRisks(auditTestPR(7, "alice"), &github.AuditDetails{})}
+
+	var csvBuf bytes.Buffer
+	if err := Write(&csvBuf, "csv", entries); err != nil {
+		t.Fatalf("CSV write failed: %v", err)
+	}
+	records, err := csv.NewReader(&csvBuf).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV output not parseable: %v", err)
+	}
+	if len(records) != 2 || records[1][1] != "7" || records[1][6] != "no_reviews" {
+		t.Errorf("Unexpected CSV records: %v", records)
+	}
+
+	var jsonBuf bytes.Buffer
+	if err := Write(&jsonBuf, "json", entries); err != nil {
+		t.Fatalf("JSON write failed: %v", err)
+	}
+	var decoded []AuditEntry
+	if err := json.Unmarshal(jsonBuf.Bytes(), &decoded); err != nil {
+		t.Fatalf("JSON output not parseable: %v", err)
+	}
+	if len(decoded) != 1 || decoded[0].Risks[0] != RiskNoReviews {
+		t.Errorf("Unexpected JSON entries: %+v", decoded)
+	}
+
+	if err := Write(&jsonBuf, "xml", entries); err == nil {
+		t.Error("Expected error for unsupported format")
+	}
+}
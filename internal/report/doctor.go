@@ -0,0 +1,233 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/provider"
+)
+
+// tokenExpiryWarning is how long before a token expires the doctor warns
+const tokenExpiryWarning = 7 * 24 * time.Hour
+
+// lowQuotaShare is the fraction of a quota below which the doctor warns
+const lowQuotaShare = 0.1
+
+// Doctor finding markers, as the authentication messages use them
+const (
+	doctorOK      = "[✓]"
+	doctorWarning = "[!]"
+	doctorProblem = "[✗]"
+	doctorSkipped = "[-]"
+)
+
+// ScopeChecker checks what a tab lists against the API
+type ScopeChecker interface {
+	CheckRepository(ctx context.Context, repo string) error
+	CheckOrganization(ctx context.Context, org string) error
+	CheckTeam(ctx context.Context, org, slug string) error
+	CountSearchResults(ctx context.Context, query string) (int, error)
+}
+
+// DoctorInput is what the doctor reports on. The caller loads the
+// configuration and token so each failure can be reported instead of
+// stopping at the first one.
+type DoctorInput struct {
+	ConfigPath string
+	ConfigErr  error
+	Scopes     []ListScope
+
+	AuthErr  error             // Finding a token failed
+	Token    *github.TokenInfo // nil if AuthErr or TokenErr is set
+	TokenErr error             // GitHub refused the token or couldn't be reached
+
+	Checker ScopeChecker // nil skips checking each tab's scope
+	Now     time.Time
+}
+
+// doctor writes findings and counts the problems among them
+type doctor struct {
+	w        io.Writer
+	problems int
+}
+
+// report writes one finding, with a fix on the next line if there is one
+func (d *doctor) report(marker, finding, fix string) {
+	if marker == doctorProblem {
+		d.problems++
+	}
+	fmt.Fprintf(d.w, "%s %s\n", marker, finding)
+	if fix != "" {
+		fmt.Fprintf(d.w, "    Fix: %s\n", fix)
+	}
+}
+
+// RunDoctor checks the configuration, the token's scopes, expiry and quotas,
+// and whether the token can read what each tab lists, writing each finding
+// with a fix. It returns the number of problems found; warnings don't count.
+func RunDoctor(ctx context.Context, in DoctorInput, w io.Writer) int {
+	d := &doctor{w: w}
+
+	if in.ConfigErr != nil {
+		d.report(doctorProblem, fmt.Sprintf("Config: %v", in.ConfigErr),
+			fmt.Sprintf("edit %s, or run 'pr-compass init' to create it", in.ConfigPath))
+	} else {
+		d.report(doctorOK, fmt.Sprintf("Config: %d tabs in %s", len(in.Scopes), in.ConfigPath), "")
+	}
+
+	switch {
+	case in.AuthErr != nil:
+		d.report(doctorProblem, fmt.Sprintf("Token: %v", in.AuthErr), "")
+	case in.TokenErr != nil:
+		d.report(doctorProblem, fmt.Sprintf("Token: %v", in.TokenErr), "")
+	case in.Token != nil:
+		d.checkToken(in)
+	}
+
+	if in.Token != nil && in.Checker != nil {
+		for _, scope := range in.Scopes {
+			d.checkScope(ctx, in.Checker, scope)
+		}
+	}
+
+	switch d.problems {
+	case 0:
+		fmt.Fprintln(w, "\nNo problems found.")
+	case 1:
+		fmt.Fprintln(w, "\n1 problem found.")
+	default:
+		fmt.Fprintf(w, "\n%d problems found.\n", d.problems)
+	}
+	return d.problems
+}
+
+// checkToken reports the token's user, expiry, scopes and quotas
+func (d *doctor) checkToken(in DoctorInput) {
+	token := in.Token
+	d.report(doctorOK, fmt.Sprintf("Token: authenticated as %s", token.Login), "")
+
+	if !token.Expires.IsZero() && token.Expires.Sub(in.Now) < tokenExpiryWarning {
+		d.report(doctorWarning, fmt.Sprintf("Token: expires %s", token.Expires.Local().Format("Mon Jan 2 15:04")),
+			fmt.Sprintf("create a new token at %ssettings/tokens before then", github.WebURL()))
+	}
+
+	if !token.ScopesListed {
+		d.report(doctorSkipped, "Scopes: fine-grained or app token, whose permissions GitHub doesn't list; the tab checks below show what it can read", "")
+	} else {
+		d.checkScopes(in)
+	}
+
+	var quotas []string
+	for _, quota := range []struct {
+		name  string
+		limit github.RateLimit
+	}{{"core", token.Core}, {"GraphQL", token.GraphQL}, {"search", token.Search}} {
+		if quota.limit.Limit == 0 {
+			continue
+		}
+		left := fmt.Sprintf("%s %d/%d", quota.name, quota.limit.Remaining, quota.limit.Limit)
+		if float64(quota.limit.Remaining) < lowQuotaShare*float64(quota.limit.Limit) {
+			d.report(doctorWarning, fmt.Sprintf("Rate limit: %s left until %s", left, quota.limit.Reset.Local().Format("15:04")),
+				"wait for the reset; longer refresh_interval_minutes spend the quota more slowly")
+		}
+		quotas = append(quotas, left)
+	}
+	if len(quotas) > 0 {
+		d.report(doctorOK, "Rate limit: "+strings.Join(quotas, ", "), "")
+	}
+}
+
+// checkScopes reports the classic token scopes PR Compass relies on
+func (d *doctor) checkScopes(in DoctorInput) {
+	token := in.Token
+	fix := func(scope string) string {
+		return fmt.Sprintf("run 'gh auth refresh -s %s', or add the scope to the token at %ssettings/tokens", scope, github.WebURL())
+	}
+
+	missing := false
+	if !token.HasScope("repo") {
+		missing = true
+		d.report(doctorWarning, "Scopes: no repo scope - private repositories aren't listed, and approving, merging and commenting fail", fix("repo"))
+	}
+	if !token.HasScope("read:org") {
+		missing = true
+		marker := doctorWarning
+		for _, scope := range in.Scopes {
+			if scope.Config.Mode == "teams" && provider.Name(scope.Config) == provider.GitHub {
+				marker = doctorProblem // Team tabs can't list their repositories
+			}
+		}
+		d.report(marker, "Scopes: no read:org scope - team tabs, team review requests and team reviewers need it", fix("read:org"))
+	}
+	for _, scope := range in.Scopes {
+		if scope.Config.Project != "" && !token.HasScope("read:project") {
+			missing = true
+			d.report(doctorProblem, fmt.Sprintf("Scopes: no read:project scope - tab %q can't read its Projects board", scope.Name), fix("read:project"))
+			break
+		}
+	}
+	if !missing {
+		d.report(doctorOK, "Scopes: "+strings.Join(token.Scopes, ", "), "")
+	}
+}
+
+// checkScope reports whether the token can read what one tab lists
+func (d *doctor) checkScope(ctx context.Context, checker ScopeChecker, scope ListScope) {
+	cfg := scope.Config
+	label := fmt.Sprintf("Tab %q", scope.Name)
+	if name := provider.Name(cfg); name != provider.GitHub {
+		d.report(doctorSkipped, fmt.Sprintf("%s: %s tabs aren't checked", label, name), "")
+		return
+	}
+
+	var failures []string
+	checked := ""
+	switch cfg.Mode {
+	case "repos":
+		for _, repo := range cfg.Repos {
+			if err := checker.CheckRepository(ctx, repo); err != nil {
+				failures = append(failures, err.Error())
+			}
+		}
+		checked = fmt.Sprintf("%d repositories readable", len(cfg.Repos))
+	case "organization":
+		if err := checker.CheckOrganization(ctx, cfg.Organization); err != nil {
+			failures = append(failures, err.Error())
+		}
+		checked = fmt.Sprintf("organization %s visible", cfg.Organization)
+	case "teams":
+		for _, slug := range cfg.Teams {
+			if err := checker.CheckTeam(ctx, cfg.Organization, slug); err != nil {
+				failures = append(failures, err.Error())
+			}
+		}
+		checked = fmt.Sprintf("%d teams in %s visible", len(cfg.Teams), cfg.Organization)
+	case "topics":
+		if cfg.TopicOrg == "" {
+			checked = "topics searched across GitHub"
+			break
+		}
+		if err := checker.CheckOrganization(ctx, cfg.TopicOrg); err != nil {
+			failures = append(failures, err.Error())
+		}
+		checked = fmt.Sprintf("organization %s visible", cfg.TopicOrg)
+	case "search":
+		count, err := checker.CountSearchResults(ctx, cfg.SearchQuery)
+		if err != nil {
+			failures = append(failures, err.Error())
+		}
+		checked = fmt.Sprintf("search matches %d issues and PRs", count)
+	}
+
+	if len(failures) == 0 {
+		d.report(doctorOK, fmt.Sprintf("%s: %s", label, checked), "")
+		return
+	}
+	for _, failure := range failures {
+		d.report(doctorProblem, fmt.Sprintf("%s: %s", label, failure), "")
+	}
+}
@@ -0,0 +1,112 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/config"
+	"github.com/bjess9/pr-compass/internal/github"
+)
+
+// fakeScopeChecker fails for the scopes listed in missing
+type fakeScopeChecker struct {
+	missing map[string]bool
+}
+
+func (c fakeScopeChecker) check(scope string) error {
+	if c.missing[scope] {
+		return errors.New(scope + " not found")
+	}
+	return nil
+}
+
+func (c fakeScopeChecker) CheckRepository(ctx context.Context, repo string) error {
+	return c.check(repo)
+}
+
+func (c fakeScopeChecker) CheckOrganization(ctx context.Context, org string) error {
+	return c.check(org)
+}
+
+func (c fakeScopeChecker) CheckTeam(ctx context.Context, org, slug string) error {
+	return c.check(org + "/" + slug)
+}
+
+func (c fakeScopeChecker) CountSearchResults(ctx context.Context, query string) (int, error) {
+	return 7, c.check(query)
+}
+
+func TestRunDoctor(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	in := DoctorInput{
+		ConfigPath: "/home/me/.prcompass_config.yaml",
+		Scopes: []ListScope{
+			{Name: "API", Config: &config.Config{Mode: "repos", Repos: []string{"acme/api", "acme/gone"}}},
+			{Name: "Backend", Config: &config.Config{Mode: "teams", Organization: "acme", Teams: []string{"backend"}}},
+			{Name: "Mine", Config: &config.Config{Mode: "search", SearchQuery: "is:pr author:@me"}},
+			{Name: "Platform", Config: &config.Config{Provider: "gitlab", Mode: "organization", Organization: "platform"}},
+		},
+		Token: &github.TokenInfo{
+			Login: "alice", Scopes: []string{"repo"}, ScopesListed: true,
+			Expires: now.Add(48 * time.Hour),
+			Core:    github.RateLimit{Limit: 5000, Remaining: 4200},
+			GraphQL: github.RateLimit{Limit: 5000, Remaining: 20},
+		},
+		Checker: fakeScopeChecker{missing: map[string]bool{"acme/gone": true}},
+		Now:     now,
+	}
+
+	var out strings.Builder
+	problems := RunDoctor(context.Background(), in, &out)
+	report := out.String()
+
+	// A missing read:org breaks the teams tab; the missing repo is the second problem
+	if problems != 2 {
+		t.Errorf("Expected 2 problems, got %d:\n%s", problems, report)
+	}
+	for _, want := range []string{
+		"[✓] Config: 4 tabs in /home/me/.prcompass_config.yaml",
+		"[✓] Token: authenticated as alice",
+		"[!] Token: expires",
+		"[✗] Scopes: no read:org scope",
+		"Fix: run 'gh auth refresh -s read:org'",
+		"[!] Rate limit: GraphQL 20/5000 left",
+		"[✓] Rate limit: core 4200/5000, GraphQL 20/5000",
+		`[✗] Tab "API": acme/gone not found`,
+		`[✓] Tab "Backend": 1 teams in acme visible`,
+		`[✓] Tab "Mine": search matches 7 issues and PRs`,
+		`[-] Tab "Platform": gitlab tabs aren't checked`,
+		"2 problems found.",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("Expected %q in the report:\n%s", want, report)
+		}
+	}
+}
+
+func TestRunDoctor_Failures(t *testing.T) {
+	in := DoctorInput{
+		ConfigPath: "/home/me/.prcompass_config.yaml",
+		ConfigErr:  errors.New("configuration file not found"),
+		TokenErr:   errors.New("GitHub rejected the token (Bad credentials)"),
+		Checker:    fakeScopeChecker{},
+	}
+
+	var out strings.Builder
+	if problems := RunDoctor(context.Background(), in, &out); problems != 2 {
+		t.Errorf("Expected the config and token problems, got %d:\n%s", problems, out.String())
+	}
+	if report := out.String(); !strings.Contains(report, "Fix: edit /home/me/.prcompass_config.yaml, or run 'pr-compass init'") ||
+		!strings.Contains(report, "[✗] Token: GitHub rejected the token") {
+		t.Errorf("Unexpected report:\n%s", report)
+	}
+
+	in = DoctorInput{Token: &github.TokenInfo{Login: "bot"}}
+	out.Reset()
+	if problems := RunDoctor(context.Background(), in, &out); problems != 0 || !strings.Contains(out.String(), "fine-grained or app token") {
+		t.Errorf("Expected unlisted scopes to be noted, got %d:\n%s", problems, out.String())
+	}
+}
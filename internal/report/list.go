@@ -0,0 +1,199 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/config"
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/provider"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// RequestsPerEnhancement is the number of API calls enhancing one PR costs
+// (a single GraphQL query)
+const RequestsPerEnhancement = 1
+
+// ErrEnhanceBudgetExhausted is recorded for PRs left unenhanced by the budget
+var ErrEnhanceBudgetExhausted = errors.New("enhancement budget exhausted")
+
+// errEnhanceNotGitHub is recorded for GitLab merge requests and Gitea pull
+// requests, which have no enhancement data
+var errEnhanceNotGitHub = errors.New("enhancement is only available for GitHub pull requests")
+
+// ListScope is a named PR source, usually one configured tab
+type ListScope struct {
+	Name   string
+	Config *config.Config
+}
+
+// ListOptions controls enhancement for the list output
+type ListOptions struct {
+	Enhance     bool // Fetch reviews, checks and file stats for each PR
+	Concurrency int  // Parallel enhancement requests; values below 1 mean 1
+	Budget      int  // Maximum API requests spent on enhancement; 0 means unlimited
+}
+
+// ListEntry is one PR in the list output
+type ListEntry struct {
+	Tab          string              `json:"tab"`
+	Repository   string              `json:"repository"`
+	Number       int                 `json:"number"`
+	Title        string              `json:"title"`
+	Author       string              `json:"author"`
+	URL          string              `json:"url"`
+	Draft        bool                `json:"draft"`
+	CreatedAt    time.Time           `json:"created_at"`
+	UpdatedAt    time.Time           `json:"updated_at"`
+	Enhanced     *types.EnhancedData `json:"enhanced,omitempty"`
+	EnhanceError string              `json:"enhance_error,omitempty"`
+}
+
+// Enhancer fetches enhancement data for a single PR
+type Enhancer func(ctx context.Context, pr *gh.PullRequest) (types.EnhancedData, error)
+
+// newListEntry describes a PR without enhancement data
+func newListEntry(tab string, pr *gh.PullRequest) ListEntry {
+	entry := ListEntry{
+		Tab:       tab,
+		Number:    pr.GetNumber(),
+		Title:     pr.GetTitle(),
+		Author:    pr.GetUser().GetLogin(),
+		URL:       pr.GetHTMLURL(),
+		Draft:     pr.GetDraft(),
+		CreatedAt: pr.GetCreatedAt().Time,
+		UpdatedAt: pr.GetUpdatedAt().Time,
+	}
+	if pr.GetBase() != nil && pr.GetBase().GetRepo() != nil {
+		entry.Repository = pr.GetBase().GetRepo().GetFullName()
+	}
+	return entry
+}
+
+// EnhanceEntries fills in enhancement data for entries in order until the
+// request budget runs out. Failures are recorded per entry rather than
+// aborting the list.
+func EnhanceEntries(ctx context.Context, entries []ListEntry, prs []*gh.PullRequest, enhance Enhancer, opts ListOptions) {
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	limit := len(prs)
+	if opts.Budget > 0 && opts.Budget/RequestsPerEnhancement < limit {
+		limit = opts.Budget / RequestsPerEnhancement
+	}
+	for i := limit; i < len(entries); i++ {
+		entries[i].EnhanceError = ErrEnhanceBudgetExhausted.Error()
+	}
+
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < limit; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			select {
+			case <-ctx.Done():
+				entries[i].EnhanceError = ctx.Err().Error()
+				return
+			case semaphore <- struct{}{}:
+			}
+			defer func() { <-semaphore }()
+
+			// Each goroutine owns entries[i], so no locking is needed
+			data, err := enhance(ctx, prs[i])
+			if err != nil {
+				entries[i].EnhanceError = err.Error()
+				return
+			}
+			entries[i].Enhanced = &data
+		}(i)
+	}
+	wg.Wait()
+}
+
+// RunList fetches open PRs for each scope, optionally enhances them and
+// writes the list as JSON or text
+func RunList(ctx context.Context, token string, scopes []ListScope, opts ListOptions, asJSON bool, w io.Writer) error {
+	seen := make(map[string]bool)
+	var (
+		prs     []*gh.PullRequest
+		entries []ListEntry
+		foreign = make(map[*gh.PullRequest]bool) // Only GitHub PRs can be enhanced
+	)
+	for _, scope := range scopes {
+		fetched, err := provider.FetchPRs(ctx, scope.Config, token, nil)
+		var emptyScope *github.NoRepositoriesError
+		if errors.As(err, &emptyScope) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", scope.Name, err)
+		}
+		for _, pr := range fetched {
+			key := pr.GetHTMLURL()
+			if key == "" || !seen[key] {
+				seen[key] = true
+				prs = append(prs, pr)
+				entries = append(entries, newListEntry(scope.Name, pr))
+				foreign[pr] = provider.Name(scope.Config) != provider.GitHub
+			}
+		}
+	}
+
+	if opts.Enhance {
+		EnhanceEntries(ctx, entries, prs, func(ctx context.Context, pr *gh.PullRequest) (types.EnhancedData, error) {
+			if foreign[pr] {
+				return types.EnhancedData{}, errEnhanceNotGitHub
+			}
+			prCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+			defer cancel()
+			return services.FetchEnhancedData(prCtx, token, pr)
+		}, opts)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Repository != entries[j].Repository {
+			return entries[i].Repository < entries[j].Repository
+		}
+		return entries[i].Number < entries[j].Number
+	})
+
+	if asJSON {
+		return WriteListJSON(w, entries)
+	}
+	return WriteListText(w, entries)
+}
+
+// WriteListJSON writes list entries as an indented JSON array
+func WriteListJSON(w io.Writer, entries []ListEntry) error {
+	if entries == nil {
+		entries = []ListEntry{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(entries)
+}
+
+// WriteListText writes list entries as aligned columns for terminals
+func WriteListText(w io.Writer, entries []ListEntry) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, entry := range entries {
+		status := ""
+		if entry.Enhanced != nil {
+			status = fmt.Sprintf("review:%s checks:%s +%d/-%d", entry.Enhanced.ReviewStatus, entry.Enhanced.ChecksStatus, entry.Enhanced.Additions, entry.Enhanced.Deletions)
+		}
+		fmt.Fprintf(tw, "%s#%d\t%s\t%s\t%s\n", entry.Repository, entry.Number, entry.Author, entry.Title, status)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,89 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	gh "github.com/google/go-github/v55/github"
+)
+
+func TestEnhanceEntriesRespectsBudgetAndConcurrency(t *testing.T) {
+	prs := []*gh.PullRequest{auditTestPR(1, "alice"), auditTestPR(2, "bob"), auditTestPR(3, "carol"), auditTestPR(4, "dave")}
+	entries := make([]ListEntry, len(prs))
+	for i, pr := range prs {
+		entries[i] = newListEntry("Main", pr)
+	}
+
+	var active, peak, calls int32
+	enhance := func(ctx context.Context, pr *gh.PullRequest) (types.EnhancedData, error) {
+		atomic.AddInt32(&calls, 1)
+		n := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		if pr.GetNumber() == 2 {
+			return types.EnhancedData{}, errors.New("boom")
+		}
+		return types.EnhancedData{Number: pr.GetNumber(), ReviewStatus: "approved", Additions: 10}, nil
+	}
+
+	// Budget for three PRs
+	EnhanceEntries(context.Background(), entries, prs, enhance, ListOptions{Enhance: true, Concurrency: 2, Budget: 3 * RequestsPerEnhancement})
+
+	if calls != 3 {
+		t.Errorf("Expected 3 enhancement calls within budget, got %d", calls)
+	}
+	if peak > 2 {
+		t.Errorf("Expected at most 2 concurrent enhancements, got %d", peak)
+	}
+	if entries[0].Enhanced == nil || entries[0].Enhanced.ReviewStatus != "approved" {
+		t.Errorf("Expected first PR enhanced, got %+v", entries[0])
+	}
+	if entries[1].Enhanced != nil || entries[1].EnhanceError != "boom" {
+		t.Errorf("Expected failure recorded on second PR, got %+v", entries[1])
+	}
+	if entries[3].Enhanced != nil || entries[3].EnhanceError != ErrEnhanceBudgetExhausted.Error() {
+		t.Errorf("Expected budget exhaustion on fourth PR, got %+v", entries[3])
+	}
+}
+
+func TestWriteListJSON(t *testing.T) {
+	enhanced := newListEntry("Main", auditTestPR(7, "alice"))
+	enhanced.Enhanced = &types.EnhancedData{Number: 7, ChecksStatus: "success", ChangedFiles: 3}
+	plain := newListEntry("Main", auditTestPR(8, "bob"))
+
+	var buf bytes.Buffer
+	if err := WriteListJSON(&buf, []ListEntry{enhanced, plain}); err != nil {
+		t.Fatalf("WriteListJSON() error = %v", err)
+	}
+
+	var decoded []map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if len(decoded) != 2 || decoded[0]["repository"] != "org/api" || decoded[0]["tab"] != "Main" {
+		t.Fatalf("Unexpected entries: %v", decoded)
+	}
+	details, ok := decoded[0]["enhanced"].(map[string]interface{})
+	if !ok || details["checks_status"] != "success" || details["changed_files"] != float64(3) {
+		t.Errorf("Expected enhanced data in JSON, got %v", decoded[0]["enhanced"])
+	}
+	if _, present := decoded[1]["enhanced"]; present {
+		t.Error("Expected enhanced to be omitted for PRs without enhancement")
+	}
+
+	buf.Reset()
+	if err := WriteListJSON(&buf, nil); err != nil || strings.TrimSpace(buf.String()) != "[]" {
+		t.Errorf("Expected empty array for no entries, got %q (err=%v)", buf.String(), err)
+	}
+}
@@ -0,0 +1,235 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/config"
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/provider"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// releaseNotesLimit caps the merged PRs listed per scope; GitHub search
+// returns at most 1,000 results
+const releaseNotesLimit = 1000
+
+// releaseNotesDateLayout is how --since dates are written
+const releaseNotesDateLayout = "2006-01-02"
+
+// Release notes group PRs by conventional-commit type or by label
+const (
+	GroupByType  = "type"
+	GroupByLabel = "label"
+)
+
+// typeHeadings name the release notes sections of conventional-commit types
+var typeHeadings = map[string]string{
+	"feat":     "Features",
+	"fix":      "Bug fixes",
+	"perf":     "Performance",
+	"refactor": "Refactoring",
+	"docs":     "Documentation",
+	"test":     "Tests",
+	"build":    "Build",
+	"ci":       "CI",
+	"chore":    "Chores",
+	"style":    "Style",
+	"revert":   "Reverts",
+}
+
+// Headings of the sections for PRs without a type or label
+const (
+	otherHeading     = "Other changes"
+	unlabeledHeading = "Unlabeled"
+)
+
+// ReleaseScope is a search qualifier for merged PRs, e.g. "repo:org/api",
+// with the configuration whose exclusions apply to it
+type ReleaseScope struct {
+	Name   string // Tab the scope comes from
+	Query  string // Search qualifiers
+	Repo   string // "owner/name" for single repo scopes, which can resolve tags
+	Config *config.Config
+}
+
+// ReleaseNotesOptions controls what the release notes cover and how they're grouped
+type ReleaseNotesOptions struct {
+	Since   string // Tag or YYYY-MM-DD date
+	GroupBy string // GroupByType or GroupByLabel
+}
+
+// ReleaseScopes returns the search scopes of a tab's configuration: its
+// repos, organization or search query. Other tabs and providers have no
+// search scope, and return an error saying so.
+func ReleaseScopes(name string, cfg *config.Config) ([]ReleaseScope, error) {
+	if provider.Name(cfg) != provider.GitHub {
+		return nil, fmt.Errorf("%s: release notes are only available for GitHub tabs", name)
+	}
+
+	var scopes []ReleaseScope
+	switch cfg.Mode {
+	case "repos":
+		for _, repo := range cfg.Repos {
+			scopes = append(scopes, ReleaseScope{Name: name, Query: "repo:" + repo, Repo: repo, Config: cfg})
+		}
+	case "organization":
+		scopes = append(scopes, ReleaseScope{Name: name, Query: "org:" + cfg.Organization, Config: cfg})
+	case "search":
+		var terms []string
+		for _, term := range strings.Fields(cfg.SearchQuery) {
+			if term != "is:open" && term != "is:pr" && term != "draft:false" {
+				terms = append(terms, term)
+			}
+		}
+		scopes = append(scopes, ReleaseScope{Name: name, Query: strings.Join(terms, " "), Config: cfg})
+	default:
+		return nil, fmt.Errorf("%s: %s tabs have no search scope, pass --repo", name, cfg.Mode)
+	}
+	return scopes, nil
+}
+
+// releaseSince resolves --since for a scope: a date applies everywhere,
+// while a tag is looked up in each repo
+func releaseSince(ctx context.Context, token string, scope ReleaseScope, since string) (time.Time, error) {
+	if date, err := time.Parse(releaseNotesDateLayout, since); err == nil {
+		return date, nil
+	}
+	if scope.Repo == "" {
+		return time.Time{}, fmt.Errorf("%s: tag %s can only be looked up in repos, pass a date or --repo", scope.Name, since)
+	}
+	return github.FetchTagDate(ctx, token, scope.Repo, since)
+}
+
+// RunReleaseNotes collects the PRs merged in each scope since a tag or date,
+// skipping the scope's excluded authors and titles, and writes them as
+// markdown release notes
+func RunReleaseNotes(ctx context.Context, token string, scopes []ReleaseScope, opts ReleaseNotesOptions, w io.Writer) error {
+	seen := make(map[string]bool)
+	var prs []*gh.PullRequest
+	for _, scope := range scopes {
+		since, err := releaseSince(ctx, token, scope, opts.Since)
+		if err != nil {
+			return err
+		}
+		query := fmt.Sprintf("is:pr %s merged:>=%s", scope.Query, since.UTC().Format(time.RFC3339))
+		merged, err := github.FetchMergedPRs(ctx, token, query, releaseNotesLimit)
+		if err != nil {
+			return fmt.Errorf("%s: %w", scope.Name, err)
+		}
+		for _, pr := range github.FilterPRs(scope.Config, merged) {
+			if !seen[pr.GetHTMLURL()] {
+				seen[pr.GetHTMLURL()] = true
+				prs = append(prs, pr)
+			}
+		}
+	}
+	return WriteReleaseNotes(w, prs, opts)
+}
+
+// releaseSection is a heading with its PRs, in merge order
+type releaseSection struct {
+	heading string
+	prs     []*gh.PullRequest
+}
+
+// releaseSections groups PRs under their conventional-commit type, in the
+// usual type order, or their first label, alphabetically. PRs without
+// either come last.
+func releaseSections(prs []*gh.PullRequest, groupBy string) []releaseSection {
+	byHeading := make(map[string][]*gh.PullRequest)
+	var headings []string
+	rest := otherHeading
+	if groupBy == GroupByLabel {
+		rest = unlabeledHeading
+	}
+	for _, pr := range prs {
+		heading := rest
+		if groupBy == GroupByLabel {
+			if len(pr.Labels) > 0 {
+				heading = pr.Labels[0].GetName()
+			}
+		} else if kind, _ := services.ParseTitleType(pr.GetTitle()); kind != "" {
+			heading = typeHeadings[kind]
+		}
+		if _, ok := byHeading[heading]; !ok && heading != rest {
+			headings = append(headings, heading)
+		}
+		byHeading[heading] = append(byHeading[heading], pr)
+	}
+
+	if groupBy == GroupByLabel {
+		sort.Strings(headings)
+	} else {
+		order := make(map[string]int, len(services.TitleTypes))
+		for i, kind := range services.TitleTypes {
+			order[typeHeadings[kind]] = i
+		}
+		sort.Slice(headings, func(i, j int) bool { return order[headings[i]] < order[headings[j]] })
+	}
+	if len(byHeading[rest]) > 0 {
+		headings = append(headings, rest)
+	}
+
+	sections := make([]releaseSection, len(headings))
+	for i, heading := range headings {
+		sections[i] = releaseSection{heading: heading, prs: byHeading[heading]}
+	}
+	return sections
+}
+
+// releaseNoteLine describes a PR in the notes, e.g.
+// "- **api:** drop v1 (⚠️ breaking) ([org/api#12](url)) @alice". Grouped by
+// type, the type prefix is left out since the heading says it.
+func releaseNoteLine(pr *gh.PullRequest, groupBy string) string {
+	title := strings.TrimSpace(pr.GetTitle())
+	if groupBy != GroupByLabel {
+		scope, subject := services.TitleSubject(title)
+		title = subject
+		if scope != "" {
+			title = fmt.Sprintf("**%s:** %s", scope, subject)
+		}
+	}
+	if _, breaking := services.ParseTitleType(pr.GetTitle()); breaking {
+		title += " (⚠️ breaking)"
+	}
+
+	ref := fmt.Sprintf("%s#%d", pr.GetBase().GetRepo().GetFullName(), pr.GetNumber())
+	if pr.GetHTMLURL() != "" {
+		ref = fmt.Sprintf("[%s](%s)", ref, pr.GetHTMLURL())
+	}
+	line := fmt.Sprintf("- %s (%s)", title, ref)
+	if author := pr.GetUser().GetLogin(); author != "" {
+		line += " @" + author
+	}
+	return line
+}
+
+// WriteReleaseNotes writes merged PRs as markdown release notes, a section
+// per group with PRs in the order they merged
+func WriteReleaseNotes(w io.Writer, prs []*gh.PullRequest, opts ReleaseNotesOptions) error {
+	sorted := make([]*gh.PullRequest, len(prs))
+	copy(sorted, prs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetMergedAt().Before(sorted[j].GetMergedAt().Time)
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Changes since %s\n", opts.Since)
+	if len(sorted) == 0 {
+		b.WriteString("\nNo PRs merged.\n")
+	}
+	for _, section := range releaseSections(sorted, opts.GroupBy) {
+		fmt.Fprintf(&b, "\n### %s\n\n", section.heading)
+		for _, pr := range section.prs {
+			b.WriteString(releaseNoteLine(pr, opts.GroupBy) + "\n")
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,106 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/config"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// mergedTestPR returns a PR merged some hours after a fixed time
+func mergedTestPR(number int, title string, hours int, labels ...string) *gh.PullRequest {
+	pr := auditTestPR(number, "alice")
+	pr.Title = gh.String(title)
+	pr.MergedAt = &gh.Timestamp{Time: time.Date(2026, 10, 1, hours, 0, 0, 0, time.UTC)}
+	for _, label := range labels {
+		pr.Labels = append(pr.Labels, &gh.Label{Name: gh.String(label)})
+	}
+	return pr
+}
+
+func TestWriteReleaseNotesByType(t *testing.T) {
+	prs := []*gh.PullRequest{
+		mergedTestPR(3, "Update README", 1),
+		mergedTestPR(2, "fix(auth): refresh expired tokens", 3),
+		mergedTestPR(1, "feat(api)!: drop v1 endpoints", 2),
+		mergedTestPR(4, "feat: dark mode", 1),
+	}
+
+	var out bytes.Buffer
+	if err := WriteReleaseNotes(&out, prs, ReleaseNotesOptions{Since: "v1.2.0", GroupBy: GroupByType}); err != nil {
+		t.Fatalf("WriteReleaseNotes() error = %v", err)
+	}
+
+	want := `## Changes since v1.2.0
+
+### Features
+
+- dark mode ([org/api#4](https://github.com/org/api/pull/4)) @alice
+- **api:** drop v1 endpoints (⚠️ breaking) ([org/api#1](https://github.com/org/api/pull/1)) @alice
+
+### Bug fixes
+
+- **auth:** refresh expired tokens ([org/api#2](https://github.com/org/api/pull/2)) @alice
+
+### Other changes
+
+- Update README ([org/api#3](https://github.com/org/api/pull/3)) @alice
+`
+	if out.String() != want {
+		t.Errorf("WriteReleaseNotes() =\n%s\nwant\n%s", out.String(), want)
+	}
+}
+
+func TestWriteReleaseNotesByLabel(t *testing.T) {
+	prs := []*gh.PullRequest{
+		mergedTestPR(1, "feat: dark mode", 1, "ui", "enhancement"),
+		mergedTestPR(2, "Fix login", 2),
+		mergedTestPR(3, "Speed up API", 3, "backend"),
+	}
+
+	var out bytes.Buffer
+	WriteReleaseNotes(&out, prs, ReleaseNotesOptions{Since: "2026-09-01", GroupBy: GroupByLabel})
+	got := out.String()
+
+	backend, ui, unlabeled := strings.Index(got, "### backend"), strings.Index(got, "### ui"), strings.Index(got, "### Unlabeled")
+	if backend < 0 || ui < backend || unlabeled < ui {
+		t.Errorf("Expected label sections alphabetically, unlabeled PRs last, got:\n%s", got)
+	}
+	if !strings.Contains(got, "- feat: dark mode (") {
+		t.Errorf("Expected titles kept whole when grouped by label, got:\n%s", got)
+	}
+
+	out.Reset()
+	WriteReleaseNotes(&out, nil, ReleaseNotesOptions{Since: "v2"})
+	if !strings.Contains(out.String(), "No PRs merged.") {
+		t.Errorf("Expected empty notes to say so, got %q", out.String())
+	}
+}
+
+func TestReleaseScopes(t *testing.T) {
+	scopes, err := ReleaseScopes("Team", &config.Config{Mode: "repos", Repos: []string{"org/api", "org/web"}})
+	if err != nil || len(scopes) != 2 || scopes[1].Query != "repo:org/web" || scopes[1].Repo != "org/web" {
+		t.Errorf("Expected a scope per repo, got %+v (%v)", scopes, err)
+	}
+
+	scopes, _ = ReleaseScopes("Search", &config.Config{Mode: "search", SearchQuery: "is:pr is:open label:backend org:acme"})
+	if len(scopes) != 1 || scopes[0].Query != "label:backend org:acme" || scopes[0].Repo != "" {
+		t.Errorf("Expected the search query without open PR terms, got %+v", scopes)
+	}
+
+	if _, err := ReleaseScopes("Teams", &config.Config{Mode: "teams", Organization: "acme", Teams: []string{"core"}}); err == nil {
+		t.Error("Expected teams tabs to have no search scope")
+	}
+
+	// A tag can't be resolved without a repo
+	if _, err := releaseSince(context.Background(), "", scopes[0], "v1.2.0"); err == nil {
+		t.Error("Expected an error for a tag in a search scope")
+	}
+	if since, err := releaseSince(context.Background(), "", scopes[0], "2026-09-01"); err != nil || since.Day() != 1 {
+		t.Errorf("Expected dates to apply to any scope, got %v (%v)", since, err)
+	}
+}
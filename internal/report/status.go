@@ -0,0 +1,124 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/provider"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// StatusSummary counts open PRs for status bars
+type StatusSummary struct {
+	Open          int
+	NeedsMyReview int
+	Failing       int
+	Login         string // Whose review requests were counted; "" skips them
+}
+
+// ChecksLookup reports a PR's checks status if it is known without an API call
+type ChecksLookup func(pr *gh.PullRequest) (string, bool)
+
+// Summarize counts open PRs, those requesting a review from login and those
+// whose checks are known to fail
+func Summarize(prs []*gh.PullRequest, login string, checks ChecksLookup) StatusSummary {
+	summary := StatusSummary{Open: len(prs), Login: login}
+	for _, pr := range prs {
+		if login != "" && reviewRequestedFrom(pr, login) {
+			summary.NeedsMyReview++
+		}
+		if status, known := checks(pr); known && status == "failure" {
+			summary.Failing++
+		}
+	}
+	return summary
+}
+
+// reviewRequestedFrom reports whether a PR waits on login's review; team
+// requests aren't counted, as membership would cost API calls
+func reviewRequestedFrom(pr *gh.PullRequest, login string) bool {
+	if strings.EqualFold(pr.GetUser().GetLogin(), login) {
+		return false
+	}
+	for _, reviewer := range pr.RequestedReviewers {
+		if strings.EqualFold(reviewer.GetLogin(), login) {
+			return true
+		}
+	}
+	return false
+}
+
+// String renders the summary as one line, e.g. "7 open · 2 need my review · 1 failing"
+func (s StatusSummary) String() string {
+	parts := []string{fmt.Sprintf("%d open", s.Open)}
+	if s.Login != "" {
+		parts = append(parts, fmt.Sprintf("%d need my review", s.NeedsMyReview))
+	}
+	parts = append(parts, fmt.Sprintf("%d failing", s.Failing))
+	return strings.Join(parts, " · ")
+}
+
+// RunStatus writes a one-line summary of the PRs in scopes. PR lists come
+// from the cache while it is fresh and are fetched (and cached) otherwise;
+// with an empty token, or when fetching fails, stale cached lists are used.
+// Checks are only read from the cache the TUI fills during enhancement.
+func RunStatus(ctx context.Context, token string, scopes []ListScope, prCache *cache.PRCache, login string, w io.Writer) error {
+	seen := make(map[string]bool)
+	var prs []*gh.PullRequest
+	var firstErr error
+	found := false
+	for _, scope := range scopes {
+		fetched, err := statusPRs(ctx, token, scope, prCache)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("%s: %w", scope.Name, err)
+			}
+			continue
+		}
+		found = true
+		for _, pr := range fetched {
+			key := pr.GetHTMLURL()
+			if key == "" || !seen[key] {
+				seen[key] = true
+				prs = append(prs, pr)
+			}
+		}
+	}
+	if !found && firstErr != nil {
+		return firstErr
+	}
+
+	summary := Summarize(prs, login, func(pr *gh.PullRequest) (string, bool) {
+		data, cached := services.CachedEnhancedData(prCache, pr)
+		return data.ChecksStatus, cached
+	})
+	_, err := fmt.Fprintln(w, summary)
+	return err
+}
+
+// statusPRs lists a scope's PRs, preferring the cache
+func statusPRs(ctx context.Context, token string, scope ListScope, prCache *cache.PRCache) ([]*gh.PullRequest, error) {
+	var err error
+	if token != "" {
+		var prs []*gh.PullRequest
+		prs, err = provider.FetchPRs(ctx, scope.Config, token, prCache)
+		var emptyScope *github.NoRepositoriesError
+		if err == nil || errors.As(err, &emptyScope) {
+			return prs, nil
+		}
+	}
+
+	if prs, _, cached := provider.CachedPRs(scope.Config, prCache); cached {
+		return prs, nil
+	}
+	if err == nil {
+		err = errors.New("no cached PRs yet; run pr-compass or status with a token first")
+	}
+	return nil, err
+}
@@ -0,0 +1,34 @@
+package report
+
+import (
+	"testing"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+func TestSummarize(t *testing.T) {
+	requested := auditTestPR(1, "alice")
+	requested.RequestedReviewers = []*gh.User{{Login: gh.String("Me")}}
+	own := auditTestPR(2, "me")
+	own.RequestedReviewers = []*gh.User{{Login: gh.String("me")}}
+	failing := auditTestPR(3, "bob")
+	unknown := auditTestPR(4, "carol")
+
+	checks := map[int]string{1: "success", 2: "failure", 3: "failure"}
+	summary := Summarize([]*gh.PullRequest{requested, own, failing, unknown}, "me", func(pr *gh.PullRequest) (string, bool) {
+		status, known := checks[pr.GetNumber()]
+		return status, known
+	})
+
+	if summary.Open != 4 || summary.NeedsMyReview != 1 || summary.Failing != 2 {
+		t.Errorf("Unexpected summary: %+v", summary)
+	}
+	if got, want := summary.String(), "4 open · 1 need my review · 2 failing"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	summary.Login = ""
+	if got, want := summary.String(), "4 open · 2 failing"; got != want {
+		t.Errorf("String() without login = %q, want %q", got, want)
+	}
+}
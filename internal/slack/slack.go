@@ -0,0 +1,195 @@
+// Package slack posts PR events to Slack incoming webhooks.
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Events a Slack notifier can post
+const (
+	EventApproved        = "approved"         // A PR got approved
+	EventChecksFailed    = "ci_failed"        // A PR's checks started failing
+	EventReviewRequested = "review_requested" // A PR started requesting my review
+)
+
+// Events lists every event, in the order the docs describe them
+var Events = []string{EventApproved, EventChecksFailed, EventReviewRequested}
+
+// DefaultWebhookEnv holds the webhook URL when webhook_env isn't set
+const DefaultWebhookEnv = "SLACK_WEBHOOK_URL"
+
+// defaultTemplates render each event in Slack's mrkdwn
+var defaultTemplates = map[string]string{
+	EventApproved:        `✅ <{{.URL}}|{{.PR}}> {{.Title}} was approved{{with .Detail}} by {{.}}{{end}}`,
+	EventChecksFailed:    `❌ Checks failed on <{{.URL}}|{{.PR}}> {{.Title}} ({{.Author}})`,
+	EventReviewRequested: `👀 <{{.URL}}|{{.PR}}> {{.Title}} by {{.Author}} needs your review`,
+}
+
+// Config sends events to a Slack incoming webhook. Webhook URLs are
+// secrets, so they are read from environment variables.
+type Config struct {
+	// Environment variable holding the webhook URL (default SLACK_WEBHOOK_URL)
+	WebhookEnv string `mapstructure:"webhook_env" yaml:"webhook_env,omitempty"`
+
+	// Events to post; unset posts all of them
+	Events []string `mapstructure:"events" yaml:"events,omitempty"`
+
+	// Go templates replacing the default message per event, with .PR
+	// ("owner/name#123"), .Title, .Author, .URL, .Tab and .Detail
+	Templates map[string]string `mapstructure:"templates" yaml:"templates,omitempty"`
+}
+
+// Validate checks event names and templates
+func (c *Config) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if err := ValidateEvents(c.Events); err != nil {
+		return fmt.Errorf("slack.events: %w", err)
+	}
+	for event, text := range c.Templates {
+		if !slices.Contains(Events, event) {
+			return fmt.Errorf("slack.templates: unknown event %q, expected one of %s", event, strings.Join(Events, ", "))
+		}
+		if _, err := template.New(event).Parse(text); err != nil {
+			return fmt.Errorf("slack.templates.%s: %w", event, err)
+		}
+	}
+	return nil
+}
+
+// ValidateEvents checks that every event name is known
+func ValidateEvents(events []string) error {
+	for _, event := range events {
+		if !slices.Contains(Events, event) {
+			return fmt.Errorf("unknown event %q, expected one of %s", event, strings.Join(Events, ", "))
+		}
+	}
+	return nil
+}
+
+// Event is something that happened to a PR
+type Event struct {
+	Kind   string // One of Events
+	Tab    string // Tab the PR is listed in
+	PR     string // "owner/name#123"
+	Title  string
+	Author string
+	URL    string
+	Detail string // Event specific, e.g. the approving reviewers
+}
+
+// Notifier renders events with the configured templates and posts them
+type Notifier struct {
+	webhookEnv string
+	events     []string
+	templates  map[string]*template.Template
+	client     *http.Client
+}
+
+// NewNotifier creates a notifier for a validated configuration
+func NewNotifier(cfg Config) (*Notifier, error) {
+	n := &Notifier{
+		webhookEnv: cfg.WebhookEnv,
+		events:     cfg.Events,
+		templates:  make(map[string]*template.Template),
+		client:     &http.Client{Timeout: 10 * time.Second},
+	}
+	if n.webhookEnv == "" {
+		n.webhookEnv = DefaultWebhookEnv
+	}
+	if len(n.events) == 0 {
+		n.events = Events
+	}
+	for _, event := range Events {
+		text := defaultTemplates[event]
+		if custom, ok := cfg.Templates[event]; ok {
+			text = custom
+		}
+		parsed, err := template.New(event).Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("slack.templates.%s: %w", event, err)
+		}
+		n.templates[event] = parsed
+	}
+	return n, nil
+}
+
+// WebhookEnv returns the environment variable holding the webhook URL: a
+// tab's own when set, else the configured one
+func (n *Notifier) WebhookEnv(tabEnv string) string {
+	if tabEnv != "" {
+		return tabEnv
+	}
+	return n.webhookEnv
+}
+
+// WebhookURL reads the webhook URL from an environment variable
+func WebhookURL(env string) string {
+	return strings.TrimSpace(os.Getenv(env))
+}
+
+// Wants reports whether an event is posted, given a tab's own event list
+// that replaces the configured one when set
+func (n *Notifier) Wants(kind string, tabEvents []string) bool {
+	if len(tabEvents) > 0 {
+		return slices.Contains(tabEvents, kind)
+	}
+	return slices.Contains(n.events, kind)
+}
+
+// Render formats an event's message
+func (n *Notifier) Render(event Event) (string, error) {
+	tmpl := n.templates[event.Kind]
+	if tmpl == nil {
+		return "", fmt.Errorf("unknown Slack event %q", event.Kind)
+	}
+	var text bytes.Buffer
+	if err := tmpl.Execute(&text, event); err != nil {
+		return "", fmt.Errorf("rendering the %s message: %w", event.Kind, err)
+	}
+	return text.String(), nil
+}
+
+// Post renders an event and posts it to a webhook
+func (n *Notifier) Post(ctx context.Context, webhookURL string, event Event) error {
+	text, err := n.Render(event)
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Slack webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusForbidden, http.StatusNotFound, http.StatusGone:
+		return fmt.Errorf("Slack rejected the webhook (%s) - it may have been revoked", resp.Status)
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("Slack rate limit exceeded - message for %s dropped", event.PR)
+	default:
+		return fmt.Errorf("Slack returned %s", resp.Status)
+	}
+}
@@ -0,0 +1,105 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+var testEvent = Event{
+	Kind:   EventApproved,
+	Tab:    "Team",
+	PR:     "acme/api#12",
+	Title:  "Retry uploads",
+	Author: "sam",
+	URL:    "https://github.com/acme/api/pull/12",
+	Detail: "@maria",
+}
+
+func TestPost(t *testing.T) {
+	var posted string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Text string `json:"text"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("Expected a JSON payload: %v", err)
+		}
+		posted = body.Text
+	}))
+	defer server.Close()
+
+	notifier, err := NewNotifier(Config{})
+	if err != nil {
+		t.Fatalf("NewNotifier() returned error: %v", err)
+	}
+	if err := notifier.Post(context.Background(), server.URL, testEvent); err != nil {
+		t.Fatalf("Post() returned error: %v", err)
+	}
+	if want := "✅ <https://github.com/acme/api/pull/12|acme/api#12> Retry uploads was approved by @maria"; posted != want {
+		t.Errorf("Posted %q, want %q", posted, want)
+	}
+}
+
+func TestPost_RevokedWebhook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	notifier, _ := NewNotifier(Config{})
+	if err := notifier.Post(context.Background(), server.URL, testEvent); err == nil || !strings.Contains(err.Error(), "revoked") {
+		t.Errorf("Expected a revoked webhook error, got %v", err)
+	}
+}
+
+func TestNotifier_TemplatesAndRouting(t *testing.T) {
+	notifier, err := NewNotifier(Config{
+		WebhookEnv: "TEAM_SLACK",
+		Events:     []string{EventApproved, EventChecksFailed},
+		Templates:  map[string]string{EventApproved: "{{.Tab}}: {{.PR}} by {{.Author}} approved"},
+	})
+	if err != nil {
+		t.Fatalf("NewNotifier() returned error: %v", err)
+	}
+	if text, _ := notifier.Render(testEvent); text != "Team: acme/api#12 by sam approved" {
+		t.Errorf("Unexpected custom message %q", text)
+	}
+	if text, _ := notifier.Render(Event{Kind: EventReviewRequested, PR: "acme/api#3"}); !strings.Contains(text, "needs your review") {
+		t.Errorf("Expected the default message for events without a template, got %q", text)
+	}
+
+	if notifier.Wants(EventReviewRequested, nil) || !notifier.Wants(EventChecksFailed, nil) {
+		t.Error("Expected the configured events to be posted")
+	}
+	if !notifier.Wants(EventReviewRequested, []string{EventReviewRequested}) || notifier.Wants(EventApproved, []string{EventReviewRequested}) {
+		t.Error("Expected a tab's events to replace the configured ones")
+	}
+	if notifier.WebhookEnv("") != "TEAM_SLACK" || notifier.WebhookEnv("OPS_SLACK") != "OPS_SLACK" {
+		t.Error("Expected a tab's webhook variable to take precedence")
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		config  *Config
+		wantErr string
+	}{
+		{"unset", nil, ""},
+		{"defaults", &Config{}, ""},
+		{"unknown event", &Config{Events: []string{"merged"}}, "unknown event"},
+		{"unknown template", &Config{Templates: map[string]string{"merged": "x"}}, "unknown event"},
+		{"bad template", &Config{Templates: map[string]string{EventApproved: "{{.PR"}}, "slack.templates.approved"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.wantErr == "" && err != nil || tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)) {
+				t.Errorf("Validate() = %v, want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
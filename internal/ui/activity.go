@@ -0,0 +1,191 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	"github.com/charmbracelet/lipgloss"
+	gh "github.com/google/go-github/v55/github"
+)
+
+const (
+	// activityLimit caps the session activity log, dropping the oldest entries
+	activityLimit = 100
+
+	// activityLines caps the entries the activity popup shows
+	activityLines = 10
+
+	// activityDedupWindow is how long an identical entry is taken to be the
+	// same event seen through another tab listing the PR
+	activityDedupWindow = 10 * time.Minute
+)
+
+// ActivityEntry is something that happened to a PR during the session
+type ActivityEntry struct {
+	At      time.Time
+	Tab     string
+	Message string // e.g. "org/api#432 approved by @maria"
+}
+
+// recordActivity adds an entry to the session activity log, unless another
+// tab recorded the same event moments ago
+func (m *MultiTabModel) recordActivity(tab *TabState, message string, at time.Time) {
+	for i := len(m.Activity) - 1; i >= 0 && at.Sub(m.Activity[i].At) < activityDedupWindow; i-- {
+		if m.Activity[i].Message == message {
+			return
+		}
+	}
+	m.Activity = append(m.Activity, ActivityEntry{At: at, Tab: tab.Config.Name, Message: message})
+	if len(m.Activity) > activityLimit {
+		m.Activity = m.Activity[len(m.Activity)-activityLimit:]
+	}
+}
+
+// recordListingChanges adds PRs opened and PRs with new commits to the
+// activity log, diffing a refresh against the listing fetched at listedAt.
+// PRs created before then merely moved into the listing, e.g. past the
+// max_prs cut, and the first listing only sets the baseline.
+func (m *MultiTabModel) recordListingChanges(tab *TabState, previous, current []*gh.PullRequest, listedAt, at time.Time) {
+	if previous == nil {
+		return
+	}
+	heads := make(map[string]string, len(previous))
+	for _, pr := range previous {
+		heads[services.PRKey(pr)] = pr.GetHead().GetSHA()
+	}
+	for _, pr := range current {
+		key := services.PRKey(pr)
+		head, listed := heads[key]
+		switch {
+		case !listed && pr.GetCreatedAt().After(listedAt):
+			m.recordActivity(tab, fmt.Sprintf("🆕 %s opened by @%s: %s", key, pr.GetUser().GetLogin(), pr.GetTitle()), at)
+		case listed && head != "" && pr.GetHead().GetSHA() != "" && head != pr.GetHead().GetSHA():
+			m.recordActivity(tab, fmt.Sprintf("⬆️ %s new commits, now at %s", key, shortSHA(pr.GetHead().GetSHA())), at)
+		}
+	}
+}
+
+// recordCompletedActivity adds PRs that merged or closed to the activity log
+func (m *MultiTabModel) recordCompletedActivity(tab *TabState, completed []CompletedPR) {
+	for _, entry := range completed {
+		verb := "🚪 closed"
+		if entry.PR.GetMerged() {
+			verb = "🎉 merged"
+		}
+		m.recordActivity(tab, fmt.Sprintf("%s %s", services.PRKey(entry.PR), verb), entry.At)
+	}
+}
+
+// recordCommentActivity adds new comments a PR's refreshed details reveal
+// to the activity log
+func (m *MultiTabModel) recordCommentActivity(tab *TabState, before, after types.EnhancedData) {
+	added := after.Comments + after.ReviewComments - before.Comments - before.ReviewComments
+	if added <= 0 {
+		return
+	}
+	m.recordActivity(tab, fmt.Sprintf("💬 %s %d new %s", prLabel(tab, after.Number), added, plural(added, "comment", "comments")), time.Now())
+}
+
+// prLabel names a tab's PR by number as "owner/name#123", or "#123" when it
+// isn't listed anymore
+func prLabel(tab *TabState, number int) string {
+	for _, listed := range tab.PRs {
+		if listed.GetNumber() == number {
+			return services.PRKey(listed)
+		}
+	}
+	return fmt.Sprintf("#%d", number)
+}
+
+// shortSHA abbreviates a commit SHA like git does
+func shortSHA(sha string) string {
+	return sha[:min(len(sha), 7)]
+}
+
+// recordReviewChange reports a PR's review state changing between two
+// enhancements, naming who changed it, in the status line and activity log
+func (m *MultiTabModel) recordReviewChange(tab *TabState, before, after types.EnhancedData) {
+	change := reviewChange(before, after)
+	if change == "" {
+		return
+	}
+	message := prLabel(tab, after.Number) + " " + change
+	m.recordActivity(tab, message, time.Now())
+	tab.StatusMsg = message
+}
+
+// reviewChange describes a change of review state and who caused it, e.g.
+// "approved by @maria", or "" if the state is unchanged or wasn't known
+func reviewChange(before, after types.EnhancedData) string {
+	if before.ReviewStatus == "" || before.ReviewStatus == after.ReviewStatus {
+		return ""
+	}
+
+	switch after.ReviewStatus {
+	case "approved":
+		if by := reviewersChangedTo(before, after, "APPROVED"); by != "" {
+			return "✅ approved by " + by
+		}
+		return "✅ approved"
+	case "changes_requested":
+		if by := reviewersChangedTo(before, after, "CHANGES_REQUESTED"); by != "" {
+			return "🔄 changes requested by " + by
+		}
+		return "🔄 changes requested"
+	}
+
+	// Back from a verdict; moving between commented and unreviewed isn't news
+	if before.ReviewStatus != "approved" && before.ReviewStatus != "changes_requested" {
+		return ""
+	}
+	if by := reviewersChangedTo(before, after, "DISMISSED"); by != "" {
+		return "⏳ needs review again, review by " + by + " dismissed"
+	}
+	return "⏳ needs review again"
+}
+
+// reviewersChangedTo lists the reviewers whose verdict changed to state,
+// e.g. "@maria, @sam"
+func reviewersChangedTo(before, after types.EnhancedData, state string) string {
+	var logins []string
+	for login, verdict := range after.Reviewers {
+		if verdict == state && before.Reviewers[login] != state {
+			logins = append(logins, "@"+login)
+		}
+	}
+	sort.Strings(logins)
+	return strings.Join(logins, ", ")
+}
+
+// renderActivity renders the session activity feed across all tabs, newest
+// first, setting entries since the feed was last closed apart
+func (m *MultiTabModel) renderActivity(now time.Time) string {
+	var lines []string
+	shown, fresh := 0, 0
+	for i := len(m.Activity) - 1; i >= 0 && shown < activityLines; i-- {
+		entry := m.Activity[i]
+		if entry.At.After(m.activityCheckedAt) {
+			fresh++
+		} else if fresh > 0 && fresh == shown {
+			lines = append(lines, mutedStyle.Render("── seen before ──"))
+		}
+		lines = append(lines, fmt.Sprintf("%s  %s  %s", formatAge(now.Sub(entry.At)), entry.Message, mutedStyle.Render(entry.Tab)))
+		shown++
+	}
+	if shown == 0 {
+		lines = []string{mutedStyle.Render("Nothing happened yet this session")}
+	} else if more := len(m.Activity) - shown; more > 0 {
+		lines = append(lines, mutedStyle.Render(fmt.Sprintf("+%d earlier", more)))
+	}
+
+	heading := "📰 Activity"
+	if fresh > 0 && !m.activityCheckedAt.IsZero() {
+		heading += fmt.Sprintf(" · %d new since you last looked", fresh)
+	}
+	title := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.TextBright)).Render(heading)
+	return "\n" + repoInfoStyle.Render(title+"\n"+strings.Join(lines, "\n"))
+}
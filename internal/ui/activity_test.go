@@ -0,0 +1,127 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestReviewChange tests naming who changed a PR's review state
+func TestReviewChange(t *testing.T) {
+	pending := types.EnhancedData{ReviewStatus: "pending", Reviewers: map[string]string{"bob": "COMMENTED"}}
+	approved := types.EnhancedData{ReviewStatus: "approved", Reviewers: map[string]string{"bob": "APPROVED", "maria": "APPROVED"}}
+	tests := []struct {
+		name          string
+		before, after types.EnhancedData
+		want          string
+	}{
+		{"approved", pending, approved, "✅ approved by @bob, @maria"},
+		{"changes requested", approved, types.EnhancedData{ReviewStatus: "changes_requested", Reviewers: map[string]string{"bob": "APPROVED", "maria": "CHANGES_REQUESTED"}}, "🔄 changes requested by @maria"},
+		{"approval dismissed", approved, types.EnhancedData{ReviewStatus: "pending", Reviewers: map[string]string{"bob": "APPROVED", "maria": "DISMISSED"}}, "⏳ needs review again, review by @maria dismissed"},
+		{"more approvals required", approved, types.EnhancedData{ReviewStatus: "pending", Reviewers: approved.Reviewers}, "⏳ needs review again"},
+		{"unchanged", approved, approved, ""},
+		{"first comment", types.EnhancedData{ReviewStatus: "no_review"}, pending, ""},
+		{"not known before", types.EnhancedData{}, approved, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := reviewChange(tt.before, tt.after); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestReviewChangeActivity tests that a re-enhanced PR's review change
+// reaches the status line and the activity log
+func TestReviewChangeActivity(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Team", Mode: "repos", Repos: []string{"org/api"}})
+	enhancedAt := time.Now().Add(-time.Hour)
+	pr := &gh.PullRequest{
+		Number:    gh.Int(432),
+		UpdatedAt: &gh.Timestamp{Time: enhancedAt},
+		Base:      &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/api")}},
+	}
+	tab.PRs = []*gh.PullRequest{pr}
+	tab.FilteredPRs = tab.PRs
+	tab.EnhancedData[432] = types.EnhancedData{Number: 432, ReviewStatus: "pending"}
+	tab.EnhancedFor[432] = enhancedAt
+
+	// A review bumps the PR's update time, so it is enhanced again
+	pr.UpdatedAt = &gh.Timestamp{Time: time.Now()}
+	if model.startEnhancementForTab(tab) == nil || !tab.EnhancementQueue[432] {
+		t.Fatal("Expected the updated PR to be enhanced again")
+	}
+
+	model.Update(types.PrEnhancementUpdateMsg{PrData: types.EnhancedData{
+		Number: 432, ReviewStatus: "approved", Reviewers: map[string]string{"maria": "APPROVED"},
+	}})
+	if tab.StatusMsg != "org/api#432 ✅ approved by @maria" {
+		t.Errorf("Expected the approval in the status line, got %q", tab.StatusMsg)
+	}
+	if len(model.Activity) != 1 || model.Activity[0].Tab != "Team" {
+		t.Fatalf("Expected one activity entry, got %+v", model.Activity)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("H")})
+	if view := model.renderActiveTabContent(tab); !strings.Contains(view, "approved by @maria") || !strings.Contains(view, "Activity") {
+		t.Error("Expected H to show the activity log")
+	}
+}
+
+// TestActivityFeed tests recording opened PRs, pushes, comments and merges
+// from refreshes once across tabs, and setting apart what was seen before
+func TestActivityFeed(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	team := model.TabManager.AddTab(&TabConfig{Name: "Team", Mode: "repos", Repos: []string{"org/api"}})
+	mine := model.TabManager.AddTab(&TabConfig{Name: "Mine", Mode: "repos", Repos: []string{"org/api"}})
+	listedAt := time.Now().Add(-time.Hour)
+	pr := func(number int, sha string, created time.Time) *gh.PullRequest {
+		listed := labeledPR(number)
+		listed.Head = &gh.PullRequestBranch{SHA: gh.String(sha)}
+		listed.CreatedAt = &gh.Timestamp{Time: created}
+		listed.User = &gh.User{Login: gh.String("sam")}
+		return listed
+	}
+	old := listedAt.Add(-24 * time.Hour)
+	previous := []*gh.PullRequest{pr(1, "aaaaaaaaaa", old)}
+	current := []*gh.PullRequest{pr(1, "bbbbbbbbbb", old), pr(2, "cccc", time.Now()), pr(3, "dddd", old)}
+
+	model.recordListingChanges(team, nil, previous, time.Time{}, time.Now())
+	if len(model.Activity) != 0 {
+		t.Fatalf("Expected the first listing to record nothing, got %+v", model.Activity)
+	}
+	for _, tab := range []*TabState{team, mine} {
+		model.recordListingChanges(tab, previous, current, listedAt, time.Now())
+	}
+	var messages []string
+	for _, entry := range model.Activity {
+		messages = append(messages, entry.Message)
+	}
+	want := []string{"⬆️ org/api#1 new commits, now at bbbbbbb", "🆕 org/api#2 opened by @sam: PR"}
+	if strings.Join(messages, "|") != strings.Join(want, "|") {
+		t.Errorf("Expected %q once across tabs, got %q", want, messages)
+	}
+
+	team.PRs = current
+	model.recordCommentActivity(team, types.EnhancedData{Number: 2, Comments: 1}, types.EnhancedData{Number: 2, Comments: 2, ReviewComments: 2})
+	model.activityCheckedAt = time.Now()
+	merged := pr(3, "dddd", old)
+	merged.Merged = gh.Bool(true)
+	model.recordCompletedActivity(team, []CompletedPR{{PR: merged, At: time.Now().Add(time.Second)}})
+
+	feed := model.renderActivity(time.Now())
+	for _, want := range []string{"💬 org/api#2 3 new comments", "🎉 merged", "1 new since you last looked", "seen before"} {
+		if !strings.Contains(feed, want) {
+			t.Errorf("Expected %q in the feed, got:\n%s", want, feed)
+		}
+	}
+	if strings.Index(feed, "🎉 merged") > strings.Index(feed, "seen before") {
+		t.Error("Expected the merge above the entries seen before")
+	}
+}
@@ -0,0 +1,215 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"gopkg.in/yaml.v3"
+)
+
+// startAddTab opens the new-tab form: a mode, its scope and a name, the same
+// questions `pr-compass init` asks. The tab is saved to the config file and
+// fetched right away.
+func (m *MultiTabModel) startAddTab(tab *TabState) {
+	draft := &TabConfig{ExcludeBots: true, IncludeDrafts: true}
+	m.pendingChoice = &choicePrompt{
+		label:   "New tab mode",
+		options: wizardModes,
+		onChoose: func(mode string) tea.Cmd {
+			draft.Mode = mode
+			m.askAddTabScope(tab, draft)
+			return nil
+		},
+	}
+	tab.StatusMsg = m.pendingChoice.status()
+}
+
+// askAddTabScope asks for what a new tab of the draft's mode lists
+func (m *MultiTabModel) askAddTabScope(tab *TabState, draft *TabConfig) {
+	askName := func() { m.askAddTabName(tab, draft) }
+
+	switch draft.Mode {
+	case "repos":
+		m.askAddTabField(tab, "Repositories (owner/name, comma-separated)", func(answer string) error {
+			var err error
+			if draft.Repos, err = splitFormList(answer); err != nil {
+				return err
+			}
+			for _, repo := range draft.Repos {
+				if owner, name, ok := strings.Cut(repo, "/"); !ok || owner == "" || name == "" {
+					return fmt.Errorf("%q isn't owner/name", repo)
+				}
+			}
+			return nil
+		}, askName)
+	case "organization":
+		m.askAddTabField(tab, "Organization", func(answer string) error {
+			draft.Organization = answer
+			return nil
+		}, askName)
+	case "teams":
+		m.askAddTabField(tab, "Organization", func(answer string) error {
+			draft.Organization = answer
+			return nil
+		}, func() {
+			m.askAddTabField(tab, "Team slugs (comma-separated)", func(answer string) error {
+				var err error
+				draft.Teams, err = splitFormList(answer)
+				return err
+			}, askName)
+		})
+	case "topics":
+		m.askAddTabField(tab, "Organization whose repos carry the topics", func(answer string) error {
+			draft.TopicOrg = answer
+			return nil
+		}, func() {
+			m.askAddTabField(tab, "Topics (comma-separated)", func(answer string) error {
+				var err error
+				draft.Topics, err = splitFormList(answer)
+				return err
+			}, askName)
+		})
+	case "search":
+		m.askAddTabField(tab, "Search query, e.g. org:acme is:pr is:open label:urgent", func(answer string) error {
+			draft.SearchQuery = answer
+			return nil
+		}, askName)
+	}
+}
+
+// askAddTabName asks for the new tab's name, suggesting one from its scope,
+// then asks to save it
+func (m *MultiTabModel) askAddTabName(tab *TabState, draft *TabConfig) {
+	m.askAddTabField(tab, "Tab name", func(answer string) error {
+		for _, existing := range m.TabManager.Tabs {
+			if existing.Config.Name == answer {
+				return fmt.Errorf("a tab named %q already exists", answer)
+			}
+		}
+		draft.Name = answer
+		return nil
+	}, func() {
+		m.pendingChoice = &choicePrompt{
+			label:   fmt.Sprintf("Add tab %q and save it to %s?", draft.Name, m.addTabConfigPath()),
+			options: []string{"save", "cancel"},
+			onChoose: func(choice string) tea.Cmd {
+				if choice != "save" {
+					tab.StatusMsg = "Cancelled"
+					return nil
+				}
+				return m.addTabFromForm(tab, *draft)
+			},
+		}
+	})
+	m.pendingInput.value = suggestTabName(draft)
+	tab.StatusMsg = m.pendingInput.status()
+}
+
+// askAddTabField asks for one answer, asking again while it's empty or set
+// rejects it, then moves on to next
+func (m *MultiTabModel) askAddTabField(tab *TabState, label string, set func(answer string) error, next func()) {
+	m.pendingInput = &textPrompt{
+		label: label,
+		onSubmit: func(answer string) string {
+			answer = strings.TrimSpace(answer)
+			err := fmt.Errorf("an answer is required")
+			if answer != "" {
+				err = set(answer)
+			}
+			if err != nil {
+				m.askAddTabField(tab, label, set, next)
+				m.pendingInput.value = answer
+				return fmt.Sprintf("✗ %v - %s", err, m.pendingInput.status())
+			}
+
+			next()
+			if m.pendingInput != nil {
+				return m.pendingInput.status()
+			}
+			return m.pendingChoice.status()
+		},
+	}
+	tab.StatusMsg = m.pendingInput.status()
+}
+
+// addTabFromForm saves the new tab to the config file, then opens and
+// fetches it
+func (m *MultiTabModel) addTabFromForm(tab *TabState, draft TabConfig) tea.Cmd {
+	path := m.addTabConfigPath()
+	tabConfig, err := m.saveTabToConfig(path, draft)
+	if err != nil {
+		tab.StatusMsg = fmt.Sprintf("Tab not added: %v", err)
+		return nil
+	}
+
+	added := m.TabManager.AddTab(&tabConfig)
+	if m.layoutBucket != "" {
+		m.applyLayoutToTab(added)
+	}
+	m.TabManager.SwitchToTab(len(m.TabManager.Tabs) - 1)
+	added.StatusMsg = fmt.Sprintf("Added tab %q and saved it to %s", tabConfig.Name, path)
+	return tea.Batch(m.fetchPRsForTab(added), m.refreshCmdForTab(added), m.spinnerTickCmd())
+}
+
+// saveTabToConfig appends a tab to the config file at path once the result
+// is known to load, returning the tab as loaded with its defaults filled in
+func (m *MultiTabModel) saveTabToConfig(path string, draft TabConfig) (TabConfig, error) {
+	multiConfig, err := m.writeConfigTabs(path, func(tabs *yaml.Node) error {
+		var node yaml.Node
+		if err := node.Encode(draft); err != nil {
+			return fmt.Errorf("failed to encode tab: %w", err)
+		}
+		tabs.Content = append(tabs.Content, &node)
+		tabs.Style = 0 // A `tabs: []` list grows into block style
+		return nil
+	})
+	if err != nil {
+		return draft, err
+	}
+
+	for _, loaded := range multiConfig.Tabs {
+		if loaded.Name == draft.Name {
+			return loaded, nil
+		}
+	}
+	return draft, nil
+}
+
+// addTabConfigPath returns the config file new tabs are saved to
+func (m *MultiTabModel) addTabConfigPath() string {
+	if m.configPath != "" {
+		return m.configPath
+	}
+	return getConfigFilePath()
+}
+
+// suggestTabName names a tab after the first thing it lists, as init does
+func suggestTabName(draft *TabConfig) string {
+	switch {
+	case len(draft.Repos) > 0:
+		_, name, _ := strings.Cut(draft.Repos[0], "/")
+		return name
+	case len(draft.Teams) > 0:
+		return draft.Teams[0]
+	case len(draft.Topics) > 0:
+		return draft.Topics[0]
+	case draft.Organization != "":
+		return draft.Organization
+	}
+	return "Search"
+}
+
+// splitFormList splits a comma-separated answer, dropping empty values
+func splitFormList(answer string) ([]string, error) {
+	var values []string
+	for _, value := range strings.Split(answer, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("an answer is required")
+	}
+	return values, nil
+}
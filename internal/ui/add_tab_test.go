@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestAddTabForm tests adding a tab with ctrl+t and saving it to the config
+func TestAddTabForm(t *testing.T) {
+	model, main := presetTestModel(t)
+	model.configPath = filepath.Join(t.TempDir(), "config.yaml")
+	legacyYAML := "# My setup\nmode: repos\nrepos: [org/api]\n"
+	if err := os.WriteFile(model.configPath, []byte(legacyYAML), 0600); err != nil {
+		t.Fatal(err)
+	}
+	typeText := func(text string) {
+		model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)})
+		model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	if model.pendingChoice == nil || !strings.Contains(main.StatusMsg, "New tab mode") {
+		t.Fatalf("Expected the mode picker, got %q", main.StatusMsg)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+
+	typeText("api")
+	if !strings.Contains(main.StatusMsg, `"api" isn't owner/name`) {
+		t.Fatalf("Expected the repo to be rejected, got %q", main.StatusMsg)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlU})
+	typeText("org/web, org/docs")
+	if model.pendingInput == nil || model.pendingInput.value != "web" {
+		t.Fatalf("Expected a name prompt suggesting web, got %q", main.StatusMsg)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlU})
+	typeText("Main")
+	if !strings.Contains(main.StatusMsg, "already exists") {
+		t.Fatalf("Expected a duplicate name to be rejected, got %q", main.StatusMsg)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlU})
+	typeText("Web")
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	if cmd == nil {
+		t.Fatal("Expected the new tab to be fetched")
+	}
+
+	added := model.TabManager.GetActiveTab()
+	if len(model.TabManager.Tabs) != 2 || added.Config.Name != "Web" || len(added.Config.Repos) != 2 {
+		t.Fatalf("Expected the Web tab to be added and active, got %d tabs", len(model.TabManager.Tabs))
+	}
+	if added.Config.MaxPRs != 50 {
+		t.Errorf("Expected the tab's defaults from the config file, got max_prs %d", added.Config.MaxPRs)
+	}
+
+	multiConfig, err := LoadMultiTabConfigFromPath(model.configPath)
+	if err != nil {
+		t.Fatalf("Expected the saved config to load: %v", err)
+	}
+	if len(multiConfig.Tabs) != 2 || multiConfig.Tabs[0].Name != "Main" || multiConfig.Tabs[1].Name != "Web" {
+		t.Errorf("Expected Main and Web tabs in the file, got %+v", multiConfig.Tabs)
+	}
+	data, _ := os.ReadFile(model.configPath)
+	if !strings.HasPrefix(string(data), "# My setup") {
+		t.Errorf("Expected comments kept, got:\n%s", data)
+	}
+	if string(data) != string(model.configWritten) {
+		t.Error("Expected the app's own write to skip the next reload")
+	}
+}
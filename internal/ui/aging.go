@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	"github.com/charmbracelet/lipgloss"
+	gh "github.com/google/go-github/v55/github"
+)
+
+const (
+	// defaultWarnDays and defaultStaleDays are the days without updates
+	// before a PR's row turns yellow, then red, when the config doesn't say
+	defaultWarnDays  = 3
+	defaultStaleDays = 5
+
+	// agingWarnMarker and agingStaleMarker lead the PR cell of aging rows.
+	// They are zero width, so the table truncates cells as usual, and are
+	// swapped for row colors once the table is rendered, since cells can't
+	// carry ANSI codes.
+	agingWarnMarker  = "\u200b"
+	agingStaleMarker = "\u200c"
+)
+
+// validateAging checks the configured aging thresholds; unset ones use the defaults
+func validateAging(warnDays, staleDays int) error {
+	if warnDays < 0 || staleDays < 0 {
+		return fmt.Errorf("warn_days and stale_days must not be negative")
+	}
+	warnDays, staleDays = agingDefaults(warnDays, staleDays)
+	if warnDays >= staleDays {
+		return fmt.Errorf("warn_days (%d) must be less than stale_days (%d)", warnDays, staleDays)
+	}
+	return nil
+}
+
+// agingDefaults fills in unset aging thresholds
+func agingDefaults(warnDays, staleDays int) (int, int) {
+	if warnDays == 0 {
+		warnDays = defaultWarnDays
+	}
+	if staleDays == 0 {
+		staleDays = defaultStaleDays
+	}
+	return warnDays, staleDays
+}
+
+// agingDays returns the tab's days without updates before its rows turn
+// yellow, then red
+func (tc *TabConfig) agingDays() (warnDays, staleDays int) {
+	return agingDefaults(tc.WarnDays, tc.StaleDays)
+}
+
+// agingMarker returns the marker for a PR's row color, or "" while it is fresh
+func agingMarker(pr *gh.PullRequest, warnDays, staleDays int, now time.Time) string {
+	switch {
+	case services.NotUpdatedFor(pr, staleDays, now):
+		return agingStaleMarker
+	case services.NotUpdatedFor(pr, warnDays, now):
+		return agingWarnMarker
+	}
+	return ""
+}
+
+// colorAgedRows swaps the aging markers in a rendered table for row colors,
+// and the history marker for dimmed italics. The selected row keeps its
+// highlight.
+func colorAgedRows(tableView string) string {
+	if !strings.Contains(tableView, agingWarnMarker) && !strings.Contains(tableView, agingStaleMarker) && !strings.Contains(tableView, historyMarker) {
+		return tableView
+	}
+	warn := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Warning))
+	stale := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Error))
+	history := lipgloss.NewStyle().Foreground(lipgloss.Color(colors.TextMuted)).Faint(true).Italic(true)
+
+	lines := strings.Split(tableView, "\n")
+	for i, line := range lines {
+		style := warn
+		switch {
+		case strings.Contains(line, historyMarker):
+			style = history
+			line = strings.ReplaceAll(line, historyMarker, "")
+		case strings.Contains(line, agingStaleMarker):
+			style = stale
+			line = strings.ReplaceAll(line, agingStaleMarker, "")
+		case strings.Contains(line, agingWarnMarker):
+			line = strings.ReplaceAll(line, agingWarnMarker, "")
+		default:
+			continue
+		}
+		// Unselected rows are unstyled; ANSI codes mean the selection highlight
+		if !strings.Contains(line, "\x1b[") {
+			line = style.Render(line)
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestAgingConfig tests that tabs inherit the global aging thresholds and
+// that inverted thresholds are rejected
+func TestAgingConfig(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	configYAML := `warn_days: 7
+stale_days: 14
+tabs:
+  - name: Product
+    mode: repos
+    repos: [org/app]
+  - name: Hotfixes
+    mode: repos
+    repos: [org/api]
+    warn_days: 1
+    stale_days: 2
+`
+	if err := os.WriteFile(configPath, []byte(configYAML), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	multiConfig, err := LoadMultiTabConfigFromPath(configPath)
+	if err != nil {
+		t.Fatalf("LoadMultiTabConfigFromPath() error = %v", err)
+	}
+	if warn, stale := multiConfig.Tabs[0].agingDays(); warn != 7 || stale != 14 {
+		t.Errorf("Expected the global thresholds inherited, got %d/%d", warn, stale)
+	}
+	if warn, stale := multiConfig.Tabs[1].agingDays(); warn != 1 || stale != 2 {
+		t.Errorf("Expected the tab's own thresholds, got %d/%d", warn, stale)
+	}
+	if warn, stale := (&TabConfig{}).agingDays(); warn != defaultWarnDays || stale != defaultStaleDays {
+		t.Errorf("Expected the defaults when unset, got %d/%d", warn, stale)
+	}
+
+	// warn_days past the default stale_days
+	if err := os.WriteFile(configPath, []byte("warn_days: 6\nmode: repos\nrepos: [org/app]\n"), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if _, err := LoadMultiTabConfigFromPath(configPath); err == nil || !strings.Contains(err.Error(), "less than stale_days") {
+		t.Errorf("Expected inverted thresholds to be rejected, got %v", err)
+	}
+}
+
+// TestAgedRows tests marking rows by last update, coloring them once
+// rendered, and the S stale filter
+func TestAgedRows(t *testing.T) {
+	now := time.Now()
+	prAt := func(number int, daysAgo int) *gh.PullRequest {
+		return &gh.PullRequest{
+			Number:    gh.Int(number),
+			Title:     gh.String("Change"),
+			UpdatedAt: &gh.Timestamp{Time: now.Add(-time.Duration(daysAgo) * 24 * time.Hour)},
+			Base:      &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/api")}},
+		}
+	}
+	prs := []*gh.PullRequest{prAt(1, 0), prAt(2, 4), prAt(3, 9)}
+
+	rows := createTableRowsWithOptions(prs, nil, tableRowOptions{WarnDays: 3, StaleDays: 7})
+	for i, want := range []string{"", agingWarnMarker, agingStaleMarker} {
+		if got := agingMarker(prs[i], 3, 7, now); got != want {
+			t.Errorf("PR #%d: expected marker %q, got %q", i+1, want, got)
+		}
+		if want != "" && !strings.HasPrefix(rows[i][0], want) {
+			t.Errorf("PR #%d: expected the PR cell to lead with its marker, got %q", i+1, rows[i][0])
+		}
+	}
+	if reviews := rows[2][5]; !strings.Contains(reviews, "Stale") {
+		t.Errorf("Expected the stale PR's review column to say so, got %q", reviews)
+	}
+	if reviews := rows[1][5]; strings.Contains(reviews, "Stale") {
+		t.Errorf("Expected a PR under stale_days not to be stale, got %q", reviews)
+	}
+
+	view := colorAgedRows("header\n" + agingWarnMarker + "#2 Change\n" + agingStaleMarker + "#3 Change")
+	if strings.Contains(view, agingWarnMarker) || strings.Contains(view, agingStaleMarker) || !strings.Contains(view, "#3 Change") {
+		t.Errorf("Expected the markers swapped for colors, got %q", view)
+	}
+
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Main", Mode: "repos", Repos: []string{"org/api"}, StaleDays: 7})
+	tab.PRs = prs
+	tab.FilteredPRs = prs
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+	if len(tab.FilteredPRs) != 1 || tab.FilteredPRs[0].GetNumber() != 3 || !strings.Contains(tab.StatusMsg, "Not updated in 7+ days (1)") {
+		t.Fatalf("Expected only the stale PR, got %d PRs and %q", len(tab.FilteredPRs), tab.StatusMsg)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+	if len(tab.FilteredPRs) != 3 || tab.FilterMode != "" {
+		t.Errorf("Expected S again to clear the filter, got %d PRs", len(tab.FilteredPRs))
+	}
+}
@@ -0,0 +1,138 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/bjess9/pr-compass/internal/slack"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// alertWatchedPR is the terminal alert event for a PR opened in a watched
+// repo; the others are alertPinned and the PR events Slack posts
+const alertWatchedPR = "watched_pr"
+
+// alertEvents lists every event terminal alerts can take
+var alertEvents = append(slices.Clone(slack.Events), alertWatchedPR, alertPinned)
+
+// appTitle is the terminal title while no alerts are pending
+const appTitle = "PR Compass"
+
+// bellOutput receives the bell character: the terminal the UI draws on
+var bellOutput io.Writer = os.Stdout
+
+// AlertConfig raises alerts in the terminal itself, for passive awareness
+// when PR Compass runs in a background tmux pane or terminal tab
+type AlertConfig struct {
+	// Ring the terminal bell; tmux flags the window, terminals may flash or beep
+	Bell bool `mapstructure:"bell" yaml:"bell,omitempty"`
+
+	// Count pending alerts in the terminal title until the next key press
+	Title bool `mapstructure:"title" yaml:"title,omitempty"`
+
+	// Events to alert on; unset alerts on all of them
+	Events []string `mapstructure:"events" yaml:"events,omitempty"`
+}
+
+// Validate checks the event names
+func (c *AlertConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	for _, event := range c.Events {
+		if !slices.Contains(alertEvents, event) {
+			return fmt.Errorf("alerts.events: unknown event %q, expected one of %s", event, strings.Join(alertEvents, ", "))
+		}
+	}
+	return nil
+}
+
+// Wants reports whether an event raises an alert
+func (c *AlertConfig) Wants(kind string) bool {
+	if c == nil || (!c.Bell && !c.Title) {
+		return false
+	}
+	return len(c.Events) == 0 || slices.Contains(c.Events, kind)
+}
+
+// alertCmd alerts on PR events, naming the latest in the title
+func (m *MultiTabModel) alertCmd(events []slack.Event) tea.Cmd {
+	if len(events) == 0 {
+		return nil
+	}
+	return m.alert(len(events), alertSummary(events[len(events)-1]))
+}
+
+// watchAlertCmd alerts on PRs newly opened in watched repos
+func (m *MultiTabModel) watchAlertCmd(opened []*gh.PullRequest) tea.Cmd {
+	if !m.Alerts.Wants(alertWatchedPR) {
+		return nil
+	}
+	return m.alert(len(opened), "👁 New PR "+services.PRKey(opened[len(opened)-1]))
+}
+
+// alert counts new alerts, then rings the bell and shows the count and the
+// latest alert in the terminal title, as configured
+func (m *MultiTabModel) alert(count int, summary string) tea.Cmd {
+	m.unseenAlerts += count
+	m.Log.Debug("Alert", "alert", summary, "pending", m.unseenAlerts)
+
+	var cmds []tea.Cmd
+	if m.Alerts.Bell {
+		cmds = append(cmds, bellCmd())
+	}
+	if m.Alerts.Title {
+		cmds = append(cmds, tea.SetWindowTitle(alertTitle(m.unseenAlerts, summary)))
+	}
+	return tea.Batch(cmds...)
+}
+
+// acknowledgeAlertsCmd clears pending alerts once the user is back, restoring
+// the terminal title
+func (m *MultiTabModel) acknowledgeAlertsCmd() tea.Cmd {
+	if m.unseenAlerts == 0 {
+		return nil
+	}
+	m.unseenAlerts = 0
+	if m.Alerts == nil || !m.Alerts.Title {
+		return nil
+	}
+	return tea.SetWindowTitle(appTitle)
+}
+
+// alertSummary describes a PR event in a few words, e.g.
+// "✅ org/api#12 approved"
+func alertSummary(event slack.Event) string {
+	switch event.Kind {
+	case slack.EventApproved:
+		return theme.Passed + " " + event.PR + " approved"
+	case slack.EventChecksFailed:
+		return theme.Failed + " " + event.PR + " checks failed"
+	case slack.EventReviewRequested:
+		return "👀 " + event.PR + " needs your review"
+	}
+	return event.PR
+}
+
+// alertTitle is the terminal title with pending alerts, e.g.
+// "(2) PR Compass: ✅ org/api#12 approved"
+func alertTitle(pending int, latest string) string {
+	if pending == 0 {
+		return appTitle
+	}
+	return fmt.Sprintf("(%d) %s: %s", pending, appTitle, latest)
+}
+
+// bellCmd rings the terminal bell. The bell character moves no cursor, so it
+// doesn't disturb the rendered view.
+func bellCmd() tea.Cmd {
+	return func() tea.Msg {
+		_, _ = io.WriteString(bellOutput, "\a")
+		return nil
+	}
+}
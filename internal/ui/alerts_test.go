@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/slack"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestTerminalAlerts tests ringing the bell and counting pending alerts in
+// the terminal title until the next key press
+func TestTerminalAlerts(t *testing.T) {
+	var bell bytes.Buffer
+	terminal := bellOutput
+	bellOutput = &bell
+	t.Cleanup(func() { bellOutput = terminal })
+
+	model := NewMultiTabModel("test-token", nil)
+	model.Alerts = &AlertConfig{Bell: true, Title: true, Events: []string{slack.EventChecksFailed, alertWatchedPR}}
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"org/api"}})
+
+	failed := slack.Event{Kind: slack.EventChecksFailed, PR: "org/api#1"}
+	approved := slack.Event{Kind: slack.EventApproved, PR: "org/api#2"}
+	runSlackCmd(t, model.eventsCmd(tab, []slack.Event{approved, failed}))
+	if bell.String() != "\a" || model.unseenAlerts != 1 {
+		t.Errorf("Expected one bell for the check failure only, got %q and %d pending", bell.String(), model.unseenAlerts)
+	}
+	if cmd := model.watchAlertCmd([]*gh.PullRequest{labeledPR(3), labeledPR(4)}); cmd == nil || model.unseenAlerts != 3 {
+		t.Errorf("Expected the watched PRs to add alerts, got %d pending", model.unseenAlerts)
+	}
+	if title := alertTitle(model.unseenAlerts, alertSummary(failed)); title != "(3) PR Compass: "+theme.Failed+" org/api#1 checks failed" {
+		t.Errorf("Unexpected title %q", title)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if model.unseenAlerts != 0 {
+		t.Errorf("Expected a key press to clear pending alerts, got %d", model.unseenAlerts)
+	}
+
+	model.Alerts = nil
+	if cmd := model.watchAlertCmd([]*gh.PullRequest{labeledPR(5)}); cmd != nil {
+		t.Error("Expected no alerts without configuring them")
+	}
+	if err := (&AlertConfig{Bell: true, Events: []string{"merged"}}).Validate(); err == nil || !strings.Contains(err.Error(), "watched_pr") {
+		t.Errorf("Expected unknown events to be rejected, got %v", err)
+	}
+}
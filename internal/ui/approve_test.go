@@ -0,0 +1,150 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+func approveTestModel(token string) (*MultiTabModel, *TabState) {
+	model := NewMultiTabModel(token, nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Main", Mode: "repos", Repos: []string{"org/api"}})
+	tab.PRs = []*gh.PullRequest{{
+		Number: gh.Int(12),
+		Title:  gh.String("Add retries"),
+		User:   &gh.User{Login: gh.String("alice")},
+		Base:   &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/api")}},
+	}}
+	tab.FilteredPRs = tab.PRs
+	tab.Loaded = true
+	tab.EnhancedData[12] = types.EnhancedData{Number: 12, ReviewStatus: "pending", EnhancedAt: time.Now().Add(-time.Minute)}
+	model.updateTableRows(tab)
+	return model, tab
+}
+
+const reviewColumn = 5
+
+// TestHotkeyApprove tests approving from the review form and the optimistic
+// Review column
+func TestHotkeyApprove(t *testing.T) {
+	model, tab := approveTestModel("test-token")
+	press := func(key string) tea.Cmd {
+		_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		return cmd
+	}
+
+	press("A")
+	if model.reviewForm == nil || !strings.Contains(tab.StatusMsg, "org/api#12") {
+		t.Fatalf("Expected the review form naming the PR, got %q", tab.StatusMsg)
+	}
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc}); cmd != nil || model.reviewForm != nil || len(model.TabManager.Reviews) != 0 {
+		t.Fatal("Expected discarding to leave the PR unreviewed")
+	}
+
+	press("A")
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlS}); cmd == nil {
+		t.Fatal("Expected ctrl+s to submit the approval")
+	}
+	if got := tab.Table.Rows()[0][reviewColumn]; got != "✅ Approved" {
+		t.Errorf("Expected optimistic approval in Review column, got %q", got)
+	}
+
+	// Fresh enhancement data takes over from the optimistic state
+	tab.EnhancedData[12] = types.EnhancedData{Number: 12, ReviewStatus: "changes_requested", EnhancedAt: time.Now().Add(time.Second)}
+	model.updateTableRows(tab)
+	if got := tab.Table.Rows()[0][reviewColumn]; got != "🔄 Changes" {
+		t.Errorf("Expected newer enhancement data to win, got %q", got)
+	}
+}
+
+// TestReviewForm tests choosing a verdict and the body GitHub requires for it
+func TestReviewForm(t *testing.T) {
+	model, tab := approveTestModel("test-token")
+	model.Width, model.Height = 120, 40
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("A")})
+	model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if view := model.View(); !strings.Contains(view, "[🔄 Request changes]") {
+		t.Error("Expected tab to choose requesting changes")
+	}
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlS}); cmd != nil || model.reviewForm == nil || !strings.Contains(tab.StatusMsg, "needs a comment") {
+		t.Fatalf("Expected a body to be required, got %q", tab.StatusMsg)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Needs a test")})
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlS}); cmd == nil || model.reviewForm != nil {
+		t.Fatal("Expected the review to be submitted")
+	}
+	if got := tab.Table.Rows()[0][reviewColumn]; got != "🔄 Changes" {
+		t.Errorf("Expected optimistic changes requested in Review column, got %q", got)
+	}
+
+	if _, cmd := model.Update(reviewResultMsg{tabName: "Main", key: "org/api#12", event: reviewRequestChanges}); cmd == nil || tab.StatusMsg != "🔄 Requested changes on org/api#12" {
+		t.Errorf("Expected the review reported and the PR re-enhanced, got %q", tab.StatusMsg)
+	}
+
+	// A comment-only review doesn't change the verdict shown
+	delete(model.TabManager.Reviews, "org/api#12")
+	model.refreshAllRows()
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("A")})
+	model.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Nice")})
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if got := tab.Table.Rows()[0][reviewColumn]; got != "⏳ Pending" {
+		t.Errorf("Expected a comment review to keep the Review column, got %q", got)
+	}
+}
+
+// TestApproveFailureReverts tests that a rejected approval restores the Review column
+func TestApproveFailureReverts(t *testing.T) {
+	model, tab := approveTestModel("test-token")
+
+	model.openReviewForm(tab)
+	model.handleReviewKey(tab, tea.KeyMsg{Type: tea.KeyCtrlS})
+
+	model.Update(reviewResultMsg{tabName: "Main", key: "org/api#12", event: reviewApprove, err: errors.New("can not approve your own pull request")})
+	if got := tab.Table.Rows()[0][reviewColumn]; got != "⏳ Pending" {
+		t.Errorf("Expected Review column reverted, got %q", got)
+	}
+	if !strings.Contains(tab.StatusMsg, "own pull request") {
+		t.Errorf("Expected failure reason in status, got %q", tab.StatusMsg)
+	}
+}
+
+// TestApproveReadOnly tests that approving is blocked without a token
+func TestApproveReadOnly(t *testing.T) {
+	model, tab := approveTestModel("")
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("A")})
+	if model.reviewForm != nil || tab.StatusMsg != readOnlyActionMsg {
+		t.Errorf("Expected read-only notice, got %q", tab.StatusMsg)
+	}
+}
+
+// TestActionsOnGitLabTab tests that GitHub-only actions and details are off for GitLab tabs
+func TestActionsOnGitLabTab(t *testing.T) {
+	model, tab := approveTestModel("test-token")
+	tab.Config.Provider = "gitlab"
+
+	for _, key := range []string{"A", "M", "C", "R", "u"} {
+		tab.StatusMsg = ""
+		if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}); cmd != nil || model.promptOpen() {
+			t.Errorf("Expected %s to do nothing on a GitLab tab", key)
+		}
+		if tab.StatusMsg == "" {
+			t.Errorf("Expected %s to explain why it is unavailable", key)
+		}
+	}
+
+	if cmd := model.startEnhancementForTab(tab); cmd != nil {
+		t.Error("Expected no enhancement for GitLab merge requests")
+	}
+	if cmd := model.prDetailsCmd(tab); cmd != nil || model.prDetailsErrors["org/api#12"] != errGitHubOnly {
+		t.Error("Expected details to be marked GitHub-only instead of fetched")
+	}
+}
@@ -0,0 +1,143 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// assignResultMsg reports the outcome of assigning or unassigning the user
+type assignResultMsg struct {
+	tabName   string
+	key       string // services.PRKey of the PR
+	assigned  bool   // The state asked for
+	assignees []*gh.User
+	err       error
+}
+
+// withAssigneeColumn appends the Assignees column, taking its width from the
+// PR title column as far as its minimum allows
+func withAssigneeColumn(columns []table.Column, terminalWidth int) []table.Column {
+	width := max(10, (terminalWidth-12)*9/100)
+
+	result := make([]table.Column, len(columns), len(columns)+1)
+	copy(result, columns)
+	result[0].Width = max(24, result[0].Width-width-2) // Cell padding too
+	return append(result, table.Column{Title: "👷 Assignees", Width: width})
+}
+
+// assigneeCell lists a PR's assignees for the table
+func assigneeCell(pr *gh.PullRequest) string {
+	logins := assigneeLogins(pr)
+	if len(logins) == 0 {
+		return "-"
+	}
+	return strings.Join(logins, ", ")
+}
+
+// assigneeLogins returns the logins of a PR's assignees
+func assigneeLogins(pr *gh.PullRequest) []string {
+	var logins []string
+	for _, user := range pr.Assignees {
+		if login := user.GetLogin(); login != "" {
+			logins = append(logins, login)
+		}
+	}
+	return logins
+}
+
+// toggleSelfAssign asks whether to assign the current user to the selected
+// PR, or unassign them, looking up who they are first if needed
+func (m *MultiTabModel) toggleSelfAssign(tab *TabState) tea.Cmd {
+	if m.writeUnavailable(tab) {
+		return nil
+	}
+	if tab.SelectedPR() == nil {
+		tab.StatusMsg = "No PR selected"
+		return nil
+	}
+	if m.viewerLogin != "" {
+		m.confirmSelfAssign(tab)
+		return nil
+	}
+
+	tab.StatusMsg = "Looking up your login..."
+	lookup := m.viewerLoginCmd(tab.Config.Name)
+	return func() tea.Msg {
+		msg := lookup().(viewerLoginMsg)
+		msg.assign = true
+		return msg
+	}
+}
+
+// confirmSelfAssign asks before assigning the current user to the selected
+// PR, or unassigning them when they already are
+func (m *MultiTabModel) confirmSelfAssign(tab *TabState) {
+	pr := tab.SelectedPR()
+	if pr == nil {
+		tab.StatusMsg = "No PR selected"
+		return
+	}
+
+	key := services.PRKey(pr)
+	assigned := true
+	prompt := fmt.Sprintf("Assign yourself (%s) to %s?", m.viewerLogin, key)
+	for _, login := range assigneeLogins(pr) {
+		if strings.EqualFold(login, m.viewerLogin) {
+			assigned = false
+			prompt = fmt.Sprintf("Unassign yourself (%s) from %s?", m.viewerLogin, key)
+		}
+	}
+	m.pendingConfirm = &confirmPrompt{
+		prompt:    prompt,
+		onConfirm: m.assignCmd(tab.Config.Name, pr, m.viewerLogin, assigned),
+	}
+	tab.StatusMsg = m.pendingConfirm.prompt + " (y/n)"
+}
+
+// assignCmd assigns login to one PR, or unassigns them
+func (m *MultiTabModel) assignCmd(tabName string, pr *gh.PullRequest, login string, assigned bool) tea.Cmd {
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 10*time.Second)
+		defer cancel()
+
+		assignees, err := github.SetAssigned(ctx, token, pr, login, assigned)
+		return assignResultMsg{tabName: tabName, key: services.PRKey(pr), assigned: assigned, assignees: assignees, err: err}
+	}
+}
+
+// handleAssignResult reports the change and copies the PR's new assignees
+// into every tab listing it
+func (m *MultiTabModel) handleAssignResult(msg assignResultMsg) (tea.Model, tea.Cmd) {
+	status := fmt.Sprintf("👷 Assigned you to %s", msg.key)
+	if !msg.assigned {
+		status = fmt.Sprintf("Unassigned you from %s", msg.key)
+	}
+	if msg.err != nil {
+		status = fmt.Sprintf("Changing the assignees of %s failed: %v", msg.key, msg.err)
+	} else {
+		for _, tab := range m.TabManager.Tabs {
+			for _, pr := range tab.PRs {
+				if services.PRKey(pr) == msg.key {
+					pr.Assignees = msg.assignees
+				}
+			}
+		}
+		m.refreshAllRows()
+	}
+
+	for _, tab := range m.TabManager.Tabs {
+		if tab.Config.Name == msg.tabName {
+			tab.StatusMsg = status
+		}
+	}
+	return m, nil
+}
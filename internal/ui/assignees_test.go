@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestAssigneeColumn tests the optional Assignees column and its layout key
+func TestAssigneeColumn(t *testing.T) {
+	model, tab := detailTestModel("test-token")
+	if tab.showsColumn(assigneeColumnKey) || len(tab.Table.Rows()[0]) != len(columnKeys) {
+		t.Fatal("Expected no Assignees column by default")
+	}
+
+	tab.Config.AssigneeColumn = true
+	tab.PRs[0].Assignees = []*gh.User{{Login: gh.String("bob")}, {Login: gh.String("alice")}}
+	model.updateTableRows(tab)
+	row := tab.Table.Rows()[0]
+	if len(row) != len(columnKeys)+1 || row[len(row)-1] != "bob, alice" {
+		t.Errorf("Expected the assignees in the last cell, got %q", row)
+	}
+	if columns := tab.Table.Columns(); columns[len(columns)-1].Title != "👷 Assignees" {
+		t.Errorf("Expected an Assignees column, got %+v", columns)
+	}
+
+	model.layout = LayoutConfig{HiddenColumns: []string{assigneeColumnKey}}
+	model.applyLayoutToTab(tab)
+	if columns := tab.Table.Columns(); columns[len(columns)-1].Width != 0 || columns[len(columns)-2].Width == 0 {
+		t.Errorf("Expected only the Assignees column hidden, got %+v", columns)
+	}
+}
+
+// TestSelfAssign tests assigning and unassigning the current user
+func TestSelfAssign(t *testing.T) {
+	model, tab := detailTestModel("test-token")
+	pr := tab.PRs[0]
+
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("I")}); cmd == nil || tab.StatusMsg != "Looking up your login..." {
+		t.Fatalf("Expected the login to be looked up first, got %q", tab.StatusMsg)
+	}
+	model.Update(viewerLoginMsg{tabName: "Main", assign: true, login: "alice"})
+	if tab.StatusMsg != "Assign yourself (alice) to org/api#12? (y/n)" {
+		t.Fatalf("Expected to be asked to assign alice, got %q", tab.StatusMsg)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+
+	model.Update(assignResultMsg{tabName: "Main", key: "org/api#12", assigned: true, err: errors.New("forbidden")})
+	if len(pr.Assignees) != 0 || !strings.Contains(tab.StatusMsg, "failed: forbidden") {
+		t.Errorf("Expected a failed assignment to leave the PR alone, got %q", tab.StatusMsg)
+	}
+	model.Update(assignResultMsg{tabName: "Main", key: "org/api#12", assigned: true, assignees: []*gh.User{{Login: gh.String("alice")}}})
+	if len(pr.Assignees) != 1 || tab.StatusMsg != "👷 Assigned you to org/api#12" {
+		t.Errorf("Expected alice assigned, got %q", tab.StatusMsg)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("I")})
+	if tab.StatusMsg != "Unassign yourself (alice) from org/api#12? (y/n)" {
+		t.Errorf("Expected to be asked to unassign alice, got %q", tab.StatusMsg)
+	}
+
+	// Read-only sessions can't assign
+	readOnly, _ := detailTestModel("")
+	if _, cmd := readOnly.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("I")}); cmd != nil || readOnly.pendingConfirm != nil {
+		t.Error("Expected no assignment without a token")
+	}
+}
@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// authorProfileMsg delivers a PR author's profile fetched for the info popup
+type authorProfileMsg struct {
+	login   string
+	profile *cache.UserProfile
+	err     error
+}
+
+// authorProfileCmd fetches the selected PR author's profile unless it is
+// already known or being fetched
+func (m *MultiTabModel) authorProfileCmd(tab *TabState) tea.Cmd {
+	login := tab.SelectedPR().GetUser().GetLogin()
+	if login == "" {
+		return nil
+	}
+	if _, known := m.authorProfiles[login]; known {
+		return nil
+	}
+	if m.authorProfilesLoading[login] {
+		return nil
+	}
+	m.authorProfilesLoading[login] = true
+
+	token := m.TabManager.Token
+	prCache := tab.PRCache
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 10*time.Second)
+		defer cancel()
+
+		profile, err := github.FetchUserProfile(ctx, token, login, prCache)
+		return authorProfileMsg{login: login, profile: profile, err: err}
+	}
+}
+
+// handleAuthorProfile stores a fetched author profile for the popup
+func (m *MultiTabModel) handleAuthorProfile(msg authorProfileMsg) (tea.Model, tea.Cmd) {
+	delete(m.authorProfilesLoading, msg.login)
+	if msg.err != nil {
+		m.authorProfileErrors[msg.login] = msg.err
+		return m, nil
+	}
+	delete(m.authorProfileErrors, msg.login)
+	m.authorProfiles[msg.login] = msg.profile
+	return m, nil
+}
+
+// authorInfoLines formats who the author is and, when their time zone is
+// configured, their local time - so reviewers know whether pinging them now
+// is reasonable
+func (m *MultiTabModel) authorInfoLines(login string, now time.Time) []string {
+	if login == "" {
+		return nil
+	}
+
+	author := "👤 " + login
+	if profile := m.authorProfiles[login]; profile != nil && profile.Name != "" {
+		author = fmt.Sprintf("👤 %s (%s)", login, profile.Name)
+	}
+	lines := []string{author}
+
+	if profile := m.authorProfiles[login]; profile != nil && profile.Location != "" {
+		lines = append(lines, "📍 "+profile.Location)
+	} else if m.authorProfileErrors[login] != nil {
+		lines = append(lines, mutedStyle.Render("Profile unavailable: "+m.authorProfileErrors[login].Error()))
+	}
+
+	if zone := m.AuthorTimezones[login]; zone != "" {
+		location, err := time.LoadLocation(zone)
+		if err != nil {
+			lines = append(lines, fmt.Sprintf("🕐 Unknown time zone %q in author_timezones", zone))
+		} else {
+			local := now.In(location)
+			lines = append(lines, fmt.Sprintf("🕐 %s local time (%s)%s", local.Format("Mon 15:04"), zone, offHoursHint(local)))
+		}
+	}
+	return lines
+}
+
+// offHoursHint flags local times outside a typical working day
+func offHoursHint(local time.Time) string {
+	switch {
+	case local.Weekday() == time.Saturday || local.Weekday() == time.Sunday:
+		return " · weekend"
+	case local.Hour() < 8 || local.Hour() >= 19:
+		return " · outside working hours"
+	default:
+		return ""
+	}
+}
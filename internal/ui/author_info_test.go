@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+)
+
+// TestAuthorInfoLines tests the author section of the info popup, including configured local time
+func TestAuthorInfoLines(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	model.AuthorTimezones = map[string]string{"alice": "Asia/Tokyo", "bob": "Not/AZone"}
+
+	// Wednesday 10:00 UTC is 19:00 in Tokyo
+	now := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+
+	model.Update(authorProfileMsg{login: "alice", profile: &cache.UserProfile{Login: "alice", Name: "Alice", Location: "Tokyo"}})
+	got := strings.Join(model.authorInfoLines("alice", now), "\n")
+	for _, want := range []string{"👤 alice (Alice)", "📍 Tokyo", "Wed 19:00 local time (Asia/Tokyo) · outside working hours"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in author info:\n%s", want, got)
+		}
+	}
+
+	// Invalid configured zones are reported rather than silently ignored
+	if got := strings.Join(model.authorInfoLines("bob", now), "\n"); !strings.Contains(got, `Unknown time zone "Not/AZone"`) {
+		t.Errorf("expected unknown time zone hint, got:\n%s", got)
+	}
+
+	// Profile errors are shown; without a configured zone no time is shown
+	model.Update(authorProfileMsg{login: "carol", err: errors.New("not found")})
+	got = strings.Join(model.authorInfoLines("carol", now), "\n")
+	if !strings.Contains(got, "Profile unavailable: not found") || strings.Contains(got, "local time") {
+		t.Errorf("unexpected author info for carol:\n%s", got)
+	}
+}
+
+// TestOffHoursHint tests working-hours classification of an author's local time
+func TestOffHoursHint(t *testing.T) {
+	tests := map[time.Time]string{
+		time.Date(2026, 3, 4, 11, 0, 0, 0, time.UTC): "",
+		time.Date(2026, 3, 4, 6, 30, 0, 0, time.UTC): " · outside working hours",
+		time.Date(2026, 3, 7, 11, 0, 0, 0, time.UTC): " · weekend",
+	}
+	for local, want := range tests {
+		if got := offHoursHint(local); got != want {
+			t.Errorf("offHoursHint(%v) = %q, want %q", local, got, want)
+		}
+	}
+}
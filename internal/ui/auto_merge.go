@@ -0,0 +1,108 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// autoMergeMarker leads the Status column of PRs with auto-merge armed
+const autoMergeMarker = "⏩"
+
+// autoMergeResultMsg reports the outcome of arming or disarming auto-merge
+type autoMergeResultMsg struct {
+	tabName string
+	key     string // services.PRKey of the PR
+	method  string // "" when disabling
+	err     error
+}
+
+// toggleAutoMerge asks how GitHub should merge the selected PR once its
+// requirements pass, or, when auto-merge is already armed, whether to disable it
+func (m *MultiTabModel) toggleAutoMerge(tab *TabState) {
+	if m.writeUnavailable(tab) {
+		return
+	}
+	pr := tab.SelectedPR()
+	if pr == nil {
+		tab.StatusMsg = "No PR selected"
+		return
+	}
+	key := services.PRKey(pr)
+
+	if pr.AutoMerge != nil {
+		m.pendingConfirm = &confirmPrompt{
+			prompt:    fmt.Sprintf("Disable auto-merge (%s) on %s?", pr.AutoMerge.GetMergeMethod(), key),
+			onConfirm: m.autoMergeCmd(tab.Config.Name, pr, ""),
+		}
+		tab.StatusMsg = m.pendingConfirm.prompt + " (y/n)"
+		return
+	}
+
+	cursor := 0
+	for i, method := range github.MergeMethods {
+		if method == m.lastMergeMethod {
+			cursor = i
+		}
+	}
+	m.pendingChoice = &choicePrompt{
+		label:   fmt.Sprintf("%s Auto-merge %s with:", autoMergeMarker, key),
+		options: github.MergeMethods,
+		cursor:  cursor,
+		onChoose: func(method string) tea.Cmd {
+			m.lastMergeMethod = method
+			tab.StatusMsg = fmt.Sprintf("Enabling auto-merge on %s (%s)...", key, method)
+			return m.autoMergeCmd(tab.Config.Name, pr, method)
+		},
+	}
+	tab.StatusMsg = m.pendingChoice.status()
+}
+
+// autoMergeCmd arms auto-merge on one PR with method, or disables it when
+// method is empty
+func (m *MultiTabModel) autoMergeCmd(tabName string, pr *gh.PullRequest, method string) tea.Cmd {
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 10*time.Second)
+		defer cancel()
+
+		err := github.SetAutoMerge(ctx, token, pr, method)
+		return autoMergeResultMsg{tabName: tabName, key: services.PRKey(pr), method: method, err: err}
+	}
+}
+
+// handleAutoMergeResult reports the change and updates the PR's Status
+// badge in every tab listing it
+func (m *MultiTabModel) handleAutoMergeResult(msg autoMergeResultMsg) (tea.Model, tea.Cmd) {
+	status := fmt.Sprintf("%s Auto-merge armed on %s (%s)", autoMergeMarker, msg.key, msg.method)
+	var autoMerge *gh.PullRequestAutoMerge
+	if msg.method == "" {
+		status = fmt.Sprintf("Auto-merge disabled on %s", msg.key)
+	} else {
+		autoMerge = &gh.PullRequestAutoMerge{MergeMethod: gh.String(msg.method)}
+	}
+	if msg.err != nil {
+		status = fmt.Sprintf("Changing auto-merge on %s failed: %v", msg.key, msg.err)
+	} else {
+		for _, tab := range m.TabManager.Tabs {
+			for _, pr := range tab.PRs {
+				if services.PRKey(pr) == msg.key {
+					pr.AutoMerge = autoMerge
+				}
+			}
+		}
+		m.refreshAllRows()
+	}
+
+	for _, tab := range m.TabManager.Tabs {
+		if tab.Config.Name == msg.tabName {
+			tab.StatusMsg = status
+		}
+	}
+	return m, nil
+}
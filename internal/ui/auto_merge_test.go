@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestAutoMerge tests arming auto-merge with a method, the Status badge and
+// disabling it again
+func TestAutoMerge(t *testing.T) {
+	model, tab := detailTestModel("test-token")
+	pr := tab.PRs[0]
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("G")})
+	if model.pendingChoice == nil || !strings.Contains(tab.StatusMsg, "Auto-merge org/api#12 with") {
+		t.Fatalf("Expected the method picker, got %q", tab.StatusMsg)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	if model.pendingChoice != nil || model.lastMergeMethod != "squash" || !strings.Contains(tab.StatusMsg, "Enabling auto-merge") {
+		t.Fatalf("Expected squash chosen, got %q", tab.StatusMsg)
+	}
+
+	model.Update(autoMergeResultMsg{tabName: "Main", key: "org/api#12", method: "squash", err: errors.New("auto-merge is not allowed")})
+	if pr.AutoMerge != nil || !strings.Contains(tab.StatusMsg, "not allowed") {
+		t.Errorf("Expected a refusal to leave the PR alone, got %q", tab.StatusMsg)
+	}
+
+	model.Update(autoMergeResultMsg{tabName: "Main", key: "org/api#12", method: "squash"})
+	if pr.AutoMerge.GetMergeMethod() != "squash" || tab.StatusMsg != autoMergeMarker+" Auto-merge armed on org/api#12 (squash)" {
+		t.Errorf("Expected auto-merge armed, got %q", tab.StatusMsg)
+	}
+	if status := tab.Table.Rows()[0][4]; !strings.HasPrefix(status, autoMergeMarker) {
+		t.Errorf("Expected the auto-merge badge in the Status column, got %q", status)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("G")})
+	if model.pendingConfirm == nil || !strings.HasPrefix(tab.StatusMsg, "Disable auto-merge (squash)") {
+		t.Fatalf("Expected to be asked to disable auto-merge, got %q", tab.StatusMsg)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	model.Update(autoMergeResultMsg{tabName: "Main", key: "org/api#12"})
+	if pr.AutoMerge != nil || strings.HasPrefix(tab.Table.Rows()[0][4], autoMergeMarker) {
+		t.Error("Expected auto-merge disabled and the badge gone")
+	}
+}
@@ -0,0 +1,164 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// blockedMarker prefixes PRs annotated as blocked on something outside GitHub
+const blockedMarker = "⛔"
+
+// Blocker records what a PR is waiting on - free text or a link to another PR or issue
+type Blocker struct {
+	Note  string    `json:"note"`
+	SetAt time.Time `json:"set_at"`
+}
+
+// BlockerStore keeps "blocked on" annotations keyed by PR ("owner/repo#123"),
+// persisted locally since GitHub has no way to model external blockers
+type BlockerStore struct {
+	mu       sync.Mutex
+	path     string // Empty path keeps annotations in memory only
+	blockers map[string]Blocker
+}
+
+// NewBlockerStore creates a blocker store backed by the given file. A
+// missing or unreadable file starts with no annotations.
+func NewBlockerStore(path string) *BlockerStore {
+	store := &BlockerStore{
+		path:     path,
+		blockers: make(map[string]Blocker),
+	}
+
+	if path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			var saved map[string]Blocker
+			if json.Unmarshal(data, &saved) == nil && saved != nil {
+				store.blockers = saved
+			}
+		}
+	}
+
+	return store
+}
+
+// Get returns the blocker annotation for a PR, if any
+func (s *BlockerStore) Get(pr *gh.PullRequest) (Blocker, bool) {
+	if s == nil || pr == nil {
+		return Blocker{}, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blocker, ok := s.blockers[services.PRKey(pr)]
+	return blocker, ok
+}
+
+// Set annotates a PR as blocked; an empty note clears the annotation
+func (s *BlockerStore) Set(pr *gh.PullRequest, note string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := services.PRKey(pr)
+	if note == "" {
+		delete(s.blockers, key)
+	} else {
+		s.blockers[key] = Blocker{Note: note, SetAt: time.Now()}
+	}
+	return s.save()
+}
+
+// Count returns how many of the given PRs are annotated as blocked
+func (s *BlockerStore) Count(prs []*gh.PullRequest) int {
+	count := 0
+	for _, pr := range prs {
+		if _, ok := s.Get(pr); ok {
+			count++
+		}
+	}
+	return count
+}
+
+// save writes annotations to disk; the caller must hold the lock
+func (s *BlockerStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(s.blockers, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode blockers: %w", err)
+	}
+	if err := cache.WriteFile(s.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to save blockers: %w", err)
+	}
+	return nil
+}
+
+// getBlockersFilePath returns the path "blocked on" annotations are saved to
+func getBlockersFilePath() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/.prcompass_blockers.json", homeDir)
+}
+
+// editBlocker prompts for what the selected PR is blocked on, prefilled with
+// the current annotation. Submitting an empty note clears it.
+func (m *MultiTabModel) editBlocker(tab *TabState) {
+	pr := tab.SelectedPR()
+	if pr == nil {
+		tab.StatusMsg = "No PR selected"
+		return
+	}
+
+	current, _ := m.TabManager.Blockers.Get(pr)
+	history := tab.inputHistory("blocked")
+	history.reset()
+	m.pendingInput = &textPrompt{
+		label:   fmt.Sprintf("%s #%d blocked on (text or PR/issue link, empty clears)", blockedMarker, pr.GetNumber()),
+		value:   current.Note,
+		history: history,
+		onSubmit: func(note string) string {
+			if err := m.TabManager.Blockers.Set(pr, note); err != nil {
+				return err.Error()
+			}
+			m.refreshAllRows()
+			if note == "" {
+				return fmt.Sprintf("Cleared blocker on #%d", pr.GetNumber())
+			}
+			return fmt.Sprintf("%s #%d blocked on: %s", blockedMarker, pr.GetNumber(), note)
+		},
+	}
+	tab.StatusMsg = m.pendingInput.status()
+}
+
+// activeBlockedInfo summarises blocked PRs of the active tab for the tab bar
+// when several tabs are open (a single tab shows it in its own header)
+func (m *MultiTabModel) activeBlockedInfo() string {
+	activeTab := m.TabManager.GetActiveTab()
+	if activeTab == nil || len(m.TabManager.Tabs) < 2 {
+		return ""
+	}
+	if blocked := m.blockedSummary(activeTab); blocked != "" {
+		return " │ " + blocked
+	}
+	return ""
+}
+
+// blockedSummary describes how many of a tab's PRs are blocked, or "" if none
+func (m *MultiTabModel) blockedSummary(tab *TabState) string {
+	count := m.TabManager.Blockers.Count(tab.PRs)
+	if count == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s Blocked: %d", blockedMarker, count)
+}
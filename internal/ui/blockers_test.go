@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+func blockerTestPR(number int) *gh.PullRequest {
+	return &gh.PullRequest{
+		Number: gh.Int(number),
+		Title:  gh.String("Migrate billing"),
+		Base:   &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/billing")}},
+	}
+}
+
+// TestBlockerStorePersistence tests that annotations survive a reload and empty notes clear them
+func TestBlockerStorePersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "blockers.json")
+	pr := blockerTestPR(7)
+
+	store := NewBlockerStore(path)
+	if err := store.Set(pr, "waiting on org/infra#12"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	reloaded := NewBlockerStore(path)
+	blocker, ok := reloaded.Get(pr)
+	if !ok || blocker.Note != "waiting on org/infra#12" || blocker.SetAt.IsZero() {
+		t.Fatalf("Expected annotation after reload, got %+v (found=%v)", blocker, ok)
+	}
+	if reloaded.Count([]*gh.PullRequest{pr, blockerTestPR(8)}) != 1 {
+		t.Error("Expected 1 blocked PR")
+	}
+
+	if err := reloaded.Set(pr, ""); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if _, ok := NewBlockerStore(path).Get(pr); ok {
+		t.Error("Expected empty note to clear the annotation")
+	}
+
+	// A nil store never reports blockers
+	var none *BlockerStore
+	if _, ok := none.Get(pr); ok {
+		t.Error("Expected nil store to report no blocker")
+	}
+}
+
+// TestHotkeyBlockedOn tests annotating the selected PR via the text prompt
+func TestHotkeyBlockedOn(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Main", Mode: "repos", Repos: []string{"org/billing"}})
+	tab.PRs = []*gh.PullRequest{blockerTestPR(7)}
+	tab.FilteredPRs = tab.PRs
+	tab.Loaded = true
+	model.updateTableRows(tab)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("B")})
+	if model.pendingInput == nil {
+		t.Fatal("Expected 'B' to open the blocked-on prompt")
+	}
+
+	// Hotkeys like q and r are typed into the prompt rather than triggering actions
+	for _, key := range []tea.KeyMsg{
+		{Type: tea.KeyRunes, Runes: []rune("legal")},
+		{Type: tea.KeySpace},
+		{Type: tea.KeyRunes, Runes: []rune("qr")},
+		{Type: tea.KeyBackspace},
+		{Type: tea.KeyEnter},
+	} {
+		_, cmd := model.Update(key)
+		if cmd != nil {
+			t.Fatalf("Expected no command while typing %q", key.String())
+		}
+	}
+
+	blocker, ok := model.TabManager.Blockers.Get(tab.PRs[0])
+	if !ok || blocker.Note != "legal q" {
+		t.Fatalf("Expected note 'legal q', got %+v (found=%v)", blocker, ok)
+	}
+	if !strings.HasPrefix(tab.Table.Rows()[0][0], blockedMarker) {
+		t.Errorf("Expected blocked badge in PR column, got %q", tab.Table.Rows()[0][0])
+	}
+	if !strings.Contains(model.renderTabBar(), "Blocked: 1") {
+		t.Error("Expected blocked count in the tab header")
+	}
+
+	// Escape cancels without changing the annotation
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("B")})
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlU})
+	model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if blocker, _ := model.TabManager.Blockers.Get(tab.PRs[0]); blocker.Note != "legal q" || model.pendingInput != nil {
+		t.Errorf("Expected escape to keep the note, got %q", blocker.Note)
+	}
+}
@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bjess9/pr-compass/internal/github"
+)
+
+// CheckHint tells authors of a PR with a failing check who owns the check,
+// e.g. e2e-* → the QA team's runbook
+type CheckHint struct {
+	Checks string `mapstructure:"checks" yaml:"checks"`         // Check name pattern; * matches anything
+	Owner  string `mapstructure:"owner" yaml:"owner,omitempty"` // Team or person to ping
+	URL    string `mapstructure:"url" yaml:"url,omitempty"`     // Runbook, channel or docs link
+}
+
+// Matches reports whether a check name fits the hint's pattern, ignoring case
+func (h CheckHint) Matches(name string) bool {
+	pattern := "(?i)^" + strings.ReplaceAll(regexp.QuoteMeta(h.Checks), `\*`, ".*") + "$"
+	matched, err := regexp.MatchString(pattern, name)
+	return err == nil && matched
+}
+
+// String describes whom to contact
+func (h CheckHint) String() string {
+	switch {
+	case h.Owner != "" && h.URL != "":
+		return fmt.Sprintf("ask %s · %s", h.Owner, h.URL)
+	case h.Owner != "":
+		return "ask " + h.Owner
+	default:
+		return h.URL
+	}
+}
+
+// validateCheckHints checks that every hint has a pattern and something to say
+func validateCheckHints(hints []CheckHint) error {
+	for i, hint := range hints {
+		if strings.TrimSpace(hint.Checks) == "" {
+			return fmt.Errorf("check_hints[%d]: checks pattern is required", i)
+		}
+		if hint.Owner == "" && hint.URL == "" {
+			return fmt.Errorf("check_hints[%d] (%s): set an owner or a url", i, hint.Checks)
+		}
+	}
+	return nil
+}
+
+// checkHintFor returns the first configured hint matching a check name
+func (m *MultiTabModel) checkHintFor(name string) (CheckHint, bool) {
+	for _, hint := range m.CheckHints {
+		if hint.Matches(name) {
+			return hint, true
+		}
+	}
+	return CheckHint{}, false
+}
+
+// failingCheckLines lists the head commit's failing checks for the detail
+// pane, each followed by its ownership hint if one is configured
+func (m *MultiTabModel) failingCheckLines(details *github.PRDetails, width int) []string {
+	lines := []string{"🚦 Failing checks:"}
+	if details.ChecksErr != nil {
+		return append(lines, "   🚫 "+details.ChecksErr.Error())
+	}
+
+	for _, check := range details.FailingChecks {
+		lines = append(lines, clipText("   "+theme.Failed+" "+check.Name, width))
+		if hint, ok := m.checkHintFor(check.Name); ok {
+			lines = append(lines, clipText("      👉 "+hint.String(), width))
+		} else if check.URL != "" {
+			lines = append(lines, mutedStyle.Render(clipText("      "+check.URL, width)))
+		}
+	}
+	return lines
+}
+
+// missingCheckLines lists the checks branch protection requires that never
+// reported on the head commit, which block merging without ever failing
+func (m *MultiTabModel) missingCheckLines(details *github.PRDetails, width int) []string {
+	lines := []string{"🧩 Required checks never reported:"}
+	for _, name := range details.MissingChecks {
+		lines = append(lines, clipText("   "+theme.Attention+" "+name, width))
+		if hint, ok := m.checkHintFor(name); ok {
+			lines = append(lines, clipText("      👉 "+hint.String(), width))
+		}
+	}
+	return lines
+}
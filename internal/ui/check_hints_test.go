@@ -0,0 +1,57 @@
+package ui
+
+import "testing"
+
+// TestCheckHintMatches tests check name patterns
+func TestCheckHintMatches(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"e2e-*", "e2e-chrome", true},
+		{"e2e-*", "E2E-Firefox", true},
+		{"e2e-*", "unit", false},
+		{"*snyk*", "security/snyk (pr)", true},
+		{"build", "build", true},
+		{"build", "build-arm", false},
+		{"ci/jenkins.pr", "ci/jenkinsXpr", false},
+	}
+
+	for _, tt := range tests {
+		if got := (CheckHint{Checks: tt.pattern}).Matches(tt.name); got != tt.want {
+			t.Errorf("CheckHint{%q}.Matches(%q) = %v, want %v", tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestCheckHintFor tests that the first matching hint wins
+func TestCheckHintFor(t *testing.T) {
+	model := &MultiTabModel{CheckHints: []CheckHint{
+		{Checks: "e2e-safari", URL: "https://wiki.example.com/safari"},
+		{Checks: "e2e-*", Owner: "@org/qa"},
+	}}
+
+	if hint, ok := model.checkHintFor("e2e-safari"); !ok || hint.String() != "https://wiki.example.com/safari" {
+		t.Errorf("Expected the specific hint first, got %+v", hint)
+	}
+	if hint, ok := model.checkHintFor("e2e-chrome"); !ok || hint.String() != "ask @org/qa" {
+		t.Errorf("Expected the QA hint, got %+v", hint)
+	}
+	if _, ok := model.checkHintFor("lint"); ok {
+		t.Error("Expected no hint for lint")
+	}
+}
+
+// TestValidateCheckHints tests rejecting incomplete hints
+func TestValidateCheckHints(t *testing.T) {
+	if err := validateCheckHints([]CheckHint{{Checks: "e2e-*", Owner: "@org/qa"}}); err != nil {
+		t.Errorf("Expected valid hints, got %v", err)
+	}
+	if err := validateCheckHints([]CheckHint{{Owner: "@org/qa"}}); err == nil {
+		t.Error("Expected an error for a missing pattern")
+	}
+	if err := validateCheckHints([]CheckHint{{Checks: "lint"}}); err == nil {
+		t.Error("Expected an error for a hint without owner or url")
+	}
+}
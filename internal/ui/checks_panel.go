@@ -0,0 +1,209 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// checksPanelLines caps the check runs the checks panel lists
+	checksPanelLines = 10
+
+	// checkRunsFreshFor is how long fetched check runs are shown before
+	// opening the panel again fetches them anew. Checks finishing doesn't
+	// change the PR's update time, so that can't tell when they're stale.
+	checkRunsFreshFor = time.Minute
+)
+
+// checkRunList holds the check runs fetched for a PR's head commit
+type checkRunList struct {
+	checks    []github.CheckRun
+	fetchedAt time.Time
+}
+
+// checkRunsMsg delivers the check runs fetched for the checks panel
+type checkRunsMsg struct {
+	key    string
+	checks []github.CheckRun
+	err    error
+}
+
+// checkRunsCmd fetches the selected PR's check runs unless they are fresh
+// or being fetched
+func (m *MultiTabModel) checkRunsCmd(tab *TabState) tea.Cmd {
+	pr := tab.SelectedPR()
+	if pr == nil || m.readOnly() {
+		return nil
+	}
+	key := services.PRKey(pr)
+	if !tab.Config.OnGitHub() {
+		m.checkRunsErrors[key] = errGitHubOnly
+		return nil
+	}
+	if list, known := m.checkRuns[key]; known && time.Since(list.fetchedAt) < checkRunsFreshFor {
+		return nil
+	}
+	if m.checkRunsLoading[key] {
+		return nil
+	}
+	m.checkRunsLoading[key] = true
+
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 10*time.Second)
+		defer cancel()
+
+		checks, err := github.FetchCheckRuns(ctx, token, pr)
+		return checkRunsMsg{key: key, checks: checks, err: err}
+	}
+}
+
+// handleCheckRuns stores fetched check runs for the checks panel
+func (m *MultiTabModel) handleCheckRuns(msg checkRunsMsg) (tea.Model, tea.Cmd) {
+	delete(m.checkRunsLoading, msg.key)
+	if msg.err != nil {
+		m.checkRunsErrors[msg.key] = msg.err
+		return m, nil
+	}
+	delete(m.checkRunsErrors, msg.key)
+	m.checkRuns[msg.key] = checkRunList{checks: msg.checks, fetchedAt: time.Now()}
+	return m, nil
+}
+
+// toggleChecks shows or hides the checks panel, making room for it in the table
+func (m *MultiTabModel) toggleChecks(tab *TabState) tea.Cmd {
+	tab.ShowChecks = !tab.ShowChecks
+	tab.Table.SetHeight(m.calculateTableHeight(tab))
+	if tab.ShowChecks {
+		return m.followSelection(tab)
+	}
+	return nil
+}
+
+// openFailingCheck opens the details page of the selected PR's first failing check
+func (m *MultiTabModel) openFailingCheck(tab *TabState) tea.Cmd {
+	pr := tab.SelectedPR()
+	if pr == nil {
+		return nil
+	}
+	list, known := m.checkRuns[services.PRKey(pr)]
+	if !known {
+		tab.StatusMsg = "Checks not loaded yet - press x to list them"
+		return nil
+	}
+	for _, check := range list.checks {
+		if !check.Failed() {
+			continue
+		}
+		if check.URL == "" {
+			tab.StatusMsg = fmt.Sprintf("Failing check %q has no details page", check.Name)
+			return nil
+		}
+		tab.StatusMsg = fmt.Sprintf("Opening %s...", check.Name)
+		return openURLCmd(check.URL)
+	}
+	tab.StatusMsg = "No failing checks on this PR"
+	return nil
+}
+
+// renderChecksPanel renders the selected PR's check runs with their
+// status, conclusion and duration
+func (m *MultiTabModel) renderChecksPanel(tab *TabState, now time.Time) string {
+	pr := tab.SelectedPR()
+	if pr == nil {
+		return ""
+	}
+	key := services.PRKey(pr)
+
+	width := m.Width - 8 // Border and padding
+	if width < 30 {
+		width = 30
+	}
+
+	var lines []string
+	list, known := m.checkRuns[key]
+	switch {
+	case m.readOnly():
+		lines = []string{mutedStyle.Render("Checks are unavailable without authentication")}
+	case known && len(list.checks) == 0:
+		lines = []string{mutedStyle.Render("No checks reported on the head commit")}
+	case known:
+		lines = checkRunLines(list.checks, width, now)
+	case m.checkRunsErrors[key] != nil:
+		lines = []string{"🚫 " + m.checkRunsErrors[key].Error()}
+	default:
+		lines = []string{"⏳ Loading checks..."}
+	}
+
+	title := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.TextBright)).
+		Render(clipText(fmt.Sprintf("🚦 Checks for #%d", pr.GetNumber()), width))
+	footer := "\n" + mutedStyle.Render("X open failing check · F re-run failed · x to close")
+	return "\n" + repoInfoStyle.Width(width+4).Render(title+"\n"+strings.Join(lines, "\n")+footer)
+}
+
+// checkRunLines lays out one line per check, failures first, capped at
+// checksPanelLines
+func checkRunLines(checks []github.CheckRun, width int, now time.Time) []string {
+	nameWidth := 0
+	for _, check := range checks {
+		nameWidth = max(nameWidth, lipgloss.Width(check.Name))
+	}
+	nameWidth = min(nameWidth, width/2)
+
+	var lines []string
+	for i, check := range checks {
+		if i == checksPanelLines {
+			lines = append(lines, mutedStyle.Render(fmt.Sprintf("+%d more", len(checks)-i)))
+			break
+		}
+		name := clipText(check.Name, nameWidth)
+		name += strings.Repeat(" ", nameWidth-lipgloss.Width(name))
+		line := fmt.Sprintf("%s %s  %-16s %s", checkRunIcon(check), name, checkRunState(check), formatCheckDuration(check.Duration(now)))
+		lines = append(lines, clipText(line, width))
+	}
+	return lines
+}
+
+// checkRunIcon returns the theme icon for a check's outcome
+func checkRunIcon(check github.CheckRun) string {
+	switch {
+	case check.Failed():
+		return theme.Failed
+	case check.Status != "completed":
+		return theme.Running
+	case check.Conclusion == "success":
+		return theme.Passed
+	case check.Conclusion == "skipped" || check.Conclusion == "neutral":
+		return theme.Skipped
+	}
+	return theme.Unknown
+}
+
+// checkRunState describes a check's status, or its conclusion once completed
+func checkRunState(check github.CheckRun) string {
+	if check.Status != "completed" {
+		return strings.ReplaceAll(check.Status, "_", " ")
+	}
+	return strings.ReplaceAll(check.Conclusion, "_", " ")
+}
+
+// formatCheckDuration renders how long a check ran, e.g. "45s" or "4m10s"
+func formatCheckDuration(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return "-"
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm%02ds", int(d.Minutes()), int(d.Seconds())%60)
+	default:
+		return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+	}
+}
@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestChecksPanel tests the x toggle, rendering fetched checks and X
+// opening the failing one
+func TestChecksPanel(t *testing.T) {
+	model, tab := detailTestModel("test-token")
+	fullHeight := model.calculateTableHeight(tab)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("X")})
+	if !strings.Contains(tab.StatusMsg, "press x") {
+		t.Errorf("Expected X to ask for the checks first, got %q", tab.StatusMsg)
+	}
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	if !tab.ShowChecks || cmd == nil || !model.checkRunsLoading["org/api#12"] {
+		t.Fatal("Expected 'x' to open the checks panel and fetch checks")
+	}
+	if height := model.calculateTableHeight(tab); height >= fullHeight {
+		t.Errorf("Expected table to shrink for the panel, got %d (was %d)", height, fullHeight)
+	}
+	if view := model.renderChecksPanel(tab, time.Now()); !strings.Contains(view, "Loading checks") {
+		t.Errorf("Expected the panel to show loading, got:\n%s", view)
+	}
+
+	started := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	model.Update(checkRunsMsg{key: "org/api#12", checks: []github.CheckRun{
+		{Name: "test", Status: "completed", Conclusion: "failure", StartedAt: started, CompletedAt: started.Add(4*time.Minute + 10*time.Second), URL: "https://github.com/org/api/runs/7"},
+		{Name: "lint", Status: "completed", Conclusion: "success", StartedAt: started, CompletedAt: started.Add(45 * time.Second)},
+		{Name: "e2e", Status: "in_progress", StartedAt: started},
+	}})
+	view := model.renderChecksPanel(tab, started.Add(2*time.Hour))
+	for _, want := range []string{"test", "failure", "4m10s", "lint", "45s", "in progress", "2h00m"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q in the checks panel, got:\n%s", want, view)
+		}
+	}
+
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("X")}); cmd == nil || tab.StatusMsg != "Opening test..." {
+		t.Errorf("Expected X to open the failing check, got %q", tab.StatusMsg)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	if tab.ShowChecks || model.calculateTableHeight(tab) != fullHeight {
+		t.Error("Expected 'x' again to close the panel and restore the table")
+	}
+}
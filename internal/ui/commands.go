@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os/exec"
 	"runtime"
+	"strings"
 
 	"github.com/bjess9/pr-compass/internal/ui/types"
 	tea "github.com/charmbracelet/bubbletea"
@@ -43,3 +44,47 @@ func openInBrowser(url string) error {
 	}
 	return nil
 }
+
+// clipboardCopiedMsg reports the outcome of copying text for a tab, with the
+// status to show on success and on failure
+type clipboardCopiedMsg struct {
+	tabName string
+	copied  string
+	failed  string
+	err     error
+}
+
+func copyToClipboardCmd(tabName, text, copied, failed string) tea.Cmd {
+	return func() tea.Msg {
+		return clipboardCopiedMsg{tabName: tabName, copied: copied, failed: failed, err: copyToClipboard(text)}
+	}
+}
+
+func copyToClipboard(text string) error {
+	var cmd *exec.Cmd
+
+	if IsWSL() {
+		cmd = exec.Command("clip.exe")
+	} else {
+		switch runtime.GOOS {
+		case "linux":
+			if _, err := exec.LookPath("wl-copy"); err == nil {
+				cmd = exec.Command("wl-copy")
+			} else {
+				cmd = exec.Command("xclip", "-selection", "clipboard")
+			}
+		case "windows":
+			cmd = exec.Command("clip")
+		case "darwin":
+			cmd = exec.Command("pbcopy")
+		default:
+			return fmt.Errorf("unsupported platform")
+		}
+	}
+
+	cmd.Stdin = strings.NewReader(text)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to copy to clipboard: %w", err)
+	}
+	return nil
+}
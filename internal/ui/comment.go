@@ -0,0 +1,152 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	gh "github.com/google/go-github/v55/github"
+)
+
+const (
+	commentComposerHeight = 8
+	maxCommentLength      = 65536 // GitHub's limit for a comment body
+)
+
+// commentComposer is the overlay for writing a comment on one PR. While open
+// it receives every key press, so enter starts a new line.
+type commentComposer struct {
+	tabName string
+	pr      *gh.PullRequest
+	input   textarea.Model
+}
+
+// commentResultMsg reports the outcome of posting a comment on a single PR
+type commentResultMsg struct {
+	tabName string
+	key     string // services.PRKey of the commented PR
+	err     error
+}
+
+// openCommentComposer starts a comment on the selected PR
+func (m *MultiTabModel) openCommentComposer(tab *TabState) {
+	if m.writeUnavailable(tab) {
+		return
+	}
+	pr := tab.SelectedPR()
+	if pr == nil {
+		tab.StatusMsg = "No PR selected"
+		return
+	}
+
+	input := textarea.New()
+	input.Placeholder = "Leave a comment (Markdown supported)"
+	input.ShowLineNumbers = false
+	input.CharLimit = maxCommentLength
+	input.SetWidth(m.commentWidth())
+	input.SetHeight(commentComposerHeight)
+	input.Cursor.SetMode(cursor.CursorStatic) // Blink messages aren't routed to the composer
+	input.Focus()
+
+	m.pendingComment = &commentComposer{tabName: tab.Config.Name, pr: pr, input: input}
+	tab.StatusMsg = fmt.Sprintf("💬 Commenting on %s - ctrl+s to post, esc to discard", services.PRKey(pr))
+}
+
+// commentWidth sizes the composer to the terminal, leaving room for the border
+func (m *MultiTabModel) commentWidth() int {
+	width := m.Width - 8
+	if width < 30 {
+		width = 30
+	}
+	return width
+}
+
+// handleCommentKey edits, posts or discards the pending comment
+func (m *MultiTabModel) handleCommentKey(tab *TabState, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	composer := m.pendingComment
+
+	switch msg.String() {
+	case "esc":
+		m.pendingComment = nil
+		tab.StatusMsg = "Comment discarded"
+		return m, nil
+	case "ctrl+s":
+		body := strings.TrimSpace(composer.input.Value())
+		if body == "" {
+			tab.StatusMsg = "Comment is empty - type something or press esc to discard"
+			return m, nil
+		}
+		m.pendingComment = nil
+		tab.StatusMsg = fmt.Sprintf("Posting comment on %s...", services.PRKey(composer.pr))
+		return m, m.commentCmd(composer.tabName, composer.pr, body)
+	}
+
+	var cmd tea.Cmd
+	composer.input, cmd = composer.input.Update(msg)
+	return m, cmd
+}
+
+// commentCmd posts a general comment on one PR
+func (m *MultiTabModel) commentCmd(tabName string, pr *gh.PullRequest, body string) tea.Cmd {
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 10*time.Second)
+		defer cancel()
+
+		err := github.CommentOnPullRequest(ctx, token, pr, body)
+		return commentResultMsg{tabName: tabName, key: services.PRKey(pr), err: err}
+	}
+}
+
+// handleCommentResult reports a posted comment and re-enhances the PR so its
+// comment count and activity catch up
+func (m *MultiTabModel) handleCommentResult(msg commentResultMsg) (tea.Model, tea.Cmd) {
+	status := fmt.Sprintf("💬 Commented on %s", msg.key)
+	if msg.err != nil {
+		status = fmt.Sprintf("Comment on %s failed: %v", msg.key, msg.err)
+	}
+
+	var cmd tea.Cmd
+	for _, tab := range m.TabManager.Tabs {
+		if tab.Config.Name != msg.tabName {
+			continue
+		}
+		tab.StatusMsg = status
+		// Enhancement updates are delivered to the active tab
+		if msg.err == nil && tab == m.TabManager.GetActiveTab() {
+			cmd = m.reenhancePR(tab, msg.key)
+		}
+	}
+	return m, cmd
+}
+
+// reenhancePR fetches fresh enhanced data for one PR in the tab
+func (m *MultiTabModel) reenhancePR(tab *TabState, key string) tea.Cmd {
+	for _, pr := range tab.PRs {
+		if services.PRKey(pr) != key {
+			continue
+		}
+		tab.EnhancementQueue[pr.GetNumber()] = true
+		m.updateTableRows(tab)
+		return m.createEnhancementCommand(pr, pr.GetNumber())
+	}
+	return nil
+}
+
+// renderCommentComposer renders the comment overlay in place of the table
+func (m *MultiTabModel) renderCommentComposer() string {
+	composer := m.pendingComment
+	width := m.commentWidth()
+
+	title := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.TextBright)).
+		Render(clipText(fmt.Sprintf("💬 Comment on #%d %s", composer.pr.GetNumber(), composer.pr.GetTitle()), width))
+	footer := mutedStyle.Render("enter new line · ctrl+s post · esc discard")
+	return repoInfoStyle.Width(width + 4).Render(title + "\n" + composer.input.View() + "\n" + footer)
+}
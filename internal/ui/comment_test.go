@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestHotkeyComment tests typing a multi-line comment, posting and discarding it
+func TestHotkeyComment(t *testing.T) {
+	model, tab := mergeTestModel("test-token")
+	press := func(msg tea.KeyMsg) tea.Cmd {
+		_, cmd := model.Update(msg)
+		return cmd
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	press(runes("C"))
+	if model.pendingComment == nil || !strings.Contains(tab.StatusMsg, "org/api#12") {
+		t.Fatalf("Expected composer for the selected PR, got %q", tab.StatusMsg)
+	}
+
+	// Empty comments aren't posted
+	if cmd := press(tea.KeyMsg{Type: tea.KeyCtrlS}); cmd != nil || model.pendingComment == nil {
+		t.Fatal("Expected empty comment to keep the composer open")
+	}
+
+	// Hotkeys and enter go into the comment
+	press(runes("q"))
+	press(tea.KeyMsg{Type: tea.KeyEnter})
+	press(runes("M"))
+	if got := model.pendingComment.input.Value(); got != "q\nM" {
+		t.Errorf("Expected keys to be typed into the comment, got %q", got)
+	}
+	if view := model.View(); !strings.Contains(view, "Comment on #12") {
+		t.Error("Expected composer to replace the table")
+	}
+
+	if cmd := press(tea.KeyMsg{Type: tea.KeyCtrlS}); cmd == nil || model.pendingComment != nil {
+		t.Fatal("Expected ctrl+s to post the comment")
+	}
+	if !strings.Contains(tab.StatusMsg, "Posting comment on org/api#12") {
+		t.Errorf("Expected posting status, got %q", tab.StatusMsg)
+	}
+
+	press(runes("C"))
+	press(runes("draft"))
+	if cmd := press(tea.KeyMsg{Type: tea.KeyEsc}); cmd != nil || model.pendingComment != nil || tab.StatusMsg != "Comment discarded" {
+		t.Errorf("Expected esc to discard the comment, got %q", tab.StatusMsg)
+	}
+}
+
+// TestCommentResult tests refreshing enhanced data after posting
+func TestCommentResult(t *testing.T) {
+	model, tab := mergeTestModel("test-token")
+
+	model.Update(commentResultMsg{tabName: "Main", key: "org/api#12", err: errors.New("forbidden")})
+	if !strings.Contains(tab.StatusMsg, "forbidden") || len(tab.EnhancementQueue) != 0 {
+		t.Errorf("Expected failure in status without a refresh, got %q", tab.StatusMsg)
+	}
+
+	_, cmd := model.Update(commentResultMsg{tabName: "Main", key: "org/api#12"})
+	if cmd == nil || !tab.EnhancementQueue[12] {
+		t.Error("Expected the commented PR to be enhanced again")
+	}
+	if !strings.Contains(tab.StatusMsg, "Commented on org/api#12") {
+		t.Errorf("Expected success status, got %q", tab.StatusMsg)
+	}
+}
+
+// TestCommentReadOnly tests that read-only mode refuses to comment
+func TestCommentReadOnly(t *testing.T) {
+	model, tab := mergeTestModel("")
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("C")})
+	if model.pendingComment != nil || tab.StatusMsg != readOnlyActionMsg {
+		t.Errorf("Expected read-only refusal, got %q", tab.StatusMsg)
+	}
+}
@@ -0,0 +1,28 @@
+package components
+
+import "strings"
+
+// barEighths are the partial cells of a bar, one eighth wider each
+var barEighths = []rune("▏▎▍▌▋▊▉")
+
+// barFull is a whole cell of a bar
+const barFull = '█'
+
+// Bar renders a horizontal bar for value, scaled so that max fills width
+// cells, in eighths of a cell. Values above zero show at least a sliver.
+func Bar(value, max, width int) string {
+	if value <= 0 || max <= 0 || width <= 0 {
+		return ""
+	}
+	eighths := min(value, max) * width * 8 / max
+	if eighths == 0 {
+		eighths = 1
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.Repeat(string(barFull), eighths/8))
+	if rest := eighths % 8; rest > 0 {
+		b.WriteRune(barEighths[rest-1])
+	}
+	return b.String()
+}
@@ -0,0 +1,27 @@
+package components
+
+import "testing"
+
+func TestBar(t *testing.T) {
+	tests := []struct {
+		name       string
+		value, max int
+		width      int
+		expected   string
+	}{
+		{"full", 6, 6, 4, "████"},
+		{"half", 3, 6, 4, "██"},
+		{"partial cell", 1, 6, 4, "▋"},
+		{"sliver for small values", 1, 1000, 4, "▏"},
+		{"zero", 0, 6, 4, ""},
+		{"capped at max", 9, 6, 2, "██"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Bar(tt.value, tt.max, tt.width); got != tt.expected {
+				t.Errorf("Bar(%d, %d, %d) = %q, want %q", tt.value, tt.max, tt.width, got, tt.expected)
+			}
+		})
+	}
+}
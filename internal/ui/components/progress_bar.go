@@ -0,0 +1,57 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ProgressBar renders a slim single-line progress bar for background work
+type ProgressBar struct {
+	width int // Number of cells in the bar itself
+}
+
+// NewProgressBar creates a progress bar with the given bar width
+func NewProgressBar(width int) *ProgressBar {
+	if width < 1 {
+		width = 1
+	}
+	return &ProgressBar{width: width}
+}
+
+// Render draws the bar with done/total counts, an ETA when known, and a
+// separate error count when some items failed
+func (p *ProgressBar) Render(done, failed, total int, eta time.Duration) string {
+	if total <= 0 {
+		return ""
+	}
+
+	processed := done + failed
+	if processed > total {
+		processed = total
+	}
+	filled := processed * p.width / total
+
+	var b strings.Builder
+	b.WriteString(strings.Repeat("█", filled))
+	b.WriteString(strings.Repeat("░", p.width-filled))
+	fmt.Fprintf(&b, " %d/%d", processed, total)
+
+	if eta > 0 {
+		fmt.Fprintf(&b, " · ETA %s", formatETA(eta))
+	}
+	if failed > 0 {
+		fmt.Fprintf(&b, " · %d failed", failed)
+	}
+
+	return b.String()
+}
+
+// formatETA renders a duration compactly, e.g. "45s" or "2m10s"
+func formatETA(d time.Duration) string {
+	d = d.Round(time.Second)
+	if d < time.Minute {
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	}
+	return fmt.Sprintf("%dm%02ds", int(d.Minutes()), int(d.Seconds())%60)
+}
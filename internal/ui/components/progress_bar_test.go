@@ -0,0 +1,40 @@
+package components
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProgressBar_Render(t *testing.T) {
+	tests := []struct {
+		name     string
+		done     int
+		failed   int
+		total    int
+		eta      time.Duration
+		expected string
+	}{
+		{"empty run", 0, 0, 0, 0, ""},
+		{"just started", 0, 0, 20, 0, "░░░░░░░░░░ 0/20"},
+		{"partway with ETA", 7, 0, 20, 45 * time.Second, "███░░░░░░░ 7/20 · ETA 45s"},
+		{"errors counted separately", 8, 2, 20, 2*time.Minute + 5*time.Second, "█████░░░░░ 10/20 · ETA 2m05s · 2 failed"},
+		{"complete", 20, 0, 20, 0, "██████████ 20/20"},
+	}
+
+	bar := NewProgressBar(10)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bar.Render(tt.done, tt.failed, tt.total, tt.eta); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestProgressBar_ClampsOverflow(t *testing.T) {
+	got := NewProgressBar(4).Render(5, 1, 3, 0)
+	if !strings.HasPrefix(got, "████ 3/3") {
+		t.Errorf("Expected bar clamped to total, got %q", got)
+	}
+}
@@ -0,0 +1,39 @@
+package components
+
+import "strings"
+
+// sparkBlocks are the bar heights of a sparkline, lowest first
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// sparkGap marks values that weren't recorded
+const sparkGap = '·'
+
+// Sparkline renders one cell per value, scaled between the smallest and
+// largest known value. Negative values are unknown and shown as gaps.
+func Sparkline(values []int) string {
+	low, high := -1, -1
+	for _, v := range values {
+		if v < 0 {
+			continue
+		}
+		if low < 0 || v < low {
+			low = v
+		}
+		if v > high {
+			high = v
+		}
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		switch {
+		case v < 0:
+			b.WriteRune(sparkGap)
+		case high == low:
+			b.WriteRune(sparkBlocks[len(sparkBlocks)/2])
+		default:
+			b.WriteRune(sparkBlocks[(v-low)*(len(sparkBlocks)-1)/(high-low)])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,25 @@
+package components
+
+import "testing"
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected string
+	}{
+		{"empty", nil, ""},
+		{"rising", []int{0, 7, 14}, "▁▄█"},
+		{"gaps for unknown days", []int{3, -1, 10}, "▁·█"},
+		{"flat", []int{5, 5}, "▅▅"},
+		{"nothing known", []int{-1, -1}, "··"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sparkline(tt.values); got != tt.expected {
+				t.Errorf("Sparkline(%v) = %q, want %q", tt.values, got, tt.expected)
+			}
+		})
+	}
+}
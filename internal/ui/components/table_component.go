@@ -21,6 +21,16 @@ func NewTableComponent() *TableComponent {
 	}
 }
 
+// NewTableComponentForWidth creates a table component sized for a terminal
+// width, as reported by tea.WindowSizeMsg
+func NewTableComponentForWidth(width int) *TableComponent {
+	formatter := formatters.NewPRFormatter()
+	return &TableComponent{
+		columns:   formatter.CreateTableColumnsForWidth(width),
+		formatter: formatter,
+	}
+}
+
 // CreateTable creates a new table with proper configuration
 func (tc *TableComponent) CreateTable() table.Model {
 	t := table.New(
@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/config"
+	"gopkg.in/yaml.v3"
+)
+
+// writeConfigTabs edits the tabs list of the config file at path and saves
+// it once the result is known to load, returning the loaded config
+func (m *MultiTabModel) writeConfigTabs(path string, edit func(tabs *yaml.Node) error) (*MultiTabConfig, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+	// #nosec G304 - the configuration file PR Compass was started with
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+	updated, err := editConfigTabs(data, edit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the new file loads before replacing the old one
+	check, err := os.CreateTemp(filepath.Dir(path), ".prcompass-edit-*.yaml")
+	if err != nil {
+		return nil, fmt.Errorf("failed to check config: %w", err)
+	}
+	defer os.Remove(check.Name()) // #nosec G104 - best effort cleanup
+	if _, err := check.Write(updated); err != nil {
+		check.Close() // #nosec G104 - the write error is the one to report
+		return nil, fmt.Errorf("failed to check config: %w", err)
+	}
+	if err := check.Close(); err != nil {
+		return nil, fmt.Errorf("failed to check config: %w", err)
+	}
+	multiConfig, err := LoadMultiTabConfigFromPath(check.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cache.WriteFile(path, updated, info.Mode().Perm()); err != nil {
+		return nil, fmt.Errorf("failed to write config: %w", err)
+	}
+	// The watcher sees this write; the caller updates the open tabs instead
+	m.configWritten = updated
+	return multiConfig, nil
+}
+
+// editConfigTabs applies edit to the tabs list of a config file's contents,
+// keeping its comments. A single-tab file is converted to tabs first.
+func editConfigTabs(data []byte, edit func(tabs *yaml.Node) error) ([]byte, error) {
+	data, _, err := config.ConvertToMultiTab(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+	root := doc.Content[0]
+	var tabs *yaml.Node
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == "tabs" {
+			tabs = root.Content[i+1]
+		}
+	}
+	if tabs == nil || tabs.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("the config's tabs aren't a list")
+	}
+	if err := edit(tabs); err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to encode config: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode config: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// configTabName returns the node holding a config tab's name, or nil
+func configTabName(tab *yaml.Node) *yaml.Node {
+	if tab.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(tab.Content); i += 2 {
+		if tab.Content[i].Value == "name" {
+			return tab.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// configTabIndex finds the tab named name in the config's tabs list
+func configTabIndex(tabs *yaml.Node, name string) (int, error) {
+	for i, tab := range tabs.Content {
+		if node := configTabName(tab); node != nil && node.Value == name {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("tab %q isn't in the config file", name)
+}
@@ -0,0 +1,218 @@
+package ui
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/fsnotify/fsnotify"
+)
+
+// configReloadDebounce lets an editor finish a save, often several events,
+// before the file is read
+const configReloadDebounce = 250 * time.Millisecond
+
+// configChangedMsg reports that the configuration file changed on disk
+type configChangedMsg struct{}
+
+// configWatcher watches the configuration file for changes
+type configWatcher struct {
+	watcher *fsnotify.Watcher
+	changes chan struct{}
+}
+
+// newConfigWatcher watches the file at path. The directory is watched rather
+// than the file, since editors often save by renaming a new file into place.
+func newConfigWatcher(path string) (*configWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch config: %w", err)
+	}
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		watcher.Close() // #nosec G104 - the add error is the one to report
+		return nil, fmt.Errorf("failed to watch config: %w", err)
+	}
+
+	w := &configWatcher{watcher: watcher, changes: make(chan struct{}, 1)}
+	go w.run(filepath.Clean(path))
+	return w, nil
+}
+
+// run turns file events into at most one pending change until the watcher closes
+func (w *configWatcher) run(path string) {
+	defer close(w.changes)
+
+	var debounce <-chan time.Time
+	for {
+		select {
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(event.Name) == path && !event.Has(fsnotify.Chmod) {
+				debounce = time.After(configReloadDebounce)
+			}
+		case _, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+		case <-debounce:
+			debounce = nil
+			select {
+			case w.changes <- struct{}{}:
+			default: // A change is already pending
+			}
+		}
+	}
+}
+
+// nextChangeCmd waits for the next change; it is issued again after each one
+func (w *configWatcher) nextChangeCmd() tea.Cmd {
+	return func() tea.Msg {
+		if _, ok := <-w.changes; !ok {
+			return nil
+		}
+		return configChangedMsg{}
+	}
+}
+
+// Close stops watching
+func (w *configWatcher) Close() error {
+	return w.watcher.Close()
+}
+
+// handleConfigChanged reloads the configuration, keeping the running one if
+// the new file doesn't load
+func (m *MultiTabModel) handleConfigChanged() (tea.Model, tea.Cmd) {
+	next := m.configWatcher.nextChangeCmd()
+
+	// A tab added in the app is already open
+	// #nosec G304 - the configuration file PR Compass was started with
+	if data, err := os.ReadFile(m.configPath); err == nil && m.configWritten != nil && bytes.Equal(data, m.configWritten) {
+		m.configWritten = nil
+		return m, next
+	}
+
+	multiConfig, err := LoadMultiTabConfigFromPath(m.configPath)
+	if err != nil {
+		m.Log.Warn("Config not reloaded", "path", m.configPath, "err", err)
+		if tab := m.TabManager.GetActiveTab(); tab != nil {
+			tab.StatusMsg = fmt.Sprintf("⚠️ Config not reloaded: %v", err)
+		}
+		return m, next
+	}
+
+	cmds := append(m.reloadConfig(multiConfig), next)
+	return m, tea.Batch(cmds...)
+}
+
+// reloadConfig applies a reloaded configuration. Tabs are matched by name:
+// new tabs are added, missing ones closed, and tabs whose scope or filters
+// changed fetch again. Refresh intervals apply from each tab's next refresh.
+func (m *MultiTabModel) reloadConfig(multiConfig *MultiTabConfig) []tea.Cmd {
+	m.applyGlobalConfig(multiConfig)
+
+	tm := m.TabManager
+	activeName := ""
+	if active := tm.GetActiveTab(); active != nil {
+		activeName = active.Config.Name
+	}
+	existing := make(map[string]*TabState, len(tm.Tabs))
+	for _, tab := range tm.Tabs {
+		existing[tab.Config.Name] = tab
+	}
+
+	var cmds []tea.Cmd
+	var changes []string
+	fetching := make(map[*TabState]bool)
+	tabs := make([]*TabState, 0, len(multiConfig.Tabs))
+	for i := range multiConfig.Tabs {
+		tabConfig := multiConfig.Tabs[i]
+		tab, found := existing[tabConfig.Name]
+		if !found {
+			// AddTab appends to the tab list, which is rebuilt below
+			tab = tm.AddTab(&tabConfig)
+			m.showCachedPreview(tab)
+			cmds = append(cmds, m.refreshCmdForTab(tab))
+			changes = append(changes, "added "+tabConfig.Name)
+		} else {
+			delete(existing, tabConfig.Name)
+			if !reflect.DeepEqual(*tab.Config, tabConfig) {
+				scopeChanged := !reflect.DeepEqual(tab.Config.ConvertToConfig(), tabConfig.ConvertToConfig())
+				tab.Config = &tabConfig
+				tm.scheduleTab(tab.Config)
+				if scopeChanged {
+					cmds = append(cmds, m.refetchTab(tab))
+					fetching[tab] = true
+				}
+				changes = append(changes, "updated "+tabConfig.Name)
+			}
+		}
+		if m.layoutBucket != "" {
+			m.applyLayoutToTab(tab)
+		}
+		tabs = append(tabs, tab)
+	}
+
+	var removed []string
+	for name, tab := range existing {
+		// Its refresh timer stops once it finds the tab gone
+		tab.Cancel()
+		if tm.refreshScheduler != nil {
+			tm.refreshScheduler.RemoveTab(name)
+		}
+		removed = append(removed, name)
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		changes = append(changes, "removed "+name)
+	}
+	tm.Tabs = tabs
+
+	tm.ActiveTabIdx = 0
+	for i, tab := range tm.Tabs {
+		if tab.Config.Name == activeName {
+			tm.ActiveTabIdx = i
+		}
+	}
+
+	// Palette and filter changes show in every tab's rows
+	m.refreshAllRows()
+	m.Log.Info("Config reloaded", "changes", strings.Join(changes, ", "))
+
+	if active := tm.GetActiveTab(); active != nil {
+		if !active.Loaded && !fetching[active] {
+			cmds = append(cmds, m.fetchPRsForTab(active))
+		}
+		active.StatusMsg = reloadNotice(changes)
+	}
+	return append(cmds, m.spinnerTickCmd())
+}
+
+// refetchTab drops a tab's PRs and fetches again after its scope changed,
+// cancelling any fetch still running for the old scope
+func (m *MultiTabModel) refetchTab(tab *TabState) tea.Cmd {
+	tab.Cancel()
+	tab.Ctx, tab.Cancel = context.WithCancel(m.TabManager.Ctx)
+	tab.Loaded = false
+	tab.Error = nil
+	tab.BackgroundRefreshing = false
+	m.setTabPRs(tab, nil)
+	m.showCachedPreview(tab)
+	return m.fetchPRsForTab(tab)
+}
+
+// reloadNotice summarises a reload for the status line
+func reloadNotice(changes []string) string {
+	if len(changes) == 0 {
+		return "🔄 Config reloaded"
+	}
+	return "🔄 Config reloaded: " + strings.Join(changes, ", ")
+}
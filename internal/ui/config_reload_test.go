@@ -0,0 +1,109 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestConfigWatcher tests that saving the config file reports a change
+func TestConfigWatcher(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(configPath, []byte("mode: repos\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	watcher, err := newConfigWatcher(configPath)
+	if err != nil {
+		t.Fatalf("newConfigWatcher() error = %v", err)
+	}
+	defer watcher.Close()
+
+	// Another file in the directory is not the config
+	if err := os.WriteFile(filepath.Join(filepath.Dir(configPath), "notes.json"), []byte("{}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(configPath, []byte("mode: search\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	changed := make(chan any, 1)
+	go func() { changed <- watcher.nextChangeCmd()() }()
+	select {
+	case msg := <-changed:
+		if _, ok := msg.(configChangedMsg); !ok {
+			t.Errorf("Expected configChangedMsg, got %T", msg)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected a change after saving the config")
+	}
+
+	watcher.Close()
+	if msg := watcher.nextChangeCmd()(); msg != nil {
+		t.Errorf("Expected no message once closed, got %T", msg)
+	}
+}
+
+// TestReloadConfig tests applying an edited config to running tabs
+func TestReloadConfig(t *testing.T) {
+	model, main := presetTestModel(t)
+	old := model.TabManager.AddTab(&TabConfig{Name: "Old", Mode: "repos", Repos: []string{"org/old"}})
+	model.TabManager.ActiveTabIdx = 1
+	model.configPath = filepath.Join(t.TempDir(), "config.yaml")
+	watcher, err := newConfigWatcher(model.configPath)
+	if err != nil {
+		t.Fatalf("newConfigWatcher() error = %v", err)
+	}
+	defer watcher.Close()
+	model.configWatcher = watcher
+
+	// A config that doesn't load leaves the tabs alone
+	if err := os.WriteFile(model.configPath, []byte("tabs: [\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	model.handleConfigChanged()
+	if len(model.TabManager.Tabs) != 2 || !strings.Contains(old.StatusMsg, "Config not reloaded") {
+		t.Fatalf("Expected the running config kept, got %d tabs and %q", len(model.TabManager.Tabs), old.StatusMsg)
+	}
+
+	configYAML := `refresh_interval_minutes: 10
+tabs:
+  - name: Team
+    mode: repos
+    repos: [org/team]
+  - name: Main
+    mode: repos
+    repos: [org/api, org/web]
+    refresh_interval_minutes: 2
+`
+	if err := os.WriteFile(model.configPath, []byte(configYAML), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, cmd := model.handleConfigChanged(); cmd == nil {
+		t.Fatal("Expected fetches for the new and changed tabs")
+	}
+
+	tabs := model.TabManager.Tabs
+	if len(tabs) != 2 || tabs[0].Config.Name != "Team" || tabs[1] != main {
+		t.Fatalf("Expected tabs Team and Main in config order, got %d tabs", len(tabs))
+	}
+	if old.Ctx.Err() == nil {
+		t.Error("Expected the removed tab to be cancelled")
+	}
+	if main.Loaded || len(main.PRs) != 0 || len(main.Config.Repos) != 2 {
+		t.Error("Expected Main to refetch for its new repos")
+	}
+	if main.Config.RefreshIntervalMinutes != 2 || model.TabManager.GlobalRefreshInterval != 10 {
+		t.Error("Expected the new refresh intervals")
+	}
+
+	// The active tab went away, so the first tab is shown with the notice
+	if model.TabManager.ActiveTabIdx != 0 {
+		t.Errorf("Expected the first tab active, got %d", model.TabManager.ActiveTabIdx)
+	}
+	want := "🔄 Config reloaded: added Team, updated Main, removed Old"
+	if tabs[0].StatusMsg != want {
+		t.Errorf("Expected %q, got %q", want, tabs[0].StatusMsg)
+	}
+}
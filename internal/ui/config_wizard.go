@@ -0,0 +1,316 @@
+package ui
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bjess9/pr-compass/internal/github"
+)
+
+// wizardModes are the tab modes the config wizard offers, in prompt order
+var wizardModes = []string{"repos", "organization", "teams", "topics", "search"}
+
+// ScopeChecker validates what a new tab will list against the API
+type ScopeChecker interface {
+	CheckRepository(ctx context.Context, repo string) error
+	CheckOrganization(ctx context.Context, org string) error
+	CheckTeam(ctx context.Context, org, slug string) error
+	CountSearchResults(ctx context.Context, query string) (int, error)
+}
+
+// GitHubScopeChecker checks scopes with the GitHub API using a token
+type GitHubScopeChecker struct {
+	Token string
+}
+
+// CheckRepository confirms the token can read a repository
+func (c GitHubScopeChecker) CheckRepository(ctx context.Context, repo string) error {
+	return github.CheckRepository(ctx, c.Token, repo)
+}
+
+// CheckOrganization confirms the token can see an organization
+func (c GitHubScopeChecker) CheckOrganization(ctx context.Context, org string) error {
+	return github.CheckOrganization(ctx, c.Token, org)
+}
+
+// CheckTeam confirms the token can see a team
+func (c GitHubScopeChecker) CheckTeam(ctx context.Context, org, slug string) error {
+	return github.CheckTeam(ctx, c.Token, org, slug)
+}
+
+// CountSearchResults counts the issues and PRs a search query matches
+func (c GitHubScopeChecker) CountSearchResults(ctx context.Context, query string) (int, error) {
+	return github.CountSearchResults(ctx, c.Token, query)
+}
+
+// ConfigFilePath returns where the configuration file is read from
+func ConfigFilePath() string {
+	return getConfigFilePath()
+}
+
+// configWizard asks for a first tab on the terminal
+type configWizard struct {
+	ctx     context.Context
+	in      *bufio.Scanner
+	out     io.Writer
+	checker ScopeChecker // nil skips API checks
+}
+
+// RunConfigWizard asks for a first tab's mode and scope, checks the scope
+// against the API when a checker is given, and writes a configuration file
+// with that tab to path. A tokenEnv is written as the profile's token_env.
+func RunConfigWizard(ctx context.Context, in io.Reader, out io.Writer, checker ScopeChecker, path, tokenEnv string) error {
+	w := &configWizard{ctx: ctx, in: bufio.NewScanner(in), out: out, checker: checker}
+	tab, err := w.askTab()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(renderWizardConfig(tab, tokenEnv)), 0o644); err != nil { // #nosec G306 - holds no secrets
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	if _, err := LoadMultiTabConfigFromPath(path); err != nil {
+		return fmt.Errorf("wrote %s, but it doesn't load: %w", path, err)
+	}
+	fmt.Fprintf(out, "\nWrote %s. Run pr-compass to start, and see example_config.yaml for more tabs and options.\n", path)
+	return nil
+}
+
+// askTab asks for everything the first tab needs
+func (w *configWizard) askTab() (TabConfig, error) {
+	tab := TabConfig{ExcludeBots: true, IncludeDrafts: true}
+
+	mode, err := w.ask(fmt.Sprintf("Mode (%s)", strings.Join(wizardModes, ", ")), "repos", func(answer string) error {
+		for _, mode := range wizardModes {
+			if answer == mode {
+				return nil
+			}
+		}
+		return fmt.Errorf("choose one of %s", strings.Join(wizardModes, ", "))
+	})
+	if err != nil {
+		return tab, err
+	}
+	tab.Mode = mode
+
+	defaultName := "Search"
+	switch mode {
+	case "repos":
+		if tab.Repos, err = w.askList("Repositories (owner/name, comma-separated)", w.checkRepository); err != nil {
+			return tab, err
+		}
+		_, defaultName, _ = strings.Cut(tab.Repos[0], "/")
+	case "organization":
+		if tab.Organization, err = w.ask("Organization", "", w.checkOrganization); err != nil {
+			return tab, err
+		}
+		defaultName = tab.Organization
+	case "teams":
+		if tab.Organization, err = w.ask("Organization", "", w.checkOrganization); err != nil {
+			return tab, err
+		}
+		if tab.Teams, err = w.askList("Team slugs (comma-separated)", func(slug string) error { return w.checkTeam(tab.Organization, slug) }); err != nil {
+			return tab, err
+		}
+		defaultName = tab.Teams[0]
+	case "topics":
+		if tab.TopicOrg, err = w.ask("Organization whose repos carry the topics", "", w.checkOrganization); err != nil {
+			return tab, err
+		}
+		if tab.Topics, err = w.askList("Topics (comma-separated)", nil); err != nil {
+			return tab, err
+		}
+		defaultName = tab.Topics[0]
+	case "search":
+		if tab.SearchQuery, err = w.ask("Search query, e.g. org:acme is:pr is:open label:urgent", "", w.checkSearch); err != nil {
+			return tab, err
+		}
+	}
+
+	if tab.Name, err = w.ask("Tab name", defaultName, nil); err != nil {
+		return tab, err
+	}
+	if tab.ExcludeBots, err = w.askYesNo("Hide PRs from bots like dependabot", true); err != nil {
+		return tab, err
+	}
+	if tab.IncludeDrafts, err = w.askYesNo("Include draft PRs", true); err != nil {
+		return tab, err
+	}
+	return tab, nil
+}
+
+// ask prompts until the answer passes check. An empty answer takes the
+// default; without a default an answer is required.
+func (w *configWizard) ask(prompt, defaultAnswer string, check func(string) error) (string, error) {
+	for {
+		if defaultAnswer != "" {
+			fmt.Fprintf(w.out, "%s [%s]: ", prompt, defaultAnswer)
+		} else {
+			fmt.Fprintf(w.out, "%s: ", prompt)
+		}
+		if !w.in.Scan() {
+			fmt.Fprintln(w.out)
+			return "", fmt.Errorf("input ended before the configuration was complete")
+		}
+
+		answer := strings.TrimSpace(w.in.Text())
+		if answer == "" {
+			answer = defaultAnswer
+		}
+		if answer == "" {
+			fmt.Fprintln(w.out, "  An answer is required.")
+			continue
+		}
+		if check != nil {
+			if err := check(answer); err != nil {
+				fmt.Fprintf(w.out, "  ✗ %v\n", err)
+				continue
+			}
+		}
+		return answer, nil
+	}
+}
+
+// askList prompts for comma-separated values until every one passes check
+func (w *configWizard) askList(prompt string, check func(string) error) ([]string, error) {
+	var values []string
+	_, err := w.ask(prompt, "", func(answer string) error {
+		values = values[:0]
+		var failed []string
+		for _, value := range strings.Split(answer, ",") {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				continue
+			}
+			if check != nil {
+				if err := check(value); err != nil {
+					failed = append(failed, err.Error())
+					continue
+				}
+			}
+			values = append(values, value)
+		}
+		if len(failed) > 0 {
+			return fmt.Errorf("%s", strings.Join(failed, "\n  ✗ "))
+		}
+		if len(values) == 0 {
+			return fmt.Errorf("an answer is required")
+		}
+		return nil
+	})
+	return values, err
+}
+
+// askYesNo prompts for a yes or no answer
+func (w *configWizard) askYesNo(prompt string, defaultYes bool) (bool, error) {
+	defaultAnswer := "n"
+	if defaultYes {
+		defaultAnswer = "y"
+	}
+	answer, err := w.ask(prompt+" (y/n)", defaultAnswer, func(answer string) error {
+		switch strings.ToLower(answer) {
+		case "y", "yes", "n", "no":
+			return nil
+		}
+		return fmt.Errorf("answer y or n")
+	})
+	return strings.HasPrefix(strings.ToLower(answer), "y"), err
+}
+
+// checkRepository checks a repository when the wizard has a checker
+func (w *configWizard) checkRepository(repo string) error {
+	if w.checker == nil {
+		return nil
+	}
+	if err := w.checker.CheckRepository(w.ctx, repo); err != nil {
+		return err
+	}
+	fmt.Fprintf(w.out, "  ✓ %s\n", repo)
+	return nil
+}
+
+// checkOrganization checks an organization when the wizard has a checker
+func (w *configWizard) checkOrganization(org string) error {
+	if w.checker == nil {
+		return nil
+	}
+	if err := w.checker.CheckOrganization(w.ctx, org); err != nil {
+		return err
+	}
+	fmt.Fprintf(w.out, "  ✓ %s\n", org)
+	return nil
+}
+
+// checkTeam checks a team when the wizard has a checker
+func (w *configWizard) checkTeam(org, slug string) error {
+	if w.checker == nil {
+		return nil
+	}
+	if err := w.checker.CheckTeam(w.ctx, org, slug); err != nil {
+		return err
+	}
+	fmt.Fprintf(w.out, "  ✓ %s/%s\n", org, slug)
+	return nil
+}
+
+// checkSearch runs a search query when the wizard has a checker, showing
+// how much it matches
+func (w *configWizard) checkSearch(query string) error {
+	if w.checker == nil {
+		return nil
+	}
+	count, err := w.checker.CountSearchResults(w.ctx, query)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w.out, "  ✓ %d matches\n", count)
+	return nil
+}
+
+// renderWizardConfig writes a configuration file with a single tab
+func renderWizardConfig(tab TabConfig, tokenEnv string) string {
+	var b strings.Builder
+	b.WriteString("# PR Compass configuration, written by `pr-compass init`.\n")
+	b.WriteString("# See example_config.yaml for more tabs and options.\n\n")
+	b.WriteString("refresh_interval_minutes: 5\n")
+	if tokenEnv != "" {
+		fmt.Fprintf(&b, "token_env: %s\n", strconv.Quote(tokenEnv))
+	}
+	b.WriteString("\n")
+	b.WriteString("tabs:\n")
+	fmt.Fprintf(&b, "  - name: %s\n", strconv.Quote(tab.Name))
+	fmt.Fprintf(&b, "    mode: %s\n", strconv.Quote(tab.Mode))
+
+	writeList := func(key string, values []string) {
+		fmt.Fprintf(&b, "    %s:\n", key)
+		for _, value := range values {
+			fmt.Fprintf(&b, "      - %s\n", strconv.Quote(value))
+		}
+	}
+	switch tab.Mode {
+	case "repos":
+		writeList("repos", tab.Repos)
+	case "organization":
+		fmt.Fprintf(&b, "    organization: %s\n", strconv.Quote(tab.Organization))
+	case "teams":
+		fmt.Fprintf(&b, "    organization: %s\n", strconv.Quote(tab.Organization))
+		writeList("teams", tab.Teams)
+	case "topics":
+		writeList("topics", tab.Topics)
+		fmt.Fprintf(&b, "    topic_org: %s\n", strconv.Quote(tab.TopicOrg))
+	case "search":
+		fmt.Fprintf(&b, "    search_query: %s\n", strconv.Quote(tab.SearchQuery))
+	}
+	fmt.Fprintf(&b, "    exclude_bots: %t\n", tab.ExcludeBots)
+	fmt.Fprintf(&b, "    include_drafts: %t\n", tab.IncludeDrafts)
+	return b.String()
+}
@@ -0,0 +1,119 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fakeScopeChecker knows a fixed set of repos, orgs and teams
+type fakeScopeChecker struct {
+	known map[string]bool
+}
+
+func (c fakeScopeChecker) check(name string) error {
+	if !c.known[name] {
+		return fmt.Errorf("%s: not found", name)
+	}
+	return nil
+}
+
+func (c fakeScopeChecker) CheckRepository(_ context.Context, repo string) error {
+	return c.check(repo)
+}
+
+func (c fakeScopeChecker) CheckOrganization(_ context.Context, org string) error {
+	return c.check(org)
+}
+
+func (c fakeScopeChecker) CheckTeam(_ context.Context, org, slug string) error {
+	return c.check(org + "/" + slug)
+}
+
+func (c fakeScopeChecker) CountSearchResults(_ context.Context, query string) (int, error) {
+	return 12, c.check(query)
+}
+
+// TestConfigWizard tests that the wizard re-asks for scopes the API doesn't
+// know and writes a loadable config
+func TestConfigWizard(t *testing.T) {
+	checker := fakeScopeChecker{known: map[string]bool{"acme/api": true, "acme/web": true, "acme": true, "acme/backend": true}}
+	tests := []struct {
+		name    string
+		input   string
+		want    TabConfig
+		prompts []string
+	}{
+		{
+			name:    "repos with a typo",
+			input:   "\nacme/api, acme/wbe\nacme/api,acme/web\n\nn\n\n",
+			want:    TabConfig{Name: "api", Mode: "repos", Repos: []string{"acme/api", "acme/web"}, IncludeDrafts: true},
+			prompts: []string{"Mode (repos, organization, teams, topics, search) [repos]:", "✗ acme/wbe: not found", "✓ acme/web", "Tab name [api]:"},
+		},
+		{
+			name:    "teams",
+			input:   "team\nteams\nacmee\nacme\nbackend\nBackend PRs\ny\nno\n",
+			want:    TabConfig{Name: "Backend PRs", Mode: "teams", Organization: "acme", Teams: []string{"backend"}, ExcludeBots: true},
+			prompts: []string{"choose one of repos, organization, teams, topics, search", "✗ acmee: not found", "✓ acme/backend"},
+		},
+		{
+			name:    "topics",
+			input:   "topics\nacme\n\nplatform, go\n\n\n\n",
+			want:    TabConfig{Name: "platform", Mode: "topics", TopicOrg: "acme", Topics: []string{"platform", "go"}, ExcludeBots: true, IncludeDrafts: true},
+			prompts: []string{"An answer is required."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			var out strings.Builder
+			if err := RunConfigWizard(context.Background(), strings.NewReader(tt.input), &out, checker, path, ""); err != nil {
+				t.Fatalf("RunConfigWizard() error = %v\n%s", err, out.String())
+			}
+			for _, prompt := range tt.prompts {
+				if !strings.Contains(out.String(), prompt) {
+					t.Errorf("Expected %q in the output:\n%s", prompt, out.String())
+				}
+			}
+
+			loaded, err := LoadMultiTabConfigFromPath(path)
+			if err != nil || len(loaded.Tabs) != 1 {
+				t.Fatalf("Expected one loadable tab, got %v", err)
+			}
+			got := loaded.Tabs[0]
+			if got.Name != tt.want.Name || got.Mode != tt.want.Mode || got.Organization != tt.want.Organization ||
+				got.TopicOrg != tt.want.TopicOrg || strings.Join(got.Repos, ",") != strings.Join(tt.want.Repos, ",") ||
+				strings.Join(got.Teams, ",") != strings.Join(tt.want.Teams, ",") || strings.Join(got.Topics, ",") != strings.Join(tt.want.Topics, ",") ||
+				got.ExcludeBots != tt.want.ExcludeBots || got.IncludeDrafts != tt.want.IncludeDrafts {
+				t.Errorf("Expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestConfigWizardEndsWithInput tests giving up when input runs out, and
+// skipping API checks without a checker
+func TestConfigWizardEndsWithInput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	var out strings.Builder
+	if err := RunConfigWizard(context.Background(), strings.NewReader("search\n"), &out, nil, path, ""); err == nil {
+		t.Error("Expected an error when input ends early")
+	}
+
+	// A new profile's directory is created, and its token variable recorded
+	path = filepath.Join(t.TempDir(), "profiles", "work.yaml")
+	input := "search\norg:acme is:pr is:open label:\"needs review\"\n\n\n\n"
+	if err := RunConfigWizard(context.Background(), strings.NewReader(input), &out, nil, path, "WORK_GITHUB_TOKEN"); err != nil {
+		t.Fatalf("RunConfigWizard() error = %v", err)
+	}
+	loaded, err := LoadMultiTabConfigFromPath(path)
+	if err != nil || loaded.Tabs[0].SearchQuery != `org:acme is:pr is:open label:"needs review"` || loaded.Tabs[0].Name != "Search" {
+		t.Errorf("Expected the quoted search query to round-trip, got %+v (%v)", loaded, err)
+	}
+	if loaded.TokenEnv != "WORK_GITHUB_TOKEN" {
+		t.Errorf("Expected the profile's token_env, got %q", loaded.TokenEnv)
+	}
+}
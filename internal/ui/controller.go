@@ -64,6 +64,15 @@ func (c *UIController) FilterDraftPRs(prs []*types.PRData) []*types.PRData {
 	return c.services.Filter.FilterPRs(prs, filter)
 }
 
+// FilterOversizedPRs returns only PRs whose changes exceed the review size budget
+func (c *UIController) FilterOversizedPRs(prs []*types.PRData, budget int) []*types.PRData {
+	filter := types.FilterOptions{
+		Mode:  "size",
+		Value: fmt.Sprintf("%d", budget),
+	}
+	return c.services.Filter.FilterPRs(prs, filter)
+}
+
 // FetchPRsForTab fetches PRs for a specific tab configuration
 func (c *UIController) FetchPRsForTab(ctx context.Context, tabConfig *TabConfig) ([]*types.PRData, error) {
 	config := tabConfig.ConvertToConfig()
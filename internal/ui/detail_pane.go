@@ -0,0 +1,291 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// detailPaneHeight is the number of content lines the detail pane shows at once
+const detailPaneHeight = 12
+
+// htmlComment matches the hidden guidance PR templates leave in descriptions
+var htmlComment = regexp.MustCompile(`(?s)<!--.*?-->`)
+
+// prDetailsMsg delivers the review timeline fetched for the detail pane
+type prDetailsMsg struct {
+	key     string
+	details *github.PRDetails
+	err     error
+}
+
+// prDetailsCmd fetches review details for the selected PR unless they are
+// already current or being fetched
+func (m *MultiTabModel) prDetailsCmd(tab *TabState) tea.Cmd {
+	pr := tab.SelectedPR()
+	if pr == nil || m.readOnly() {
+		return nil
+	}
+	key := services.PRKey(pr)
+	if !tab.Config.OnGitHub() {
+		m.prDetailsErrors[key] = errGitHubOnly
+		return nil
+	}
+	if details, known := m.prDetails[key]; known && !pr.GetUpdatedAt().After(details.FetchedAt) {
+		return nil
+	}
+	if m.prDetailsLoading[key] {
+		return nil
+	}
+	m.prDetailsLoading[key] = true
+
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 10*time.Second)
+		defer cancel()
+
+		details, err := github.FetchPRDetails(ctx, token, pr)
+		return prDetailsMsg{key: key, details: details, err: err}
+	}
+}
+
+// handlePRDetails stores a fetched review timeline for the detail pane
+func (m *MultiTabModel) handlePRDetails(msg prDetailsMsg) (tea.Model, tea.Cmd) {
+	delete(m.prDetailsLoading, msg.key)
+	if msg.err != nil {
+		m.prDetailsErrors[msg.key] = msg.err
+		return m, nil
+	}
+	delete(m.prDetailsErrors, msg.key)
+	m.prDetails[msg.key] = msg.details
+	return m, nil
+}
+
+// toggleDetails shows or hides the detail pane, making room for it in the table
+func (m *MultiTabModel) toggleDetails(tab *TabState) tea.Cmd {
+	tab.ShowDetails = !tab.ShowDetails
+	tab.DetailScroll = 0
+	tab.Table.SetHeight(m.calculateTableHeight(tab))
+	if tab.ShowDetails {
+		return m.followSelection(tab)
+	}
+	return nil
+}
+
+// scrollDetails moves the detail pane by delta lines; clamping happens on render
+func (tab *TabState) scrollDetails(delta int) {
+	tab.DetailScroll += delta
+	if tab.DetailScroll < 0 {
+		tab.DetailScroll = 0
+	}
+}
+
+// renderDetailPane renders the scrollable description and review pane for the selected PR
+func (m *MultiTabModel) renderDetailPane(tab *TabState) string {
+	pr := tab.SelectedPR()
+	if pr == nil {
+		return ""
+	}
+
+	width := m.Width - 8 // Border and padding
+	if width < 30 {
+		width = 30
+	}
+	lines := m.detailLines(pr, width, time.Now())
+
+	// Clamp here so the offset tracks content that loads or changes later
+	maxScroll := len(lines) - detailPaneHeight
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+	if tab.DetailScroll > maxScroll {
+		tab.DetailScroll = maxScroll
+	}
+	end := tab.DetailScroll + detailPaneHeight
+	if end > len(lines) {
+		end = len(lines)
+	}
+	visible := lines[tab.DetailScroll:end]
+
+	title := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.TextBright)).
+		Render(clipText(fmt.Sprintf("📄 #%d %s", pr.GetNumber(), pr.GetTitle()), width))
+	footer := ""
+	if maxScroll > 0 {
+		footer = "\n" + mutedStyle.Render(fmt.Sprintf("lines %d-%d of %d · PgUp/PgDn or K/J to scroll · v to close", tab.DetailScroll+1, end, len(lines)))
+	}
+	return "\n" + repoInfoStyle.Width(width+4).Render(title+"\n"+strings.Join(visible, "\n")+footer)
+}
+
+// detailLines lays out reviewers, the review timeline and the description,
+// wrapped to width
+func (m *MultiTabModel) detailLines(pr *gh.PullRequest, width int, now time.Time) []string {
+	key := services.PRKey(pr)
+	details := m.prDetails[key]
+
+	lines := m.noteLines(pr, width, now)
+	lines = append(lines, m.ticketLines(pr, width)...)
+
+	// Requested reviewers from the fresh fetch, falling back to the list payload
+	var users, teams []string
+	if details != nil {
+		users, teams = details.RequestedReviewers, details.RequestedTeams
+	} else {
+		for _, user := range pr.RequestedReviewers {
+			users = append(users, user.GetLogin())
+		}
+		for _, team := range pr.RequestedTeams {
+			teams = append(teams, team.GetSlug())
+		}
+	}
+	requested := users
+	for _, team := range teams {
+		requested = append(requested, "team:"+team)
+	}
+	if len(requested) == 0 {
+		lines = append(lines, "👀 Requested reviewers: none")
+	} else {
+		lines = append(lines, "👀 Requested reviewers: "+strings.Join(requested, ", "))
+	}
+
+	lines = append(lines, "🗓️  Reviews:")
+	switch {
+	case m.readOnly():
+		lines = append(lines, mutedStyle.Render("   Review timeline is unavailable without authentication"))
+	case details != nil && len(details.Reviews) == 0:
+		lines = append(lines, mutedStyle.Render("   No reviews yet"))
+	case details != nil:
+		for _, review := range details.Reviews {
+			lines = append(lines, fmt.Sprintf("   %s %s %s · %s", reviewStateIcon(review.State), review.Reviewer, reviewStateVerb(review.State), formatAge(now.Sub(review.SubmittedAt))))
+			if summary := firstLine(review.Body); summary != "" {
+				lines = append(lines, mutedStyle.Render("      "+clipText(summary, width-6)))
+			}
+		}
+	case m.prDetailsErrors[key] != nil:
+		lines = append(lines, "   🚫 "+m.prDetailsErrors[key].Error())
+	default:
+		lines = append(lines, "   ⏳ Loading reviews...")
+	}
+
+	if details != nil && (len(details.FailingChecks) > 0 || details.ChecksErr != nil) {
+		lines = append(lines, "")
+		lines = append(lines, m.failingCheckLines(details, width)...)
+	}
+
+	if details != nil && len(details.MissingChecks) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, m.missingCheckLines(details, width)...)
+	}
+
+	if details != nil {
+		lines = append(lines, "")
+		lines = append(lines, coverageLines(details, width)...)
+	}
+
+	lines = append(lines, "", "📝 Description:")
+	body := strings.TrimSpace(htmlComment.ReplaceAllString(strings.ReplaceAll(pr.GetBody(), "\r\n", "\n"), ""))
+	if body == "" {
+		return append(lines, mutedStyle.Render("   No description provided."))
+	}
+	wrap := lipgloss.NewStyle().Width(width)
+	for _, paragraph := range strings.Split(body, "\n") {
+		lines = append(lines, strings.Split(wrap.Render(paragraph), "\n")...)
+	}
+	return lines
+}
+
+// coverageLines maps current approvers onto the directories they own and
+// flags changed paths that no owner has approved
+func coverageLines(details *github.PRDetails, width int) []string {
+	lines := []string{"🧭 Owner coverage:"}
+	coverage := details.Coverage
+	switch {
+	case details.CoverageErr != nil:
+		return append(lines, "   🚫 "+details.CoverageErr.Error())
+	case coverage == nil:
+		return lines
+	case !coverage.HasCodeowners:
+		return append(lines, mutedStyle.Render("   No CODEOWNERS file on the base branch"))
+	}
+
+	dirs := coverage.ApproverDirs()
+	for _, approver := range coverage.Approvers {
+		if owned := dirs[approver]; len(owned) > 0 {
+			lines = append(lines, clipText(fmt.Sprintf("   ✅ %s owns %s", approver, strings.Join(owned, ", ")), width))
+		} else {
+			lines = append(lines, fmt.Sprintf("   ⚠️  %s approved but owns none of the changes", approver))
+		}
+	}
+
+	uncovered := coverage.Uncovered()
+	switch {
+	case len(uncovered) > 0:
+		lines = append(lines, fmt.Sprintf("   ⚠️  %d changed paths lack an owner's approval:", len(uncovered)))
+		for _, p := range uncovered {
+			lines = append(lines, clipText(fmt.Sprintf("      %s (%s)", p.Path, strings.Join(p.Owners, " ")), width))
+		}
+	case len(coverage.Paths) > 0:
+		lines = append(lines, "   ✅ Every owned path has an owner's approval")
+	}
+	if coverage.Truncated {
+		lines = append(lines, mutedStyle.Render(fmt.Sprintf("   Only the first %d changed files were checked", len(coverage.Paths))))
+	}
+	for _, team := range coverage.UnresolvedTeams {
+		lines = append(lines, mutedStyle.Render(clipText(fmt.Sprintf("   Couldn't list members of %s - their approvals aren't counted", team), width)))
+	}
+	return lines
+}
+
+// reviewStateIcon marks a review state in the timeline
+func reviewStateIcon(state string) string {
+	switch state {
+	case "APPROVED":
+		return theme.Passed
+	case "CHANGES_REQUESTED":
+		return theme.Failed
+	case "DISMISSED":
+		return "🚮"
+	default:
+		return "💬"
+	}
+}
+
+// reviewStateVerb describes a review state in the timeline
+func reviewStateVerb(state string) string {
+	switch state {
+	case "APPROVED":
+		return "approved"
+	case "CHANGES_REQUESTED":
+		return "requested changes"
+	case "DISMISSED":
+		return "review dismissed"
+	default:
+		return "commented"
+	}
+}
+
+// firstLine returns the first non-empty line of text
+func firstLine(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+// clipText shortens text to at most width runes, marking the cut with "..."
+func clipText(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) <= width || width < 4 {
+		return text
+	}
+	return string(runes[:width-3]) + "..."
+}
@@ -0,0 +1,191 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+func detailTestModel(token string) (*MultiTabModel, *TabState) {
+	model := NewMultiTabModel(token, nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Main", Mode: "repos", Repos: []string{"org/api"}})
+	pr := &gh.PullRequest{
+		Number:             gh.Int(12),
+		Title:              gh.String("Add retries"),
+		Body:               gh.String("<!-- template: describe the change -->\r\nRetries failed uploads.\r\n\r\n" + strings.Repeat("More context.\n", 30)),
+		User:               &gh.User{Login: gh.String("alice")},
+		RequestedReviewers: []*gh.User{{Login: gh.String("stale")}},
+		Base:               &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/api")}},
+	}
+	tab.PRs = []*gh.PullRequest{pr}
+	tab.FilteredPRs = tab.PRs
+	tab.Loaded = true
+	model.updateTableRows(tab)
+	return model, tab
+}
+
+// TestDetailPaneToggleAndScroll tests the v toggle, table resizing and scrolling
+func TestDetailPaneToggleAndScroll(t *testing.T) {
+	model, tab := detailTestModel("test-token")
+	fullHeight := model.calculateTableHeight(tab)
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	if !tab.ShowDetails || cmd == nil {
+		t.Fatal("Expected 'v' to open the detail pane and fetch reviews")
+	}
+	if !model.prDetailsLoading["org/api#12"] {
+		t.Error("Expected review fetch to be in flight")
+	}
+	if height := model.calculateTableHeight(tab); height >= fullHeight {
+		t.Errorf("Expected table to shrink for the pane, got %d (was %d)", height, fullHeight)
+	}
+
+	view := model.renderDetailPane(tab)
+	if !strings.Contains(view, "Loading reviews") || !strings.Contains(view, "stale") {
+		t.Errorf("Expected loading timeline with list reviewers, got:\n%s", view)
+	}
+	if strings.Contains(view, "template") {
+		t.Error("Expected HTML comments to be stripped from the description")
+	}
+
+	// Scrolling past the end clamps to the last page
+	for i := 0; i < 20; i++ {
+		model.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+	}
+	model.renderDetailPane(tab)
+	lines := model.detailLines(tab.PRs[0], 80, time.Now())
+	if tab.DetailScroll != len(lines)-detailPaneHeight {
+		t.Errorf("Expected scroll clamped to %d, got %d", len(lines)-detailPaneHeight, tab.DetailScroll)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("K")})
+	if tab.DetailScroll != len(lines)-detailPaneHeight-detailPaneHeight/2 {
+		t.Errorf("Expected K to scroll up half a page, got %d", tab.DetailScroll)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	if tab.ShowDetails || model.calculateTableHeight(tab) != fullHeight {
+		t.Error("Expected 'v' to close the pane and restore the table height")
+	}
+}
+
+// TestDetailPaneTimeline tests rendering of fetched reviews and errors
+func TestDetailPaneTimeline(t *testing.T) {
+	model, tab := detailTestModel("test-token")
+	pr := tab.PRs[0]
+	key := services.PRKey(pr)
+	now := time.Now()
+
+	model.handlePRDetails(prDetailsMsg{key: key, err: errors.New("rate limited")})
+	if text := strings.Join(model.detailLines(pr, 80, now), "\n"); !strings.Contains(text, "rate limited") {
+		t.Errorf("Expected fetch error in pane, got:\n%s", text)
+	}
+
+	model.handlePRDetails(prDetailsMsg{key: key, details: &github.PRDetails{
+		Reviews: []github.ReviewEvent{
+			{Reviewer: "bob", State: "CHANGES_REQUESTED", Body: "\nPlease add tests", SubmittedAt: now.Add(-3 * time.Hour)},
+			{Reviewer: "carol", State: "APPROVED", SubmittedAt: now.Add(-time.Hour)},
+		},
+		RequestedReviewers: []string{"erin"},
+		RequestedTeams:     []string{"platform"},
+		FetchedAt:          now,
+	}})
+	if model.prDetailsErrors[key] != nil {
+		t.Error("Expected successful fetch to clear the error")
+	}
+
+	text := strings.Join(model.detailLines(pr, 80, now), "\n")
+	for _, want := range []string{"erin, team:platform", "❌ bob requested changes · 3h ago", "Please add tests", "✅ carol approved · 1h ago", "Retries failed uploads."} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected %q in pane, got:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "stale") {
+		t.Error("Expected fetched reviewers to replace the list payload")
+	}
+
+	// Current details aren't fetched again
+	tab.ShowDetails = true
+	if cmd := model.prDetailsCmd(tab); cmd != nil {
+		t.Error("Expected no refetch for unchanged PR")
+	}
+}
+
+// TestDetailPaneReadOnly tests that public mode shows the description without fetching
+func TestDetailPaneReadOnly(t *testing.T) {
+	model, tab := detailTestModel("")
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	if len(model.prDetailsLoading) != 0 {
+		t.Error("Expected no review fetch without a token")
+	}
+	if view := model.renderDetailPane(tab); !strings.Contains(view, "unavailable without authentication") {
+		t.Errorf("Expected read-only timeline notice, got:\n%s", view)
+	}
+}
+
+// TestDetailPaneOwnerCoverage tests mapping approvers to owned directories
+func TestDetailPaneOwnerCoverage(t *testing.T) {
+	model, tab := detailTestModel("test-token")
+	pr := tab.PRs[0]
+	model.prDetails["org/api#12"] = &github.PRDetails{
+		Reviews: []github.ReviewEvent{{Reviewer: "carol", State: "APPROVED"}, {Reviewer: "zed", State: "APPROVED"}},
+		Coverage: &github.ReviewCoverage{
+			HasCodeowners: true,
+			Approvers:     []string{"carol", "zed"},
+			Paths: []github.PathCoverage{
+				{Path: "api/retry.go", Owners: []string{"@carol"}, ApprovedBy: []string{"carol"}},
+				{Path: "web/upload.ts", Owners: []string{"@org/frontend"}},
+			},
+			UnresolvedTeams: []string{"@org/frontend"},
+		},
+		FetchedAt: time.Now(),
+	}
+
+	text := strings.Join(model.detailLines(pr, 100, time.Now()), "\n")
+	for _, want := range []string{"carol owns api/", "zed approved but owns none", "1 changed paths lack", "web/upload.ts (@org/frontend)", "Couldn't list members of @org/frontend"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected %q in detail pane, got:\n%s", want, text)
+		}
+	}
+
+	model.prDetails["org/api#12"].Coverage = nil
+	model.prDetails["org/api#12"].CoverageErr = errors.New("rate limited")
+	if text := strings.Join(model.detailLines(pr, 100, time.Now()), "\n"); !strings.Contains(text, "🚫 rate limited") {
+		t.Errorf("Expected coverage error in detail pane, got:\n%s", text)
+	}
+}
+
+// TestDetailPaneFailingCheckHints tests hinting the owners of failing checks
+func TestDetailPaneFailingCheckHints(t *testing.T) {
+	model, tab := detailTestModel("test-token")
+	pr := tab.PRs[0]
+	model.CheckHints = []CheckHint{{Checks: "e2e-*", Owner: "@org/qa", URL: "https://wiki.example.com/e2e"}}
+	model.prDetails["org/api#12"] = &github.PRDetails{
+		FailingChecks: []github.FailingCheck{
+			{Name: "e2e-chrome", URL: "https://ci.example.com/1"},
+			{Name: "security/snyk", URL: "https://snyk.example.com/2"},
+		},
+		FetchedAt: time.Now(),
+	}
+
+	text := strings.Join(model.detailLines(pr, 100, time.Now()), "\n")
+	for _, want := range []string{"🚦 Failing checks:", "❌ e2e-chrome", "ask @org/qa · https://wiki.example.com/e2e", "❌ security/snyk", "https://snyk.example.com/2"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected %q in detail pane, got:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "https://ci.example.com/1") {
+		t.Errorf("Expected the hint to replace the check link, got:\n%s", text)
+	}
+
+	model.prDetails["org/api#12"].FailingChecks = nil
+	if text := strings.Join(model.detailLines(pr, 100, time.Now()), "\n"); strings.Contains(text, "Failing checks") {
+		t.Errorf("Expected no failing checks section, got:\n%s", text)
+	}
+}
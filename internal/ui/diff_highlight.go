@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// lineCommentMarkers maps file extensions, or names of extensionless files,
+// to the marker starting a comment. Files not listed get diff colors only.
+var lineCommentMarkers = map[string]string{
+	".go": "//", ".js": "//", ".jsx": "//", ".ts": "//", ".tsx": "//", ".java": "//", ".kt": "//",
+	".scala": "//", ".swift": "//", ".c": "//", ".h": "//", ".cc": "//", ".cpp": "//", ".hpp": "//",
+	".cs": "//", ".rs": "//", ".php": "//", ".proto": "//",
+	".py": "#", ".rb": "#", ".sh": "#", ".bash": "#", ".zsh": "#", ".yaml": "#", ".yml": "#",
+	".toml": "#", ".tf": "#", ".pl": "#", ".r": "#", "makefile": "#", "dockerfile": "#",
+	".sql": "--", ".lua": "--", ".hs": "--",
+}
+
+// codeKeywords are emphasized in highlighted code. One set serves every
+// language; words that are keywords only elsewhere rarely get in the way.
+var codeKeywords = map[string]bool{
+	"break": true, "case": true, "catch": true, "class": true, "const": true, "continue": true,
+	"def": true, "default": true, "defer": true, "do": true, "elif": true, "else": true,
+	"enum": true, "export": true, "extends": true, "false": true, "finally": true, "fn": true,
+	"for": true, "from": true, "func": true, "function": true, "go": true, "if": true,
+	"impl": true, "import": true, "in": true, "interface": true, "let": true, "match": true,
+	"mut": true, "new": true, "nil": true, "None": true, "null": true, "package": true,
+	"pub": true, "range": true, "return": true, "select": true, "self": true, "static": true,
+	"struct": true, "switch": true, "this": true, "throw": true, "true": true, "True": true,
+	"False": true, "try": true, "type": true, "var": true, "while": true, "with": true, "yield": true,
+}
+
+// commentMarker returns the line comment marker of a file's language, or ""
+// if the language isn't known
+func commentMarker(filename string) string {
+	name := strings.ToLower(path.Base(filename))
+	if marker, ok := lineCommentMarkers[path.Ext(name)]; ok {
+		return marker
+	}
+	return lineCommentMarkers[name]
+}
+
+// highlightCode colors one line of code: keywords bold, strings in the
+// warning color and comments muted, everything else in base. Without a
+// comment marker the language is unknown and the line is only colored base.
+func highlightCode(code, marker string, base lipgloss.Style) string {
+	if marker == "" || code == "" {
+		return base.Render(code)
+	}
+	keyword := base.Bold(true)
+	str := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Warning))
+	comment := lipgloss.NewStyle().Foreground(lipgloss.Color(colors.TextMuted)).Italic(true)
+
+	var out, plain strings.Builder
+	flush := func() {
+		if plain.Len() > 0 {
+			out.WriteString(base.Render(plain.String()))
+			plain.Reset()
+		}
+	}
+
+	runes := []rune(code)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case strings.HasPrefix(string(runes[i:]), marker):
+			flush()
+			out.WriteString(comment.Render(string(runes[i:])))
+			return out.String()
+		case r == '"' || r == '\'' || r == '`':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				if runes[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			end = min(end+1, len(runes))
+			flush()
+			out.WriteString(str.Render(string(runes[i:end])))
+			i = end
+		case unicode.IsLetter(r) || r == '_':
+			end := i + 1
+			for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end]) || runes[end] == '_') {
+				end++
+			}
+			if word := string(runes[i:end]); codeKeywords[word] {
+				flush()
+				out.WriteString(keyword.Render(word))
+			} else {
+				plain.WriteString(word)
+			}
+			i = end
+		default:
+			plain.WriteRune(r)
+			i++
+		}
+	}
+	flush()
+	return out.String()
+}
@@ -0,0 +1,237 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// diffTabWidth is how many spaces a tab in a diff line takes
+const diffTabWidth = 4
+
+// diffView is the overlay showing one PR's diff in place of the table.
+// While open it receives every key press.
+type diffView struct {
+	tabName string
+	pr      *gh.PullRequest
+	scroll  int // First visible line
+}
+
+// prDiffMsg delivers the changed files fetched for the diff viewer
+type prDiffMsg struct {
+	key  string
+	diff *github.PRDiff
+	err  error
+}
+
+// openDiff shows the selected PR's diff, fetching it unless it is current
+func (m *MultiTabModel) openDiff(tab *TabState) tea.Cmd {
+	switch {
+	case m.readOnly():
+		tab.StatusMsg = "Read-only mode: set GITHUB_TOKEN to view diffs"
+		return nil
+	case !tab.Config.OnGitHub():
+		tab.StatusMsg = gitHubOnlyMsg
+		return nil
+	}
+	pr := tab.SelectedPR()
+	if pr == nil {
+		tab.StatusMsg = "No PR selected"
+		return nil
+	}
+
+	key := services.PRKey(pr)
+	m.diffView = &diffView{tabName: tab.Config.Name, pr: pr}
+	tab.StatusMsg = fmt.Sprintf("🔍 Reviewing the diff of %s", key)
+	if diff, known := m.prDiffs[key]; known && !pr.GetUpdatedAt().After(diff.FetchedAt) {
+		return nil
+	}
+	if m.prDiffsLoading[key] {
+		return nil
+	}
+	delete(m.prDiffErrors, key)
+	m.prDiffsLoading[key] = true
+
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 20*time.Second)
+		defer cancel()
+
+		diff, err := github.FetchPRDiff(ctx, token, pr)
+		return prDiffMsg{key: key, diff: diff, err: err}
+	}
+}
+
+// handlePRDiff stores a fetched diff; an open viewer picks it up on render
+func (m *MultiTabModel) handlePRDiff(msg prDiffMsg) (tea.Model, tea.Cmd) {
+	delete(m.prDiffsLoading, msg.key)
+	if msg.err != nil {
+		m.prDiffErrors[msg.key] = msg.err
+		return m, nil
+	}
+	delete(m.prDiffErrors, msg.key)
+	m.prDiffs[msg.key] = msg.diff
+	return m, nil
+}
+
+// diffViewHeight is the number of diff lines the viewer shows at once
+func (m *MultiTabModel) diffViewHeight() int {
+	return max(5, m.controller.CalculateTableHeight(m.Height))
+}
+
+// handleDiffKey scrolls the viewer, jumps between files or closes it
+func (m *MultiTabModel) handleDiffKey(tab *TabState, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	view := m.diffView
+	_, starts := m.diffLines(view.pr, m.diffWidth())
+	page := m.diffViewHeight()
+
+	switch msg.String() {
+	case "esc", "q", "V", "ctrl+c":
+		m.diffView = nil
+		tab.StatusMsg = ""
+	case "down", "j":
+		view.scroll++
+	case "up", "k":
+		view.scroll--
+	case "pgdown", "J", " ", "f":
+		view.scroll += page
+	case "pgup", "K", "b":
+		view.scroll -= page
+	case "home", "g":
+		view.scroll = 0
+	case "end", "G":
+		view.scroll = 1 << 30 // Clamped when rendered
+	case "n", "]", "tab":
+		for _, start := range starts {
+			if start > view.scroll {
+				view.scroll = start
+				break
+			}
+		}
+	case "p", "[", "shift+tab":
+		for i := len(starts) - 1; i >= 0; i-- {
+			if starts[i] < view.scroll {
+				view.scroll = starts[i]
+				break
+			}
+		}
+	}
+	if view.scroll < 0 {
+		view.scroll = 0
+	}
+	return m, nil
+}
+
+// diffWidth is the width diff lines are clipped to
+func (m *MultiTabModel) diffWidth() int {
+	return max(30, m.Width-8) // Border and padding
+}
+
+// diffLines lays out the PR's files with their colored hunks, returning the
+// lines and the index of each file's header line
+func (m *MultiTabModel) diffLines(pr *gh.PullRequest, width int) ([]string, []int) {
+	key := services.PRKey(pr)
+	diff := m.prDiffs[key]
+	switch {
+	case m.prDiffsLoading[key]:
+		return []string{mutedStyle.Render("⏳ Loading diff...")}, nil
+	case m.prDiffErrors[key] != nil:
+		return []string{errorStyle.Render(fmt.Sprintf("Couldn't load the diff: %v", m.prDiffErrors[key]))}, nil
+	case diff == nil:
+		return nil, nil
+	case len(diff.Files) == 0:
+		return []string{mutedStyle.Render("No changed files")}, nil
+	}
+
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.TextBright))
+	hunkStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Info))
+	addStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Success))
+	removeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Error))
+	contextStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Text))
+
+	var lines []string
+	var starts []int
+	for i, file := range diff.Files {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		starts = append(starts, len(lines))
+		name := file.Filename
+		if file.PreviousFilename != "" {
+			name = file.PreviousFilename + " → " + file.Filename
+		}
+		header := fmt.Sprintf("━━ %s (%s) +%d -%d", name, file.Status, file.Additions, file.Deletions)
+		lines = append(lines, headerStyle.Render(clipText(header, width)))
+
+		if file.Patch == "" {
+			lines = append(lines, mutedStyle.Render("Binary file, or diff too large to show here"))
+			continue
+		}
+		marker := commentMarker(file.Filename)
+		for _, line := range strings.Split(file.Patch, "\n") {
+			line = clipText(strings.ReplaceAll(line, "\t", strings.Repeat(" ", diffTabWidth)), width)
+			switch {
+			case strings.HasPrefix(line, "@@"):
+				lines = append(lines, hunkStyle.Render(line))
+			case strings.HasPrefix(line, "+"):
+				lines = append(lines, addStyle.Render("+")+highlightCode(line[1:], marker, addStyle))
+			case strings.HasPrefix(line, "-"):
+				lines = append(lines, removeStyle.Render("-")+highlightCode(line[1:], marker, removeStyle))
+			case strings.HasPrefix(line, `\`):
+				lines = append(lines, mutedStyle.Render(line)) // "\ No newline at end of file"
+			default:
+				lines = append(lines, highlightCode(line, marker, contextStyle))
+			}
+		}
+	}
+	if diff.Truncated {
+		lines = append(lines, "", mutedStyle.Render(fmt.Sprintf("Only the first %d files are shown", len(diff.Files))))
+	}
+	return lines, starts
+}
+
+// renderDiffView renders the diff overlay in place of the table
+func (m *MultiTabModel) renderDiffView() string {
+	view := m.diffView
+	width := m.diffWidth()
+	height := m.diffViewHeight()
+	lines, starts := m.diffLines(view.pr, width)
+
+	// Clamp here so the offset tracks a diff that loads later. The last
+	// file's header may still reach the top, so n always lands on it.
+	limit := max(0, len(lines)-height)
+	if len(starts) > 0 {
+		limit = max(limit, starts[len(starts)-1])
+	}
+	view.scroll = min(view.scroll, limit)
+	end := min(view.scroll+height, len(lines))
+
+	title := fmt.Sprintf("🔍 #%d %s", view.pr.GetNumber(), view.pr.GetTitle())
+	if diff := m.prDiffs[services.PRKey(view.pr)]; diff != nil {
+		additions, deletions := 0, 0
+		for _, file := range diff.Files {
+			additions += file.Additions
+			deletions += file.Deletions
+		}
+		title += fmt.Sprintf(" · %d files +%d -%d", len(diff.Files), additions, deletions)
+	}
+	title = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.TextBright)).Render(clipText(title, width))
+
+	file := 0
+	for i, start := range starts {
+		if start <= view.scroll {
+			file = i + 1
+		}
+	}
+	footer := fmt.Sprintf("file %d/%d · lines %d-%d of %d · j/k scroll · PgUp/PgDn page · n/p next/prev file · esc close",
+		file, len(starts), view.scroll+1, end, len(lines))
+	body := strings.Join(lines[view.scroll:end], "\n")
+	return repoInfoStyle.Width(width + 4).Render(title + "\n" + body + "\n" + mutedStyle.Render(clipText(footer, width)))
+}
@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// diffTestFiles are two changed files of org/api#12
+var diffTestFiles = []github.DiffFile{
+	{Filename: "retry.go", Status: "modified", Additions: 2, Deletions: 1, Patch: "@@ -1,3 +1,4 @@\n func retry() {\n-\treturn nil\n+\t// Try again\n+\treturn \"again\"\n" + strings.Repeat(" \tlog()\n", 20) + " }"},
+	{Filename: "logo.png", Status: "added"},
+}
+
+// TestDiffViewer tests opening the viewer, loading the diff and moving
+// between files
+func TestDiffViewer(t *testing.T) {
+	model, tab := detailTestModel("test-token")
+	model.Width, model.Height = 120, 30
+	press := func(key string) tea.Cmd {
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+		if key == "esc" {
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		}
+		_, cmd := model.Update(msg)
+		return cmd
+	}
+
+	if cmd := press("V"); cmd == nil || model.diffView == nil || !model.prDiffsLoading["org/api#12"] {
+		t.Fatal("Expected V to open the viewer and fetch the diff")
+	}
+	if view := ansi.Strip(model.View()); !strings.Contains(view, "Loading diff") {
+		t.Error("Expected the viewer to show the diff loading")
+	}
+
+	model.Update(prDiffMsg{key: "org/api#12", err: errors.New("not found")})
+	if view := ansi.Strip(model.View()); !strings.Contains(view, "Couldn't load the diff: not found") {
+		t.Error("Expected the fetch error in the viewer")
+	}
+
+	model.Update(prDiffMsg{key: "org/api#12", diff: &github.PRDiff{Files: diffTestFiles, FetchedAt: time.Now()}})
+	view := ansi.Strip(model.View())
+	for _, want := range []string{"2 files +2 -1", "━━ retry.go (modified) +2 -1", `+    return "again"`, "file 1/2"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q in the viewer:\n%s", want, view)
+		}
+	}
+
+	// Hotkeys stay inside the viewer
+	_, starts := model.diffLines(tab.PRs[0], model.diffWidth())
+	press("n")
+	view = ansi.Strip(model.View())
+	if model.diffView.scroll != starts[1] || !strings.Contains(view, "file 2/2") || !strings.Contains(view, "Binary file") {
+		t.Errorf("Expected n to jump to the second file, got line %d", model.diffView.scroll)
+	}
+	press("p")
+	press("k")
+	if model.diffView.scroll != 0 {
+		t.Errorf("Expected p to jump back to the first file, got line %d", model.diffView.scroll)
+	}
+	if press("q"); model.diffView != nil {
+		t.Error("Expected q to close the viewer")
+	}
+
+	// A current diff isn't fetched again
+	tab.PRs[0].UpdatedAt = nil
+	if cmd := press("V"); cmd != nil || model.diffView == nil {
+		t.Error("Expected the cached diff to open right away")
+	}
+	press("esc")
+
+	readOnly, readOnlyTab := detailTestModel("")
+	if cmd := readOnly.openDiff(readOnlyTab); cmd != nil || readOnly.diffView != nil {
+		t.Error("Expected no diff viewer without a token")
+	}
+}
+
+// TestHighlightCode tests keyword, string and comment highlighting
+func TestHighlightCode(t *testing.T) {
+	if commentMarker("cmd/main.go") != "//" || commentMarker("Dockerfile") != "#" || commentMarker("README.md") != "" {
+		t.Error("Unexpected comment markers")
+	}
+
+	base := lipgloss.NewStyle()
+	line := `return "a // b" // done`
+	if got := ansi.Strip(highlightCode(line, "//", base)); got != line {
+		t.Errorf("Expected highlighting to keep the text, got %q", got)
+	}
+	if got := highlightCode("plain text", "", base); got != base.Render("plain text") {
+		t.Errorf("Expected unknown languages left alone, got %q", got)
+	}
+}
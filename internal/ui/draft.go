@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// draftResultMsg reports the outcome of switching a PR's draft state
+type draftResultMsg struct {
+	tabName string
+	key     string // services.PRKey of the switched PR
+	draft   bool   // The state asked for
+	err     error
+}
+
+// confirmToggleDraft asks before marking the selected draft ready for
+// review, or converting the selected PR to a draft
+func (m *MultiTabModel) confirmToggleDraft(tab *TabState) {
+	if m.writeUnavailable(tab) {
+		return
+	}
+	pr := tab.SelectedPR()
+	if pr == nil {
+		tab.StatusMsg = "No PR selected"
+		return
+	}
+
+	draft := !pr.GetDraft()
+	prompt := fmt.Sprintf("Mark %s %q ready for review?", services.PRKey(pr), pr.GetTitle())
+	if draft {
+		prompt = fmt.Sprintf("Convert %s %q to a draft?", services.PRKey(pr), pr.GetTitle())
+	}
+	m.pendingConfirm = &confirmPrompt{
+		prompt:    prompt,
+		onConfirm: m.toggleDraftCmd(tab.Config.Name, pr, draft),
+	}
+	tab.StatusMsg = m.pendingConfirm.prompt + " (y/n)"
+}
+
+// toggleDraftCmd switches one PR's draft state
+func (m *MultiTabModel) toggleDraftCmd(tabName string, pr *gh.PullRequest, draft bool) tea.Cmd {
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 10*time.Second)
+		defer cancel()
+
+		err := github.SetPullRequestDraft(ctx, token, pr, draft)
+		return draftResultMsg{tabName: tabName, key: services.PRKey(pr), draft: draft, err: err}
+	}
+}
+
+// handleDraftResult updates the PR's rows in every tab listing it once its
+// draft state switched
+func (m *MultiTabModel) handleDraftResult(msg draftResultMsg) (tea.Model, tea.Cmd) {
+	status := fmt.Sprintf("🚀 Marked %s ready for review", msg.key)
+	if msg.draft {
+		status = fmt.Sprintf("🚧 Converted %s to a draft", msg.key)
+	}
+	if msg.err != nil {
+		status = fmt.Sprintf("Changing the draft state of %s failed: %v", msg.key, msg.err)
+	} else {
+		for _, tab := range m.TabManager.Tabs {
+			for _, pr := range tab.PRs {
+				if services.PRKey(pr) == msg.key {
+					pr.Draft = gh.Bool(msg.draft)
+				}
+			}
+		}
+		m.refreshAllRows()
+	}
+
+	for _, tab := range m.TabManager.Tabs {
+		if tab.Config.Name == msg.tabName {
+			tab.StatusMsg = status
+		}
+	}
+	return m, nil
+}
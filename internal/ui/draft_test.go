@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestToggleDraft tests confirming a draft state switch and updating the
+// PR's rows afterwards
+func TestToggleDraft(t *testing.T) {
+	model, tab := detailTestModel("test-token")
+	pr := tab.PRs[0]
+
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	if model.pendingConfirm == nil || !strings.HasPrefix(tab.StatusMsg, "Convert org/api#12") {
+		t.Fatalf("Expected to be asked to convert the PR to a draft, got %q", tab.StatusMsg)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+
+	model.Update(draftResultMsg{tabName: "Main", key: "org/api#12", draft: true, err: errors.New("forbidden")})
+	if pr.GetDraft() || !strings.Contains(tab.StatusMsg, "failed: forbidden") {
+		t.Errorf("Expected a failed switch to leave the PR alone, got %q", tab.StatusMsg)
+	}
+
+	model.Update(draftResultMsg{tabName: "Main", key: "org/api#12", draft: true})
+	if !pr.GetDraft() || tab.StatusMsg != "🚧 Converted org/api#12 to a draft" {
+		t.Errorf("Expected the PR converted to a draft, got %q", tab.StatusMsg)
+	}
+	if reviews := tab.Table.Rows()[0][5]; !strings.Contains(reviews, "WIP") {
+		t.Errorf("Expected the row to show the draft, got %q", reviews)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	if !strings.HasPrefix(tab.StatusMsg, "Mark org/api#12") {
+		t.Errorf("Expected to be asked to mark the draft ready, got %q", tab.StatusMsg)
+	}
+
+	// Read-only sessions can't switch drafts
+	readOnly, readOnlyTab := detailTestModel("")
+	readOnlyTab.PRs[0].Draft = gh.Bool(true)
+	readOnly.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	if readOnly.pendingConfirm != nil {
+		t.Error("Expected no prompt without a token")
+	}
+}
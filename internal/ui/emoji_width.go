@@ -0,0 +1,211 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+// Emoji width modes accepted by the emoji_width config setting
+const (
+	emojiWidthAuto       = "auto"
+	emojiWidthNative     = "native"
+	emojiWidthPad        = "pad"
+	emojiWidthSubstitute = "substitute"
+	emojiWidthASCII      = "ascii"
+)
+
+// variationSelector16 asks for emoji presentation of the character before
+// it. Layout counts such emoji as two columns, but some terminals draw them
+// in one, shifting the rest of the line left.
+const variationSelector16 = '\uFE0F'
+
+// zeroWidthJoiner glues emoji into one glyph, e.g. flags; those are left alone
+const zeroWidthJoiner = '\u200D'
+
+// emojiSubstitutes replace the variation-selector emoji PR Compass draws
+// with emoji every terminal draws two columns wide
+var emojiSubstitutes = strings.NewReplacer(
+	"⚠️", "🔶",
+	"🏷️", "🔖",
+	"🕰️", "⌛",
+	"✂️", "📏",
+	"🎛️", "🔧",
+	"⏸️", "💤",
+	"🗄️", "📚",
+	"🗂️", "📂",
+	"↕️", "🔃",
+	"🗓️", "📅",
+)
+
+// asciiEmoji replace emoji in ascii mode, two columns each so the layout
+// holds. Emoji without one become a bullet.
+var asciiEmoji = map[string]string{
+	"✅":  "OK",
+	"❌":  "XX",
+	"⚠️": "!!",
+	"⛔":  "!!",
+	"🚨":  "!!",
+	"🚫":  "--",
+	"⏳":  "..",
+	"🔄":  "~~",
+	"🔁":  "~~",
+	"❓":  "??",
+	"⭐":  "**",
+	"📌":  "**",
+	"🔵":  "()",
+	"🆕":  "+ ",
+	"⏩":  ">>",
+	"👀":  "oo",
+	"💬":  "\"\"",
+	"🔍":  "/ ",
+	"🔎":  "/ ",
+	"📈":  "/^",
+	"📉":  "\\_",
+	"⬆️": "^ ",
+	"🟢":  "o ",
+	"🔴":  "o ",
+	"🟡":  "o ",
+	"🟠":  "o ",
+	"🟣":  "o ",
+	"🟤":  "o ",
+	"⚪":  "o ",
+	"⚫":  "o ",
+}
+
+// asciiBullet stands in for emoji without an ASCII replacement
+const asciiBullet = "* "
+
+// asciiIcons are the status icons in ascii mode
+var asciiIcons = statusTheme{
+	Passed:    "[+]",
+	Failed:    "[x]",
+	Attention: "[!]",
+	Running:   "[~]",
+	Skipped:   "[-]",
+	Unknown:   "[?]",
+}
+
+// emojiWidth is the active emoji width mode, never auto
+var emojiWidth = emojiWidthNative
+
+// validateEmojiWidth checks the configured emoji width mode
+func validateEmojiWidth(mode string) error {
+	switch mode {
+	case "", emojiWidthAuto, emojiWidthNative, emojiWidthPad, emojiWidthSubstitute, emojiWidthASCII:
+		return nil
+	}
+	return fmt.Errorf("emoji_width must be %q, %q, %q, %q or %q, got %q", emojiWidthAuto, emojiWidthNative, emojiWidthPad, emojiWidthSubstitute, emojiWidthASCII, mode)
+}
+
+// applyEmojiWidth switches the emoji width mode, detecting the terminal
+// when the config doesn't choose. Ascii mode also swaps the palette's status
+// icons, so it applies after the palette.
+func applyEmojiWidth(mode string) {
+	if mode == "" || mode == emojiWidthAuto {
+		mode = detectEmojiWidth(os.Getenv)
+	}
+	emojiWidth = mode
+	if mode == emojiWidthASCII {
+		theme.Passed, theme.Failed, theme.Attention = asciiIcons.Passed, asciiIcons.Failed, asciiIcons.Attention
+		theme.Running, theme.Skipped, theme.Unknown = asciiIcons.Running, asciiIcons.Skipped, asciiIcons.Unknown
+		theme.TypeColors = false
+	}
+}
+
+// detectEmojiWidth guesses from the environment whether the terminal draws
+// variation-selector emoji two columns wide. Terminals known to draw them in
+// one column get padding; unknown terminals are left alone.
+func detectEmojiWidth(getenv func(string) string) string {
+	switch getenv("TERM_PROGRAM") {
+	case "iTerm.app", "Apple_Terminal", "WezTerm", "vscode", "ghostty":
+		return emojiWidthNative
+	case "tmux":
+		return emojiWidthPad // tmux measures lines itself, one column per emoji
+	}
+	if getenv("WT_SESSION") != "" { // Windows Terminal
+		return emojiWidthNative
+	}
+	if getenv("TERM") == "alacritty" || getenv("ALACRITTY_WINDOW_ID") != "" ||
+		getenv("TERM") == "linux" || getenv("VTE_VERSION") != "" { // GNOME Terminal, Tilix
+		return emojiWidthPad
+	}
+	return emojiWidthNative
+}
+
+// fixEmojiWidth rewrites variation-selector emoji for the active mode: pad
+// swaps the selector for a space, drawing the plain symbol plus a space in
+// the two columns layout reserved, and substitute swaps known emoji for
+// wide ones, padding the rest. Ascii replaces every emoji.
+func fixEmojiWidth(s string) string {
+	if emojiWidth == emojiWidthASCII {
+		return asciiGlyphs(s)
+	}
+	if emojiWidth == emojiWidthNative || !strings.ContainsRune(s, variationSelector16) {
+		return s
+	}
+	if emojiWidth == emojiWidthSubstitute {
+		s = emojiSubstitutes.Replace(s)
+	}
+
+	runes := []rune(s)
+	var fixed strings.Builder
+	fixed.Grow(len(s))
+	for i, r := range runes {
+		if r == variationSelector16 && i > 0 && (i+1 == len(runes) || runes[i+1] != zeroWidthJoiner) {
+			if lipgloss.Width(string(runes[i-1])) == 1 {
+				fixed.WriteByte(' ')
+			}
+			continue
+		}
+		fixed.WriteRune(r)
+	}
+	return fixed.String()
+}
+
+// isEmoji reports whether a rune is drawn as a wide emoji. Wide letters,
+// like CJK in PR titles, are kept.
+func isEmoji(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF: // Pictographs, emoticons, symbols
+		return true
+	case r >= 0x2300 && r <= 0x2BFF: // Technical symbols, dingbats, arrows
+		return runewidth.RuneWidth(r) == 2
+	}
+	return false
+}
+
+// asciiGlyphs replaces emoji with two ASCII columns each, the width layout
+// reserved: variation-selector emoji and wide emoji alike
+func asciiGlyphs(s string) string {
+	runes := []rune(s)
+	var fixed strings.Builder
+	fixed.Grow(len(s))
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		selected := i+1 < len(runes) && runes[i+1] == variationSelector16
+		if !selected && !isEmoji(r) {
+			if r != variationSelector16 && r != zeroWidthJoiner {
+				fixed.WriteRune(r)
+			}
+			continue
+		}
+
+		glyph := string(r)
+		if selected {
+			glyph += string(variationSelector16)
+			i++
+		}
+		if ascii, ok := asciiEmoji[glyph]; ok {
+			fixed.WriteString(ascii)
+		} else if ascii, ok := asciiEmoji[string(r)]; ok {
+			fixed.WriteString(ascii)
+		} else {
+			fixed.WriteString(asciiBullet)
+		}
+	}
+	return fixed.String()
+}
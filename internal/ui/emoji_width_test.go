@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	"github.com/charmbracelet/lipgloss"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestFixEmojiWidth tests padding and substituting emoji some terminals
+// draw one column wide, keeping the width layout reserved for them
+func TestFixEmojiWidth(t *testing.T) {
+	defer applyEmojiWidth(emojiWidthNative)
+	line := "⚠️ Conflicts | 🏷️ Type | ✅ Ready | ⚠️ hotfix: 🏳️‍🌈"
+
+	applyEmojiWidth(emojiWidthNative)
+	if got := fixEmojiWidth(line); got != line {
+		t.Errorf("Expected native mode to leave emoji alone, got %q", got)
+	}
+
+	applyEmojiWidth(emojiWidthPad)
+	padded := fixEmojiWidth(line)
+	if padded != "⚠  Conflicts | 🏷  Type | ✅ Ready | ⚠  hotfix: 🏳️‍🌈" {
+		t.Errorf("Expected selectors swapped for spaces, got %q", padded)
+	}
+	if lipgloss.Width(padded) != lipgloss.Width(line) {
+		t.Errorf("Expected padding to keep the laid out width, got %d and %d", lipgloss.Width(padded), lipgloss.Width(line))
+	}
+
+	applyEmojiWidth(emojiWidthSubstitute)
+	substituted := fixEmojiWidth(line)
+	if !strings.HasPrefix(substituted, "🔶 Conflicts | 🔖 Type | ✅ Ready | 🔶 hotfix") {
+		t.Errorf("Expected known emoji substituted, got %q", substituted)
+	}
+	if got := fixEmojiWidth("☺️ thanks"); got != "☺  thanks" {
+		t.Errorf("Expected unknown emoji padded in substitute mode, got %q", got)
+	}
+
+	model, tab := mergeTestModel("test-token")
+	tab.PRs[0].MergeableState = gh.String("dirty")
+	model.Width = 160 // Status cells fit "Conflicts" untruncated
+	model.updateTableRows(tab)
+	applyEmojiWidth(emojiWidthPad)
+	if view := model.View(); !strings.Contains(view, "⚠  Conflict") || strings.ContainsRune(view, variationSelector16) {
+		t.Error("Expected the rendered view to be fixed up")
+	}
+}
+
+// TestASCIIGlyphs tests replacing every emoji with two ASCII columns,
+// keeping wide letters and the laid out width
+func TestASCIIGlyphs(t *testing.T) {
+	defer applyPalette(paletteDefault)
+	defer applyEmojiWidth(emojiWidthNative)
+	line := "⚠️ Conflicts | ✅ Ready | 📊 Load | 修正 | ↕️ Sort"
+
+	applyEmojiWidth(emojiWidthASCII)
+	got := fixEmojiWidth(line)
+	if got != "!! Conflicts | OK Ready | *  Load | 修正 | *  Sort" {
+		t.Errorf("fixEmojiWidth() = %q", got)
+	}
+	if lipgloss.Width(got) != lipgloss.Width(line) {
+		t.Errorf("Expected the laid out width kept, got %d and %d", lipgloss.Width(got), lipgloss.Width(line))
+	}
+
+	pr := &gh.PullRequest{Number: gh.Int(1), Title: gh.String("fix: handle nil config")}
+	enhanced := map[int]types.EnhancedData{1: {Mergeable: "clean", ChecksStatus: "failure", ReviewStatus: "approved"}}
+	row := createTableRowsWithEnhancement([]*gh.PullRequest{pr}, enhanced)[0]
+	if row[1] != "fix" || row[4] != "[x] Failed Checks [x] CI" || row[5] != "[+] Approved" {
+		t.Errorf("Expected ASCII status icons without type circles, got %q", row)
+	}
+}
+
+// TestDetectEmojiWidth tests picking a mode from the terminal's environment
+func TestDetectEmojiWidth(t *testing.T) {
+	tests := []struct {
+		env  map[string]string
+		want string
+	}{
+		{map[string]string{"TERM_PROGRAM": "iTerm.app"}, emojiWidthNative},
+		{map[string]string{"TERM_PROGRAM": "WezTerm"}, emojiWidthNative},
+		{map[string]string{"WT_SESSION": "1b2c"}, emojiWidthNative},
+		{map[string]string{"TERM_PROGRAM": "tmux", "TERM": "tmux-256color"}, emojiWidthPad},
+		{map[string]string{"TERM": "alacritty"}, emojiWidthPad},
+		{map[string]string{"TERM": "xterm-256color", "ALACRITTY_WINDOW_ID": "1"}, emojiWidthPad},
+		{map[string]string{"TERM": "xterm-256color", "VTE_VERSION": "7600"}, emojiWidthPad},
+		{map[string]string{"TERM": "xterm-256color"}, emojiWidthNative},
+	}
+	for _, tt := range tests {
+		if got := detectEmojiWidth(func(key string) string { return tt.env[key] }); got != tt.want {
+			t.Errorf("detectEmojiWidth(%v) = %q, want %q", tt.env, got, tt.want)
+		}
+	}
+
+	for _, mode := range []string{"", "auto", "native", "pad", "substitute", "ascii"} {
+		if err := validateEmojiWidth(mode); err != nil {
+			t.Errorf("validateEmojiWidth(%q) error = %v", mode, err)
+		}
+	}
+	if err := validateEmojiWidth("wide"); err == nil {
+		t.Error("Expected unknown emoji width mode to be rejected")
+	}
+}
@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// failedReposShown caps how many failed repos the issues panel and status
+// message name
+const failedReposShown = 3
+
+// issuesKey identifies a tab's failed repos, so dismissing the issues panel
+// lasts until a fetch fails differently
+func issuesKey(tab *TabState) string {
+	return strings.Join(tab.PRCounts.Failed(), ",")
+}
+
+// showIssues reports whether the issues panel is open: some repos failed to
+// load and the panel wasn't dismissed for them
+func showIssues(tab *TabState) bool {
+	key := issuesKey(tab)
+	return key != "" && key != tab.DismissedIssues
+}
+
+// failedRepoRows has a row per repo whose PRs couldn't be listed saying why,
+// capped at failedReposShown with a summary of the rest
+func failedRepoRows(tab *TabState) []string {
+	failed := tab.PRCounts.Failed()
+	var rows []string
+	for _, repo := range failed[:min(len(failed), failedReposShown)] {
+		rows = append(rows, fmt.Sprintf("⚠️ %s: %v", repo, tab.PRCounts[repo].Err))
+	}
+	if more := len(failed) - failedReposShown; more > 0 {
+		rows = append(rows, fmt.Sprintf("+%d more repos - E shows them all in the log", more))
+	}
+	return rows
+}
+
+// issuesPanelHeight is the lines the issues panel takes below the status
+// line: its rows, title, footer and border
+func issuesPanelHeight(tab *TabState) int {
+	if !showIssues(tab) {
+		return 0
+	}
+	return len(failedRepoRows(tab)) + 5
+}
+
+// failedReposStatus summarizes failed repos for the status line, e.g.
+// "⚠️ 3 repos failed: org/a, org/b, org/c", or returns "" if none failed
+func failedReposStatus(counts github.PRCounts) string {
+	failed := counts.Failed()
+	switch len(failed) {
+	case 0:
+		return ""
+	case 1:
+		return "⚠️ 1 repo failed: " + failed[0]
+	}
+	names := strings.Join(failed[:min(len(failed), failedReposShown)], ", ")
+	if len(failed) > failedReposShown {
+		names += ", …"
+	}
+	return fmt.Sprintf("⚠️ %d repos failed: %s", len(failed), names)
+}
+
+// toggleIssues dismisses the issues panel, or brings a dismissed one back
+func (m *MultiTabModel) toggleIssues(tab *TabState) {
+	key := issuesKey(tab)
+	switch {
+	case key == "":
+		tab.StatusMsg = "Every repo in this tab loaded"
+		return
+	case showIssues(tab):
+		tab.DismissedIssues = key
+		tab.StatusMsg = "Issues panel dismissed until other repos fail - Z shows it again"
+	default:
+		tab.DismissedIssues = ""
+		tab.StatusMsg = failedReposStatus(tab.PRCounts)
+	}
+	tab.Table.SetHeight(m.calculateTableHeight(tab))
+}
+
+// renderIssuesPanel lists the repos whose PRs the last fetch couldn't list,
+// so a tab missing a repo doesn't pass for a complete list
+func (m *MultiTabModel) renderIssuesPanel(tab *TabState) string {
+	if !showIssues(tab) {
+		return ""
+	}
+	width := max(30, m.Width-8) // Border and padding
+
+	var rows []string
+	for _, row := range failedRepoRows(tab) {
+		rows = append(rows, clipText(row, width))
+	}
+	failed := len(tab.PRCounts.Failed())
+	noun := "repos"
+	if failed == 1 {
+		noun = "repo"
+	}
+	title := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(theme.Warning)).
+		Render(fmt.Sprintf("🚧 Issues: %d %s not listed", failed, noun))
+	footer := "\n" + mutedStyle.Render(clipText("Z dismiss · run 'pr-compass doctor' to check the token's access", width))
+	return "\n" + repoInfoStyle.Width(width+4).Render(title+"\n"+strings.Join(rows, "\n")+footer)
+}
@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestIssuesPanel tests the status message, panel and dismissal for repos a
+// fetch couldn't list
+func TestIssuesPanel(t *testing.T) {
+	model, tab := detailTestModel("test-token")
+	model.Width, model.Height = 120, 40
+	fullHeight := model.calculateTableHeight(tab)
+
+	counts := github.PRCounts{
+		"org/api":    {Open: 1, Fetched: 1},
+		"org/secret": {Err: errors.New("organization SAML single sign-on hasn't authorized the token")},
+		"org/gone":   {Err: errors.New("GitHub resource not found: org/gone")},
+	}
+	model.Update(tabPrsMsg{tabName: "Main", prs: tab.PRs, counts: counts})
+	if tab.StatusMsg != "⚠️ 2 repos failed: org/gone, org/secret" {
+		t.Errorf("Unexpected status %q", tab.StatusMsg)
+	}
+	view := model.renderIssuesPanel(tab)
+	if !strings.Contains(view, "2 repos not listed") || !strings.Contains(view, "org/secret: organization SAML") {
+		t.Errorf("Expected a row per failed repo, got:\n%s", view)
+	}
+	if height := model.calculateTableHeight(tab); height >= fullHeight {
+		t.Errorf("Expected the table to shrink for the panel, got %d (was %d)", height, fullHeight)
+	}
+	if line := repoCountLine(tab, "org/secret"); !strings.Contains(line, "Couldn't list") {
+		t.Errorf("Expected the failure in the repo's count line, got %q", line)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Z")})
+	if model.renderIssuesPanel(tab) != "" || model.calculateTableHeight(tab) != fullHeight {
+		t.Error("Expected Z to dismiss the panel and give the table its height back")
+	}
+
+	// The same failures stay dismissed; a different set opens the panel again
+	model.Update(tabPrsMsg{tabName: "Main", prs: tab.PRs, counts: counts})
+	if showIssues(tab) {
+		t.Error("Expected the panel to stay dismissed for the same failures")
+	}
+	counts["org/more"] = github.RepoPRCount{Err: errors.New("not found")}
+	model.Update(tabPrsMsg{tabName: "Main", prs: tab.PRs, counts: counts})
+	if !showIssues(tab) {
+		t.Error("Expected new failures to open the panel again")
+	}
+
+	model.Update(tabPrsMsg{tabName: "Main", prs: tab.PRs, counts: github.PRCounts{"org/api": {Open: 1, Fetched: 1}}})
+	if tab.StatusMsg != "" || showIssues(tab) {
+		t.Errorf("Expected no issues once every repo loads, got %q", tab.StatusMsg)
+	}
+}
+
+// TestFailedRepoRows tests capping the rows and summarizing the rest
+func TestFailedRepoRows(t *testing.T) {
+	tab := &TabState{Config: &TabConfig{Name: "Main", Mode: "repos"}, PRCounts: github.PRCounts{}}
+	for _, repo := range []string{"org/a", "org/b", "org/c", "org/d", "org/e"} {
+		tab.PRCounts[repo] = github.RepoPRCount{Err: errors.New("not found")}
+	}
+
+	rows := failedRepoRows(tab)
+	if len(rows) != failedReposShown+1 || !strings.HasPrefix(rows[0], "⚠️ org/a: not found") || !strings.Contains(rows[failedReposShown], "+2 more repos") {
+		t.Errorf("Expected %d rows and a summary of the rest, got %q", failedReposShown+1, rows)
+	}
+	if status := failedReposStatus(tab.PRCounts); status != "⚠️ 5 repos failed: org/a, org/b, org/c, …" {
+		t.Errorf("Unexpected status %q", status)
+	}
+}
@@ -138,7 +138,13 @@ func (f *PRFormatter) GetEnhancedReviewStatus(enhanced *types.EnhancedData) stri
 
 // CreateTableColumns creates table columns with proper widths
 func (f *PRFormatter) CreateTableColumns() []table.Column {
-	totalWidth := f.getTerminalWidth() - 12 // Account for borders and padding
+	return f.CreateTableColumnsForWidth(defaultTerminalWidth)
+}
+
+// CreateTableColumnsForWidth sizes the table columns for a terminal width.
+// Columns keep their minimum widths, so narrow terminals truncate the table.
+func (f *PRFormatter) CreateTableColumnsForWidth(terminalWidth int) []table.Column {
+	totalWidth := terminalWidth - 12 // Account for borders and padding
 
 	// Calculate optimal widths
 	prNameWidth := max(32, totalWidth*22/100)
@@ -164,10 +170,8 @@ func (f *PRFormatter) CreateTableColumns() []table.Column {
 	}
 }
 
-// getTerminalWidth returns terminal width with fallback
-func (f *PRFormatter) getTerminalWidth() int {
-	return 160 // Conservative default
-}
+// defaultTerminalWidth sizes columns before the terminal size is known
+const defaultTerminalWidth = 160
 
 // max returns the maximum of two integers
 func max(a, b int) int {
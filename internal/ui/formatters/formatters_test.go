@@ -355,3 +355,16 @@ func TestPRFormatter_CreateTableColumns(t *testing.T) {
 		}
 	}
 }
+
+func TestPRFormatter_CreateTableColumnsForWidth(t *testing.T) {
+	formatter := NewPRFormatter()
+
+	narrow := formatter.CreateTableColumnsForWidth(80)
+	wide := formatter.CreateTableColumnsForWidth(240)
+	if wide[0].Width <= narrow[0].Width {
+		t.Errorf("Expected a wider PR column on a wider terminal, got %d and %d", narrow[0].Width, wide[0].Width)
+	}
+	if narrow[0].Width < 32 {
+		t.Errorf("Expected the PR column to keep its minimum width, got %d", narrow[0].Width)
+	}
+}
@@ -0,0 +1,95 @@
+package ui
+
+import "strings"
+
+// maxHistoryEntries caps how many inputs each tab remembers per prompt
+const maxHistoryEntries = 20
+
+// inputHistory remembers submitted prompt values, oldest first, and tracks
+// the position while browsing with up/down
+type inputHistory struct {
+	entries []string
+	pos     int    // Entry being shown; len(entries) while editing a fresh line
+	draft   string // Text typed before browsing started, restored past the newest entry
+}
+
+// add records a submitted value, moving repeats to the newest position
+func (h *inputHistory) add(value string) {
+	value = strings.TrimSpace(value)
+	if value != "" {
+		for i, entry := range h.entries {
+			if entry == value {
+				h.entries = append(h.entries[:i], h.entries[i+1:]...)
+				break
+			}
+		}
+		h.entries = append(h.entries, value)
+		if len(h.entries) > maxHistoryEntries {
+			h.entries = h.entries[len(h.entries)-maxHistoryEntries:]
+		}
+	}
+	h.reset()
+}
+
+// reset starts a fresh line, forgetting any browsing position
+func (h *inputHistory) reset() {
+	h.pos = len(h.entries)
+	h.draft = ""
+}
+
+// prev returns the next older entry, or current when there is none
+func (h *inputHistory) prev(current string) string {
+	if h.pos == 0 || len(h.entries) == 0 {
+		return current
+	}
+	if h.pos >= len(h.entries) {
+		h.draft = current
+		h.pos = len(h.entries)
+	}
+	h.pos--
+	return h.entries[h.pos]
+}
+
+// next returns the next newer entry, or the draft once past the newest
+func (h *inputHistory) next(current string) string {
+	if h.pos >= len(h.entries) {
+		return current
+	}
+	h.pos++
+	if h.pos == len(h.entries) {
+		return h.draft
+	}
+	return h.entries[h.pos]
+}
+
+// inputHistory returns the tab's history for a prompt kind (a filter mode
+// such as "author", or a prompt like "blocked"), creating it on first use
+func (t *TabState) inputHistory(kind string) *inputHistory {
+	if t.InputHistory == nil {
+		t.InputHistory = make(map[string]*inputHistory)
+	}
+	h, ok := t.InputHistory[kind]
+	if !ok {
+		h = &inputHistory{}
+		t.InputHistory[kind] = h
+	}
+	return h
+}
+
+// typingFilter reports whether the tab is collecting a free-text filter value,
+// as opposed to a toggled filter like drafts
+func (t *TabState) typingFilter() bool {
+	switch t.FilterMode {
+	case "author", "status", "title", "repo", "language", "topic", "search":
+		return true
+	}
+	return false
+}
+
+// filterPromptStatus is shown when a text filter starts, hinting at history
+func filterPromptStatus(tab *TabState, label string) string {
+	if len(tab.inputHistory(tab.FilterMode).entries) > 0 {
+		return label + " (↑↓ history)"
+	}
+	return label
+}
@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestInputHistoryBrowsing tests recall order, deduplication and draft restore
+func TestInputHistoryBrowsing(t *testing.T) {
+	h := &inputHistory{}
+	h.add("alice")
+	h.add("bob")
+	h.add("  ")
+	h.add("alice") // Repeats move to the newest position
+
+	if len(h.entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %v", h.entries)
+	}
+
+	steps := []struct {
+		up   bool
+		want string
+	}{
+		{true, "alice"},
+		{true, "bob"},
+		{true, "bob"}, // Oldest entry stays put
+		{false, "alice"},
+		{false, "ca"}, // Past the newest entry the draft comes back
+		{false, "ca"},
+	}
+	value := "ca"
+	for i, step := range steps {
+		if step.up {
+			value = h.prev(value)
+		} else {
+			value = h.next(value)
+		}
+		if value != step.want {
+			t.Errorf("Step %d: expected %q, got %q", i, step.want, value)
+		}
+	}
+
+	for i := 0; i < maxHistoryEntries+5; i++ {
+		h.add(strings.Repeat("x", i+1))
+	}
+	if len(h.entries) != maxHistoryEntries {
+		t.Errorf("Expected history capped at %d, got %d", maxHistoryEntries, len(h.entries))
+	}
+}
+
+// TestFilterHistoryIsTabScoped tests recalling filter values with up/down per tab
+func TestFilterHistoryIsTabScoped(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	first := model.TabManager.AddTab(&TabConfig{Name: "First", Mode: "repos", Repos: []string{"org/a"}})
+	second := model.TabManager.AddTab(&TabConfig{Name: "Second", Mode: "repos", Repos: []string{"org/b"}})
+
+	press := func(keys ...tea.KeyMsg) {
+		for _, key := range keys {
+			model.Update(key)
+		}
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+	up := tea.KeyMsg{Type: tea.KeyUp}
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+
+	press(runes("f"), runes("a"), runes("e"), enter)
+	press(runes("f"), runes("w"), enter)
+
+	press(runes("f"))
+	if !strings.Contains(first.StatusMsg, "history") {
+		t.Errorf("Expected history hint when starting a filter, got %q", first.StatusMsg)
+	}
+	press(up, up)
+	if first.FilterValue != "ae" {
+		t.Errorf("Expected second-newest author filter 'ae', got %q", first.FilterValue)
+	}
+	press(down, down)
+	if first.FilterValue != "" {
+		t.Errorf("Expected empty draft after browsing back down, got %q", first.FilterValue)
+	}
+
+	// Status filters keep their own history
+	first.FilterMode = ""
+	press(runes("s"), up)
+	if first.FilterValue != "" {
+		t.Errorf("Expected no status history yet, got %q", first.FilterValue)
+	}
+
+	// Other tabs don't share the history
+	model.TabManager.SwitchToTab(1)
+	press(runes("f"), up)
+	if second.FilterValue != "" {
+		t.Errorf("Expected no history in the second tab, got %q", second.FilterValue)
+	}
+}
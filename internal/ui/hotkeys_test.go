@@ -144,6 +144,9 @@ func TestHotkeyFilter(t *testing.T) {
 
 	// Test status filter
 	t.Run("status_filter", func(t *testing.T) {
+		// Leave the author prompt, where 's' would be typed into the filter
+		model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
 		// Press 's' to start status filter
 		keyMsg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")}
 		model.Update(keyMsg)
@@ -187,6 +190,7 @@ func TestHotkeyFilter(t *testing.T) {
 		// Set up some filter first
 		activeTab.FilterMode = "author"
 		activeTab.FilterValue = "alice"
+		model.Update(tea.KeyMsg{Type: tea.KeyEnter})
 
 		// Press 'c' to clear filters
 		keyMsg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")}
@@ -486,8 +490,8 @@ func TestUpdateTableRows(t *testing.T) {
 			t.Errorf("Expected 1 row, got %d", len(rows))
 		}
 
-		if len(rows) > 0 && len(rows[0]) != 9 {
-			t.Errorf("Expected 9 columns per row, got %d", len(rows[0]))
+		if len(rows) > 0 && len(rows[0]) != 10 {
+			t.Errorf("Expected 10 columns per row, got %d", len(rows[0]))
 		}
 	})
 
@@ -528,16 +532,237 @@ func TestUpdateTableRows(t *testing.T) {
 			t.Errorf("Expected 1 row, got %d", len(rows))
 		}
 
-		if len(rows) > 0 && len(rows[0]) != 9 {
-			t.Errorf("Expected 9 columns per row, got %d", len(rows[0]))
+		if len(rows) > 0 && len(rows[0]) != 10 {
+			t.Errorf("Expected 10 columns per row, got %d", len(rows[0]))
 		}
 
 		// Check that enhanced data is used (comments should show "8" instead of "?")
 		if len(rows) > 0 {
-			commentsCol := rows[0][5] // Comments column
+			commentsCol := rows[0][6] // Comments column
 			if commentsCol != "8" {
 				t.Errorf("Expected comments to show '8' with enhanced data, got '%s'", commentsCol)
 			}
 		}
 	})
 }
+
+// TestHotkeySizeBudgetFilter tests the review size budget filter toggle
+func TestHotkeySizeBudgetFilter(t *testing.T) {
+	tabManager := NewTabManager("test-token")
+	tabManager.AddTab(&TabConfig{
+		Name:             "Test Tab",
+		Mode:             "repos",
+		Repos:            []string{"test/repo"},
+		ReviewSizeBudget: 400,
+	})
+
+	model := NewMultiTabModel("test-token", nil)
+	model.TabManager = tabManager
+
+	activeTab := model.TabManager.GetActiveTab()
+	testPRs := []*gh.PullRequest{
+		{Number: gh.Int(1), Title: gh.String("Big change")},
+		{Number: gh.Int(2), Title: gh.String("Small change")},
+	}
+	activeTab.PRs = testPRs
+	activeTab.FilteredPRs = testPRs
+	activeTab.EnhancedData[1] = types.EnhancedData{Number: 1, Additions: 500}
+	activeTab.EnhancedData[2] = types.EnhancedData{Number: 2, Additions: 5}
+	activeTab.Loaded = true
+
+	keyMsg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")}
+	model.Update(keyMsg)
+
+	if activeTab.FilterMode != "size" {
+		t.Fatalf("Expected FilterMode 'size', got '%s'", activeTab.FilterMode)
+	}
+	if len(activeTab.FilteredPRs) != 1 || activeTab.FilteredPRs[0].GetNumber() != 1 {
+		t.Errorf("Expected only PR #1 to exceed the budget, got %d PRs", len(activeTab.FilteredPRs))
+	}
+
+	// Pressing again clears the filter
+	model.Update(keyMsg)
+	if activeTab.FilterMode != "" || len(activeTab.FilteredPRs) != 2 {
+		t.Errorf("Expected size filter cleared, got mode '%s' with %d PRs", activeTab.FilterMode, len(activeTab.FilteredPRs))
+	}
+
+	// Tabs without a budget don't enter size filter mode
+	activeTab.Config.ReviewSizeBudget = 0
+	model.Update(keyMsg)
+	if activeTab.FilterMode != "" {
+		t.Errorf("Expected no filter without a budget, got '%s'", activeTab.FilterMode)
+	}
+}
+
+// TestHotkeyIssueLinkFilter tests that 'l' lists PRs breaking the issue link policy
+func TestHotkeyIssueLinkFilter(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	activeTab := model.TabManager.AddTab(&TabConfig{
+		Name:             "Test Tab",
+		Mode:             "repos",
+		Repos:            []string{"test/repo"},
+		RequireIssueLink: true,
+	})
+
+	testPRs := []*gh.PullRequest{
+		{Number: gh.Int(1), Title: gh.String("PAY-12: retry webhooks")},
+		{Number: gh.Int(2), Title: gh.String("Tweak logging")},
+		{Number: gh.Int(3), Title: gh.String("Bump timeout"), Head: &gh.PullRequestBranch{Ref: gh.String("OPS-9-timeout")}},
+	}
+	activeTab.PRs = testPRs
+	activeTab.FilteredPRs = testPRs
+	activeTab.Loaded = true
+
+	keyMsg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")}
+	model.Update(keyMsg)
+
+	if activeTab.FilterMode != "unlinked" {
+		t.Fatalf("Expected FilterMode 'unlinked', got '%s'", activeTab.FilterMode)
+	}
+	if len(activeTab.FilteredPRs) != 1 || activeTab.FilteredPRs[0].GetNumber() != 2 {
+		t.Errorf("Expected only PR #2 to be listed, got %d PRs", len(activeTab.FilteredPRs))
+	}
+
+	// Pressing again clears the filter
+	model.Update(keyMsg)
+	if activeTab.FilterMode != "" || len(activeTab.FilteredPRs) != 3 {
+		t.Errorf("Expected filter cleared, got mode '%s' with %d PRs", activeTab.FilterMode, len(activeTab.FilteredPRs))
+	}
+
+	// Tabs without the policy don't enter the filter
+	activeTab.Config.RequireIssueLink = false
+	model.Update(keyMsg)
+	if activeTab.FilterMode != "" {
+		t.Errorf("Expected no filter without a policy, got '%s'", activeTab.FilterMode)
+	}
+}
+
+// TestHotkeyDuplicateGroupConfirm tests that bulk approve asks for confirmation first
+func TestHotkeyDuplicateGroupConfirm(t *testing.T) {
+	tabManager := NewTabManager("test-token")
+	tabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos"})
+
+	model := NewMultiTabModel("test-token", nil)
+	model.TabManager = tabManager
+
+	activeTab := model.TabManager.GetActiveTab()
+	testPRs := []*gh.PullRequest{
+		{Number: gh.Int(1), Title: gh.String("Bump lodash"), Base: &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/a")}}},
+		{Number: gh.Int(2), Title: gh.String("Bump lodash"), Base: &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/b")}}},
+	}
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: testPRs})
+
+	if len(activeTab.DuplicateGroups) != 1 {
+		t.Fatalf("Expected 1 duplicate group after fetch, got %d", len(activeTab.DuplicateGroups))
+	}
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlA})
+	if cmd != nil {
+		t.Error("Expected no command before confirmation")
+	}
+	if model.pendingConfirm == nil {
+		t.Fatal("Expected a pending confirmation prompt")
+	}
+
+	// Any key other than y cancels without running the action
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if cmd != nil || model.pendingConfirm != nil {
+		t.Error("Expected confirmation to be cancelled")
+	}
+	if activeTab.StatusMsg != "Cancelled" {
+		t.Errorf("Expected 'Cancelled' status, got %q", activeTab.StatusMsg)
+	}
+}
+
+// TestHotkeyTitleTypeFilter tests that 't' cycles through the title types present in the tab
+func TestHotkeyTitleTypeFilter(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	activeTab := model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"test/repo"}})
+
+	prs := []*gh.PullRequest{
+		{Number: gh.Int(1), Title: gh.String("fix: handle nil user")},
+		{Number: gh.Int(2), Title: gh.String("feat(ui): add Type column")},
+		{Number: gh.Int(3), Title: gh.String("Update README")},
+		{Number: gh.Int(4), Title: gh.String("fix(api): retry on 502")},
+	}
+	activeTab.PRs = prs
+	activeTab.FilteredPRs = prs
+	activeTab.Loaded = true
+
+	press := func() {
+		model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	}
+
+	expected := []struct {
+		value string
+		count int
+	}{
+		{"feat", 1},
+		{"fix", 2},
+		{"none", 1},
+		{"", 4}, // Cycle complete - filter cleared
+	}
+
+	for _, step := range expected {
+		press()
+		if activeTab.FilterValue != step.value || len(activeTab.FilteredPRs) != step.count {
+			t.Errorf("Expected type filter %q with %d PRs, got %q with %d", step.value, step.count, activeTab.FilterValue, len(activeTab.FilteredPRs))
+		}
+	}
+}
+
+// TestHotkeysTypedIntoTextFilters tests that letters and digits bound to
+// hotkeys are typed into a text filter being entered rather than run
+func TestHotkeysTypedIntoTextFilters(t *testing.T) {
+	tests := []struct {
+		start string
+		mode  string
+		value string
+	}{
+		{"f", "author", "bob alice tom Nina dev42"},
+		{"s", "status", "Mergeable"},
+		{"L", "language", "TypeScript"},
+		{"T", "topic", "qa-tooling 2024"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			model, tab := mergeTestModel("test-token")
+			model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(tt.start)})
+			for _, r := range tt.value {
+				if r == ' ' {
+					model.Update(tea.KeyMsg{Type: tea.KeySpace})
+					continue
+				}
+				model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+			}
+			if tab.FilterMode != tt.mode || tab.FilterValue != tt.value {
+				t.Fatalf("Expected %s filter %q being typed, got %s filter %q", tt.mode, tt.value, tab.FilterMode, tab.FilterValue)
+			}
+			if model.pendingChoice != nil || model.pendingNote != nil || tab.PRLimit != 0 {
+				t.Error("Expected no hotkey run while typing")
+			}
+
+			model.Update(tea.KeyMsg{Type: tea.KeyLeft})
+			model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+			model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+			if want := tt.mode + "=" + tt.value[:len(tt.value)-1]; tab.FilterMode != "" || tab.AppliedFilter != want {
+				t.Errorf("Expected filter %q applied, got mode %q, filter %q", want, tab.FilterMode, tab.AppliedFilter)
+			}
+		})
+	}
+}
+
+// TestToggleFilterKeepsHotkeys tests that a toggled filter such as drafts
+// leaves J/K and other keys alone instead of collecting them as its value
+func TestToggleFilterKeepsHotkeys(t *testing.T) {
+	model, tab := mergeTestModel("test-token")
+	tab.PRs[0].Draft = gh.Bool(true)
+	tab.PRs[1].Draft = gh.Bool(true)
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("J")})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	if tab.FilterMode != "draft" || tab.FilterValue != "true" || len(tab.FilteredPRs) != 2 {
+		t.Errorf("Expected the draft filter untouched, got %s filter %q", tab.FilterMode, tab.FilterValue)
+	}
+}
@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"fmt"
+
 	"github.com/bjess9/pr-compass/internal/cache"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -65,6 +67,19 @@ func InitialMultiTabModel(token string, multiConfig *MultiTabConfig) tea.Model {
 	var prCache *cache.PRCache = nil // For now, no caching in initial model
 
 	model := NewMultiTabModel(token, prCache)
+	model.Layouts = NewLayoutStore(getLayoutsFilePath(), multiConfig.Layouts)
+	model.StartupRamp = multiConfig.StartupRamp
+	sharedCache, cacheErr := cache.Open(multiConfig.Cache)
+	model.TabManager.PRCache = sharedCache
+	model.TabManager.Blockers = NewBlockerStore(getBlockersFilePath())
+	model.TabManager.Notes = NewNoteStore(getNotesFilePath())
+	model.TabManager.Pins = NewPinStore(getPinsFilePath())
+	model.TabManager.Seen = NewSeenStore(getSeenFilePath())
+	model.Watches = NewWatchStore(getWatchFilePath())
+	model.applyGlobalConfig(multiConfig)
+	model.startMetrics(multiConfig.Metrics)
+	model.Presets = NewPresetStore(getPresetsFilePath())
+	model.Views = NewViewStore(getViewsFilePath())
 
 	// Add all configured tabs
 	for _, tabConfig := range multiConfig.Tabs {
@@ -72,11 +87,21 @@ func InitialMultiTabModel(token string, multiConfig *MultiTabConfig) tea.Model {
 		tabConfigCopy := tabConfig
 		model.TabManager.AddTab(&tabConfigCopy)
 	}
+	model.restoreViews()
+	model.restorePresets()
+	if cacheErr != nil {
+		// Tabs fall back to the default file cache rather than not starting
+		for _, tab := range model.TabManager.Tabs {
+			tab.StatusMsg = fmt.Sprintf("Cache unavailable, using local files: %v", cacheErr)
+		}
+	}
 
-	// Set global refresh interval
-	model.TabManager.GlobalRefreshInterval = multiConfig.RefreshIntervalMinutes
-	if model.TabManager.GlobalRefreshInterval == 0 {
-		model.TabManager.GlobalRefreshInterval = 5
+	// Reload the configuration when its file changes; without a watcher
+	// changes need a restart as before
+	configPath := getConfigFilePath()
+	if watcher, err := newConfigWatcher(configPath); err == nil {
+		model.configWatcher = watcher
+		model.configPath = configPath
 	}
 
 	return &InitializedMultiTabModel{
@@ -84,6 +109,31 @@ func InitialMultiTabModel(token string, multiConfig *MultiTabConfig) tea.Model {
 	}
 }
 
+// applyGlobalConfig applies the settings that aren't per tab, at startup and
+// on every reload
+func (m *MultiTabModel) applyGlobalConfig(multiConfig *MultiTabConfig) {
+	m.AuthorTimezones = multiConfig.AuthorTimezones
+	m.WorkHours = multiConfig.WorkHours
+	m.WatchRepos = multiConfig.WatchRepos
+	m.CheckHints = multiConfig.CheckHints
+	m.OpenWith = multiConfig.OpenWith
+	m.applyJiraConfig(multiConfig.Jira)
+	m.applySlackConfig(multiConfig.Slack)
+	m.Alerts = multiConfig.Alerts
+	m.EnhancementQuotaFloor = multiConfig.EnhancementQuotaFloor
+	m.RecentlyCompletedMinutes = multiConfig.RecentlyCompletedMinutes
+	applyTheme(multiConfig.Theme, multiConfig.ThemeColors)
+	applyPalette(multiConfig.Palette)
+	applyEmojiWidth(multiConfig.EmojiWidth)
+	m.FilterPresets = multiConfig.FilterPresets
+
+	// Set global refresh interval
+	m.TabManager.GlobalRefreshInterval = multiConfig.RefreshIntervalMinutes
+	if m.TabManager.GlobalRefreshInterval == 0 {
+		m.TabManager.GlobalRefreshInterval = 5
+	}
+}
+
 // InitialModelMultiTab is the entry point for multi-tab mode
 // It automatically detects single vs multi-tab configuration
 func InitialModelMultiTab(token string) tea.Model {
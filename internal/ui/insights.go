@@ -0,0 +1,269 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/components"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	gh "github.com/google/go-github/v55/github"
+)
+
+const (
+	insightsWindowDays  = 14                   // Days charted in the insights panel
+	insightsHistoryDays = 90                   // Days kept in the cache
+	insightsHistoryTTL  = 180 * 24 * time.Hour // Drops history of tabs no longer used
+	insightsPanelHeight = 6                    // Title, three charts and the border
+	insightsDateLayout  = "2006-01-02"
+)
+
+// insightsMsg delivers an insights tab's updated history
+type insightsMsg struct {
+	tabName string
+	days    []cache.InsightsDay
+	err     error
+}
+
+// countFunc counts the PRs matching a search query
+type countFunc func(query string) (int, error)
+
+// insightsOpenQuery returns the search query for the tab's open PRs, ignoring
+// any active filter. It also identifies the tab's history in the cache.
+func insightsOpenQuery(tab *TabState) string {
+	query, _ := searchQueryForTab(&TabState{Config: tab.Config, PRs: tab.PRs})
+	return query
+}
+
+// insightsMergedQuery turns the open PR query into one for PRs merged on a day
+func insightsMergedQuery(openQuery, date string) string {
+	var terms []string
+	for _, term := range strings.Fields(openQuery) {
+		switch term {
+		case "is:open":
+			terms = append(terms, "is:merged")
+		case "draft:false": // Merged PRs aren't drafts
+		default:
+			terms = append(terms, term)
+		}
+	}
+	return strings.Join(append(terms, "merged:"+date), " ")
+}
+
+// medianPRAge returns the median time since the PRs were opened
+func medianPRAge(prs []*gh.PullRequest, now time.Time) time.Duration {
+	if len(prs) == 0 {
+		return 0
+	}
+	ages := make([]time.Duration, len(prs))
+	for i, pr := range prs {
+		ages[i] = now.Sub(pr.GetCreatedAt().Time)
+	}
+	sort.Slice(ages, func(i, j int) bool { return ages[i] < ages[j] })
+	middle := len(ages) / 2
+	if len(ages)%2 == 0 {
+		return (ages[middle-1] + ages[middle]) / 2
+	}
+	return ages[middle]
+}
+
+// recordInsights adds today's open count and median age to the history and
+// fills in merge counts for the charted days. Today's and yesterday's merges
+// are always recounted, as they may have grown since last recorded; older
+// days are counted once. Counting stops at the first error, keeping what was
+// recorded. The result is sorted oldest first and always covers the window.
+func recordInsights(history []cache.InsightsDay, now time.Time, openQuery string, medianAge time.Duration, count countFunc) ([]cache.InsightsDay, error) {
+	byDate := make(map[string]cache.InsightsDay, len(history))
+	for _, day := range history {
+		byDate[day.Date] = day
+	}
+
+	var err error
+	for offset := insightsWindowDays - 1; offset >= 0; offset-- {
+		date := now.AddDate(0, 0, -offset).Format(insightsDateLayout)
+		day, recorded := byDate[date]
+		if !recorded {
+			day = cache.InsightsDay{Date: date, Open: -1, Merged: -1}
+		}
+		if err == nil && (offset <= 1 || day.Merged < 0) {
+			if merged, countErr := count(insightsMergedQuery(openQuery, date)); countErr != nil {
+				err = countErr
+			} else {
+				day.Merged = merged
+			}
+		}
+		if offset == 0 && err == nil {
+			if open, countErr := count(openQuery); countErr != nil {
+				err = countErr
+			} else {
+				day.Open, day.MedianAge = open, medianAge
+			}
+		}
+		byDate[date] = day
+	}
+
+	days := make([]cache.InsightsDay, 0, len(byDate))
+	for _, day := range byDate {
+		days = append(days, day)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Date < days[j].Date })
+	if len(days) > insightsHistoryDays {
+		days = days[len(days)-insightsHistoryDays:]
+	}
+	return days, err
+}
+
+// insightsCmd records today's aggregates for an insights tab and persists
+// its history. It costs two to three search requests, plus one per charted
+// day not yet counted.
+func (m *MultiTabModel) insightsCmd(tab *TabState) tea.Cmd {
+	if !tab.Config.Insights || m.readOnly() || !tab.Config.OnGitHub() || tab.PRCache == nil {
+		return nil
+	}
+
+	token := m.TabManager.Token
+	tabName := tab.Config.Name
+	prCache := tab.PRCache
+	openQuery := insightsOpenQuery(tab)
+	now := time.Now()
+	medianAge := medianPRAge(tab.PRs, now)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 30*time.Second)
+		defer cancel()
+
+		history, _ := prCache.GetInsightsHistory(openQuery)
+		days, err := recordInsights(history, now, openQuery, medianAge, func(query string) (int, error) {
+			return github.CountSearchResults(ctx, token, query)
+		})
+		_ = prCache.SetInsightsHistory(openQuery, days, insightsHistoryTTL) // ignore cache errors
+		return insightsMsg{tabName: tabName, days: days, err: err}
+	}
+}
+
+// handleInsights shows an insights tab's updated history
+func (m *MultiTabModel) handleInsights(msg insightsMsg) (tea.Model, tea.Cmd) {
+	for _, tab := range m.TabManager.Tabs {
+		if tab.Config.Name == msg.tabName {
+			tab.Insights = msg.days
+			tab.InsightsErr = msg.err
+			break
+		}
+	}
+	return m, nil
+}
+
+// renderInsights draws the trend panel shown above an insights tab's table
+func (m *MultiTabModel) renderInsights(tab *TabState) string {
+	width := m.Width - 8 // Border and padding
+	if width < 30 {
+		width = 30
+	}
+
+	title := fmt.Sprintf("📈 Insights · last %d days", insightsWindowDays)
+	var lines []string
+	switch {
+	case m.readOnly():
+		lines = []string{"Insights need GITHUB_TOKEN: counts come from the search API", "", ""}
+	case len(tab.Insights) == 0 && tab.InsightsErr != nil:
+		lines = []string{"🚫 " + tab.InsightsErr.Error(), "", ""}
+	case len(tab.Insights) == 0:
+		lines = []string{"Recording insights...", "", ""}
+	default:
+		lines = insightsLines(tab.Insights)
+		if tab.InsightsErr != nil {
+			title += " · partly updated: " + tab.InsightsErr.Error()
+		}
+	}
+
+	for i, line := range lines {
+		lines[i] = clipText(line, width)
+	}
+	title = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.TextBright)).Render(clipText(title, width))
+	return repoInfoStyle.Width(width+4).Render(title+"\n"+strings.Join(lines, "\n")) + "\n"
+}
+
+// insightsLines charts open PRs, merges and median age over the window
+func insightsLines(history []cache.InsightsDay) []string {
+	window := history
+	if len(window) > insightsWindowDays {
+		window = window[len(window)-insightsWindowDays:]
+	}
+
+	open := make([]int, len(window))
+	merged := make([]int, len(window))
+	age := make([]int, len(window))
+	for i, day := range window {
+		open[i], merged[i], age[i] = day.Open, day.Merged, -1
+		if day.Open >= 0 {
+			age[i] = int(day.MedianAge.Hours())
+		}
+	}
+
+	openSummary := "not recorded yet"
+	if first, last, ok := knownRange(open); ok {
+		openSummary = fmt.Sprintf("%d (%+d since %s)", last.value, last.value-first.value, window[first.index].Date[5:])
+	}
+	mergedSummary := "not counted yet"
+	if total, days := knownSum(merged); days > 0 {
+		mergedSummary = fmt.Sprintf("%d today · %.1f/day avg", max(merged[len(merged)-1], 0), float64(total)/float64(days))
+	}
+	ageSummary := "not recorded yet"
+	if _, last, ok := knownRange(age); ok {
+		ageSummary = formatSpan(window[last.index].MedianAge)
+	}
+
+	return []string{
+		fmt.Sprintf("Open PRs     %s  %s", components.Sparkline(open), openSummary),
+		fmt.Sprintf("Merged/day   %s  %s", components.Sparkline(merged), mergedSummary),
+		fmt.Sprintf("Median age   %s  %s", components.Sparkline(age), ageSummary),
+	}
+}
+
+// knownValue is a recorded value and its position in a series
+type knownValue struct {
+	index, value int
+}
+
+// knownRange returns the first and last recorded values of a series
+func knownRange(values []int) (knownValue, knownValue, bool) {
+	first, last := knownValue{index: -1}, knownValue{index: -1}
+	for i, v := range values {
+		if v < 0 {
+			continue
+		}
+		if first.index < 0 {
+			first = knownValue{i, v}
+		}
+		last = knownValue{i, v}
+	}
+	return first, last, first.index >= 0
+}
+
+// knownSum adds up the recorded values of a series and counts them
+func knownSum(values []int) (int, int) {
+	total, days := 0, 0
+	for _, v := range values {
+		if v >= 0 {
+			total += v
+			days++
+		}
+	}
+	return total, days
+}
+
+// formatSpan renders a duration for the insights panel, e.g. "5h" or "3.5d"
+func formatSpan(d time.Duration) string {
+	switch {
+	case d < time.Hour:
+		return "<1h"
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%.1fd", d.Hours()/24)
+	}
+}
@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestRecordInsights tests that merges are backfilled once and today's numbers are recorded on every refresh
+func TestRecordInsights(t *testing.T) {
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.Local)
+	openQuery := "is:pr is:open org:acme draft:false"
+
+	var queries []string
+	count := func(query string) (int, error) {
+		queries = append(queries, query)
+		if strings.Contains(query, "is:merged") {
+			return 3, nil
+		}
+		return 20, nil
+	}
+
+	days, err := recordInsights(nil, now, openQuery, 30*time.Hour, count)
+	if err != nil {
+		t.Fatalf("recordInsights() error = %v", err)
+	}
+	if len(queries) != insightsWindowDays+1 {
+		t.Errorf("Expected %d merge counts and one open count on the first run, got %d queries", insightsWindowDays, len(queries))
+	}
+	if queries[0] != "is:pr is:merged org:acme merged:2024-03-02" {
+		t.Errorf("Unexpected merged query %q", queries[0])
+	}
+	today := days[len(days)-1]
+	if len(days) != insightsWindowDays || today.Date != "2024-03-15" || today.Open != 20 || today.Merged != 3 || today.MedianAge != 30*time.Hour {
+		t.Errorf("Unexpected history %+v", days)
+	}
+	if days[0].Open != -1 || days[0].Merged != 3 {
+		t.Errorf("Expected backfilled days to have merges but no open count, got %+v", days[0])
+	}
+
+	// A later refresh only recounts yesterday and today
+	queries = nil
+	if _, err := recordInsights(days, now.Add(time.Hour), openQuery, time.Hour, count); err != nil || len(queries) != 3 {
+		t.Errorf("Expected 3 queries on a later refresh, got %d (%v)", len(queries), err)
+	}
+
+	// Counting stops at the first failure, keeping what was recorded
+	failing := func(string) (int, error) { return 0, errors.New("rate limited") }
+	days, err = recordInsights(days, now.AddDate(0, 0, 1), openQuery, time.Hour, failing)
+	if err == nil || len(days) != insightsWindowDays+1 || days[len(days)-1].Open != -1 || days[len(days)-2].Open != 20 {
+		t.Errorf("Expected a failed refresh to keep the history, got %+v (%v)", days, err)
+	}
+}
+
+func TestMedianPRAge(t *testing.T) {
+	now := time.Now()
+	opened := func(ago time.Duration) *gh.PullRequest {
+		return &gh.PullRequest{CreatedAt: &gh.Timestamp{Time: now.Add(-ago)}}
+	}
+
+	if got := medianPRAge(nil, now); got != 0 {
+		t.Errorf("Expected 0 without PRs, got %v", got)
+	}
+	if got := medianPRAge([]*gh.PullRequest{opened(time.Hour), opened(10 * time.Hour), opened(2 * time.Hour)}, now); got != 2*time.Hour {
+		t.Errorf("Expected the middle age, got %v", got)
+	}
+	if got := medianPRAge([]*gh.PullRequest{opened(time.Hour), opened(3 * time.Hour)}, now); got != 2*time.Hour {
+		t.Errorf("Expected the mean of the middle ages, got %v", got)
+	}
+}
+
+// TestInsightsPanel tests that insights tabs chart their history above the table
+func TestInsightsPanel(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	model.Width, model.Height = 160, 50
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Org", Mode: "organization", Organization: "acme", Insights: true})
+
+	if panel := model.renderInsights(tab); !strings.Contains(panel, "Recording insights...") {
+		t.Errorf("Expected a placeholder before insights arrive, got:\n%s", panel)
+	}
+
+	model.handleInsights(insightsMsg{tabName: "Org", days: []cache.InsightsDay{
+		{Date: "2024-03-13", Open: -1, Merged: 2},
+		{Date: "2024-03-14", Open: 10, Merged: 4, MedianAge: 12 * time.Hour},
+		{Date: "2024-03-15", Open: 13, Merged: 6, MedianAge: 60 * time.Hour},
+	}})
+	panel := model.renderInsights(tab)
+	for _, want := range []string{"Open PRs", "13 (+3 since 03-14)", "6 today · 4.0/day avg", "2.5d"} {
+		if !strings.Contains(panel, want) {
+			t.Errorf("Expected %q in the insights panel, got:\n%s", want, panel)
+		}
+	}
+
+	plain := model.TabManager.AddTab(&TabConfig{Name: "Repos", Mode: "repos", Repos: []string{"acme/api"}})
+	if model.calculateTableHeight(tab) >= model.calculateTableHeight(plain) {
+		t.Error("Expected the insights panel to take room from the table")
+	}
+}
+
+func TestInsightsConfigNeedsGitHub(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	configYAML := `tabs:
+  - name: Platform
+    provider: gitlab
+    mode: organization
+    organization: platform
+    insights: true
+`
+	if err := os.WriteFile(configPath, []byte(configYAML), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if _, err := LoadMultiTabConfigFromPath(configPath); err == nil || !strings.Contains(err.Error(), "insights") {
+		t.Errorf("Expected insights on a GitLab tab to be rejected, got %v", err)
+	}
+}
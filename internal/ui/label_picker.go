@@ -0,0 +1,256 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// labelPicker is the overlay for adding and removing labels on one PR. It
+// opens with the PR's current labels selected.
+type labelPicker struct {
+	tabName string
+	pr      *gh.PullRequest
+	current []string // Labels on the PR when the picker opened
+	multiPicker
+}
+
+// repoLabelsMsg delivers the labels of a PR's repository
+type repoLabelsMsg struct {
+	tabName string
+	repo    string
+	key     string // services.PRKey of the PR the picker is for
+	labels  []string
+	err     error
+}
+
+// labelUpdateResultMsg reports the outcome of changing a PR's labels
+type labelUpdateResultMsg struct {
+	tabName string
+	key     string
+	added   []string
+	removed []string
+	labels  []*gh.Label // The PR's labels afterwards
+	err     error
+}
+
+// withLabelColumn appends the Labels column, taking its width from the PR
+// title column as far as its minimum allows
+func withLabelColumn(columns []table.Column, terminalWidth int) []table.Column {
+	width := max(12, (terminalWidth-12)*10/100)
+
+	result := make([]table.Column, len(columns), len(columns)+1)
+	copy(result, columns)
+	result[0].Width = max(24, result[0].Width-width-2) // Cell padding too
+	return append(result, table.Column{Title: "🏷️ Labels", Width: width})
+}
+
+// labelCell lists a PR's labels for the table
+func labelCell(pr *gh.PullRequest) string {
+	names := labelNames(pr)
+	if len(names) == 0 {
+		return "-"
+	}
+	return strings.Join(names, ", ")
+}
+
+// labelNames returns the names of a PR's labels
+func labelNames(pr *gh.PullRequest) []string {
+	var names []string
+	for _, label := range pr.Labels {
+		if name := label.GetName(); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// openLabelPicker opens the picker for the selected PR, fetching its
+// repository's labels first unless they are already known
+func (m *MultiTabModel) openLabelPicker(tab *TabState) tea.Cmd {
+	if m.writeUnavailable(tab) {
+		return nil
+	}
+	pr := tab.SelectedPR()
+	if pr == nil {
+		tab.StatusMsg = "No PR selected"
+		return nil
+	}
+
+	repo := pr.GetBase().GetRepo().GetFullName()
+	if labels, known := m.repoLabels[repo]; known {
+		m.showLabelPicker(tab, pr, labels)
+		return nil
+	}
+
+	tab.StatusMsg = fmt.Sprintf("Loading labels of %s...", repo)
+	token := m.TabManager.Token
+	tabName := tab.Config.Name
+	prCache := tab.PRCache
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 15*time.Second)
+		defer cancel()
+
+		labels, err := github.FetchRepoLabels(ctx, token, repo, prCache)
+		return repoLabelsMsg{tabName: tabName, repo: repo, key: services.PRKey(pr), labels: labels, err: err}
+	}
+}
+
+// showLabelPicker opens the picker over the repository's labels. Labels on
+// the PR are offered even when the repository list doesn't have them yet.
+func (m *MultiTabModel) showLabelPicker(tab *TabState, pr *gh.PullRequest, repoLabels []string) {
+	current := labelNames(pr)
+	options := append([]string{}, repoLabels...)
+	for _, name := range current {
+		if !containsFold(options, name) {
+			options = append(options, name)
+		}
+	}
+	if len(options) == 0 {
+		tab.StatusMsg = fmt.Sprintf("%s has no labels", pr.GetBase().GetRepo().GetFullName())
+		return
+	}
+
+	m.labelPicker = &labelPicker{tabName: tab.Config.Name, pr: pr, current: current, multiPicker: newMultiPicker(options, current)}
+	tab.StatusMsg = fmt.Sprintf("🏷️  Labeling %s", services.PRKey(pr))
+}
+
+// handleRepoLabels stores fetched labels and opens the picker if the PR is
+// still selected
+func (m *MultiTabModel) handleRepoLabels(msg repoLabelsMsg) (tea.Model, tea.Cmd) {
+	tab := m.TabManager.GetActiveTab()
+	if msg.err != nil {
+		if tab != nil && tab.Config.Name == msg.tabName {
+			tab.StatusMsg = fmt.Sprintf("Couldn't list labels: %v", msg.err)
+		}
+		return m, nil
+	}
+	m.repoLabels[msg.repo] = msg.labels
+
+	if tab == nil || tab.Config.Name != msg.tabName || m.promptOpen() {
+		return m, nil
+	}
+	if pr := tab.SelectedPR(); pr != nil && services.PRKey(pr) == msg.key {
+		m.showLabelPicker(tab, pr, msg.labels)
+	}
+	return m, nil
+}
+
+// handleLabelKey filters, moves through, toggles or applies the picker
+func (m *MultiTabModel) handleLabelKey(tab *TabState, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	picker := m.labelPicker
+
+	switch msg.Type {
+	case tea.KeyEsc, tea.KeyCtrlC:
+		m.labelPicker = nil
+		tab.StatusMsg = "Cancelled"
+		return m, nil
+	case tea.KeyEnter:
+		add, remove := picker.changes()
+		m.labelPicker = nil
+		if len(add) == 0 && len(remove) == 0 {
+			tab.StatusMsg = "Labels unchanged"
+			return m, nil
+		}
+		tab.StatusMsg = fmt.Sprintf("Updating labels on %s...", services.PRKey(picker.pr))
+		return m, m.labelUpdateCmd(picker.tabName, picker.pr, add, remove)
+	default:
+		picker.handleKey(msg)
+	}
+	return m, nil
+}
+
+// changes returns the labels to add and to remove for the current selection
+func (p *labelPicker) changes() (add, remove []string) {
+	chosen := p.chosen()
+	for _, name := range chosen {
+		if !containsFold(p.current, name) {
+			add = append(add, name)
+		}
+	}
+	for _, name := range p.current {
+		if !containsFold(chosen, name) {
+			remove = append(remove, name)
+		}
+	}
+	return add, remove
+}
+
+// labelUpdateCmd adds and removes labels on one PR
+func (m *MultiTabModel) labelUpdateCmd(tabName string, pr *gh.PullRequest, add, remove []string) tea.Cmd {
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 15*time.Second)
+		defer cancel()
+
+		labels, err := github.UpdateLabels(ctx, token, pr, add, remove)
+		return labelUpdateResultMsg{tabName: tabName, key: services.PRKey(pr), added: add, removed: remove, labels: labels, err: err}
+	}
+}
+
+// handleLabelUpdateResult reports the change and copies the PR's new labels
+// into every tab listing it
+func (m *MultiTabModel) handleLabelUpdateResult(msg labelUpdateResultMsg) (tea.Model, tea.Cmd) {
+	var changes []string
+	for _, name := range msg.added {
+		changes = append(changes, "+"+name)
+	}
+	for _, name := range msg.removed {
+		changes = append(changes, "-"+name)
+	}
+	status := fmt.Sprintf("🏷️  Labels on %s: %s", msg.key, strings.Join(changes, ", "))
+	if msg.err != nil {
+		status = fmt.Sprintf("Updating labels on %s failed: %v", msg.key, msg.err)
+	} else {
+		for _, tab := range m.TabManager.Tabs {
+			for _, pr := range tab.PRs {
+				if services.PRKey(pr) == msg.key {
+					pr.Labels = msg.labels
+				}
+			}
+		}
+		m.refreshAllRows()
+	}
+
+	for _, tab := range m.TabManager.Tabs {
+		if tab.Config.Name == msg.tabName {
+			tab.StatusMsg = status
+		}
+	}
+	return m, nil
+}
+
+// renderLabelPicker renders the picker overlay in place of the table
+func (m *MultiTabModel) renderLabelPicker() string {
+	picker := m.labelPicker
+	width := m.commentWidth()
+
+	title := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.TextBright)).
+		Render(clipText(fmt.Sprintf("🏷️  Labels of #%d %s", picker.pr.GetNumber(), picker.pr.GetTitle()), width))
+	lines := []string{title, "Filter: " + picker.query + "_"}
+	lines = append(lines, picker.renderOptions()...)
+
+	add, remove := picker.changes()
+	footer := fmt.Sprintf("+%d -%d · type to filter · ↑↓ move · space toggle · enter apply · esc cancel", len(add), len(remove))
+	lines = append(lines, mutedStyle.Render(clipText(footer, width)))
+	return repoInfoStyle.Width(width + 4).Render(strings.Join(lines, "\n"))
+}
+
+// containsFold reports whether names contains name, ignoring case like
+// GitHub does for label names
+func containsFold(names []string, name string) bool {
+	for _, candidate := range names {
+		if strings.EqualFold(candidate, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestLabelPicker tests loading a repo's labels and applying the difference
+// to the selected PR
+func TestLabelPicker(t *testing.T) {
+	model, tab := detailTestModel("test-token")
+	tab.PRs[0].Labels = []*gh.Label{{Name: gh.String("bug")}, {Name: gh.String("wontfix")}}
+	press := func(msg tea.KeyMsg) tea.Cmd {
+		_, cmd := model.Update(msg)
+		return cmd
+	}
+
+	if cmd := press(tea.KeyMsg{Type: tea.KeyCtrlL}); cmd == nil || tab.StatusMsg != "Loading labels of org/api..." {
+		t.Fatalf("Expected the repo's labels to be fetched first, got %q", tab.StatusMsg)
+	}
+	model.Update(repoLabelsMsg{tabName: "Main", repo: "org/api", key: "org/api#12", labels: []string{"bug", "feature", "needs-triage"}})
+	picker := model.labelPicker
+	if picker == nil {
+		t.Fatal("Expected the picker to open once the labels arrive")
+	}
+	// Labels missing from the repo list stay offered
+	if strings.Join(picker.options, ",") != "bug,feature,needs-triage,wontfix" || strings.Join(picker.chosen(), ",") != "bug,wontfix" {
+		t.Errorf("Expected the PR's labels checked, got %v of %v", picker.chosen(), picker.options)
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("triage")})
+	press(tea.KeyMsg{Type: tea.KeySpace})
+	press(tea.KeyMsg{Type: tea.KeyCtrlU})
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("wont")})
+	press(tea.KeyMsg{Type: tea.KeySpace})
+	if view := model.View(); !strings.Contains(view, "[ ] wontfix") || !strings.Contains(view, "+1 -1") {
+		t.Error("Expected the picker to replace the table and count the changes")
+	}
+	if cmd := press(tea.KeyMsg{Type: tea.KeyEnter}); cmd == nil || model.labelPicker != nil {
+		t.Fatal("Expected enter to apply the changes")
+	}
+
+	model.Update(labelUpdateResultMsg{tabName: "Main", key: "org/api#12", err: errors.New("forbidden")})
+	if !strings.Contains(tab.StatusMsg, "failed: forbidden") || len(tab.PRs[0].Labels) != 2 {
+		t.Errorf("Expected a failed update to leave the PR alone, got %q", tab.StatusMsg)
+	}
+	updated := []*gh.Label{{Name: gh.String("bug")}, {Name: gh.String("needs-triage")}}
+	model.Update(labelUpdateResultMsg{tabName: "Main", key: "org/api#12", added: []string{"needs-triage"}, removed: []string{"wontfix"}, labels: updated})
+	if tab.StatusMsg != "🏷️  Labels on org/api#12: +needs-triage, -wontfix" || labelCell(tab.PRs[0]) != "bug, needs-triage" {
+		t.Errorf("Expected the new labels, got %q", tab.StatusMsg)
+	}
+
+	// Labels are remembered per repo; an unchanged selection sends nothing
+	if cmd := press(tea.KeyMsg{Type: tea.KeyCtrlL}); cmd != nil || model.labelPicker == nil {
+		t.Fatal("Expected known labels to open the picker right away")
+	}
+	if cmd := press(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil || tab.StatusMsg != "Labels unchanged" {
+		t.Errorf("Expected no update without changes, got %q", tab.StatusMsg)
+	}
+}
+
+// TestLabelColumn tests the optional Labels column
+func TestLabelColumn(t *testing.T) {
+	model, tab := detailTestModel("test-token")
+	tab.Config.AssigneeColumn = true
+	tab.Config.LabelColumn = true
+	tab.PRs[0].Labels = []*gh.Label{{Name: gh.String("bug")}}
+	model.updateTableRows(tab)
+
+	row := tab.Table.Rows()[0]
+	if len(row) != len(columnKeys)+2 || row[len(row)-2] != "-" || row[len(row)-1] != "bug" {
+		t.Errorf("Expected assignees then labels in the last cells, got %q", row)
+	}
+	if keys := tab.columnKeys(); keys[len(keys)-1] != labelColumnKey {
+		t.Errorf("Expected the labels key last, got %v", keys)
+	}
+}
@@ -0,0 +1,68 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// labelsByFrequency returns the labels on the PRs, most common first
+func labelsByFrequency(prs []*gh.PullRequest) []string {
+	counts := make(map[string]int)
+	var labels []string
+	for _, pr := range prs {
+		for _, label := range pr.Labels {
+			name := label.GetName()
+			if name == "" {
+				continue
+			}
+			if counts[name] == 0 {
+				labels = append(labels, name)
+			}
+			counts[name]++
+		}
+	}
+
+	sort.SliceStable(labels, func(i, j int) bool {
+		if counts[labels[i]] != counts[labels[j]] {
+			return counts[labels[i]] > counts[labels[j]]
+		}
+		return labels[i] < labels[j]
+	})
+	return labels
+}
+
+// pickLabel offers the labels present in the tab and filters to PRs carrying
+// the chosen one; with a label filter active it clears the filter instead
+func (m *MultiTabModel) pickLabel(tab *TabState) {
+	if tab.FilterMode == "label" {
+		tab.FilterMode = ""
+		tab.FilterValue = ""
+		tab.FilteredPRs = tab.PRs
+		tab.StatusMsg = "Filter cleared"
+		m.updateTableRows(tab)
+		return
+	}
+
+	labels := labelsByFrequency(tab.PRs)
+	if len(labels) == 0 {
+		tab.StatusMsg = "No labels on PRs in this tab"
+		return
+	}
+
+	m.pendingChoice = &choicePrompt{
+		label:   "🏷️  Filter by label:",
+		options: labels,
+		onChoose: func(label string) tea.Cmd {
+			tab.FilterMode = "label"
+			tab.FilterValue = label
+			tab.FilteredPRs = m.applyFilter(tab.PRs, "label", label)
+			tab.StatusMsg = fmt.Sprintf("Label: %s (%d) - # to clear", label, len(tab.FilteredPRs))
+			m.updateTableRows(tab)
+			return nil
+		},
+	}
+	tab.StatusMsg = m.pendingChoice.status()
+}
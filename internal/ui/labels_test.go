@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+func labeledPR(number int, labels ...string) *gh.PullRequest {
+	pr := &gh.PullRequest{
+		Number: gh.Int(number),
+		Title:  gh.String("PR"),
+		Base:   &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/api")}},
+	}
+	for _, label := range labels {
+		pr.Labels = append(pr.Labels, &gh.Label{Name: gh.String(label)})
+	}
+	return pr
+}
+
+// TestLabelsByFrequency tests that the most common labels come first
+func TestLabelsByFrequency(t *testing.T) {
+	prs := []*gh.PullRequest{labeledPR(1, "bug", "backend"), labeledPR(2, "backend"), labeledPR(3, "ui", "bug", "backend"), labeledPR(4)}
+	if got, want := labelsByFrequency(prs), []string{"backend", "bug", "ui"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("labelsByFrequency() = %v, want %v", got, want)
+	}
+}
+
+// TestHotkeyLabelFilter tests picking a label with # and clearing it again
+func TestHotkeyLabelFilter(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"org/api"}})
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("#")})
+	if model.pendingChoice != nil || tab.StatusMsg != "No labels on PRs in this tab" {
+		t.Errorf("Expected a notice without labels, got %q", tab.StatusMsg)
+	}
+
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: []*gh.PullRequest{
+		labeledPR(1, "bug"), labeledPR(2, "bug", "infra"), labeledPR(3, "infra"), labeledPR(4, "infra"),
+	}})
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("#")})
+	if model.pendingChoice == nil || !reflect.DeepEqual(model.pendingChoice.options, []string{"infra", "bug"}) {
+		t.Fatalf("Expected a picker of infra and bug, got %+v", model.pendingChoice)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	if tab.FilterMode != "label" || len(tab.FilteredPRs) != 2 || tab.StatusMsg != "Label: bug (2) - # to clear" {
+		t.Errorf("Expected the bug label filter, got %q with %d PRs / %q", tab.FilterMode, len(tab.FilteredPRs), tab.StatusMsg)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("#")})
+	if tab.FilterMode != "" || len(tab.FilteredPRs) != 4 || model.pendingChoice != nil {
+		t.Errorf("Expected # to clear the label filter, got %q with %d PRs", tab.FilterMode, len(tab.FilteredPRs))
+	}
+}
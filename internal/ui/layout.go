@@ -0,0 +1,312 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/charmbracelet/bubbles/table"
+)
+
+// LayoutBucket groups terminal widths so each screen setup (e.g. laptop vs
+// docked ultrawide) keeps its own column layout
+type LayoutBucket string
+
+const (
+	LayoutBucketNarrow    LayoutBucket = "narrow"    // Split panes and small windows
+	LayoutBucketLaptop    LayoutBucket = "laptop"    // Typical laptop screens
+	LayoutBucketUltrawide LayoutBucket = "ultrawide" // Large or docked monitors
+)
+
+// Table densities
+const (
+	DensityComfortable = "comfortable" // Padded cells (default)
+	DensityCompact     = "compact"     // No cell padding, fits more on screen
+)
+
+// layoutBucketForWidth returns the size bucket for a terminal width
+func layoutBucketForWidth(width int) LayoutBucket {
+	switch {
+	case width < 120:
+		return LayoutBucketNarrow
+	case width < 200:
+		return LayoutBucketLaptop
+	default:
+		return LayoutBucketUltrawide
+	}
+}
+
+// LayoutConfig describes the column layout and density for one size bucket
+type LayoutConfig struct {
+	Density       string   `mapstructure:"density" yaml:"density,omitempty" json:"density,omitempty"`
+	HiddenColumns []string `mapstructure:"hidden_columns" yaml:"hidden_columns,omitempty" json:"hidden_columns,omitempty"`
+}
+
+// IsCompact reports whether the layout uses compact density
+func (l LayoutConfig) IsCompact() bool {
+	return l.Density == DensityCompact
+}
+
+// IsHidden reports whether the given column is hidden
+func (l LayoutConfig) IsHidden(column string) bool {
+	for _, hidden := range l.HiddenColumns {
+		if hidden == column {
+			return true
+		}
+	}
+	return false
+}
+
+// columnKeys identifies table columns in layouts, in the order createTableColumns returns them
+var columnKeys = []string{"pr", "type", "author", "repo", "status", "review", "comments", "files", "created", "updated"}
+
+// Keys of the optional columns, appended in this order when a tab shows them
+var (
+	stackColumnKeys    = []string{"language", "topics"}
+	assigneeColumnKey  = "assignees"
+	labelColumnKey     = "labels"
+	policyColumnKey    = "policy"
+	milestoneColumnKey = "milestone"
+	projectColumnKey   = "project"
+	ticketColumnKey    = "ticket"
+)
+
+// columnHidePriority is the order columns are hidden in when collapsing the
+// layout - least important first. The PR column can never be hidden.
+var columnHidePriority = []string{"topics", "language", "labels", "assignees", "milestone", "project", "ticket", "policy", "created", "type", "comments", "updated", "files", "review", "author", "status", "repo"}
+
+// hideNextColumn returns a copy of the layout with the next column in
+// priority order hidden, and false if nothing is left to hide
+func (l LayoutConfig) hideNextColumn() (LayoutConfig, string, bool) {
+	for _, column := range columnHidePriority {
+		if !l.IsHidden(column) {
+			l.HiddenColumns = append(append([]string{}, l.HiddenColumns...), column)
+			return l, column, true
+		}
+	}
+	return l, "", false
+}
+
+// applyLayout hides columns for the layout and gives their width to the PR
+// title column. keys identifies the columns, see TabState.columnKeys.
+func applyLayout(columns []table.Column, keys []string, layout LayoutConfig) []table.Column {
+	result := make([]table.Column, len(columns))
+	copy(result, columns)
+
+	freed := 0
+	for i := 1; i < len(result) && i < len(keys); i++ {
+		if layout.IsHidden(keys[i]) {
+			freed += result[i].Width + 2 // Cell padding is freed too
+			result[i].Width = 0          // Zero-width columns are skipped by the table
+		}
+	}
+	if len(result) > 0 {
+		result[0].Width += freed
+	}
+	return result
+}
+
+// Widths taken around the table: the view's border and padding, then the
+// selected row's padding and each cell's
+const (
+	containerChrome = 6
+	tableChrome     = containerChrome + 2
+	fitCellPadding  = 2
+)
+
+// How narrow the PR title column gets while fitting a terminal, before and
+// after other columns are hidden
+const (
+	fitTitleWidth    = 24
+	fitTitleMinWidth = 10
+)
+
+// fitColumns narrows columns overflowing a terminal width: the PR title
+// column shrinks first, then columns are hidden in columnHidePriority order,
+// then the title shrinks further. Width left over goes to the title.
+func fitColumns(columns []table.Column, keys []string, width int, compact bool) []table.Column {
+	var order []int
+	for _, column := range columnHidePriority {
+		for i := 1; i < len(columns) && i < len(keys); i++ {
+			if keys[i] == column {
+				order = append(order, i)
+			}
+		}
+	}
+	return fitColumnsInOrder(columns, order, width, compact)
+}
+
+// scrollColumns shows the columns right of the PR title as a window
+// scrolled sideways: the first offset-1 of them are skipped, and those
+// past the terminal's right edge hidden. Offset 0 isn't scrolled, see
+// fitColumns.
+func scrollColumns(columns []table.Column, offset, width int, compact bool) []table.Column {
+	result := make([]table.Column, len(columns))
+	copy(result, columns)
+	for i := 1; i < len(result) && offset > 1; i++ {
+		if result[i].Width > 0 {
+			result[i].Width = 0
+			offset--
+		}
+	}
+
+	var order []int
+	for i := len(result) - 1; i > 0; i-- {
+		order = append(order, i)
+	}
+	return fitColumnsInOrder(result, order, width, compact)
+}
+
+// fitColumnsInOrder fits columns to a terminal width, hiding them by index
+// in the given order once the PR title column is down to fitTitleWidth
+func fitColumnsInOrder(columns []table.Column, order []int, width int, compact bool) []table.Column {
+	result := make([]table.Column, len(columns))
+	copy(result, columns)
+	if width <= 0 || len(result) == 0 {
+		return result
+	}
+
+	padding := fitCellPadding
+	if compact {
+		padding = 0
+	}
+	overflow := tableChrome - width
+	for _, column := range result {
+		if column.Width > 0 {
+			overflow += column.Width + padding
+		}
+	}
+	shrinkTitle := func(minWidth int) {
+		if take := min(overflow, result[0].Width-minWidth); take > 0 {
+			result[0].Width -= take
+			overflow -= take
+		}
+	}
+
+	shrinkTitle(fitTitleWidth)
+	for _, i := range order {
+		if overflow <= 0 {
+			break
+		}
+		if result[i].Width > 0 {
+			overflow -= result[i].Width + padding
+			result[i].Width = 0
+		}
+	}
+	shrinkTitle(fitTitleMinWidth)
+	if overflow < 0 {
+		result[0].Width -= overflow
+	}
+	return result
+}
+
+// visibleColumns counts the columns with a width
+func visibleColumns(columns []table.Column) int {
+	visible := 0
+	for _, column := range columns {
+		if column.Width > 0 {
+			visible++
+		}
+	}
+	return visible
+}
+
+// tableStylesForLayout returns table styles matching the layout density
+func tableStylesForLayout(layout LayoutConfig) table.Styles {
+	styles := tableStyles()
+	if layout.IsCompact() {
+		styles.Header = styles.Header.Padding(0, 0)
+		styles.Cell = styles.Cell.Padding(0, 0)
+		styles.Selected = styles.Selected.Padding(0, 0)
+	}
+	return styles
+}
+
+// LayoutStore remembers one layout per size bucket. Layouts from the config
+// file act as defaults; adjustments made in the TUI are saved to disk.
+type LayoutStore struct {
+	mu       sync.Mutex
+	path     string // Empty path keeps layouts in memory only
+	defaults map[LayoutBucket]LayoutConfig
+	saved    map[LayoutBucket]LayoutConfig
+}
+
+// NewLayoutStore creates a layout store backed by the given file, using the
+// configured layouts as defaults. A missing or unreadable file is ignored.
+func NewLayoutStore(path string, defaults map[string]LayoutConfig) *LayoutStore {
+	store := &LayoutStore{
+		path:     path,
+		defaults: make(map[LayoutBucket]LayoutConfig),
+		saved:    make(map[LayoutBucket]LayoutConfig),
+	}
+	for bucket, layout := range defaults {
+		store.defaults[LayoutBucket(bucket)] = layout
+	}
+
+	if path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			var saved map[LayoutBucket]LayoutConfig
+			if json.Unmarshal(data, &saved) == nil && saved != nil {
+				store.saved = saved
+			}
+		}
+	}
+
+	return store
+}
+
+// Get returns the layout for a bucket, preferring saved adjustments over config defaults
+func (s *LayoutStore) Get(bucket LayoutBucket) LayoutConfig {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if layout, ok := s.saved[bucket]; ok {
+		return layout
+	}
+	return s.defaults[bucket]
+}
+
+// Set remembers the layout for a bucket and persists all saved layouts
+func (s *LayoutStore) Set(bucket LayoutBucket, layout LayoutConfig) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.saved[bucket] = layout
+	return s.save()
+}
+
+// Reset forgets the saved layout for a bucket so the config default applies again
+func (s *LayoutStore) Reset(bucket LayoutBucket) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.saved, bucket)
+	return s.save()
+}
+
+// save writes saved layouts to disk; the caller must hold the lock
+func (s *LayoutStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(s.saved, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode layouts: %w", err)
+	}
+	if err := cache.WriteFile(s.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to save layouts: %w", err)
+	}
+	return nil
+}
+
+// getLayoutsFilePath returns the path layouts adjusted in the TUI are saved to
+func getLayoutsFilePath() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/.prcompass_layouts.json", homeDir)
+}
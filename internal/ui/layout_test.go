@@ -0,0 +1,243 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestLayoutBucketForWidth verifies terminal widths map to size buckets
+func TestLayoutBucketForWidth(t *testing.T) {
+	tests := []struct {
+		width    int
+		expected LayoutBucket
+	}{
+		{80, LayoutBucketNarrow},
+		{119, LayoutBucketNarrow},
+		{120, LayoutBucketLaptop},
+		{199, LayoutBucketLaptop},
+		{200, LayoutBucketUltrawide},
+		{340, LayoutBucketUltrawide},
+	}
+
+	for _, tt := range tests {
+		if got := layoutBucketForWidth(tt.width); got != tt.expected {
+			t.Errorf("layoutBucketForWidth(%d) = %s, expected %s", tt.width, got, tt.expected)
+		}
+	}
+}
+
+// TestApplyLayoutHidesColumns verifies hidden columns are zeroed and their width goes to the PR column
+func TestApplyLayoutHidesColumns(t *testing.T) {
+	columns := createTableColumnsForWidth(160)
+	layout := LayoutConfig{HiddenColumns: []string{"created", "comments"}}
+
+	result := applyLayout(columns, columnKeys, layout)
+
+	if result[8].Width != 0 || result[6].Width != 0 {
+		t.Errorf("Expected created and comments columns hidden, got widths %d and %d", result[8].Width, result[6].Width)
+	}
+	expectedPRWidth := columns[0].Width + columns[8].Width + columns[6].Width + 4
+	if result[0].Width != expectedPRWidth {
+		t.Errorf("Expected PR column width %d, got %d", expectedPRWidth, result[0].Width)
+	}
+	if columns[8].Width == 0 {
+		t.Error("applyLayout should not modify the input columns")
+	}
+}
+
+// TestHideNextColumn verifies columns are hidden in priority order and the PR column stays
+func TestHideNextColumn(t *testing.T) {
+	layout := LayoutConfig{}
+	for i := 0; i < len(columnHidePriority); i++ {
+		var ok bool
+		layout, _, ok = layout.hideNextColumn()
+		if !ok {
+			t.Fatalf("Expected column %d to be hideable", i)
+		}
+	}
+
+	if _, _, ok := layout.hideNextColumn(); ok {
+		t.Error("Expected nothing left to hide once only the PR column remains")
+	}
+	if layout.IsHidden("pr") {
+		t.Error("PR column must never be hidden")
+	}
+}
+
+// TestLayoutStorePersistence verifies saved layouts override config defaults and survive reloads
+func TestLayoutStorePersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "layouts.json")
+	defaults := map[string]LayoutConfig{
+		"laptop": {Density: DensityCompact},
+	}
+
+	store := NewLayoutStore(path, defaults)
+	if !store.Get(LayoutBucketLaptop).IsCompact() {
+		t.Error("Expected config default for laptop bucket")
+	}
+
+	ultrawide := LayoutConfig{HiddenColumns: []string{"files"}}
+	if err := store.Set(LayoutBucketUltrawide, ultrawide); err != nil {
+		t.Fatalf("Set() returned error: %v", err)
+	}
+
+	reloaded := NewLayoutStore(path, defaults)
+	if !reloaded.Get(LayoutBucketUltrawide).IsHidden("files") {
+		t.Error("Expected saved ultrawide layout after reload")
+	}
+
+	if err := reloaded.Reset(LayoutBucketUltrawide); err != nil {
+		t.Fatalf("Reset() returned error: %v", err)
+	}
+	if NewLayoutStore(path, defaults).Get(LayoutBucketUltrawide).IsHidden("files") {
+		t.Error("Expected reset layout to be forgotten on disk")
+	}
+}
+
+// TestWindowResizeSwitchesLayout verifies the layout for each size bucket is applied on resize
+func TestWindowResizeSwitchesLayout(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"test/repo"}})
+	model.Layouts = NewLayoutStore("", map[string]LayoutConfig{
+		"laptop": {Density: DensityCompact, HiddenColumns: []string{"created"}},
+	})
+
+	model.Update(tea.WindowSizeMsg{Width: 150, Height: 40})
+	columns := model.TabManager.GetActiveTab().Table.Columns()
+	if !model.layout.IsCompact() || columns[8].Width != 0 {
+		t.Errorf("Expected compact laptop layout with created hidden, got %+v", model.layout)
+	}
+
+	// Docking to a wide monitor switches to that bucket's (default) layout
+	model.Update(tea.WindowSizeMsg{Width: 250, Height: 60})
+	columns = model.TabManager.GetActiveTab().Table.Columns()
+	if model.layout.IsCompact() || columns[8].Width == 0 {
+		t.Errorf("Expected default ultrawide layout, got %+v", model.layout)
+	}
+
+	// Adjustments are remembered for the bucket they were made in
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("z")})
+	model.Update(tea.WindowSizeMsg{Width: 150, Height: 40})
+	model.Update(tea.WindowSizeMsg{Width: 250, Height: 60})
+	if !model.layout.IsCompact() {
+		t.Error("Expected density change to be remembered for ultrawide screens")
+	}
+}
+
+// TestFitColumns verifies overflowing columns shrink the PR column, then hide in priority order
+func TestFitColumns(t *testing.T) {
+	columns := createTableColumnsForWidth(160)
+
+	// Wide enough: only the PR column changes, taking up the slack
+	fitted := fitColumns(columns, columnKeys, 200, false)
+	for i := 1; i < len(fitted); i++ {
+		if fitted[i].Width != columns[i].Width {
+			t.Errorf("Expected column %d untouched, got width %d", i, fitted[i].Width)
+		}
+	}
+	if fitted[0].Width <= columns[0].Width {
+		t.Errorf("Expected the PR column to take the spare width, got %d", fitted[0].Width)
+	}
+
+	// Narrow: the least important columns go first, the PR column stays
+	fitted = fitColumns(columns, columnKeys, 80, false)
+	if fitted[8].Width != 0 || fitted[1].Width != 0 {
+		t.Errorf("Expected created and type hidden, got widths %d and %d", fitted[8].Width, fitted[1].Width)
+	}
+	if fitted[3].Width == 0 || fitted[0].Width < fitTitleWidth {
+		t.Errorf("Expected repo and PR columns kept, got widths %d and %d", fitted[3].Width, fitted[0].Width)
+	}
+	total := tableChrome
+	for _, column := range fitted {
+		if column.Width > 0 {
+			total += column.Width + fitCellPadding
+		}
+	}
+	if total != 80 {
+		t.Errorf("Expected columns to fill 80 columns exactly, got %d", total)
+	}
+
+	// Unknown terminal width leaves the columns alone
+	if fitted = fitColumns(columns, columnKeys, 0, false); fitted[0].Width != columns[0].Width {
+		t.Error("Expected no fitting without a terminal width")
+	}
+}
+
+// TestWindowResizeFitsTerminal verifies the view stays within the terminal
+// as it is resized, and titles are truncated to the new width
+func TestWindowResizeFitsTerminal(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"test/repo"}})
+	pr := labeledPR(1)
+	pr.Title = gh.String("Rework the scheduler so that retries back off exponentially across every queue")
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: []*gh.PullRequest{pr}})
+
+	for _, width := range []int{60, 80, 120, 200} {
+		model.Update(tea.WindowSizeMsg{Width: width, Height: 40})
+		for _, line := range strings.Split(model.View(), "\n") {
+			if lipgloss.Width(line) > width {
+				t.Errorf("Expected lines within %d columns, got %d: %q", width, lipgloss.Width(line), line)
+				break
+			}
+		}
+
+		tab := model.TabManager.GetActiveTab()
+		if title := tab.Table.Rows()[0][0]; len(title) > tab.Table.Columns()[0].Width {
+			t.Errorf("Expected the title truncated to %d columns at width %d, got %q", tab.Table.Columns()[0].Width, width, title)
+		}
+	}
+}
+
+// TestColumnScrolling verifies ←/→ scroll columns that don't fit into view and back
+func TestColumnScrolling(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"test/repo"}})
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: []*gh.PullRequest{labeledPR(1)}})
+	model.Update(tea.WindowSizeMsg{Width: 80, Height: 40})
+	tab := model.TabManager.GetActiveTab()
+	if tab.ColumnsShown >= tab.ColumnsTotal {
+		t.Fatalf("Expected columns dropped at 80 wide, got %d of %d", tab.ColumnsShown, tab.ColumnsTotal)
+	}
+	if footer := renderTableFooter(tab); !strings.Contains(footer, "columns, ←/→ scroll") {
+		t.Errorf("Expected the footer to point out the hidden columns, got %q", footer)
+	}
+
+	right := tea.KeyMsg{Type: tea.KeyRight}
+	seen := make(map[int]bool)
+	for i := 0; i < len(columnKeys); i++ {
+		model.Update(right)
+		for index, column := range tab.Table.Columns() {
+			if column.Width > 0 {
+				seen[index] = true
+			}
+		}
+	}
+	if len(seen) != len(columnKeys) {
+		t.Errorf("Expected scrolling to reach every column, saw %d of %d", len(seen), len(columnKeys))
+	}
+	if tab.StatusMsg != "Scrolled to the last column" {
+		t.Errorf("Expected scrolling to stop at the last column, got %q", tab.StatusMsg)
+	}
+	if columns := tab.Table.Columns(); columns[len(columns)-1].Width == 0 || columns[1].Width != 0 {
+		t.Error("Expected the last columns in view and the first scrolled past")
+	}
+
+	for i := 0; i < len(columnKeys)+1; i++ {
+		model.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	}
+	if tab.ColumnOffset != 0 {
+		t.Errorf("Expected scrolling back to the start, got offset %d", tab.ColumnOffset)
+	}
+
+	// Widening the terminal until every column fits ends scrolling
+	model.Update(right)
+	model.Update(tea.WindowSizeMsg{Width: 250, Height: 40})
+	if tab.ColumnOffset != 0 || tab.ColumnsShown != tab.ColumnsTotal {
+		t.Errorf("Expected every column shown unscrolled, got offset %d and %d of %d", tab.ColumnOffset, tab.ColumnsShown, tab.ColumnsTotal)
+	}
+}
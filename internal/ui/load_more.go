@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/bjess9/pr-compass/internal/config"
+	"github.com/bjess9/pr-compass/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// loadMoreThreshold is how close to the last row the selection gets before
+// the next page of PRs shows
+const loadMoreThreshold = 5
+
+// prPageSize is how many PRs a tab shows at first and adds per page loaded:
+// its max_prs
+func (t *TabState) prPageSize() int {
+	return t.Config.ConvertToConfig().MaxPRs
+}
+
+// prLimit is how many PRs the tab shows, a page more for every page loaded
+func (t *TabState) prLimit() int {
+	if t.PRLimit > 0 {
+		return t.PRLimit
+	}
+	return t.prPageSize()
+}
+
+// fetchConfig is the tab's provider config, keeping every PR the page depth
+// lists so later pages show without fetching again. The depth includes the
+// pages listed by scrolling past max_pages.
+func (t *TabState) fetchConfig() *config.Config {
+	cfg := t.Config.ConvertToConfig()
+	cfg.MaxPRs = github.AllPRs
+	if t.ExtraPages > 0 {
+		cfg.MaxPages = github.MaxPages(cfg) + t.ExtraPages
+	}
+	return cfg
+}
+
+// showListed keeps a fetched list as the tab's ListedPRs and returns the PRs
+// to show: the most recently updated up to the tab's limit
+func (t *TabState) showListed(listed []*gh.PullRequest) []*gh.PullRequest {
+	t.ListedPRs = listed
+	return github.LimitPRs(&config.Config{MaxPRs: t.prLimit()}, append([]*gh.PullRequest(nil), listed...))
+}
+
+// morePRs reports whether the last fetch listed PRs past the tab's limit
+func (t *TabState) morePRs() bool {
+	return len(t.ListedPRs) > t.prLimit()
+}
+
+// moreOpen reports whether the page depth left open PRs unlisted that
+// listing another page could reach
+func (t *TabState) moreOpen() bool {
+	return !t.ListedAll && t.PRCounts.Truncated()
+}
+
+// loadMoreCmd shows the next page of the listed PRs once the selection nears
+// the last row. The pages come from the last fetch, so no requests are made
+// until their details load: only shown PRs are enhanced, so detail requests
+// grow with what's browsed rather than with the scope. Once every listed PR
+// shows, it lists deeper instead.
+func (m *MultiTabModel) loadMoreCmd(tab *TabState) tea.Cmd {
+	if len(tab.FilteredPRs) == 0 || tab.Table.Cursor() < len(tab.Table.Rows())-loadMoreThreshold {
+		return nil
+	}
+	if !tab.morePRs() {
+		return m.listMoreCmd(tab)
+	}
+
+	tab.PRLimit = tab.prLimit() + tab.prPageSize()
+	prs := tab.showListed(tab.ListedPRs)
+	if err := tab.Seen.Baseline(prs); err != nil {
+		m.Log.Warn("Read state not saved", "err", err)
+	}
+	m.setTabPRs(tab, prs)
+	tab.StatusMsg = fmt.Sprintf("⏬ Showing %d of %d PRs", len(tab.PRs), len(tab.ListedPRs))
+	m.Log.Info("Showing more PRs", "tab", tab.Config.Name, "limit", tab.PRLimit)
+	return tea.Batch(m.startEnhancementForTab(tab), m.stackMetadataCmd(tab), m.requiredChecksCmd(tab),
+		m.teamMembersCmd(tab), m.projectItemsCmd(tab), m.ticketsCmd(tab))
+}
+
+// listMoreCmd lists another page of open PRs per repo, or of search results,
+// once every listed PR shows and the counts say the page depth left some
+// out. It doesn't wait for the tab's next refresh, but still goes through
+// the rate limiter; pages listed before revalidate against the cache.
+func (m *MultiTabModel) listMoreCmd(tab *TabState) tea.Cmd {
+	if !tab.moreOpen() || tab.ListingMore || tab.BackgroundRefreshing {
+		return nil
+	}
+
+	tab.ExtraPages++
+	tab.PRLimit = tab.prLimit() + tab.prPageSize()
+	tab.ListingMore = true
+	tab.BackgroundRefreshing = true
+	pages := github.MaxPages(tab.fetchConfig())
+	tab.StatusMsg = fmt.Sprintf("⏬ Listing page %d of open PRs...", pages)
+	m.Log.Info("Listing more PRs", "tab", tab.Config.Name, "pages", pages)
+	return m.fetchPRsForTab(tab)
+}
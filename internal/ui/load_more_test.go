@@ -0,0 +1,112 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestShowListed tests showing the most recently updated listed PRs up to
+// the tab's limit
+func TestShowListed(t *testing.T) {
+	tab := &TabState{Config: &TabConfig{Name: "Main", Mode: "repos", MaxPRs: 2}}
+	now := time.Now()
+	listed := []*gh.PullRequest{updatedPR(1, now.Add(-3*time.Hour)), updatedPR(2, now), updatedPR(3, now.Add(-time.Hour))}
+
+	shown := tab.showListed(listed)
+	if len(shown) != 2 || shown[0].GetNumber() != 2 || shown[1].GetNumber() != 3 || !tab.morePRs() {
+		t.Errorf("Expected PRs 2 and 3 shown with more listed, got %d PRs (more %v)", len(shown), tab.morePRs())
+	}
+	if len(tab.ListedPRs) != 3 || tab.ListedPRs[0].GetNumber() != 1 {
+		t.Error("Expected the listing kept as fetched")
+	}
+	if shown := tab.showListed(listed[:2]); len(shown) != 2 || tab.morePRs() {
+		t.Errorf("Expected a list within the limit shown whole, got %d PRs (more %v)", len(shown), tab.morePRs())
+	}
+}
+
+// TestLoadMoreNearBottom tests that scrolling near the last row shows the
+// next page of the listed PRs without fetching again
+func TestLoadMoreNearBottom(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	model.TabManager.AddTab(&TabConfig{Name: "Big", Mode: "repos", Repos: []string{"org/api"}, MaxPRs: 10})
+	tab := model.TabManager.GetActiveTab()
+	if cfg := tab.fetchConfig(); cfg.MaxPRs != github.AllPRs {
+		t.Errorf("Expected fetches to keep every listed PR, got %d", cfg.MaxPRs)
+	}
+
+	var prs []*gh.PullRequest
+	for i := 1; i <= 13; i++ {
+		prs = append(prs, updatedPR(i, time.Now().Add(-time.Duration(i)*time.Hour)))
+	}
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Big", prs: prs})
+	if len(tab.Table.Rows()) != 10 {
+		t.Fatalf("Expected the first 10 PRs shown, got %d", len(tab.Table.Rows()))
+	}
+	if footer := renderTableFooter(tab); !strings.Contains(footer, "more load as you scroll down") {
+		t.Errorf("Expected the footer to mention more PRs, got %q", footer)
+	}
+	if model.loadMoreCmd(tab) != nil {
+		t.Error("Expected nothing loaded with the selection at the top")
+	}
+
+	for i := 0; i < 9 && len(tab.Table.Rows()) == 10; i++ {
+		model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	if tab.Table.Cursor() != 5 || tab.PRLimit != 20 || len(tab.Table.Rows()) != 13 {
+		t.Fatalf("Expected the next page shown near the bottom, got %d rows at limit %d", len(tab.Table.Rows()), tab.PRLimit)
+	}
+	if tab.morePRs() || strings.Contains(renderTableFooter(tab), "more load") {
+		t.Error("Expected nothing left to load")
+	}
+
+	// Refreshes keep the pages shown
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Big", prs: prs})
+	if len(tab.Table.Rows()) != 13 {
+		t.Errorf("Expected a refresh to keep 13 PRs shown, got %d", len(tab.Table.Rows()))
+	}
+}
+
+// TestListMoreAtDepth tests that scrolling past every listed PR of a partial
+// list lists another page, until a page adds nothing
+func TestListMoreAtDepth(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	model.TabManager.AddTab(&TabConfig{Name: "Big", Mode: "repos", Repos: []string{"org/api"}, MaxPRs: 10})
+	tab := model.TabManager.GetActiveTab()
+
+	var prs []*gh.PullRequest
+	for i := 1; i <= 10; i++ {
+		prs = append(prs, updatedPR(i, time.Now().Add(-time.Duration(i)*time.Hour)))
+	}
+	counts := github.PRCounts{"org/api": {Open: 450, Fetched: 300}}
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Big", prs: prs, counts: counts})
+	if footer := renderTableFooter(tab); !strings.Contains(footer, "more load as you scroll down") {
+		t.Errorf("Expected the footer to mention more PRs, got %q", footer)
+	}
+
+	for i := 0; i < 9; i++ {
+		tab.Table.MoveDown(1)
+	}
+	if cmd := model.loadMoreCmd(tab); cmd == nil || !tab.ListingMore || tab.ExtraPages != 1 {
+		t.Fatalf("Expected another page listed, got extra pages %d", tab.ExtraPages)
+	}
+	if pages := tab.fetchConfig().MaxPages; pages != 4 || tab.PRLimit != 20 {
+		t.Errorf("Expected 4 pages listed for 20 PRs, got %d pages for %d", pages, tab.PRLimit)
+	}
+	if model.loadMoreCmd(tab) != nil {
+		t.Error("Expected one page listed at a time")
+	}
+
+	// A page adding nothing ends the listing
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Big", prs: prs, counts: counts})
+	if !tab.ListedAll || tab.ListingMore || !strings.Contains(tab.StatusMsg, "all 10 PRs the API lists") {
+		t.Errorf("Expected the listing ended, got status %q", tab.StatusMsg)
+	}
+	if model.loadMoreCmd(tab) != nil || strings.Contains(renderTableFooter(tab), "more load") {
+		t.Error("Expected nothing left to list")
+	}
+}
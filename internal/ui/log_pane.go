@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// logCapacity caps the records kept for the log pane, dropping the oldest
+	logCapacity = 500
+
+	// logPaneLines caps the records the log pane shows
+	logPaneLines = 12
+)
+
+// logLevels are cycled through with e, from everything to errors only
+var logLevels = []slog.Level{slog.LevelDebug, slog.LevelInfo, slog.LevelWarn, slog.LevelError}
+
+// cycleLogLevel shows the log pane at the next level, wrapping from errors
+// back to debug
+func (m *MultiTabModel) cycleLogLevel(tab *TabState) {
+	next := logLevels[0]
+	for i, level := range logLevels {
+		if level == m.logLevel && i+1 < len(logLevels) {
+			next = logLevels[i+1]
+		}
+	}
+	m.logLevel = next
+	m.ShowLog = true
+	tab.StatusMsg = fmt.Sprintf("Log: %s and above", next)
+}
+
+// renderLogPane renders the newest log records at or above the pane's
+// level, oldest first like a tailed log
+func (m *MultiTabModel) renderLogPane() string {
+	entries := m.logRing.Entries(m.logLevel)
+	hidden := 0
+	if len(entries) > logPaneLines {
+		hidden = len(entries) - logPaneLines
+		entries = entries[hidden:]
+	}
+
+	var lines []string
+	if hidden > 0 {
+		lines = append(lines, mutedStyle.Render(fmt.Sprintf("+%d earlier", hidden)))
+	}
+	for _, entry := range entries {
+		line := entry.String()
+		switch {
+		case entry.Level >= slog.LevelError:
+			line = lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Error)).Render(line)
+		case entry.Level >= slog.LevelWarn:
+			line = lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Warning)).Render(line)
+		case entry.Level < slog.LevelInfo:
+			line = mutedStyle.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	if len(entries) == 0 {
+		lines = []string{mutedStyle.Render(fmt.Sprintf("Nothing logged at %s or above yet", m.logLevel))}
+	}
+
+	title := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.TextBright)).
+		Render(fmt.Sprintf("🪵 Log (%s+)", m.logLevel)) + mutedStyle.Render("  e level  E close")
+	return "\n" + repoInfoStyle.Render(title+"\n"+strings.Join(lines, "\n"))
+}
@@ -0,0 +1,47 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestLogPane tests that fetch and enhancement problems reach the log pane
+// and that e changes the level it shows
+func TestLogPane(t *testing.T) {
+	model, tab := presetTestModel(t)
+	model.TabManager.SwitchToTab(0)
+	model.Update(tabPrsMsg{tabName: "Main", err: errors.New("502 Bad Gateway")})
+	model.Update(types.PrEnhancementUpdateMsg{PrData: types.EnhancedData{Number: 2}, Error: errors.New("timeout")})
+	model.startEnhancementForTab(tab)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("E")})
+	view := model.renderActiveTabContent(tab)
+	if !strings.Contains(view, "Fetch failed tab=Main") || !strings.Contains(view, `err="502 Bad Gateway"`) {
+		t.Error("Expected the fetch failure in the log pane")
+	}
+	if !strings.Contains(view, "Enhancement failed tab=Main pr=2 err=timeout") {
+		t.Error("Expected the enhancement failure in the log pane")
+	}
+	if strings.Contains(view, "Enhancing PRs") {
+		t.Error("Expected debug records hidden at the default level")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	if model.logLevel.String() != "ERROR" || !strings.Contains(model.renderActiveTabContent(tab), "Nothing logged at ERROR") {
+		t.Errorf("Expected e to step the level up to errors, got %s", model.logLevel)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	if view := model.renderActiveTabContent(tab); !strings.Contains(view, "Enhancing PRs tab=Main") {
+		t.Error("Expected e to wrap around to debug records")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("E")})
+	if strings.Contains(model.renderActiveTabContent(tab), "🪵 Log") {
+		t.Error("Expected E to close the log pane")
+	}
+}
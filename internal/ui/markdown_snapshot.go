@@ -0,0 +1,40 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// markdownEscaper escapes characters that would break a markdown table cell or link text
+var markdownEscaper = strings.NewReplacer("|", `\|`, "[", `\[`, "]", `\]`, "\n", " ", "\r", "")
+
+// markdownSnapshot renders PRs as a markdown table with linked titles, ready
+// to paste into chat or an issue
+func markdownSnapshot(prs []*gh.PullRequest, enhancedData map[int]types.EnhancedData, staleDays int) string {
+	var b strings.Builder
+	b.WriteString("| PR | Repo | Author | Status | Review |\n")
+	b.WriteString("| --- | --- | --- | --- | --- |\n")
+
+	for _, pr := range prs {
+		title := markdownEscaper.Replace(fmt.Sprintf("#%d %s", pr.GetNumber(), pr.GetTitle()))
+		if pr.GetHTMLURL() != "" {
+			title = fmt.Sprintf("[%s](%s)", title, pr.GetHTMLURL())
+		}
+		status := getPRStatusIndicatorEnhanced(pr, enhancedData)
+		if isHistoryPR(pr) {
+			status = historyStatus(pr, time.Now())
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n",
+			title,
+			markdownEscaper.Replace(repoFullName(pr)),
+			markdownEscaper.Replace(pr.GetUser().GetLogin()),
+			status,
+			getPRReviewIndicatorEnhanced(pr, enhancedData, staleDays))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestMarkdownSnapshot verifies PRs render as a markdown table with linked, escaped titles
+func TestMarkdownSnapshot(t *testing.T) {
+	prs := []*gh.PullRequest{
+		{
+			Number:  gh.Int(42),
+			Title:   gh.String("fix: handle a|b [edge] case"),
+			HTMLURL: gh.String("https://github.com/org/api/pull/42"),
+			User:    &gh.User{Login: gh.String("alice")},
+			Base:    &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/api")}},
+		},
+		{
+			Number: gh.Int(7),
+			Title:  gh.String("docs: readme"),
+			User:   &gh.User{Login: gh.String("bob")},
+		},
+	}
+	enhanced := map[int]types.EnhancedData{
+		42: {Number: 42, Mergeable: "clean", ChecksStatus: "success", ReviewStatus: "approved"},
+	}
+
+	lines := strings.Split(strings.TrimSpace(markdownSnapshot(prs, enhanced, defaultStaleDays)), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected header, separator and 2 rows, got %d lines:\n%s", len(lines), strings.Join(lines, "\n"))
+	}
+	if lines[0] != "| PR | Repo | Author | Status | Review |" {
+		t.Errorf("unexpected header %q", lines[0])
+	}
+
+	want := `| [#42 fix: handle a\|b \[edge\] case](https://github.com/org/api/pull/42) | org/api | alice | ✅ Ready | ✅ Approved |`
+	if lines[2] != want {
+		t.Errorf("row = %q\nwant  %q", lines[2], want)
+	}
+
+	// PRs without a URL keep a plain title
+	if !strings.HasPrefix(lines[3], "| #7 docs: readme |  | bob |") {
+		t.Errorf("unexpected row without URL %q", lines[3])
+	}
+}
@@ -0,0 +1,124 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// mergeResultMsg reports the outcome of merging a single PR
+type mergeResultMsg struct {
+	tabName string
+	key     string // services.PRKey of the merged PR
+	method  string
+	err     error
+}
+
+// pickMergeMethod asks how to merge the selected PR. Choosing a method is the
+// confirmation; escape cancels.
+func (m *MultiTabModel) pickMergeMethod(tab *TabState) {
+	if m.writeUnavailable(tab) {
+		return
+	}
+	pr := tab.SelectedPR()
+	if pr == nil {
+		tab.StatusMsg = "No PR selected"
+		return
+	}
+	if pr.GetDraft() {
+		tab.StatusMsg = fmt.Sprintf("%s is a draft - mark it ready for review before merging", services.PRKey(pr))
+		return
+	}
+
+	cursor := 0
+	for i, method := range github.MergeMethods {
+		if method == m.lastMergeMethod {
+			cursor = i
+		}
+	}
+	m.pendingChoice = &choicePrompt{
+		label:   fmt.Sprintf("🔀 Merge %s with:", services.PRKey(pr)),
+		options: github.MergeMethods,
+		cursor:  cursor,
+		onChoose: func(method string) tea.Cmd {
+			m.lastMergeMethod = method
+			tab.StatusMsg = fmt.Sprintf("Merging %s (%s)...", services.PRKey(pr), method)
+			return m.mergeCmd(tab.Config.Name, pr, method)
+		},
+	}
+	tab.StatusMsg = m.pendingChoice.status()
+}
+
+// mergeCmd merges one PR with the given method
+func (m *MultiTabModel) mergeCmd(tabName string, pr *gh.PullRequest, method string) tea.Cmd {
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 15*time.Second)
+		defer cancel()
+
+		err := github.MergePullRequest(ctx, token, pr, method)
+		return mergeResultMsg{tabName: tabName, key: services.PRKey(pr), method: method, err: err}
+	}
+}
+
+// handleMergeResult reports a merge and moves the merged PR from every tab's
+// list to its recently completed section, since only open PRs are listed
+func (m *MultiTabModel) handleMergeResult(msg mergeResultMsg) (tea.Model, tea.Cmd) {
+	status := fmt.Sprintf("🔀 Merged %s (%s)", msg.key, msg.method)
+	if msg.err != nil {
+		status = msg.err.Error()
+	} else {
+		now := time.Now()
+		for _, tab := range m.TabManager.Tabs {
+			if removed := m.removePR(tab, msg.key); removed != nil {
+				merged := *removed
+				merged.State = gh.String("closed")
+				merged.Merged = gh.Bool(true)
+				m.addRecentlyCompleted(tab, []CompletedPR{{PR: &merged, At: now}})
+			}
+		}
+	}
+
+	for _, tab := range m.TabManager.Tabs {
+		if tab.Config.Name == msg.tabName {
+			tab.StatusMsg = status
+		}
+	}
+	return m, nil
+}
+
+// removePR drops a PR from a tab without disturbing its filters, returning
+// the dropped PR, or nil when the tab didn't list it
+func (m *MultiTabModel) removePR(tab *TabState, key string) *gh.PullRequest {
+	var removed *gh.PullRequest
+	without := func(prs []*gh.PullRequest) []*gh.PullRequest {
+		kept := make([]*gh.PullRequest, 0, len(prs))
+		for _, pr := range prs {
+			if services.PRKey(pr) != key {
+				kept = append(kept, pr)
+			} else {
+				removed = pr
+			}
+		}
+		return kept
+	}
+	kept := without(tab.PRs)
+	if removed == nil {
+		return nil
+	}
+
+	tab.PRs = kept
+	tab.FilteredPRs = without(tab.FilteredPRs)
+	tab.ListedPRs = without(tab.ListedPRs)
+	tab.DuplicateGroups = services.DetectDuplicateGroups(tab.PRs)
+	m.updateTableRows(tab)
+	if cursor := tab.Table.Cursor(); cursor >= len(tab.FilteredPRs) && cursor > 0 {
+		tab.Table.SetCursor(len(tab.FilteredPRs) - 1)
+	}
+	return removed
+}
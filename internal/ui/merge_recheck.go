@@ -0,0 +1,111 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// mergeRecheckDelay gives GitHub time to recompute mergeability after a
+// base branch moves; it reports "unknown" until it has
+const mergeRecheckDelay = 15 * time.Second
+
+// mergeRecheckAttempts caps how often a PR whose mergeability is still
+// being computed is checked again
+const mergeRecheckAttempts = 3
+
+// mergeRecheckMsg delivers fresh enhancement data for conflicting PRs
+type mergeRecheckMsg struct {
+	tabName string
+	prs     []*gh.PullRequest
+	results []types.EnhancedData
+	errs    []error
+	attempt int
+}
+
+// baseMovedConflicts returns the PRs shown with conflicts whose base branch
+// received new commits since the previous refresh
+func baseMovedConflicts(tab *TabState, previous, current []*gh.PullRequest) []*gh.PullRequest {
+	baseSHAs := make(map[string]string, len(previous))
+	for _, pr := range previous {
+		baseSHAs[services.PRKey(pr)] = pr.GetBase().GetSHA()
+	}
+
+	var moved []*gh.PullRequest
+	for _, pr := range current {
+		if tab.EnhancedData[pr.GetNumber()].Mergeable != "conflicts" {
+			continue
+		}
+		before, seen := baseSHAs[services.PRKey(pr)]
+		if seen && before != "" && before != pr.GetBase().GetSHA() {
+			moved = append(moved, pr)
+		}
+	}
+	return moved
+}
+
+// mergeRecheckCmd re-enhances PRs after mergeRecheckDelay, so the Status
+// column catches up without waiting for the next enhancement pass
+func (m *MultiTabModel) mergeRecheckCmd(tab *TabState, prs []*gh.PullRequest, attempt int) tea.Cmd {
+	if len(prs) == 0 || m.readOnly() || !tab.Config.OnGitHub() {
+		return nil
+	}
+
+	token := m.TabManager.Token
+	tabName := tab.Config.Name
+	return func() tea.Msg {
+		time.Sleep(mergeRecheckDelay)
+
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 20*time.Second)
+		defer cancel()
+		results, errs := services.FetchEnhancedBatch(ctx, token, prs)
+		return mergeRecheckMsg{tabName: tabName, prs: prs, results: results, errs: errs, attempt: attempt}
+	}
+}
+
+// handleMergeRecheck stores rechecked PRs, announces resolved conflicts and
+// checks again on PRs GitHub is still computing
+func (m *MultiTabModel) handleMergeRecheck(msg mergeRecheckMsg) (tea.Model, tea.Cmd) {
+	var tab *TabState
+	for _, candidate := range m.TabManager.Tabs {
+		if candidate.Config.Name == msg.tabName {
+			tab = candidate
+			break
+		}
+	}
+	if tab == nil {
+		return m, nil // Closed meanwhile
+	}
+
+	var resolved []string
+	var computing []*gh.PullRequest
+	for i, pr := range msg.prs {
+		if msg.errs[i] != nil {
+			continue // The next enhancement pass catches up
+		}
+		data := msg.results[i]
+		if tab.EnhancedData[data.Number].Mergeable == "conflicts" && data.Mergeable == "clean" {
+			resolved = append(resolved, services.PRKey(pr))
+		}
+		if data.Mergeable == "unknown" {
+			computing = append(computing, pr)
+		}
+		tab.EnhancedData[data.Number] = data
+	}
+
+	if len(resolved) > 0 {
+		tab.StatusMsg = fmt.Sprintf("✅ No longer conflicting after base branch update: %s", strings.Join(resolved, ", "))
+	}
+	m.updateTableRows(tab)
+
+	if msg.attempt+1 < mergeRecheckAttempts {
+		return m, m.mergeRecheckCmd(tab, computing, msg.attempt+1)
+	}
+	return m, nil
+}
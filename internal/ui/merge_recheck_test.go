@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	gh "github.com/google/go-github/v55/github"
+)
+
+func recheckTestPR(number int, baseSHA string) *gh.PullRequest {
+	return &gh.PullRequest{
+		Number: gh.Int(number),
+		Title:  gh.String("Change"),
+		User:   &gh.User{Login: gh.String("alice")},
+		Base:   &gh.PullRequestBranch{SHA: gh.String(baseSHA), Repo: &gh.Repository{FullName: gh.String("org/api")}},
+	}
+}
+
+// TestBaseMovedConflicts tests which PRs a refresh schedules for a recheck
+func TestBaseMovedConflicts(t *testing.T) {
+	_, tab := approveTestModel("test-token")
+	tab.EnhancedData[1] = types.EnhancedData{Number: 1, Mergeable: "conflicts"}
+	tab.EnhancedData[2] = types.EnhancedData{Number: 2, Mergeable: "conflicts"}
+	tab.EnhancedData[3] = types.EnhancedData{Number: 3, Mergeable: "clean"}
+	previous := []*gh.PullRequest{recheckTestPR(1, "aaa"), recheckTestPR(2, "aaa"), recheckTestPR(3, "aaa")}
+	current := []*gh.PullRequest{recheckTestPR(1, "bbb"), recheckTestPR(2, "aaa"), recheckTestPR(3, "bbb"), recheckTestPR(4, "bbb")}
+
+	moved := baseMovedConflicts(tab, previous, current)
+	if len(moved) != 1 || moved[0].GetNumber() != 1 {
+		t.Errorf("Expected only the conflicting PR with a moved base, got %d PRs", len(moved))
+	}
+}
+
+// TestMergeRecheck tests that a refresh triggers a recheck and its result updates the tab
+func TestMergeRecheck(t *testing.T) {
+	model, tab := approveTestModel("test-token")
+	tab.PRs = []*gh.PullRequest{recheckTestPR(12, "aaa")}
+	tab.EnhancedData[12] = types.EnhancedData{Number: 12, Mergeable: "conflicts"}
+
+	_, cmd := model.Update(tabPrsMsg{tabName: "Main", prs: []*gh.PullRequest{recheckTestPR(12, "bbb")}})
+	if cmd == nil {
+		t.Fatal("Expected the refresh to schedule a recheck")
+	}
+
+	pr := tab.PRs[0]
+	_, cmd = model.Update(mergeRecheckMsg{
+		tabName: "Main",
+		prs:     []*gh.PullRequest{pr},
+		results: []types.EnhancedData{{Number: 12, Mergeable: "clean"}},
+		errs:    []error{nil},
+	})
+	if tab.EnhancedData[12].Mergeable != "clean" || !strings.Contains(tab.StatusMsg, "org/api#12") {
+		t.Errorf("Expected the resolved conflict to be shown, got %q", tab.StatusMsg)
+	}
+	if cmd != nil {
+		t.Error("Expected no further recheck once mergeability is known")
+	}
+
+	// GitHub may still be computing; check again a limited number of times
+	_, cmd = model.Update(mergeRecheckMsg{
+		tabName: "Main",
+		prs:     []*gh.PullRequest{pr},
+		results: []types.EnhancedData{{Number: 12, Mergeable: "unknown"}},
+		errs:    []error{nil},
+	})
+	if cmd == nil {
+		t.Error("Expected another recheck while mergeability is unknown")
+	}
+	_, cmd = model.Update(mergeRecheckMsg{
+		tabName: "Main",
+		prs:     []*gh.PullRequest{pr},
+		results: []types.EnhancedData{{Number: 12, Mergeable: "unknown"}},
+		errs:    []error{nil},
+		attempt: mergeRecheckAttempts - 1,
+	})
+	if cmd != nil {
+		t.Error("Expected rechecks to stop after the last attempt")
+	}
+}
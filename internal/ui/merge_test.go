@@ -0,0 +1,107 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+func mergeTestModel(token string) (*MultiTabModel, *TabState) {
+	model := NewMultiTabModel(token, nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Main", Mode: "repos", Repos: []string{"org/api"}})
+	for _, number := range []int{12, 13} {
+		tab.PRs = append(tab.PRs, &gh.PullRequest{
+			Number: gh.Int(number),
+			Title:  gh.String("Add retries"),
+			User:   &gh.User{Login: gh.String("alice")},
+			Base:   &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/api")}},
+		})
+	}
+	tab.FilteredPRs = tab.PRs
+	tab.Loaded = true
+	model.updateTableRows(tab)
+	return model, tab
+}
+
+// TestHotkeyMergePicker tests choosing a merge method and cancelling the picker
+func TestHotkeyMergePicker(t *testing.T) {
+	model, tab := mergeTestModel("test-token")
+	press := func(msg tea.KeyMsg) tea.Cmd {
+		_, cmd := model.Update(msg)
+		return cmd
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	press(runes("M"))
+	if model.pendingChoice == nil || !strings.Contains(tab.StatusMsg, "[1 merge]") {
+		t.Fatalf("Expected merge picker with merge preselected, got %q", tab.StatusMsg)
+	}
+
+	// Keys like q and tab stay inside the picker
+	press(tea.KeyMsg{Type: tea.KeyTab})
+	if !strings.Contains(tab.StatusMsg, "[2 squash]") {
+		t.Errorf("Expected tab to move to squash, got %q", tab.StatusMsg)
+	}
+	if cmd := press(runes("q")); cmd != nil || model.pendingChoice != nil || tab.StatusMsg != "Cancelled" {
+		t.Fatalf("Expected q to cancel the picker, got %q", tab.StatusMsg)
+	}
+
+	press(runes("M"))
+	press(tea.KeyMsg{Type: tea.KeyLeft})
+	if cmd := press(tea.KeyMsg{Type: tea.KeyEnter}); cmd == nil {
+		t.Fatal("Expected enter to start the merge")
+	}
+	if model.lastMergeMethod != "rebase" || !strings.Contains(tab.StatusMsg, "Merging org/api#12 (rebase)") {
+		t.Errorf("Expected rebase merge in progress, got %q", tab.StatusMsg)
+	}
+
+	// The last method is preselected next time, and digits pick directly
+	press(runes("M"))
+	if !strings.Contains(tab.StatusMsg, "[3 rebase]") {
+		t.Errorf("Expected rebase preselected, got %q", tab.StatusMsg)
+	}
+	if cmd := press(runes("2")); cmd == nil || model.lastMergeMethod != "squash" {
+		t.Error("Expected 2 to merge with squash")
+	}
+}
+
+// TestMergeResult tests removing merged PRs and surfacing rejections
+func TestMergeResult(t *testing.T) {
+	model, tab := mergeTestModel("test-token")
+	tab.Table.SetCursor(1)
+
+	model.Update(mergeResultMsg{tabName: "Main", key: "org/api#12", method: "squash", err: errors.New(`cannot merge org/api#12: Required status check "build" is expected.`)})
+	if len(tab.PRs) != 2 || !strings.Contains(tab.StatusMsg, "Required status check") {
+		t.Errorf("Expected rejection in status and PR kept, got %q", tab.StatusMsg)
+	}
+
+	model.Update(mergeResultMsg{tabName: "Main", key: "org/api#13", method: "squash"})
+	if len(tab.PRs) != 1 || len(tab.FilteredPRs) != 1 || tab.PRs[0].GetNumber() != 12 {
+		t.Fatalf("Expected merged PR removed, got %d PRs", len(tab.PRs))
+	}
+	if tab.Table.Cursor() != 0 || tab.StatusMsg != "🔀 Merged org/api#13 (squash)" {
+		t.Errorf("Expected cursor clamped and merge reported, got cursor %d, %q", tab.Table.Cursor(), tab.StatusMsg)
+	}
+	if len(tab.RecentlyCompleted) != 1 || !tab.RecentlyCompleted[0].PR.GetMerged() {
+		t.Errorf("Expected the merged PR among recently completed, got %+v", tab.RecentlyCompleted)
+	}
+}
+
+// TestMergeGuards tests read-only mode and drafts
+func TestMergeGuards(t *testing.T) {
+	model, tab := mergeTestModel("")
+	model.pickMergeMethod(tab)
+	if model.pendingChoice != nil || tab.StatusMsg != readOnlyActionMsg {
+		t.Errorf("Expected read-only notice, got %q", tab.StatusMsg)
+	}
+
+	model, tab = mergeTestModel("test-token")
+	tab.PRs[0].Draft = gh.Bool(true)
+	model.pickMergeMethod(tab)
+	if model.pendingChoice != nil || !strings.Contains(tab.StatusMsg, "draft") {
+		t.Errorf("Expected draft notice, got %q", tab.StatusMsg)
+	}
+}
@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"context"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/metrics"
+)
+
+// metricsShutdownTimeout bounds waiting for scrapes underway at shutdown
+const metricsShutdownTimeout = 2 * time.Second
+
+// startMetrics serves metrics and the health check on the configured
+// address. The endpoint is optional, so the app runs on without it when the
+// address can't be bound.
+func (m *MultiTabModel) startMetrics(cfg *metrics.Config) {
+	if !cfg.Enabled() {
+		return
+	}
+	collector := metrics.NewCollector()
+	server, err := metrics.Serve(*cfg, collector)
+	if err != nil {
+		m.Log.Warn("Metrics endpoint off", "err", err)
+		return
+	}
+	m.Metrics, m.metricsServer = collector, server
+	m.Log.Info("Serving metrics", "addr", server.Addr().String())
+}
+
+// recordTabMetrics records a tab's fetch and open PRs, and forgets tabs
+// closed or renamed since the last fetch
+func (m *MultiTabModel) recordTabMetrics(tab *TabState, msg tabPrsMsg) {
+	if m.Metrics == nil {
+		return
+	}
+	if msg.took > 0 {
+		m.Metrics.ObserveFetch(tab.Config.Name, msg.took, msg.err)
+	}
+	if msg.err == nil {
+		m.Metrics.SetOpenPRs(tab.Config.Name, len(tab.PRs))
+	}
+
+	names := make([]string, len(m.TabManager.Tabs))
+	for i, open := range m.TabManager.Tabs {
+		names[i] = open.Config.Name
+	}
+	m.Metrics.Retain(names)
+}
+
+// stopMetrics stops serving metrics
+func (m *MultiTabModel) stopMetrics() {
+	if m.metricsServer == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), metricsShutdownTimeout)
+	defer cancel()
+	if err := m.metricsServer.Close(ctx); err != nil {
+		m.Log.Debug("Metrics endpoint stopped uncleanly", "err", err)
+	}
+	m.metricsServer = nil
+}
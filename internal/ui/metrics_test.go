@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/metrics"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestRecordTabMetrics tests that fetches and open PRs reach the metrics,
+// and that closed tabs drop out of them
+func TestRecordTabMetrics(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	model.Metrics = metrics.NewCollector()
+	model.TabManager.AddTab(&TabConfig{Name: "Team", Mode: "repos", Repos: []string{"org/api"}})
+	model.TabManager.AddTab(&TabConfig{Name: "Other", Mode: "repos", Repos: []string{"org/web"}})
+
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Team", prs: []*gh.PullRequest{labeledPR(1), labeledPR(2)}, took: time.Second})
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Other", err: errors.New("boom"), took: time.Second})
+
+	var out strings.Builder
+	model.Metrics.WriteText(&out)
+	for _, want := range []string{`pr_compass_open_prs{tab="Team"} 2`, `pr_compass_fetch_failures_total{tab="Other"} 1`} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Expected %q in metrics, got:\n%s", want, out.String())
+		}
+	}
+
+	model.TabManager.CloseTab(1)
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Team", prs: []*gh.PullRequest{labeledPR(1)}})
+	out.Reset()
+	model.Metrics.WriteText(&out)
+	if strings.Contains(out.String(), "Other") || !strings.Contains(out.String(), `pr_compass_fetches_total{tab="Team"} 1`) {
+		t.Errorf("Expected the closed tab dropped and skipped fetches not counted, got:\n%s", out.String())
+	}
+}
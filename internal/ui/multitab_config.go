@@ -6,15 +6,97 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/bjess9/pr-compass/internal/cache"
 	"github.com/bjess9/pr-compass/internal/config"
 	"github.com/bjess9/pr-compass/internal/errors"
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/jira"
+	"github.com/bjess9/pr-compass/internal/metrics"
+	"github.com/bjess9/pr-compass/internal/provider"
+	"github.com/bjess9/pr-compass/internal/slack"
 	"github.com/spf13/viper"
 )
 
 // MultiTabConfig represents a multi-tab configuration
 type MultiTabConfig struct {
 	// Global settings
-	RefreshIntervalMinutes int `mapstructure:"refresh_interval_minutes" yaml:"refresh_interval_minutes,omitempty"`
+	RefreshIntervalMinutes int  `mapstructure:"refresh_interval_minutes" yaml:"refresh_interval_minutes,omitempty"`
+	ReviewSizeBudget       int  `mapstructure:"review_size_budget" yaml:"review_size_budget,omitempty"`
+	RequireIssueLink       bool `mapstructure:"require_issue_link" yaml:"require_issue_link,omitempty"`
+
+	// Days without updates before PR rows turn yellow, then red; tabs may override
+	WarnDays  int `mapstructure:"warn_days" yaml:"warn_days,omitempty"`
+	StaleDays int `mapstructure:"stale_days" yaml:"stale_days,omitempty"`
+
+	// Column layouts keyed by terminal size bucket (narrow, laptop, ultrawide)
+	Layouts map[string]LayoutConfig `mapstructure:"layouts" yaml:"layouts,omitempty"`
+
+	// IANA time zones keyed by GitHub login, e.g. alice: Europe/Berlin.
+	// GitHub profiles don't expose a time zone, so this fills the gap.
+	AuthorTimezones map[string]string `mapstructure:"author_timezones" yaml:"author_timezones,omitempty"`
+
+	// Work hours; outside them refreshes slow down and enhancement pauses
+	WorkHours *WorkHours `mapstructure:"work_hours" yaml:"work_hours,omitempty"`
+
+	// Delays spreading out requests that would otherwise fire together
+	StartupRamp *StartupRamp `mapstructure:"startup_ramp" yaml:"startup_ramp,omitempty"`
+
+	// Repos ("owner/name") that alert on every newly opened PR, whichever tab shows it
+	WatchRepos []string `mapstructure:"watch_repos" yaml:"watch_repos,omitempty"`
+
+	// Owners of checks, shown next to failing checks in the detail pane; first match wins
+	CheckHints []CheckHint `mapstructure:"check_hints" yaml:"check_hints,omitempty"`
+
+	// Jira instance resolving ticket keys in PR titles and branches to their status
+	Jira jira.Config `mapstructure:"jira" yaml:"jira,omitempty"`
+
+	// Slack webhook posting approvals, failing checks and new review requests
+	Slack *slack.Config `mapstructure:"slack" yaml:"slack,omitempty"`
+
+	// HTTP endpoint serving Prometheus metrics and a health check
+	Metrics *metrics.Config `mapstructure:"metrics" yaml:"metrics,omitempty"`
+
+	// Terminal bell and title alerts for the same events and new PRs in watched repos
+	Alerts *AlertConfig `mapstructure:"alerts" yaml:"alerts,omitempty"`
+
+	// Remaining GraphQL quota below which PR details stop loading until the quota resets (default 100)
+	EnhancementQuotaFloor int `mapstructure:"enhancement_quota_floor" yaml:"enhancement_quota_floor,omitempty"`
+
+	// Minutes PRs that merged or closed during the session stay dimmed below the table (default 15)
+	RecentlyCompletedMinutes int `mapstructure:"recently_completed_minutes" yaml:"recently_completed_minutes,omitempty"`
+
+	// Interface colors: "dark" (default), "light", "solarized", or "custom"
+	// with ThemeColors set over a base theme
+	Theme       string       `mapstructure:"theme" yaml:"theme,omitempty"`
+	ThemeColors *ThemeColors `mapstructure:"theme_colors" yaml:"theme_colors,omitempty"`
+
+	// Status colors and icons: "default", or "colorblind" for shapes and text
+	// instead of red/green distinctions
+	Palette string `mapstructure:"palette" yaml:"palette,omitempty"`
+
+	// Emoji drawn one column wide by some terminals: "auto" detects the
+	// terminal, "native" leaves them, "pad" adds a space and "substitute"
+	// swaps in wide emoji
+	EmojiWidth string `mapstructure:"emoji_width" yaml:"emoji_width,omitempty"`
+
+	// Command Enter runs for the selected PR instead of opening the browser,
+	// with PR fields filled in, e.g. "gh pr view {{.Number}} --repo {{.Repo}} --web"
+	OpenWith string `mapstructure:"open_with" yaml:"open_with,omitempty"`
+
+	// Named filter combinations applied with the number keys 1-9
+	FilterPresets []FilterPreset `mapstructure:"filter_presets" yaml:"filter_presets,omitempty"`
+
+	// Where cached API responses are stored: files by default, or a shared
+	// Redis server so a team's dashboards make one set of API calls
+	Cache cache.BackendConfig `mapstructure:"cache" yaml:"cache,omitempty"`
+
+	// GitHub Enterprise Server endpoints; unset means github.com
+	GitHubBaseURL   string `mapstructure:"github_base_url" yaml:"github_base_url,omitempty"`
+	GitHubUploadURL string `mapstructure:"github_upload_url" yaml:"github_upload_url,omitempty"`
+
+	// Environment variable holding this profile's token; unset uses
+	// GITHUB_TOKEN, GH_TOKEN or the GitHub CLI
+	TokenEnv string `mapstructure:"token_env" yaml:"token_env,omitempty"`
 
 	// Tab definitions
 	Tabs []TabConfig `mapstructure:"tabs" yaml:"tabs"`
@@ -65,8 +147,97 @@ func LoadMultiTabConfigFromPath(configPath string) (*MultiTabConfig, error) {
 			if tab.MaxPRs == 0 {
 				tab.MaxPRs = 50 // Conservative default for multi-tab
 			}
+
+			// Inherit the global review size budget if the tab doesn't set one
+			if tab.ReviewSizeBudget == 0 {
+				tab.ReviewSizeBudget = multiConfig.ReviewSizeBudget
+			}
+
+			// Inherit the global issue link policy if the tab doesn't set one
+			if !v.IsSet(fmt.Sprintf("tabs.%d.require_issue_link", i)) {
+				tab.RequireIssueLink = multiConfig.RequireIssueLink
+			}
+
+			// Inherit the global aging thresholds the tab doesn't set
+			if tab.WarnDays == 0 {
+				tab.WarnDays = multiConfig.WarnDays
+			}
+			if tab.StaleDays == 0 {
+				tab.StaleDays = multiConfig.StaleDays
+			}
+			if err := validateAging(tab.WarnDays, tab.StaleDays); err != nil {
+				return nil, fmt.Errorf("tab %q: %w", tab.Name, err)
+			}
+
+			// Reject unknown providers and modes GitLab or Gitea can't serve up front
+			if _, err := provider.New(tab.ConvertToConfig(), ""); err != nil {
+				return nil, fmt.Errorf("tab %q: %w", tab.Name, err)
+			}
+			if tab.Insights && !tab.OnGitHub() {
+				return nil, fmt.Errorf("tab %q: insights are only available for GitHub tabs", tab.Name)
+			}
+			if tab.History && !tab.OnGitHub() {
+				return nil, fmt.Errorf("tab %q: history is only available for GitHub tabs", tab.Name)
+			}
+			if err := validateHistoryDays(tab.HistoryDays); err != nil {
+				return nil, fmt.Errorf("tab %q: %w", tab.Name, err)
+			}
+			if err := slack.ValidateEvents(tab.SlackEvents); err != nil {
+				return nil, fmt.Errorf("tab %q: slack_events: %w", tab.Name, err)
+			}
 		}
 
+		if err := multiConfig.WorkHours.Validate(); err != nil {
+			return nil, errors.NewConfigInvalidError(err)
+		}
+		if err := multiConfig.StartupRamp.Validate(); err != nil {
+			return nil, errors.NewConfigInvalidError(err)
+		}
+		if err := validateWatchRepos(multiConfig.WatchRepos); err != nil {
+			return nil, errors.NewConfigInvalidError(err)
+		}
+		if err := validateCheckHints(multiConfig.CheckHints); err != nil {
+			return nil, errors.NewConfigInvalidError(err)
+		}
+		if err := multiConfig.Jira.Validate(); err != nil {
+			return nil, errors.NewConfigInvalidError(err)
+		}
+		if err := multiConfig.Slack.Validate(); err != nil {
+			return nil, errors.NewConfigInvalidError(err)
+		}
+		if err := multiConfig.Metrics.Validate(); err != nil {
+			return nil, errors.NewConfigInvalidError(err)
+		}
+		if err := multiConfig.Alerts.Validate(); err != nil {
+			return nil, errors.NewConfigInvalidError(err)
+		}
+		if err := validateQuotaFloor(multiConfig.EnhancementQuotaFloor); err != nil {
+			return nil, errors.NewConfigInvalidError(err)
+		}
+		if err := validateRecentlyCompletedMinutes(multiConfig.RecentlyCompletedMinutes); err != nil {
+			return nil, errors.NewConfigInvalidError(err)
+		}
+		if err := validateTheme(multiConfig.Theme, multiConfig.ThemeColors); err != nil {
+			return nil, errors.NewConfigInvalidError(err)
+		}
+		if err := validatePalette(multiConfig.Palette); err != nil {
+			return nil, errors.NewConfigInvalidError(err)
+		}
+		if err := validateEmojiWidth(multiConfig.EmojiWidth); err != nil {
+			return nil, errors.NewConfigInvalidError(err)
+		}
+		if err := validateOpenWith(multiConfig.OpenWith); err != nil {
+			return nil, errors.NewConfigInvalidError(err)
+		}
+		if err := validateFilterPresets(multiConfig.FilterPresets); err != nil {
+			return nil, errors.NewConfigInvalidError(err)
+		}
+		if err := multiConfig.Cache.Validate(); err != nil {
+			return nil, errors.NewConfigInvalidError(err)
+		}
+		if err := github.SetEnterpriseURLs(multiConfig.GitHubBaseURL, multiConfig.GitHubUploadURL); err != nil {
+			return nil, errors.NewConfigInvalidError(err)
+		}
 		return &multiConfig, nil
 	}
 
@@ -80,6 +251,9 @@ func LoadMultiTabConfigFromPath(configPath string) (*MultiTabConfig, error) {
 	tabConfig := TabConfig{
 		Name:                   "Main", // Default name for legacy tab
 		Mode:                   legacyConfig.Mode,
+		Provider:               legacyConfig.Provider,
+		GitLabURL:              legacyConfig.GitLabURL,
+		GiteaURL:               legacyConfig.GiteaURL,
 		Repos:                  legacyConfig.Repos,
 		Organization:           legacyConfig.Organization,
 		Teams:                  legacyConfig.Teams,
@@ -90,7 +264,15 @@ func LoadMultiTabConfigFromPath(configPath string) (*MultiTabConfig, error) {
 		ExcludeAuthors:         legacyConfig.ExcludeAuthors,
 		ExcludeTitles:          legacyConfig.ExcludeTitles,
 		IncludeDrafts:          legacyConfig.IncludeDrafts,
+		Milestone:              legacyConfig.Milestone,
+		Project:                legacyConfig.Project,
+		ProjectStatus:          legacyConfig.ProjectStatus,
 		RefreshIntervalMinutes: legacyConfig.RefreshIntervalMinutes,
+		MaxPages:               legacyConfig.MaxPages,
+		ReviewSizeBudget:       legacyConfig.ReviewSizeBudget,
+		RequireIssueLink:       legacyConfig.RequireIssueLink,
+		WarnDays:               multiConfig.WarnDays,
+		StaleDays:              multiConfig.StaleDays,
 	}
 
 	// Auto-detect mode if not set (for backward compatibility)
@@ -123,10 +305,96 @@ func LoadMultiTabConfigFromPath(configPath string) (*MultiTabConfig, error) {
 	}
 
 	multiConfig = MultiTabConfig{
-		RefreshIntervalMinutes: tabConfig.RefreshIntervalMinutes,
-		Tabs:                   []TabConfig{tabConfig},
+		RefreshIntervalMinutes:   tabConfig.RefreshIntervalMinutes,
+		ReviewSizeBudget:         tabConfig.ReviewSizeBudget,
+		RequireIssueLink:         tabConfig.RequireIssueLink,
+		WarnDays:                 tabConfig.WarnDays,
+		StaleDays:                tabConfig.StaleDays,
+		Layouts:                  multiConfig.Layouts,
+		AuthorTimezones:          multiConfig.AuthorTimezones,
+		WorkHours:                multiConfig.WorkHours,
+		StartupRamp:              multiConfig.StartupRamp,
+		WatchRepos:               multiConfig.WatchRepos,
+		CheckHints:               multiConfig.CheckHints,
+		Jira:                     multiConfig.Jira,
+		Slack:                    multiConfig.Slack,
+		Metrics:                  multiConfig.Metrics,
+		Alerts:                   multiConfig.Alerts,
+		EnhancementQuotaFloor:    multiConfig.EnhancementQuotaFloor,
+		RecentlyCompletedMinutes: multiConfig.RecentlyCompletedMinutes,
+		Theme:                    multiConfig.Theme,
+		ThemeColors:              multiConfig.ThemeColors,
+		Palette:                  multiConfig.Palette,
+		EmojiWidth:               multiConfig.EmojiWidth,
+		OpenWith:                 multiConfig.OpenWith,
+		FilterPresets:            multiConfig.FilterPresets,
+		Cache:                    multiConfig.Cache,
+		GitHubBaseURL:            legacyConfig.GitHubBaseURL,
+		GitHubUploadURL:          legacyConfig.GitHubUploadURL,
+		TokenEnv:                 legacyConfig.TokenEnv,
+		Tabs:                     []TabConfig{tabConfig},
 	}
 
+	if err := multiConfig.WorkHours.Validate(); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := multiConfig.StartupRamp.Validate(); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := validateWatchRepos(multiConfig.WatchRepos); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := validateCheckHints(multiConfig.CheckHints); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := multiConfig.Jira.Validate(); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := multiConfig.Slack.Validate(); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := multiConfig.Metrics.Validate(); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := multiConfig.Alerts.Validate(); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := validateQuotaFloor(multiConfig.EnhancementQuotaFloor); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := validateRecentlyCompletedMinutes(multiConfig.RecentlyCompletedMinutes); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := validateTheme(multiConfig.Theme, multiConfig.ThemeColors); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := validatePalette(multiConfig.Palette); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := validateEmojiWidth(multiConfig.EmojiWidth); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := validateOpenWith(multiConfig.OpenWith); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := validateFilterPresets(multiConfig.FilterPresets); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := multiConfig.Cache.Validate(); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := validateAging(tabConfig.WarnDays, tabConfig.StaleDays); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := validateHistoryDays(tabConfig.HistoryDays); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if err := github.SetEnterpriseURLs(multiConfig.GitHubBaseURL, multiConfig.GitHubUploadURL); err != nil {
+		return nil, errors.NewConfigInvalidError(err)
+	}
+	if _, err := provider.New(tabConfig.ConvertToConfig(), ""); err != nil {
+		return nil, err
+	}
 	return &multiConfig, nil
 }
 
@@ -153,12 +421,7 @@ func IsMultiTab(configPath string) bool {
 
 // getConfigFilePath returns the path to the configuration file
 func getConfigFilePath() string {
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		fmt.Printf("Unable to determine user home directory: %v\n", err)
-		os.Exit(1)
-	}
-	return fmt.Sprintf("%s/.prcompass_config.yaml", homeDir)
+	return config.ConfigFilePath()
 }
 
 // CreateExampleMultiTabConfig creates an example multi-tab configuration file
@@ -183,6 +446,7 @@ func CreateExampleMultiTabConfig(path string) error {
 
 # Global settings (applied to all tabs unless overridden)
 refresh_interval_minutes: 5
+review_size_budget: 400  # Flag PRs changing more lines than this with a "consider splitting" marker
 
 # Tab definitions - each tab can monitor different repositories/organizations
 tabs:
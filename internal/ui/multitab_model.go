@@ -2,12 +2,21 @@ package ui
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/bjess9/pr-compass/internal/cache"
 	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/jira"
+	"github.com/bjess9/pr-compass/internal/logring"
+	"github.com/bjess9/pr-compass/internal/metrics"
+	"github.com/bjess9/pr-compass/internal/provider"
+	"github.com/bjess9/pr-compass/internal/slack"
+	"github.com/bjess9/pr-compass/internal/ui/components"
 	"github.com/bjess9/pr-compass/internal/ui/services"
 	"github.com/bjess9/pr-compass/internal/ui/types"
 	gh "github.com/google/go-github/v55/github"
@@ -29,11 +38,181 @@ type MultiTabModel struct {
 	HelpMode       bool
 	SpinnerIndex   int // For animating loading spinner
 
+	// Pending confirmation for outward-facing actions (y confirms, any other key cancels)
+	pendingConfirm *confirmPrompt
+
+	// Pending free-text prompt, which receives every key until submitted or cancelled
+	pendingInput *textPrompt
+
+	// Pending option picker (merge method), which receives every key until resolved
+	pendingChoice *choicePrompt
+
+	// What happened to PRs this session (reviews, pushes, comments, merges),
+	// oldest first
+	Activity          []ActivityEntry
+	activityCheckedAt time.Time // When the activity feed was last closed
+
+	// Profile chosen with P; the program quits so main can restart with it
+	nextProfile string
+
+	// Watches the config file so edits apply without a restart; nil if it can't be watched
+	configWatcher *configWatcher
+	configPath    string
+	configWritten []byte // Last config saved in the app, which needs no reload
+
+	// Open comment composer, which receives every key until posted or discarded
+	pendingComment *commentComposer
+
+	// Open review form, which receives every key until submitted or discarded
+	reviewForm *reviewForm
+
+	// Open note editor, which receives every key until saved or discarded
+	pendingNote *noteEditor
+
+	// Open reviewer picker, which receives every key until submitted or cancelled
+	reviewerPicker *reviewerPicker
+
+	// Reviewer candidates keyed by repository owner, fetched once per session
+	reviewerCandidates map[string]*github.ReviewerCandidates
+
+	// Open label picker, which receives every key until applied or cancelled
+	labelPicker *labelPicker
+
+	// Label names keyed by "owner/name", fetched once per session or from the cache
+	repoLabels map[string][]string
+
+	// Open diff viewer, which receives every key until closed
+	diffView *diffView
+
+	// Changed files per PR for the diff viewer, keyed by services.PRKey
+	prDiffs        map[string]*github.PRDiff
+	prDiffsLoading map[string]bool
+	prDiffErrors   map[string]error
+
+	// Delays staggering tabs' refresh timers and a first load's repo requests (nil: defaults)
+	StartupRamp *StartupRamp
+
+	// Configured work hours; outside them the model runs in night mode (nil: always work hours)
+	WorkHours *WorkHours
+
+	// Repos watched for newly opened PRs regardless of tabs, the baseline of
+	// PRs already seen, and alerts waiting to be opened
+	WatchRepos  []string
+	Watches     *WatchStore
+	watchAlerts []*gh.PullRequest
+	watchErr    error
+
+	// Merge method picked last, preselected next time
+	lastMergeMethod string
+
+	// Column layout and density, remembered per terminal size bucket
+	Layouts      *LayoutStore
+	layoutBucket LayoutBucket
+	layout       LayoutConfig
+
+	// Repository metadata for the repo info popup, keyed by "owner/name"
+	repoMetadata        map[string]*cache.RepoMetadata
+	repoMetadataLoading map[string]bool
+	repoMetadataErrors  map[string]error
+
+	// Status checks required by base branch protection, keyed by "owner/name@branch"
+	requiredChecks        map[string][]string
+	requiredChecksLoading map[string]bool
+	requiredChecksErrors  map[string]error
+
+	// Projects boards of GitHub PRs by PR key, and tabs whose lookup is in flight
+	projectItems        map[string][]github.ProjectItem
+	projectItemsLoading map[string]bool
+
+	// Members of teams asked to review, keyed by "org/slug"
+	teamMembers        map[string][]string
+	teamMembersLoading map[string]bool
+	teamMembersErrors  map[string]error
+
+	// PR author profiles for the info popup, keyed by login, and configured
+	// author time zones (GitHub profiles don't expose one)
+	AuthorTimezones       map[string]string
+	authorProfiles        map[string]*cache.UserProfile
+	authorProfilesLoading map[string]bool
+	authorProfileErrors   map[string]error
+
+	// Configured owners of checks, hinted next to failing checks in the detail pane
+	CheckHints []CheckHint
+
+	// Command template Enter runs for the selected PR instead of opening the
+	// browser (empty opens the browser)
+	OpenWith string
+
+	// Jira instance resolving ticket keys in PRs (nil when not configured),
+	// and the issues read from it by key
+	Jira        *jira.Client
+	jiraURL     string
+	jiraIssues  map[string]*cache.JiraIssue
+	jiraLoading map[string]bool
+	jiraErrors  map[string]error
+
+	// Slack notifier posting tab events (nil when not configured)
+	Slack *slack.Notifier
+
+	// Metrics served over HTTP for scraping (nil when not configured)
+	Metrics       *metrics.Collector
+	metricsServer *metrics.Server
+
+	// Terminal bell and title alerts (nil when not configured), and how many
+	// alerts arrived since the last key press
+	Alerts       *AlertConfig
+	unseenAlerts int
+
+	// Configured filter presets for the number keys, and the preset each tab
+	// had active last, restored on the next start
+	FilterPresets []FilterPreset
+	Presets       *PresetStore
+	Views         *ViewStore // Each tab's filter and sort, restored at startup
+
+	// Recent fetch, enhancement and config events, shown in the log pane
+	Log      *slog.Logger
+	logRing  *logring.Handler
+	ShowLog  bool
+	logLevel slog.Level // Lowest level the log pane shows
+
+	// The current user's login, resolved at startup for the "my PRs" filter
+	viewerLogin string
+
+	// The current user's login and teams ("org/slug") for the needs-my-review
+	// filter, looked up once per session; teams are missing if listing them failed
+	viewerReviewers []string
+	viewerTeamsErr  error
+
+	// Remaining GraphQL quota below which enhancement pauses (0: default), and
+	// when the paused quota window resets (zero while not paused)
+	EnhancementQuotaFloor int
+	quotaPausedUntil      time.Time
+
+	// Minutes merged and closed PRs stay visible below the table (0: default)
+	RecentlyCompletedMinutes int
+
+	// Review timelines for the detail pane, keyed by services.PRKey
+	prDetails        map[string]*github.PRDetails
+	prDetailsLoading map[string]bool
+	prDetailsErrors  map[string]error
+
+	// Head commit check runs for the checks panel, keyed by services.PRKey
+	checkRuns        map[string]checkRunList
+	checkRunsLoading map[string]bool
+	checkRunsErrors  map[string]error
+
 	// Global state
 	Width  int
 	Height int
 }
 
+// confirmPrompt is an action waiting for the user to confirm it
+type confirmPrompt struct {
+	prompt    string
+	apply     func() // Optional immediate update when confirmed, before onConfirm runs
+	onConfirm tea.Cmd
+}
+
 // Tab-specific message types
 type tabSwitchMsg struct {
 	tabIndex int
@@ -58,7 +237,21 @@ type tabRefreshMsg struct {
 type tabPrsMsg struct {
 	tabName string
 	prs     []*gh.PullRequest
+	counts  github.PRCounts // Open PRs per listed repo; nil keeps the last known counts
 	err     error
+	took    time.Duration // How long the fetch took; zero when it was skipped
+}
+
+// enhancementBatchMsg delivers the enhancement results of one page of PRs
+type enhancementBatchMsg struct {
+	updates []types.PrEnhancementUpdateMsg
+}
+
+type bulkApproveResultMsg struct {
+	tabName  string
+	approved int
+	failed   int
+	lastErr  error
 }
 
 // NewMultiTabModel creates a new multi-tab model
@@ -69,14 +262,60 @@ func NewMultiTabModel(token string, prCache *cache.PRCache) *MultiTabModel {
 	manager := NewTabManager(token)
 	controller := NewUIController(serviceRegistry)
 	viewModel := NewViewModel(controller)
+	logRing := logring.New(logCapacity)
 
 	return &MultiTabModel{
 		TabManager:     manager,
 		controller:     controller,
 		viewModel:      viewModel,
 		ShowTabNumbers: false,
+		Layouts:        NewLayoutStore("", nil),
 		Width:          120, // More reasonable default width for modern terminals
 		Height:         30,  // More reasonable default height
+
+		repoMetadata:        make(map[string]*cache.RepoMetadata),
+		repoMetadataLoading: make(map[string]bool),
+		repoMetadataErrors:  make(map[string]error),
+
+		requiredChecks:        make(map[string][]string),
+		requiredChecksLoading: make(map[string]bool),
+		requiredChecksErrors:  make(map[string]error),
+		projectItems:          make(map[string][]github.ProjectItem),
+		projectItemsLoading:   make(map[string]bool),
+		jiraIssues:            make(map[string]*cache.JiraIssue),
+		jiraLoading:           make(map[string]bool),
+		jiraErrors:            make(map[string]error),
+
+		teamMembers:        make(map[string][]string),
+		teamMembersLoading: make(map[string]bool),
+		teamMembersErrors:  make(map[string]error),
+
+		authorProfiles:        make(map[string]*cache.UserProfile),
+		authorProfilesLoading: make(map[string]bool),
+		authorProfileErrors:   make(map[string]error),
+
+		prDetails:        make(map[string]*github.PRDetails),
+		prDetailsLoading: make(map[string]bool),
+		prDetailsErrors:  make(map[string]error),
+
+		checkRuns:        make(map[string]checkRunList),
+		checkRunsLoading: make(map[string]bool),
+		checkRunsErrors:  make(map[string]error),
+
+		reviewerCandidates: make(map[string]*github.ReviewerCandidates),
+		repoLabels:         make(map[string][]string),
+
+		prDiffs:        make(map[string]*github.PRDiff),
+		prDiffsLoading: make(map[string]bool),
+		prDiffErrors:   make(map[string]error),
+
+		Watches: NewWatchStore(""),
+		Presets: NewPresetStore(""),
+		Views:   NewViewStore(""),
+
+		Log:      slog.New(logRing),
+		logRing:  logRing,
+		logLevel: slog.LevelInfo,
 	}
 }
 
@@ -86,22 +325,63 @@ func (m *MultiTabModel) Init() tea.Cmd {
 }
 
 // Update handles messages for the multi-tab model
-func (m *MultiTabModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+func (m *MultiTabModel) Update(msg tea.Msg) (_ tea.Model, cmd tea.Cmd) {
+	// Whatever this message changed, tabs reopen with the same view
+	defer m.rememberViews()
+
+	// Any key press means the user is back and has seen pending alerts
+	if _, isKey := msg.(tea.KeyMsg); isKey {
+		if acknowledge := m.acknowledgeAlertsCmd(); acknowledge != nil {
+			defer func() { cmd = tea.Batch(cmd, acknowledge) }()
+		}
+	}
 
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		m.Width = msg.Width
 		m.Height = msg.Height
 
-		// Update all tab table heights
+		// Switch to the layout remembered for this terminal size
+		m.layoutBucket = layoutBucketForWidth(msg.Width)
+		m.layout = m.Layouts.Get(m.layoutBucket)
+
+		// Update all tab table heights and columns, and truncate titles to the new width
 		for _, tab := range m.TabManager.Tabs {
 			tableHeight := m.calculateTableHeight(tab)
 			tab.Table.SetHeight(tableHeight)
+			m.applyLayoutToTab(tab)
+			m.refreshRows(tab)
 		}
 
 		return m, nil
 
 	case tea.KeyMsg:
+		// An open comment composer or pending prompt takes every key, including tab switching
+		if activeTab := m.TabManager.GetActiveTab(); m.pendingComment != nil && activeTab != nil {
+			return m.handleCommentKey(activeTab, msg)
+		}
+		if activeTab := m.TabManager.GetActiveTab(); m.reviewForm != nil && activeTab != nil {
+			return m.handleReviewKey(activeTab, msg)
+		}
+		if activeTab := m.TabManager.GetActiveTab(); m.pendingNote != nil && activeTab != nil {
+			return m.handleNoteKey(activeTab, msg)
+		}
+		if activeTab := m.TabManager.GetActiveTab(); m.reviewerPicker != nil && activeTab != nil {
+			return m.handleReviewerKey(activeTab, msg)
+		}
+		if activeTab := m.TabManager.GetActiveTab(); m.labelPicker != nil && activeTab != nil {
+			return m.handleLabelKey(activeTab, msg)
+		}
+		if activeTab := m.TabManager.GetActiveTab(); m.diffView != nil && activeTab != nil {
+			return m.handleDiffKey(activeTab, msg)
+		}
+		if activeTab := m.TabManager.GetActiveTab(); m.pendingInput != nil && activeTab != nil {
+			return m.handleInputKey(activeTab, msg)
+		}
+		if activeTab := m.TabManager.GetActiveTab(); m.pendingChoice != nil && activeTab != nil {
+			return m.handleChoiceKey(activeTab, msg)
+		}
+
 		// Handle global tab switching keys first
 		switch msg.String() {
 		case "tab":
@@ -127,13 +407,38 @@ func (m *MultiTabModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 
 		case "ctrl+t":
-			// TODO: Add new tab (will implement later)
+			// Add a tab through a short form, saved to the config file
+			if activeTab := m.TabManager.GetActiveTab(); activeTab != nil {
+				m.startAddTab(activeTab)
+			}
 			return m, nil
 
 		case "ctrl+w":
-			// Close current tab
-			if m.TabManager.GetTabCount() > 1 {
-				m.TabManager.CloseTab(m.TabManager.ActiveTabIdx)
+			// Close current tab, keeping it for ctrl+z
+			if closing := m.TabManager.GetActiveTab(); closing != nil && m.TabManager.CloseTab(m.TabManager.ActiveTabIdx) {
+				m.TabManager.GetActiveTab().StatusMsg = fmt.Sprintf("Closed tab %q - ctrl+z to reopen", closing.Config.Name)
+			}
+			return m, nil
+
+		case "ctrl+z":
+			// Undo the last tab close
+			return m, m.reopenClosedTab()
+
+		case "<", ">":
+			// Move the active tab, saving the order to the config file
+			if activeTab := m.TabManager.GetActiveTab(); activeTab != nil && !activeTab.typingFilter() {
+				delta := 1
+				if msg.String() == "<" {
+					delta = -1
+				}
+				m.moveActiveTab(activeTab, delta)
+				return m, nil
+			}
+
+		case "ctrl+e":
+			// Rename the active tab, saving the name to the config file
+			if activeTab := m.TabManager.GetActiveTab(); activeTab != nil {
+				m.startRenameTab(activeTab)
 			}
 			return m, nil
 
@@ -166,7 +471,10 @@ func (m *MultiTabModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tabAddMsg:
-		m.TabManager.AddTab(msg.config)
+		tab := m.TabManager.AddTab(msg.config)
+		if m.layoutBucket != "" {
+			m.applyLayoutToTab(tab)
+		}
 		return m, nil
 
 	case tabCloseMsg:
@@ -177,9 +485,12 @@ func (m *MultiTabModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Initialize tabs after they've been added
 		var cmds []tea.Cmd
 
-		// Start refresh timers for ALL tabs (they'll only refresh when loaded)
-		for _, tab := range m.TabManager.Tabs {
-			cmds = append(cmds, m.refreshCmdForTab(tab))
+		// Start refresh timers for ALL tabs (they'll only refresh when loaded),
+		// showing each tab's last cached PRs until its real fetch lands
+		// Timers start a ramp delay apart so loaded tabs don't all refresh at once
+		for i, tab := range m.TabManager.Tabs {
+			m.showCachedPreview(tab)
+			cmds = append(cmds, m.refreshCmdForTabAfter(tab, time.Duration(i)*m.StartupRamp.tabDelay()))
 		}
 
 		// Fetch data for the active tab immediately
@@ -188,8 +499,26 @@ func (m *MultiTabModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, m.spinnerTickCmd()) // Start spinner animation
 		}
 
+		// Check watched repos right away, reporting PRs opened since the last run
+		cmds = append(cmds, m.watchCheckCmd())
+
+		// Resolve who the user is once, for the "my PRs" filter
+		cmds = append(cmds, m.viewerLoginCmd(""))
+
+		// Review request events count team requests too
+		if m.wantsReviewRequests() {
+			cmds = append(cmds, m.viewerReviewersCmd("", nil))
+		}
+
+		if m.configWatcher != nil {
+			cmds = append(cmds, m.configWatcher.nextChangeCmd())
+		}
+
 		return m, tea.Batch(cmds...)
 
+	case configChangedMsg:
+		return m.handleConfigChanged()
+
 	case spinnerTickMsg:
 		// Update spinner animation
 		m.SpinnerIndex = (m.SpinnerIndex + 1) % 10
@@ -229,6 +558,12 @@ func (m *MultiTabModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case watchTickMsg:
+		return m, m.watchCheckCmd()
+
+	case watchResultMsg:
+		return m.handleWatchResult(msg)
+
 	case tabPrsMsg:
 		// Handle PR data for a specific tab
 		return m.handleTabPRsMessage(msg)
@@ -237,6 +572,111 @@ func (m *MultiTabModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Handle PR enhancement updates
 		return m.handleEnhancementUpdate(msg)
 
+	case quotaResumeMsg:
+		return m.handleQuotaResume()
+
+	case enhancementBatchMsg:
+		for _, update := range msg.updates {
+			m.handleEnhancementUpdate(update)
+		}
+		return m, nil
+
+	case bulkApproveResultMsg:
+		return m.handleBulkApproveResult(msg)
+
+	case reviewResultMsg:
+		return m.handleReviewResult(msg)
+
+	case commentResultMsg:
+		return m.handleCommentResult(msg)
+
+	case insightsMsg:
+		return m.handleInsights(msg)
+
+	case historyMsg:
+		return m.handleHistory(msg)
+
+	case viewerLoginMsg:
+		return m.handleViewerLogin(msg)
+
+	case slackSentMsg:
+		return m.handleSlackSent(msg)
+
+	case viewerReviewersMsg:
+		return m.handleViewerReviewers(msg)
+
+	case reviewerCandidatesMsg:
+		return m.handleReviewerCandidates(msg)
+
+	case reviewRequestResultMsg:
+		return m.handleReviewRequestResult(msg)
+
+	case prDiffMsg:
+		return m.handlePRDiff(msg)
+
+	case repoLabelsMsg:
+		return m.handleRepoLabels(msg)
+
+	case labelUpdateResultMsg:
+		return m.handleLabelUpdateResult(msg)
+
+	case mergeResultMsg:
+		return m.handleMergeResult(msg)
+
+	case clipboardCopiedMsg:
+		return m.handleClipboardCopied(msg)
+
+	case openWithDoneMsg:
+		return m.handleOpenWithDone(msg)
+
+	case authorProfileMsg:
+		return m.handleAuthorProfile(msg)
+
+	case repoMetadataMsg:
+		return m.handleRepoMetadata(msg)
+
+	case requiredChecksMsg:
+		return m.handleRequiredChecks(msg)
+
+	case projectItemsMsg:
+		return m.handleProjectItems(msg)
+
+	case teamMembersMsg:
+		return m.handleTeamMembers(msg)
+
+	case jiraIssueMsg:
+		return m.handleJiraIssue(msg)
+
+	case prDetailsMsg:
+		return m.handlePRDetails(msg)
+
+	case checkRunsMsg:
+		return m.handleCheckRuns(msg)
+
+	case rerunChecksResultMsg:
+		return m.handleRerunChecksResult(msg)
+
+	case draftResultMsg:
+		return m.handleDraftResult(msg)
+
+	case autoMergeResultMsg:
+		return m.handleAutoMergeResult(msg)
+
+	case assignResultMsg:
+		return m.handleAssignResult(msg)
+
+	case updateBranchResultMsg:
+		return m.handleUpdateBranchResult(msg)
+
+	case branchUpdateCheckMsg:
+		return m.handleBranchUpdateCheck(msg)
+
+	case mergeRecheckMsg:
+		return m.handleMergeRecheck(msg)
+
+	case completedPRsMsg:
+		return m.handleCompletedPRs(msg)
+
 	default:
 		// Pass other messages to the active tab
 		return m.updateActiveTab(msg)
@@ -252,6 +692,26 @@ func (m *MultiTabModel) updateActiveTab(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// A pending confirmation swallows the next key press
+		if m.pendingConfirm != nil {
+			return m.handleConfirmKey(activeTab, msg.String())
+		}
+
+		// A text filter or search being typed takes every typed character,
+		// so values can contain hotkeys, and its editing keys
+		if activeTab.typingFilter() {
+			switch msg.Type {
+			case tea.KeyRunes, tea.KeySpace, tea.KeyBackspace, tea.KeyLeft, tea.KeyRight:
+				return m.handleFilterInput(activeTab, msg.String())
+			case tea.KeyEnter:
+				return m.handleFilterInput(activeTab, "enter")
+			case tea.KeyUp:
+				return m.handleFilterInput(activeTab, "up")
+			case tea.KeyDown:
+				return m.handleFilterInput(activeTab, "down")
+			}
+		}
+
 		switch msg.String() {
 		case "q", "ctrl+c":
 			// Quit the application
@@ -272,20 +732,87 @@ func (m *MultiTabModel) updateActiveTab(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return m, nil
 
+		case "/":
+			// Start a fuzzy search narrowing rows as you type
+			m.startSearch(activeTab)
+			return m, nil
+
 		case "f":
 			// Start author filter
 			activeTab.FilterMode = "author"
 			activeTab.FilterValue = ""
-			activeTab.StatusMsg = "Filter by author:"
+			activeTab.inputHistory("author").reset()
+			activeTab.StatusMsg = filterPromptStatus(activeTab, "Filter by author:")
 			return m, nil
 
 		case "s":
 			// Start status filter
 			activeTab.FilterMode = "status"
 			activeTab.FilterValue = ""
-			activeTab.StatusMsg = "Filter by status:"
+			activeTab.inputHistory("status").reset()
+			activeTab.StatusMsg = filterPromptStatus(activeTab, "Filter by status:")
+			return m, nil
+
+		case "L":
+			// Start repository language filter
+			activeTab.FilterMode = "language"
+			activeTab.FilterValue = ""
+			activeTab.inputHistory("language").reset()
+			activeTab.StatusMsg = filterPromptStatus(activeTab, "Filter by repo language:")
+			return m, nil
+
+		case "T":
+			// Start repository topic filter
+			activeTab.FilterMode = "topic"
+			activeTab.FilterValue = ""
+			activeTab.inputHistory("topic").reset()
+			activeTab.StatusMsg = filterPromptStatus(activeTab, "Filter by repo topic:")
+			return m, nil
+
+		case "t":
+			// Cycle the title type filter through the types present in this tab
+			current := ""
+			if activeTab.FilterMode == "type" {
+				current = activeTab.FilterValue
+			}
+			next := nextTitleTypeFilter(activeTab.PRs, current)
+			if next == "" {
+				activeTab.FilterMode = ""
+				activeTab.FilterValue = ""
+				activeTab.FilteredPRs = activeTab.PRs
+				activeTab.StatusMsg = "Filter cleared"
+			} else {
+				activeTab.FilterMode = "type"
+				activeTab.FilterValue = next
+				activeTab.FilteredPRs = m.applyFilter(activeTab.PRs, "type", next)
+				activeTab.StatusMsg = fmt.Sprintf("Type: %s (%d) - t for next type", next, len(activeTab.FilteredPRs))
+			}
+			m.updateTableRows(activeTab)
+			return m, nil
+
+		case "#":
+			// Pick a label to filter by, or clear the label filter; not l,
+			// which lists PRs breaking the issue link policy
+			m.pickLabel(activeTab)
+			return m, nil
+
+		case "y":
+			// Pick a milestone to filter by, or clear the milestone filter
+			m.pickMilestone(activeTab)
 			return m, nil
 
+		case "Y":
+			// Pick a Projects board column to filter by, or clear that filter
+			return m, m.pickProjectStatus(activeTab)
+
+		case "n":
+			// Toggle PRs requesting a review from me or my teams
+			return m, m.toggleNeedsMyReview(activeTab)
+
+		case "p":
+			// Toggle PRs I opened
+			return m, m.toggleMyPRs(activeTab)
+
 		case "d":
 			// Toggle draft filter
 			if activeTab.FilterMode == "draft" {
@@ -302,41 +829,402 @@ func (m *MultiTabModel) updateActiveTab(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.updateTableRows(activeTab)
 			return m, nil
 
+		case "b":
+			// Toggle review size budget filter
+			budget := activeTab.Config.ReviewSizeBudget
+			if budget <= 0 {
+				activeTab.StatusMsg = "No review_size_budget configured for this tab"
+				return m, nil
+			}
+			if activeTab.FilterMode == "size" {
+				activeTab.FilterMode = ""
+				activeTab.FilterValue = ""
+				activeTab.FilteredPRs = activeTab.PRs
+				activeTab.StatusMsg = "Filter cleared"
+			} else {
+				activeTab.FilterMode = "size"
+				activeTab.FilterValue = strconv.Itoa(budget)
+				activeTab.FilteredPRs = m.filterPRsOverBudget(activeTab.PRs, activeTab.EnhancedData, budget)
+				activeTab.StatusMsg = fmt.Sprintf("%s Over %d changed lines - consider splitting (%d)", sizeBudgetMarker, budget, len(activeTab.FilteredPRs))
+			}
+			m.updateTableRows(activeTab)
+			return m, nil
+
+		case "l":
+			// Toggle listing PRs that break the issue link policy
+			if !activeTab.Config.RequireIssueLink {
+				activeTab.StatusMsg = "No require_issue_link policy configured for this tab"
+				return m, nil
+			}
+			if activeTab.FilterMode == "unlinked" {
+				activeTab.FilterMode = ""
+				activeTab.FilterValue = ""
+				activeTab.FilteredPRs = activeTab.PRs
+				activeTab.StatusMsg = "Filter cleared"
+			} else {
+				activeTab.FilterMode = "unlinked"
+				activeTab.FilterValue = "true"
+				activeTab.FilteredPRs = m.applyFilter(activeTab.PRs, "unlinked", "true")
+				activeTab.StatusMsg = fmt.Sprintf("%s No linked issue or ticket (%d)", missingIssueMarker, len(activeTab.FilteredPRs))
+			}
+			m.updateTableRows(activeTab)
+			return m, nil
+
+		case "S":
+			// Toggle listing PRs not updated in the tab's stale_days
+			if activeTab.FilterMode == "stale" {
+				activeTab.FilterMode = ""
+				activeTab.FilterValue = ""
+				activeTab.FilteredPRs = activeTab.PRs
+				activeTab.StatusMsg = "Filter cleared"
+			} else {
+				_, staleDays := activeTab.Config.agingDays()
+				activeTab.FilterMode = "stale"
+				activeTab.FilterValue = strconv.Itoa(staleDays)
+				activeTab.FilteredPRs = m.applyFilter(activeTab.PRs, "stale", activeTab.FilterValue)
+				activeTab.StatusMsg = fmt.Sprintf("🕰️ Not updated in %d+ days (%d)", staleDays, len(activeTab.FilteredPRs))
+			}
+			m.updateTableRows(activeTab)
+			return m, nil
+
+		case "0", "1", "2", "3", "4", "5", "6", "7", "8", "9":
+			// Toggle the quick filter or filter preset with this number
+			return m, m.numberKey(activeTab, int(msg.String()[0]-'0'))
+
+		case "a":
+			// Mark every PR shown read
+			m.markAllRead(activeTab)
+			return m, nil
+
+		case "*":
+			// Pin or unpin the selected PR
+			m.togglePin(activeTab)
+			return m, nil
+
+		case "w":
+			// Show or hide recently merged and closed PRs
+			return m, m.toggleHistory(activeTab)
+
+		case "B":
+			// Annotate what the selected PR is blocked on
+			m.editBlocker(activeTab)
+			return m, nil
+
+		case "N":
+			// Edit the private note on the selected PR
+			m.openNoteEditor(activeTab)
+			return m, nil
+
+		case "o":
+			// Cycle the sort key
+			activeTab.SortKey = activeTab.SortKey.next()
+			m.resortTab(activeTab)
+			activeTab.StatusMsg = sortDescription(activeTab)
+			return m, nil
+
+		case "O":
+			// Reverse the sort direction
+			activeTab.SortAscending = !activeTab.SortAscending
+			m.resortTab(activeTab)
+			activeTab.StatusMsg = sortDescription(activeTab)
+			return m, nil
+
+		case "D":
+			// Open every PR in the selected PR's duplicate group
+			group, ok := m.selectedDuplicateGroup(activeTab)
+			if !ok {
+				activeTab.StatusMsg = "Selected PR is not part of a duplicate group"
+				return m, nil
+			}
+			cmds := make([]tea.Cmd, 0, group.Size())
+			for _, pr := range group.PRs {
+				if url := pr.GetHTMLURL(); url != "" {
+					cmds = append(cmds, openURLCmd(url))
+				}
+			}
+			activeTab.StatusMsg = fmt.Sprintf("%s Opening %d PRs for %q", duplicateMarker, len(cmds), group.Key)
+			return m, tea.Batch(cmds...)
+
+		case "ctrl+o":
+			// Open every listed PR in the browser (after confirmation)
+			m.confirmOpenAll(activeTab)
+			return m, nil
+
+		case "A":
+			// Review the selected PR: approve, request changes or comment
+			m.openReviewForm(activeTab)
+			return m, nil
+
+		case "M":
+			// Merge the selected PR after picking a merge method; shifted,
+			// since m copies the table as markdown
+			m.pickMergeMethod(activeTab)
+			return m, nil
+
+		case "C":
+			// Write a comment on the selected PR
+			m.openCommentComposer(activeTab)
+			return m, nil
+
+		case "R":
+			// Request reviewers on the selected PR
+			return m, m.openReviewerPicker(activeTab)
+
+		case "W":
+			// Open the newly opened PRs from watched repos
+			return m, m.openWatchAlerts(activeTab)
+
+		case "P":
+			// Switch to another config profile
+			m.pickProfile(activeTab)
+			return m, nil
+
+		case "ctrl+a":
+			// Approve every PR in the selected PR's duplicate group (after confirmation)
+			if m.writeUnavailable(activeTab) {
+				return m, nil
+			}
+			group, ok := m.selectedDuplicateGroup(activeTab)
+			if !ok {
+				activeTab.StatusMsg = "Selected PR is not part of a duplicate group"
+				return m, nil
+			}
+			m.pendingConfirm = &confirmPrompt{
+				prompt:    fmt.Sprintf("Approve %d PRs for %q?", group.Size(), group.Key),
+				onConfirm: m.bulkApproveCmd(activeTab.Config.Name, group.PRs),
+			}
+			activeTab.StatusMsg = m.pendingConfirm.prompt + " (y/n)"
+			return m, nil
+
+		case "z":
+			// Toggle table density for the current terminal size
+			layout := m.layout
+			if layout.IsCompact() {
+				layout.Density = DensityComfortable
+			} else {
+				layout.Density = DensityCompact
+			}
+			activeTab.StatusMsg = m.saveLayout(layout, fmt.Sprintf("Density: %s", layout.Density))
+			return m, nil
+
+		case "-":
+			// Hide the least important visible column for the current terminal size
+			layout, column, ok := m.layout.hideNextColumn()
+			for ok && !activeTab.showsColumn(column) {
+				// Skip optional columns this tab doesn't have, so each press changes the view
+				layout, column, ok = layout.hideNextColumn()
+			}
+			if !ok {
+				activeTab.StatusMsg = "Only the PR column is left"
+				return m, nil
+			}
+			activeTab.StatusMsg = m.saveLayout(layout, fmt.Sprintf("Hid %s column", column))
+			return m, nil
+
+		case "=":
+			// Forget layout adjustments for the current terminal size
+			if m.layoutBucket == "" {
+				m.layoutBucket = layoutBucketForWidth(m.Width)
+			}
+			if err := m.Layouts.Reset(m.layoutBucket); err != nil {
+				activeTab.StatusMsg = err.Error()
+			} else {
+				activeTab.StatusMsg = fmt.Sprintf("Reset %s layout", m.layoutBucket)
+			}
+			m.layout = m.Layouts.Get(m.layoutBucket)
+			for _, tab := range m.TabManager.Tabs {
+				m.applyLayoutToTab(tab)
+			}
+			return m, nil
+
 		case "c":
 			// Clear all filters
+			if activeTab.FilterMode == "preset" {
+				_ = m.Presets.Set(activeTab.Config.Name, "") // Don't restore a cleared preset
+			}
 			activeTab.FilterMode = ""
 			activeTab.FilterValue = ""
+			activeTab.AppliedFilter = ""
 			activeTab.FilteredPRs = activeTab.PRs
 			activeTab.StatusMsg = "Filters cleared"
 			m.updateTableRows(activeTab)
 			return m, nil
 
-		case "enter":
-			// Open selected PR in browser
+		case "enter", "alt+enter":
+			// Open selected PR with the open_with command, or in the browser
 			if len(activeTab.FilteredPRs) > 0 {
 				selectedIndex := activeTab.Table.Cursor()
 				if selectedIndex < len(activeTab.FilteredPRs) {
 					pr := activeTab.FilteredPRs[selectedIndex]
+					if m.OpenWith != "" && msg.String() == "enter" {
+						m.markRead(activeTab, pr)
+						return m, m.openWithCmd(activeTab, pr)
+					}
 					url := pr.GetHTMLURL()
 					if url != "" {
+						m.markRead(activeTab, pr)
 						return m, openURLCmd(url)
 					}
 				}
 			}
 			return m, nil
 
+		case "i":
+			// Toggle the repo info popup for the selected PR's repository
+			activeTab.ShowRepoInfo = !activeTab.ShowRepoInfo
+			if activeTab.ShowRepoInfo {
+				return m, m.followSelection(activeTab)
+			}
+			return m, nil
+
+		case "H":
+			// Toggle the feed of what happened to PRs this session; closing
+			// it marks its entries seen
+			activeTab.ShowActivity = !activeTab.ShowActivity
+			if !activeTab.ShowActivity {
+				m.activityCheckedAt = time.Now()
+			}
+			return m, nil
+
+		case "left", "right":
+			// Scroll the columns that don't fit the terminal into view
+			delta := 1
+			if msg.String() == "left" {
+				delta = -1
+			}
+			activeTab.StatusMsg = m.scrollTableColumns(activeTab, delta)
+			return m, nil
+
+		case "Q":
+			// Toggle the review load chart of open PRs per author and reviewer
+			activeTab.ShowReviewLoad = !activeTab.ShowReviewLoad
+			return m, nil
+
+		case "Z":
+			// Dismiss the failed repos panel, or bring it back
+			m.toggleIssues(activeTab)
+			return m, nil
+
+		case "E":
+			// Toggle the log pane with recent fetch and enhancement events
+			m.ShowLog = !m.ShowLog
+			return m, nil
+
+		case "e":
+			// Show the log pane at the next level
+			m.cycleLogLevel(activeTab)
+			return m, nil
+
+		case "v":
+			// Toggle the detail pane with the selected PR's description and reviews
+			return m, m.toggleDetails(activeTab)
+
+		case "x":
+			// Toggle the panel listing the selected PR's check runs
+			return m, m.toggleChecks(activeTab)
+
+		case "X":
+			// Open the selected PR's failing check in the browser
+			return m, m.openFailingCheck(activeTab)
+
+		case "F":
+			// Re-run the selected PR's failed checks, after confirming
+			m.confirmRerunChecks(activeTab)
+			return m, nil
+
+		case "ctrl+r":
+			// Mark the selected draft ready for review, or convert the PR to a draft
+			m.confirmToggleDraft(activeTab)
+			return m, nil
+
+		case "G":
+			// Arm auto-merge on the selected PR, or disable it
+			m.toggleAutoMerge(activeTab)
+			return m, nil
+
+		case "V":
+			// Review the selected PR's diff in place of the table
+			return m, m.openDiff(activeTab)
+
+		case "ctrl+l":
+			// Add or remove labels on the selected PR
+			return m, m.openLabelPicker(activeTab)
+
+		case "I":
+			// Assign yourself to the selected PR, or unassign yourself
+			return m, m.toggleSelfAssign(activeTab)
+
+		case "ctrl+b":
+			// Merge the base branch into the selected PR's branch, after confirming
+			m.confirmUpdateBranch(activeTab)
+			return m, nil
+
+		case "pgdown", "J":
+			// Scroll the detail pane while it is open
+			if activeTab.ShowDetails {
+				activeTab.scrollDetails(detailPaneHeight / 2)
+				return m, nil
+			}
+			activeTab.Table, _ = activeTab.Table.Update(msg)
+			return m, m.followSelection(activeTab)
+
+		case "pgup", "K":
+			if activeTab.ShowDetails {
+				activeTab.scrollDetails(-detailPaneHeight / 2)
+				return m, nil
+			}
+			activeTab.Table, _ = activeTab.Table.Update(msg)
+			return m, m.followSelection(activeTab)
+
+		case "u":
+			// Copy a GitHub search URL reproducing this view, to share with others
+			// The URL itself is shown when the clipboard is unavailable so it can be copied by hand
+			if !activeTab.Config.OnGitHub() {
+				activeTab.StatusMsg = "Search URLs are only available for GitHub tabs"
+				return m, nil
+			}
+			searchURL, notes := searchURLForTab(activeTab)
+			return m, copyToClipboardCmd(activeTab.Config.Name, searchURL,
+				searchURLStatus("Copied", notes), "Clipboard unavailable - search URL: "+searchURL)
+
+		case "m":
+			// Copy the filtered table as markdown for pasting into chat
+			if len(activeTab.FilteredPRs) == 0 {
+				activeTab.StatusMsg = "No PRs to copy"
+				return m, nil
+			}
+			_, staleDays := activeTab.Config.agingDays()
+			snapshot := markdownSnapshot(activeTab.FilteredPRs, activeTab.EnhancedData, staleDays)
+			return m, copyToClipboardCmd(activeTab.Config.Name, snapshot,
+				fmt.Sprintf("Copied %d PRs as a markdown table", len(activeTab.FilteredPRs)),
+				"Clipboard unavailable - could not copy markdown table")
+
+		case "U":
+			// Open the GitHub search URL for this view in the browser
+			if !activeTab.Config.OnGitHub() {
+				activeTab.StatusMsg = "Search URLs are only available for GitHub tabs"
+				return m, nil
+			}
+			searchURL, notes := searchURLForTab(activeTab)
+			activeTab.StatusMsg = searchURLStatus("Opened", notes)
+			return m, openURLCmd(searchURL)
+
 		case "up", "k":
 			// Move table cursor up
 			activeTab.Table, _ = activeTab.Table.Update(msg)
-			return m, nil
+			return m, m.followSelection(activeTab)
 
 		case "down", "j":
 			// Move table cursor down
 			activeTab.Table, _ = activeTab.Table.Update(msg)
-			return m, nil
+			return m, m.followSelection(activeTab)
 
-		case "escape":
+		case "esc", "escape":
 			// Cancel current filter input
+			if activeTab.FilterMode == "search" {
+				m.cancelSearch(activeTab)
+				return m, nil
+			}
 			if activeTab.FilterMode != "" {
 				activeTab.FilterMode = ""
 				activeTab.FilterValue = ""
@@ -346,16 +1234,198 @@ func (m *MultiTabModel) updateActiveTab(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		default:
 			// Handle filter input
-			if activeTab.FilterMode != "" {
+			if activeTab.typingFilter() {
 				return m.handleFilterInput(activeTab, msg.String())
 			}
 
 			// Pass other keys to table for navigation
 			activeTab.Table, _ = activeTab.Table.Update(msg)
-			return m, nil
+			return m, m.followSelection(activeTab)
+		}
+	}
+
+	return m, nil
+}
+
+// saveLayout applies a layout to every tab and remembers it for the current
+// terminal size bucket, returning the status message to show
+func (m *MultiTabModel) saveLayout(layout LayoutConfig, status string) string {
+	if m.layoutBucket == "" {
+		m.layoutBucket = layoutBucketForWidth(m.Width)
+	}
+	m.layout = layout
+	for _, tab := range m.TabManager.Tabs {
+		m.applyLayoutToTab(tab)
+		m.refreshRows(tab)
+	}
+
+	if err := m.Layouts.Set(m.layoutBucket, layout); err != nil {
+		return fmt.Sprintf("%s (not saved: %v)", status, err)
+	}
+	return fmt.Sprintf("%s - saved for %s screens", status, m.layoutBucket)
+}
+
+// applyLayoutToTab sizes a tab's columns for the terminal width and current layout
+func (m *MultiTabModel) applyLayoutToTab(tab *TabState) {
+	columns := createTableColumnsForWidth(m.Width)
+	if tab.Config.ShowsStackColumns() {
+		columns = withStackColumns(columns, m.Width)
+	}
+	if tab.Config.AssigneeColumn {
+		columns = withAssigneeColumn(columns, m.Width)
+	}
+	if tab.Config.LabelColumn {
+		columns = withLabelColumn(columns, m.Width)
+	}
+	if tab.Config.PolicyColumn {
+		columns = withPolicyColumn(columns, m.Width)
+	}
+	if tab.Config.MilestoneColumn {
+		columns = withMilestoneColumn(columns, m.Width)
+	}
+	if tab.Config.ShowsProjectColumn() {
+		columns = withProjectColumn(columns, m.Width)
+	}
+	if tab.Config.TicketColumn {
+		columns = withTicketColumn(columns, m.Width)
+	}
+	keys := tab.columnKeys()
+	columns = applyLayout(columns, keys, m.layout)
+	fitted := fitColumns(columns, keys, m.Width, m.layout.IsCompact())
+	if visibleColumns(fitted) == visibleColumns(columns) {
+		tab.ColumnOffset = 0 // Every column fits, nothing to scroll
+	}
+	if tab.ColumnOffset > 0 {
+		fitted = scrollColumns(columns, tab.ColumnOffset, m.Width, m.layout.IsCompact())
+	}
+	tab.ColumnsShown, tab.ColumnsTotal = visibleColumns(fitted), visibleColumns(columns)
+	tab.Table.SetColumns(fitted)
+}
+
+// scrollTableColumns scrolls the columns right of the PR title sideways by
+// one, as far as the last column, returning the status message to show.
+// Scrolling back past the first column drops the least important columns
+// again instead.
+func (m *MultiTabModel) scrollTableColumns(tab *TabState, delta int) string {
+	switch {
+	case tab.ColumnsShown == tab.ColumnsTotal:
+		return "All columns fit the terminal"
+	case delta < 0 && tab.ColumnOffset == 0:
+		return "Showing the most important columns - → scrolls through the rest"
+	case delta > 0 && tab.ColumnsShown+max(tab.ColumnOffset-1, 0) >= tab.ColumnsTotal:
+		return "Scrolled to the last column"
+	}
+	tab.ColumnOffset += delta
+	m.applyLayoutToTab(tab)
+	m.refreshRows(tab)
+	return fmt.Sprintf("Showing %d of %d columns - ←/→ scroll", tab.ColumnsShown, tab.ColumnsTotal)
+}
+
+// refreshRows rebuilds a tab's rows after its columns are resized, keeping
+// the order and selection
+func (m *MultiTabModel) refreshRows(tab *TabState) {
+	if len(tab.FilteredPRs) == 0 {
+		return
+	}
+	tab.Table.SetRows(createTableRowsWithOptions(tab.FilteredPRs, tab.EnhancedData, m.rowOptions(tab)))
+}
+
+// followSelection loads whatever the selection-dependent popups need for the newly selected PR
+func (m *MultiTabModel) followSelection(tab *TabState) tea.Cmd {
+	var cmds []tea.Cmd
+	if tab.ShowRepoInfo && tab.Config.OnGitHub() {
+		cmds = append(cmds, m.repoMetadataCmd(tab), m.authorProfileCmd(tab))
+	}
+	if tab.ShowDetails {
+		tab.DetailScroll = 0
+		m.markRead(tab, tab.SelectedPR())
+		cmds = append(cmds, m.prDetailsCmd(tab), m.ticketsCmd(tab))
+	}
+	if tab.ShowChecks {
+		cmds = append(cmds, m.checkRunsCmd(tab))
+	}
+	if more := m.loadMoreCmd(tab); more != nil {
+		cmds = append(cmds, more)
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	return tea.Batch(cmds...)
+}
+
+// handleConfirmKey resolves a pending confirmation prompt
+func (m *MultiTabModel) handleConfirmKey(tab *TabState, key string) (tea.Model, tea.Cmd) {
+	prompt := m.pendingConfirm
+	m.pendingConfirm = nil
+
+	if key == "y" || key == "Y" {
+		tab.StatusMsg = "Working..."
+		if prompt.apply != nil {
+			prompt.apply()
+		}
+		return m, prompt.onConfirm
+	}
+
+	tab.StatusMsg = "Cancelled"
+	return m, nil
+}
+
+// selectedDuplicateGroup returns the duplicate group of the selected PR, if any
+func (m *MultiTabModel) selectedDuplicateGroup(tab *TabState) (services.DuplicateGroup, bool) {
+	pr := tab.SelectedPR()
+	if pr == nil {
+		return services.DuplicateGroup{}, false
+	}
+	return services.FindDuplicateGroup(tab.DuplicateGroups, pr)
+}
+
+// bulkApproveCmd approves each PR in turn and reports a summary
+func (m *MultiTabModel) bulkApproveCmd(tabName string, prs []*gh.PullRequest) tea.Cmd {
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		result := bulkApproveResultMsg{tabName: tabName}
+		for _, pr := range prs {
+			ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 10*time.Second)
+			err := github.ApprovePullRequest(ctx, token, pr)
+			cancel()
+			if err != nil {
+				result.failed++
+				result.lastErr = err
+				continue
+			}
+			result.approved++
 		}
+		return result
 	}
+}
 
+// handleClipboardCopied reports whether text was copied to the clipboard
+func (m *MultiTabModel) handleClipboardCopied(msg clipboardCopiedMsg) (tea.Model, tea.Cmd) {
+	for _, tab := range m.TabManager.Tabs {
+		if tab.Config.Name != msg.tabName {
+			continue
+		}
+		if msg.err != nil {
+			tab.StatusMsg = msg.failed
+		} else {
+			tab.StatusMsg = msg.copied
+		}
+	}
+	return m, nil
+}
+
+// handleBulkApproveResult reports the outcome of a bulk approval
+func (m *MultiTabModel) handleBulkApproveResult(msg bulkApproveResultMsg) (tea.Model, tea.Cmd) {
+	for _, tab := range m.TabManager.Tabs {
+		if tab.Config.Name != msg.tabName {
+			continue
+		}
+		if msg.failed > 0 {
+			tab.StatusMsg = fmt.Sprintf("Approved %d, failed %d: %v", msg.approved, msg.failed, msg.lastErr)
+		} else {
+			tab.StatusMsg = fmt.Sprintf("Approved %d PRs", msg.approved)
+		}
+	}
 	return m, nil
 }
 
@@ -366,14 +1436,20 @@ func (m *MultiTabModel) handleFilterInput(tab *TabState, input string) (tea.Mode
 		if len(tab.FilterValue) > 0 {
 			tab.FilterValue = tab.FilterValue[:len(tab.FilterValue)-1]
 		}
+	case "up":
+		tab.FilterValue = tab.inputHistory(tab.FilterMode).prev(tab.FilterValue)
+	case "down":
+		tab.FilterValue = tab.inputHistory(tab.FilterMode).next(tab.FilterValue)
 	case "enter":
 		// Apply the filter
+		tab.inputHistory(tab.FilterMode).add(tab.FilterValue)
 		tab.FilteredPRs = m.applyFilter(tab.PRs, tab.FilterMode, tab.FilterValue)
+		tab.AppliedFilter = fmt.Sprintf("%s=%s", tab.FilterMode, tab.FilterValue)
 		m.updateTableRows(tab)
 		tab.StatusMsg = fmt.Sprintf("Filter: %s=%s (%d)", tab.FilterMode, tab.FilterValue, len(tab.FilteredPRs))
 		tab.FilterMode = "" // Exit filter input mode
 		return m, nil
-	case "escape":
+	case "esc", "escape":
 		// Cancel filter
 		tab.FilterMode = ""
 		tab.FilterValue = ""
@@ -386,6 +1462,12 @@ func (m *MultiTabModel) handleFilterInput(tab *TabState, input string) (tea.Mode
 		}
 	}
 
+	// Search narrows rows on every keystroke
+	if tab.FilterMode == "search" {
+		m.updateSearch(tab)
+		return m, nil
+	}
+
 	// Update status message with current filter input
 	tab.StatusMsg = fmt.Sprintf("Filter %s: %s_", tab.FilterMode, tab.FilterValue)
 	return m, nil
@@ -397,6 +1479,10 @@ func (m *MultiTabModel) applyFilter(prs []*gh.PullRequest, mode, value string) [
 	prData := make([]*types.PRData, len(prs))
 	for i, pr := range prs {
 		prData[i] = &types.PRData{PullRequest: pr}
+		if metadata := m.repoMetadata[repoFullName(pr)]; metadata != nil {
+			prData[i].RepoLanguage = metadata.Language
+			prData[i].RepoTopics = metadata.Topics
+		}
 	}
 
 	// Apply filter
@@ -405,6 +1491,34 @@ func (m *MultiTabModel) applyFilter(prs []*gh.PullRequest, mode, value string) [
 	return result.FilteredPRs
 }
 
+// nextTitleTypeFilter returns the title type after current among those present
+// in the PRs ("none" for untyped titles last), or "" once the cycle is complete
+func nextTitleTypeFilter(prs []*gh.PullRequest, current string) string {
+	present := make(map[string]bool)
+	for _, pr := range prs {
+		kind, _ := services.ParseTitleType(pr.GetTitle())
+		if kind == "" {
+			kind = "none"
+		}
+		present[kind] = true
+	}
+
+	kinds := append(append([]string{}, services.TitleTypes...), "none")
+	passedCurrent := current == ""
+	for _, kind := range kinds {
+		if !present[kind] {
+			continue
+		}
+		if passedCurrent {
+			return kind
+		}
+		if kind == current {
+			passedCurrent = true
+		}
+	}
+	return ""
+}
+
 // filterPRsByDraft returns only draft PRs using the controller
 func (m *MultiTabModel) filterPRsByDraft(prs []*gh.PullRequest) []*gh.PullRequest {
 	// Convert to PRData format
@@ -425,21 +1539,48 @@ func (m *MultiTabModel) filterPRsByDraft(prs []*gh.PullRequest) []*gh.PullReques
 	return result
 }
 
+// filterPRsOverBudget returns only PRs exceeding the review size budget using the controller
+func (m *MultiTabModel) filterPRsOverBudget(prs []*gh.PullRequest, enhancedData map[int]types.EnhancedData, budget int) []*gh.PullRequest {
+	// Convert to PRData format, attaching enhanced data since size is only known after enhancement
+	prData := make([]*types.PRData, len(prs))
+	for i, pr := range prs {
+		prData[i] = &types.PRData{PullRequest: pr}
+		if enhanced, exists := enhancedData[pr.GetNumber()]; exists {
+			enhancedCopy := enhanced
+			prData[i].Enhanced = &enhancedCopy
+		}
+	}
+
+	filteredData := m.controller.FilterOversizedPRs(prData, budget)
+
+	// Convert back to GitHub PR format
+	result := make([]*gh.PullRequest, len(filteredData))
+	for i, pr := range filteredData {
+		result[i] = pr.PullRequest
+	}
+
+	return result
+}
+
 // updateTableRows updates the table with current filtered PRs
 func (m *MultiTabModel) updateTableRows(tab *TabState) {
+	// Merged and closed PRs follow the open ones, most recently closed first
+	var open []*gh.PullRequest
+	for _, pr := range tab.FilteredPRs {
+		if !isHistoryPR(pr) {
+			open = append(open, pr)
+		}
+	}
+	tab.FilteredPRs = append(pinnedFirst(sortPRs(open, tab.EnhancedData, tab.SortKey, tab.SortAscending), tab.Pins), m.historyRows(tab)...)
 	if len(tab.FilteredPRs) == 0 {
 		tab.Table.SetRows([]table.Row{})
 		return
 	}
 
-	// Use enhanced table rows if we have enhanced data
-	if len(tab.EnhancedData) > 0 {
-		rows := createTableRowsWithEnhancement(tab.FilteredPRs, tab.EnhancedData)
-		tab.Table.SetRows(rows)
-	} else {
-		rows := createTableRows(tab.FilteredPRs)
-		tab.Table.SetRows(rows)
-	}
+	// Enhanced rows fall back to basic data per PR, and carry per-tab markers (size budget, duplicates)
+	m.applyLayoutToTab(tab)
+	rows := createTableRowsWithOptions(tab.FilteredPRs, tab.EnhancedData, m.rowOptions(tab))
+	tab.Table.SetRows(rows)
 }
 
 // View renders the multi-tab interface
@@ -460,7 +1601,9 @@ func (m *MultiTabModel) View() string {
 	// Render the active tab's content using existing single-tab view logic
 	tabContent := m.renderActiveTabContent(activeTab)
 
-	return tabBar + "\n" + tabContent
+	// Emoji some terminals draw narrower than laid out are fixed up last,
+	// so table columns and borders line up
+	return fixEmojiWidth(tabBar + "\n" + tabContent)
 }
 
 // renderTabBar renders the enhanced tab bar at the top with rate limiting info
@@ -469,13 +1612,13 @@ func (m *MultiTabModel) renderTabBar() string {
 
 	// Rate limit status with better formatting
 	rateLimitInfo := ""
-	if m.TabManager.refreshScheduler != nil {
+	if m.TabManager.refreshScheduler != nil && !m.readOnly() {
 		summary := m.TabManager.refreshScheduler.GetRateLimitSummary()
-		rateLimitColor := TextMuted
+		rateLimitColor := colors.TextMuted
 		if summary.RequestsRemaining < 100 {
-			rateLimitColor = ErrorColor // Red when low
+			rateLimitColor = theme.Error // Red when low
 		} else if summary.RequestsRemaining < 500 {
-			rateLimitColor = WarningColor // Yellow when getting low
+			rateLimitColor = theme.Warning // Yellow when getting low
 		}
 
 		rateLimitInfo = fmt.Sprintf(" │ %s %d/5000 │ Active: %d",
@@ -499,20 +1642,20 @@ func (m *MultiTabModel) renderTabBar() string {
 
 		if tab.Error != nil {
 			icon = "🚨"
-			statusColor = ErrorColor
+			statusColor = theme.Error
 		} else if tab.BackgroundRefreshing {
 			icon = "🔄"
-			statusColor = AccentColor
+			statusColor = theme.Accent
 		} else if !tab.Loaded {
 			icon = "⏳"
-			statusColor = WarningColor
+			statusColor = theme.Warning
 		} else {
 			if prCount > 0 {
 				icon = "📋"
-				statusColor = SuccessColor
+				statusColor = theme.Success
 			} else {
 				icon = "✅"
-				statusColor = TextSecondary
+				statusColor = colors.TextSecondary
 			}
 		}
 
@@ -525,8 +1668,8 @@ func (m *MultiTabModel) renderTabBar() string {
 		if i == m.TabManager.ActiveTabIdx {
 			// Active tab - prominent with border
 			tabButton := lipgloss.NewStyle().
-				Foreground(lipgloss.Color(TextBright)).
-				Background(lipgloss.Color(SelectedBgColor)).
+				Foreground(lipgloss.Color(colors.TextBright)).
+				Background(lipgloss.Color(colors.SelectedBg)).
 				Bold(true).
 				Border(lipgloss.RoundedBorder()).
 				BorderForeground(lipgloss.Color(statusColor)).
@@ -536,10 +1679,10 @@ func (m *MultiTabModel) renderTabBar() string {
 		} else {
 			// Inactive tab - subtle with rounded corners
 			tabButton := lipgloss.NewStyle().
-				Foreground(lipgloss.Color(TextSecondary)).
-				Background(lipgloss.Color(SurfaceColor)).
+				Foreground(lipgloss.Color(colors.TextSecondary)).
+				Background(lipgloss.Color(colors.Surface)).
 				Border(lipgloss.RoundedBorder()).
-				BorderForeground(lipgloss.Color(BorderColor)).
+				BorderForeground(lipgloss.Color(colors.Border)).
 				Padding(0, 1).
 				Render(tabText)
 			tabButtons = append(tabButtons, tabButton)
@@ -572,13 +1715,16 @@ func (m *MultiTabModel) renderTabBar() string {
 			// IDENTICAL format every time - no special cases
 			tabInfo := fmt.Sprintf("🧭 %s %s │ PRs: %3d │ Enhanced: %3d",
 				activeTab.Config.Name, statusIndicator, prCount, enhancedCount)
+			if blocked := m.blockedSummary(activeTab); blocked != "" {
+				tabInfo += " │ " + blocked
+			}
 
 			tabBarContent = lipgloss.NewStyle().
-				Foreground(lipgloss.Color(TextBright)).
-				Background(lipgloss.Color(SelectedBgColor)).
+				Foreground(lipgloss.Color(colors.TextBright)).
+				Background(lipgloss.Color(colors.SelectedBg)).
 				Bold(true).
 				Border(lipgloss.RoundedBorder()).
-				BorderForeground(lipgloss.Color(SuccessColor)).
+				BorderForeground(lipgloss.Color(theme.Success)).
 				Padding(0, 1).
 				Render(tabInfo)
 		}
@@ -596,14 +1742,33 @@ func (m *MultiTabModel) renderTabBar() string {
 
 	// Compact help text with compass theme
 	helpText := lipgloss.NewStyle().
-		Foreground(lipgloss.Color(TextMuted)).
+		Foreground(lipgloss.Color(colors.TextMuted)).
 		Italic(true).
-		Render("🧭 Tab/⇧Tab Navigate • ^1-9 Switch • h Help" + rateLimitInfo)
+		MaxWidth(m.Width).
+		Render("🧭 Tab/⇧Tab Navigate • ^1-9 Switch • h Help" + rateLimitInfo + m.profileInfo() + m.activeBlockedInfo())
 
 	// Compact separator line
 	separator := lipgloss.NewStyle().
-		Foreground(lipgloss.Color(BorderColor)).
-		Render(strings.Repeat("─", 60)) // Shorter line
+		Foreground(lipgloss.Color(colors.Border)).
+		Render(strings.Repeat("─", min(60, m.Width))) // Shorter line
+
+	if m.readOnly() {
+		helpText += "\n" + readOnlyStyle.Render(readOnlyBanner())
+	}
+	if m.nightMode() {
+		helpText += "\n" + mutedStyle.Render(m.nightModeBanner(time.Now()))
+	}
+	if banner := m.quotaPauseBanner(time.Now()); banner != "" {
+		helpText += "\n" + readOnlyStyle.Render(banner)
+	}
+	if activeTab := m.TabManager.GetActiveTab(); activeTab != nil {
+		if banner := truncationBanner(activeTab); banner != "" {
+			helpText += "\n" + readOnlyStyle.Render(banner)
+		}
+	}
+	if banner := m.watchBanner(); banner != "" {
+		helpText += "\n" + watchStyle.Render(banner)
+	}
 
 	return tabBarContent + "\n" + helpText + "\n" + separator
 }
@@ -612,6 +1777,9 @@ func (m *MultiTabModel) renderTabBar() string {
 func (m *MultiTabModel) renderActiveTabContent(activeTab *TabState) string {
 	// Handle error state
 	if activeTab.Error != nil {
+		if m.ShowLog {
+			return errorView(activeTab.Error) + m.renderLogPane()
+		}
 		return errorView(activeTab.Error)
 	}
 
@@ -620,25 +1788,124 @@ func (m *MultiTabModel) renderActiveTabContent(activeTab *TabState) string {
 	// Render the table directly without creating the old model
 
 	// Use the existing view logic but without the title (since we have tabs)
-	activeTab.Table.SetStyles(tableStyles())
+	activeTab.Table.SetStyles(tableStylesForLayout(m.layout))
 
-	// Table
-	tableView := activeTab.Table.View()
+	// Table, or guidance explaining why it is empty
+	tableView := colorAgedRows(activeTab.Table.View())
+	if activeTab.Loaded && len(activeTab.FilteredPRs) == 0 {
+		tableView = nullStateView(activeTab)
+	}
+	if m.pendingComment != nil {
+		tableView = m.renderCommentComposer()
+	}
+	if m.reviewForm != nil {
+		tableView = m.renderReviewForm()
+	}
+	if m.pendingNote != nil {
+		tableView = m.renderNoteEditor()
+	}
+	if m.reviewerPicker != nil {
+		tableView = m.renderReviewerPicker()
+	}
+	if m.labelPicker != nil {
+		tableView = m.renderLabelPicker()
+	}
+	if m.diffView != nil {
+		tableView = m.renderDiffView()
+	}
+	if m.pendingComment == nil && m.reviewForm == nil && m.pendingNote == nil && m.reviewerPicker == nil && m.labelPicker == nil && m.diffView == nil {
+		tableView = m.renderQuickFilterBar(activeTab) + tableView + m.renderRecentlyCompleted(activeTab, time.Now()) +
+			"\n" + lipgloss.NewStyle().MaxWidth(m.Width-containerChrome).Render(strings.TrimPrefix(renderTableFooter(activeTab), "\n"))
+		if activeTab.Config.Insights {
+			tableView = m.renderInsights(activeTab) + tableView
+		}
+	}
 
 	// Status message - ALWAYS same height to prevent UI jumping
 	statusMsg := activeTab.StatusMsg
+	if statusMsg == "" && !activeTab.StaleSince.IsZero() {
+		statusMsg = staleNotice(activeTab.StaleSince, time.Now())
+	}
 	if statusMsg == "" {
 		statusMsg = " " // Always show something to maintain consistent spacing
 	}
+	// Background enhancement progress shares the status line
+	if activeTab.Progress.Active() {
+		progress := &activeTab.Progress
+		bar := components.NewProgressBar(progressBarWidth).Render(progress.Completed, progress.Failed, progress.Total, progress.ETA(time.Now()))
+		statusMsg = strings.TrimSpace(statusMsg + "  " + progressStyle.Render("⏳ "+bar))
+	}
 	statusLine := "\n" + statusStyle.Render(statusMsg)
+	statusLine += m.renderIssuesPanel(activeTab)
+	if activeTab.ShowRepoInfo {
+		statusLine += m.renderRepoInfo(activeTab)
+	}
+	if activeTab.ShowDetails {
+		statusLine += m.renderDetailPane(activeTab)
+	}
+	if activeTab.ShowChecks {
+		statusLine += m.renderChecksPanel(activeTab, time.Now())
+	}
+	if activeTab.ShowActivity {
+		statusLine += m.renderActivity(time.Now())
+	}
+	if activeTab.ShowReviewLoad {
+		statusLine += m.renderReviewLoad(activeTab, time.Now())
+	}
+	if m.ShowLog {
+		statusLine += m.renderLogPane()
+	}
 
 	// Extended help (compact with compass theme) - only show when help is toggled
 	if activeTab.ShowHelp {
 		extendedHelp := "\n" + helpStyle.Render(`
 ╭─ 🧭 PR Compass - Navigation Guide ─╮
 │ 🎯 Navigate: ↑↓/jk  ⏎ Open PR      │
+│ 🌐 Open all listed PRs: ^O           │
+│ 🧰 open_with: ⏎ Run  alt+⏎ Browser   │
 │ 📑 Tabs: Tab/⇧Tab  ^1-9 Switch     │
+│ 🗂️  New: ^T  Close: ^W  Reopen: ^Z   │
+│ ↔️  Move tab: < >  Rename: ^E        │
 │ 🔍 Filter: a Author s Status d Draft │
+│ 🔎 Search: / Title branch author repo │
+│ 🏷️  Type: t Cycle feat/fix/chore...  │
+│ 🔖 Label: # Pick from this tab       │
+│ 🎯 Milestone: y  📋 Board column: Y  │
+│ ⚡ Quick filters: 1-5  Presets: 6-9  │
+│ 👀 Needs my review: n  🙋 My PRs: p  │
+│ 🧰 Repo stack: L Language T Topic    │
+│ ✂️  Size budget: b  🎫 No issue: l    │
+│ 🕰️  Stale: S Not updated lately      │
+│ ✅ Review: A  🔀 Merge: M  💬 C      │
+│ 🚧 Draft / ready for review: ^R      │
+│ ⏩ Auto-merge when green: G          │
+│ 📥 Update branch from base: ^B       │
+│ 👥 Request reviewers: R              │
+│ 👷 Assign / unassign yourself: I     │
+│ 🏷️  Labels: ^L Add/remove on the PR  │
+│ 👁  Watched repos: W Open new PRs     │
+│ 👤 Profile: P Switch config profile  │
+│ ↕️  Sort: o Cycle key O Reverse       │
+│ 🔁 Duplicates: D Open all ^A Approve │
+│ ⛔ Blocked on: B Set/clear note      │
+│ 📌 Private note: N Edit (local only) │
+│ ⭐ Pin: * Sort first, alert changes  │
+│ 🔵 Unread: a Mark all read           │
+│ 🗂️  Merged/closed: w Show recent     │
+│ 🕘 History: ↑↓ while typing a prompt │
+│ 📦 Repo & author info: i             │
+│ 📰 Activity: H Reviews pushes merges │
+│ 📊 Review load: Q Authors, reviewers │
+│ 🪵 Log: E Show  e Level              │
+│ 🚧 Failed repos: Z Dismiss / show    │
+│ 📄 Details: v Toggle  PgUp/PgDn Scroll │
+│ 🔍 Diff: V  n/p Next/prev file       │
+│ 🚦 Checks: x List  X Open failing    │
+│ 🔁 Re-run failed checks: F           │
+│ 🔗 Search URL: u Copy U Open         │
+│ 📝 Markdown table: m Copy            │
+│ 📐 Layout: z Density - Hide col = Reset │
+│ ↔️  Columns: ←/→ Scroll when narrow   │
 │ 🧹 Clear: c  🔄 Refresh: r  ❓ Help: h │
 │                                     │
 │ 🏷️  Status: ✅Ready ⚠️Conflict 🔄CI  │
@@ -662,21 +1929,65 @@ func (m *MultiTabModel) renderNoTabs() string {
 
 // calculateTableHeight calculates the appropriate table height using the controller
 func (m *MultiTabModel) calculateTableHeight(tab *TabState) int {
-	return m.controller.CalculateTableHeight(m.Height)
+	height := m.controller.CalculateTableHeight(m.Height) - quickFilterBarHeight - tableFooterHeight - recentlyCompletedHeight(tab) - issuesPanelHeight(tab)
+	if tab.Config.Insights {
+		height -= insightsPanelHeight
+	}
+	if tab.ShowDetails {
+		// Make room for the pane's content, title, footer and border
+		height -= detailPaneHeight + 4
+	}
+	if tab.ShowChecks {
+		// Make room for the panel's checks, title, footer and border
+		height -= checksPanelLines + 5
+	}
+	if height < 3 {
+		height = 3
+	}
+	return height
 }
 
 // Helper methods for tab operations
 
+// reopenClosedTab restores the last closed tab, refetching it if it was
+// closed before its PRs finished loading
+func (m *MultiTabModel) reopenClosedTab() tea.Cmd {
+	tab, ok := m.TabManager.ReopenClosedTab()
+	if !ok {
+		if activeTab := m.TabManager.GetActiveTab(); activeTab != nil {
+			activeTab.StatusMsg = "No closed tabs to reopen"
+		}
+		return nil
+	}
+
+	tab.StatusMsg = fmt.Sprintf("Reopened tab %q", tab.Config.Name)
+	if !tab.Loaded || tab.BackgroundRefreshing {
+		// Closing cancelled the fetch in flight
+		return tea.Batch(m.fetchPRsForTab(tab), m.spinnerTickCmd())
+	}
+	return nil
+}
+
 func (m *MultiTabModel) fetchPRsForTab(tab *TabState) tea.Cmd {
+	// A tab's first load ramps up its per-repo requests
+	var ramp time.Duration
+	if !tab.Loaded {
+		ramp = m.StartupRamp.repoDelay()
+	}
+	cfg := tab.fetchConfig()
+	listed := tab.ListedPRs
+	listingMore := tab.ListingMore
+
 	return func() tea.Msg {
-		// For initial fetch (when tab is not loaded), bypass rate limiting
-		if tab.Loaded {
+		// For initial fetch (when tab is not loaded), bypass rate limiting.
+		// A page listed by scrolling doesn't wait for the tab's turn either.
+		if tab.Loaded && !listingMore {
 			// Check if this tab should refresh based on rate limiting (only for subsequent refreshes)
 			if m.TabManager.refreshScheduler != nil && !m.TabManager.refreshScheduler.ShouldRefreshTab(tab.Config.Name) {
 				// Skip refresh due to rate limiting, but return a message to clear refresh state
 				return tabPrsMsg{
 					tabName: tab.Config.Name,
-					prs:     tab.PRs, // Use existing PRs
+					prs:     listed, // Use existing PRs
 					err:     nil,
 				}
 			}
@@ -687,10 +1998,10 @@ func (m *MultiTabModel) fetchPRsForTab(tab *TabState) tea.Cmd {
 			m.TabManager.refreshScheduler.MarkRefreshStarted(tab.Config.Name)
 		}
 
-		// Convert tab config to standard config
-		cfg := tab.Config.ConvertToConfig()
+		started := time.Now()
 
 		var prs []*gh.PullRequest
+		var counts github.PRCounts
 		var err error
 
 		// Create rate-limited request
@@ -701,15 +2012,13 @@ func (m *MultiTabModel) fetchPRsForTab(tab *TabState) tea.Cmd {
 				Priority:   PriorityNormal,
 				Timeout:    30 * time.Second,
 				ResultChan: make(chan error, 1),
+				Ctx:        tab.Ctx,
 				RequestFunc: func(ctx context.Context) error {
 					var fetchErr error
 
-					// Use optimized fetching with GraphQL + Caching
-					if tab.PRCache != nil {
-						prs, fetchErr = github.FetchPRsFromConfigOptimized(ctx, cfg, m.TabManager.Token, tab.PRCache)
-					} else {
-						prs, fetchErr = github.FetchPRsFromConfig(ctx, cfg, m.TabManager.Token)
-					}
+					// The tab's provider serves from the cache when it is fresh
+					ctx = github.WithRequestRamp(ctx, ramp)
+					prs, counts, fetchErr = provider.FetchPRsWithCounts(ctx, cfg, m.TabManager.Token, tab.PRCache)
 
 					return fetchErr
 				},
@@ -718,20 +2027,18 @@ func (m *MultiTabModel) fetchPRsForTab(tab *TabState) tea.Cmd {
 			err = m.TabManager.RateLimiter.RequestWithRateLimit(req)
 		} else {
 			// Fallback to direct fetching
-			ctx, cancel := context.WithTimeout(tab.Ctx, 30*time.Second)
+			ctx, cancel := context.WithTimeout(github.WithRequestRamp(tab.Ctx, ramp), 30*time.Second)
 			defer cancel()
 
-			if tab.PRCache != nil {
-				prs, err = github.FetchPRsFromConfigOptimized(ctx, cfg, m.TabManager.Token, tab.PRCache)
-			} else {
-				prs, err = github.FetchPRsFromConfig(ctx, cfg, m.TabManager.Token)
-			}
+			prs, counts, err = provider.FetchPRsWithCounts(ctx, cfg, m.TabManager.Token, tab.PRCache)
 		}
 
 		return tabPrsMsg{
 			tabName: tab.Config.Name,
 			prs:     prs,
+			counts:  counts,
 			err:     err,
+			took:    time.Since(started),
 		}
 	}
 }
@@ -749,56 +2056,120 @@ func (m *MultiTabModel) handleTabPRsMessage(msg tabPrsMsg) (tea.Model, tea.Cmd)
 
 	if targetTab == nil {
 		// Tab not found - might have been closed
+		m.Log.Debug("Fetch result for a closed tab dropped", "tab", msg.tabName)
 		return m, nil
 	}
 
+	// A scope that resolved to no repositories is shown as guidance, not an error
+	var emptyScope *github.NoRepositoriesError
+	if errors.As(msg.err, &emptyScope) {
+		m.Log.Info("Tab scope has no repositories", "tab", msg.tabName, "err", msg.err)
+		msg.prs, msg.err = []*gh.PullRequest{}, nil
+	}
+	targetTab.EmptyScope = emptyScope
+	defer m.recordTabMetrics(targetTab, msg)
+	listedMore := targetTab.ListingMore
+	targetTab.ListingMore = false
+
 	// Update the tab state based on the message
+	var recheck tea.Cmd
 	if msg.err != nil {
 		targetTab.Error = msg.err
 		targetTab.Loaded = true
 		targetTab.BackgroundRefreshing = false // Clear refresh indicator on error
 		targetTab.StatusMsg = fmt.Sprintf("Refresh failed: %v", msg.err)
+		m.Log.Warn("Fetch failed", "tab", msg.tabName, "err", msg.err)
+		if listedMore {
+			targetTab.ExtraPages-- // Scrolling down again retries the page
+		}
 	} else {
-		targetTab.PRs = msg.prs
+		m.Log.Info("Fetched PRs", "tab", msg.tabName, "prs", len(msg.prs))
+		msg.prs = targetTab.showListed(msg.prs)
 		targetTab.Loaded = true
 		targetTab.Error = nil
 		targetTab.BackgroundRefreshing = false // Clear refresh indicator on success
-
-		// Apply existing filters if any are active
-		if targetTab.FilterMode != "" && targetTab.FilterValue != "" {
-			targetTab.FilteredPRs = m.applyFilter(msg.prs, targetTab.FilterMode, targetTab.FilterValue)
-		} else if targetTab.FilterMode == "draft" {
-			targetTab.FilteredPRs = m.filterPRsByDraft(msg.prs)
-		} else {
-			targetTab.FilteredPRs = msg.prs
+		listedAt := targetTab.LastRefreshTime
+		if listedAt.IsZero() {
+			listedAt = targetTab.StaleSince // The cached preview's fetch
 		}
-
+		targetTab.StaleSince = time.Time{} // Fresh data replaces any cached preview
+		targetTab.LastRefreshTime = time.Now()
+		previous := targetTab.PRs
+		m.recordListingChanges(targetTab, previous, msg.prs, listedAt, time.Now())
+		if listedMore && msg.counts != nil && msg.counts.Fetched() <= targetTab.PRCounts.Fetched() {
+			targetTab.ListedAll = true // The API lists no deeper
+		}
+		if msg.counts != nil {
+			// Set first, since the failed repos panel takes table height
+			targetTab.PRCounts = msg.counts
+		}
+		if err := targetTab.Seen.Baseline(msg.prs); err != nil {
+			m.Log.Warn("Read state not saved", "err", err)
+		}
+		m.setTabPRs(targetTab, msg.prs)
+		m.pruneRecentlyCompleted(targetTab, time.Now())
+		recheck = tea.Batch(
+			m.mergeRecheckCmd(targetTab, baseMovedConflicts(targetTab, previous, msg.prs), 0),
+			m.completedLookupCmd(targetTab, vanishedPRs(previous, msg.prs)),
+			m.reviewRequestEventsCmd(targetTab),
+		)
 		targetTab.StatusMsg = "" // Clear status after successful refresh
-
-		// Update table data using filtered PRs and preserve enhanced data
-		if len(targetTab.FilteredPRs) > 0 {
-			rows := createTableRowsWithEnhancement(targetTab.FilteredPRs, targetTab.EnhancedData)
-			targetTab.Table.SetRows(rows)
-		} else {
-			// Clear table if no PRs after filtering
-			targetTab.Table.SetRows([]table.Row{})
+		if msg.counts != nil {
+			for _, repo := range msg.counts.Failed() {
+				m.Log.Warn("Repo not listed", "tab", msg.tabName, "repo", repo, "err", msg.counts[repo].Err)
+			}
+			targetTab.StatusMsg = failedReposStatus(msg.counts)
 		}
+		if listedMore && targetTab.ListedAll && targetTab.StatusMsg == "" {
+			targetTab.StatusMsg = fmt.Sprintf("📉 Showing all %d PRs the API lists for this tab", len(targetTab.ListedPRs))
+		}
+	}
 
-		// ALWAYS enforce fixed table height regardless of number of rows
-		// This ensures the table viewport stays within terminal bounds
-		tableHeight := m.calculateTableHeight(targetTab)
-		targetTab.Table.SetHeight(tableHeight)
-
-		// Ensure table stays focused and scrollable within fixed bounds
-		targetTab.Table.Focus()
+	var insights tea.Cmd
+	if msg.err == nil {
+		insights = tea.Batch(m.insightsCmd(targetTab), m.historyCmd(targetTab))
 	}
 
 	// If this is the active tab, start enhancement process
 	if targetTab == m.TabManager.GetActiveTab() {
-		return m, m.startEnhancementForTab(targetTab)
+		return m, tea.Batch(m.startEnhancementForTab(targetTab), m.stackMetadataCmd(targetTab), m.requiredChecksCmd(targetTab), m.teamMembersCmd(targetTab), m.projectItemsCmd(targetTab), m.ticketsCmd(targetTab), recheck, insights)
 	}
 
-	return m, nil
+	return m, tea.Batch(m.stackMetadataCmd(targetTab), m.requiredChecksCmd(targetTab), m.teamMembersCmd(targetTab), m.projectItemsCmd(targetTab), m.ticketsCmd(targetTab), recheck, insights)
+}
+
+// setTabPRs shows a new PR list in a tab, re-applying active filters and
+// keeping already enhanced data
+func (m *MultiTabModel) setTabPRs(tab *TabState, prs []*gh.PullRequest) {
+	tab.PRs = prs
+	tab.DuplicateGroups = services.DetectDuplicateGroups(prs)
+
+	// Apply existing filters if any are active
+	if tab.FilterMode == "size" {
+		tab.FilteredPRs = m.filterPRsOverBudget(prs, tab.EnhancedData, tab.Config.ReviewSizeBudget)
+	} else if tab.FilterMode == "quick" {
+		tab.FilteredPRs = m.filterQuick(prs, tab.EnhancedData, tab.FilterValue)
+	} else if tab.FilterMode == "project" {
+		tab.FilteredPRs = m.filterPRsOnBoard(prs, tab.FilterValue)
+	} else if tab.FilterMode != "" && tab.FilterValue != "" {
+		tab.FilteredPRs = m.applyFilter(prs, tab.FilterMode, tab.FilterValue)
+	} else if tab.FilterMode == "draft" {
+		tab.FilteredPRs = m.filterPRsByDraft(prs)
+	} else {
+		tab.FilteredPRs = prs
+		tab.AppliedFilter = "" // Text filters don't survive a refresh
+	}
+
+	// Update table data using filtered PRs in the tab's sort order and preserve enhanced data
+	m.updateTableRows(tab)
+
+	// ALWAYS enforce fixed table height regardless of number of rows
+	// This ensures the table viewport stays within terminal bounds
+	tableHeight := m.calculateTableHeight(tab)
+	tab.Table.SetHeight(tableHeight)
+
+	// Ensure table stays focused and scrollable within fixed bounds
+	tab.Table.Focus()
 }
 
 // handleEnhancementUpdate handles PR enhancement updates
@@ -823,8 +2194,16 @@ func (m *MultiTabModel) handleEnhancementUpdate(msg types.PrEnhancementUpdateMsg
 		return m, m.startEnhancementForTab(targetTab)
 	}
 
+	targetTab.Progress.Record(msg.PrData.Number, msg.Error)
+
 	// Update the enhanced data for this PR
+	var eventsCmd tea.Cmd
 	if msg.Error == nil {
+		if previous, known := targetTab.EnhancedData[msg.PrData.Number]; known {
+			m.recordReviewChange(targetTab, previous, msg.PrData)
+			m.recordCommentActivity(targetTab, previous, msg.PrData)
+			eventsCmd = tea.Batch(m.enhancementEventsCmd(targetTab, previous, msg.PrData), m.pinnedEnhancementCmd(targetTab, previous, msg.PrData))
+		}
 		targetTab.EnhancedData[msg.PrData.Number] = msg.PrData
 
 		// Remove from enhancement queue
@@ -832,37 +2211,110 @@ func (m *MultiTabModel) handleEnhancementUpdate(msg types.PrEnhancementUpdateMsg
 
 		// Update enhanced count
 		targetTab.EnhancedCount = len(targetTab.EnhancedData)
+
+		m.refilterEnhanced(targetTab)
 	} else {
-		// Handle enhancement error - remove from queue but don't add to enhanced data
+		// Handle enhancement error - remove from queue but don't add to enhanced data.
+		// Failures are counted in the progress bar rather than the status line.
 		delete(targetTab.EnhancementQueue, msg.PrData.Number)
-		// Could show error in status, but for now just continue
+		m.Log.Warn("Enhancement failed", "tab", targetTab.Config.Name, "pr", msg.PrData.Number, "err", msg.Error)
+	}
+
+	// Report failures once the run finishes, since the progress bar disappears then
+	if !targetTab.Progress.Active() && targetTab.Progress.Failed > 0 && targetTab.StatusMsg == "" {
+		targetTab.StatusMsg = fmt.Sprintf("Enhanced %d PRs, %d failed", targetTab.Progress.Completed, targetTab.Progress.Failed)
 	}
 
 	// Update the table display with the new enhanced data
 	m.updateTableRows(targetTab)
 
-	return m, nil
+	return m, eventsCmd
+}
+
+// refilterEnhanced re-evaluates filters that depend on enhanced data
+func (m *MultiTabModel) refilterEnhanced(tab *TabState) {
+	// Size is only known after enhancement, so re-evaluate an active size budget filter
+	if tab.FilterMode == "size" {
+		tab.FilteredPRs = m.filterPRsOverBudget(tab.PRs, tab.EnhancedData, tab.Config.ReviewSizeBudget)
+	}
+	// As are reviews, checks and mergeability for quick filters
+	if tab.FilterMode == "quick" {
+		tab.FilteredPRs = m.filterQuick(tab.PRs, tab.EnhancedData, tab.FilterValue)
+	}
+}
+
+// restoreCachedEnhancements fills in enhanced data cached by an earlier run
+// for PRs whose head commit and update time are unchanged, so they aren't
+// fetched again after a restart
+func (m *MultiTabModel) restoreCachedEnhancements(tab *TabState) {
+	var missing []*gh.PullRequest
+	for _, pr := range tab.PRs {
+		if _, enhanced := tab.EnhancedData[pr.GetNumber()]; !enhanced {
+			missing = append(missing, pr)
+		}
+	}
+	if len(missing) == 0 {
+		return
+	}
+
+	restored := services.CachedEnhancedBatch(tab.PRCache, missing)
+	if len(restored) == 0 {
+		return
+	}
+	for number, data := range restored {
+		tab.EnhancedData[number] = data
+	}
+	for _, pr := range missing {
+		if _, found := restored[pr.GetNumber()]; found {
+			tab.EnhancedFor[pr.GetNumber()] = pr.GetUpdatedAt().Time
+		}
+	}
+	tab.EnhancedCount = len(tab.EnhancedData)
+	m.refilterEnhanced(tab)
+	m.updateTableRows(tab)
 }
 
 // startEnhancementForTab starts the background enhancement process for a tab's PRs
 func (m *MultiTabModel) startEnhancementForTab(tab *TabState) tea.Cmd {
-	if len(tab.PRs) == 0 {
+	// Enhancement costs several requests per PR - far too many for the
+	// unauthenticated budget
+	if len(tab.PRs) == 0 || m.readOnly() || !tab.Config.OnGitHub() {
+		return nil
+	}
+	// Results from an earlier run still hold for unchanged PRs, even when
+	// night mode or low quota hold off fetching the rest
+	m.restoreCachedEnhancements(tab)
+	// Night mode skips enhancement; the first refresh of the work day catches up
+	if m.nightMode() {
 		return nil
 	}
+	// Low quota pauses enhancement until the window resets, leaving the
+	// remaining requests to list refreshes
+	if limit, low := m.lowQuota(time.Now()); low {
+		return m.pauseEnhancementForQuota(tab, limit)
+	}
 
 	// Find PRs that need enhancement
 	var prsToEnhance []*gh.PullRequest
 
-	for _, pr := range tab.PRs {
+	// Pinned PRs go first
+	for _, pr := range pinnedFirst(tab.PRs, tab.Pins) {
 		prNumber := pr.GetNumber()
 
-		// Skip if already enhanced or in enhancement queue
-		if _, enhanced := tab.EnhancedData[prNumber]; enhanced {
+		// Skip if already enhanced or in enhancement queue. A PR updated
+		// since, e.g. by a review, is enhanced again to catch the change,
+		// and pinned PRs on every listing so their checks stay current.
+		data, enhanced := tab.EnhancedData[prNumber]
+		refreshPinned := tab.Pins.Pinned(pr) && data.EnhancedAt.Before(tab.LastRefreshTime)
+		if enhanced && !pr.GetUpdatedAt().After(tab.EnhancedFor[prNumber]) && !refreshPinned {
 			continue
 		}
 		if _, inQueue := tab.EnhancementQueue[prNumber]; inQueue {
 			continue
 		}
+		if tab.Progress.Active() && tab.Progress.HasFailed(prNumber) {
+			continue
+		}
 
 		prsToEnhance = append(prsToEnhance, pr)
 	}
@@ -871,21 +2323,20 @@ func (m *MultiTabModel) startEnhancementForTab(tab *TabState) tea.Cmd {
 		return nil
 	}
 
-	// Process PRs in smaller batches to avoid overwhelming the API
-	const batchSize = 10 // Process 10 at a time
-	var cmds []tea.Cmd
-
-	for i := 0; i < len(prsToEnhance) && i < batchSize; i++ {
-		pr := prsToEnhance[i]
-		prNumber := pr.GetNumber()
-
-		// Add to enhancement queue
-		tab.EnhancementQueue[prNumber] = true
+	// Later batches continue the current run; otherwise this is a new run
+	if !tab.Progress.Active() {
+		tab.Progress.Start(len(prsToEnhance), time.Now())
+	}
 
-		// Create enhancement command
-		enhanceCmd := m.createEnhancementCommand(pr, prNumber)
-		cmds = append(cmds, enhanceCmd)
+	// Each batch is a single GraphQL query, paced to avoid overwhelming the API
+	batchSize := github.SummaryBatchSize
+	batch := prsToEnhance[:min(len(prsToEnhance), batchSize)]
+	m.Log.Debug("Enhancing PRs", "tab", tab.Config.Name, "batch", len(batch), "remaining", len(prsToEnhance)-len(batch))
+	for _, pr := range batch {
+		tab.EnhancementQueue[pr.GetNumber()] = true
+		tab.EnhancedFor[pr.GetNumber()] = pr.GetUpdatedAt().Time
 	}
+	cmds := []tea.Cmd{m.createBatchEnhancementCommand(batch, tab.PRCache)}
 
 	// If there are more PRs to enhance, schedule the next batch
 	if len(prsToEnhance) > batchSize {
@@ -900,17 +2351,38 @@ func (m *MultiTabModel) startEnhancementForTab(tab *TabState) tea.Cmd {
 		cmds = append(cmds, nextBatchCmd)
 	}
 
-	if len(cmds) > 0 {
-		return tea.Batch(cmds...)
+	return tea.Batch(cmds...)
+}
+
+// createBatchEnhancementCommand creates a command enhancing a page of PRs
+// with one GraphQL query. Results are also cached for headless commands.
+func (m *MultiTabModel) createBatchEnhancementCommand(prs []*gh.PullRequest, prCache *cache.PRCache) tea.Cmd {
+	token := ""
+	if m.TabManager != nil {
+		token = m.TabManager.Token
 	}
 
-	return nil
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 20*time.Second)
+		defer cancel()
+
+		results, errs := services.FetchEnhancedBatch(ctx, token, prs)
+		services.CacheEnhancedBatch(prCache, prs, results, errs)
+		updates := make([]types.PrEnhancementUpdateMsg, len(prs))
+		for i, pr := range prs {
+			updates[i] = types.PrEnhancementUpdateMsg{PrData: results[i], Error: errs[i]}
+			if errs[i] != nil {
+				updates[i].PrData = types.EnhancedData{Number: pr.GetNumber()}
+			}
+		}
+		return enhancementBatchMsg{updates: updates}
+	}
 }
 
 // createEnhancementCommand creates a command for enhancing a single PR
 func (m *MultiTabModel) createEnhancementCommand(pr *gh.PullRequest, prNumber int) tea.Cmd {
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 10*time.Second)
 		defer cancel()
 
 		// Get token from tab manager
@@ -939,16 +2411,26 @@ func (m *MultiTabModel) createEnhancementCommand(pr *gh.PullRequest, prNumber in
 }
 
 func (m *MultiTabModel) refreshCmdForTab(tab *TabState) tea.Cmd {
+	return m.refreshCmdForTabAfter(tab, 0)
+}
+
+// refreshCmdForTabAfter schedules the tab's next refresh, held back by offset
+func (m *MultiTabModel) refreshCmdForTabAfter(tab *TabState, offset time.Duration) tea.Cmd {
 	refreshInterval := tab.Config.RefreshIntervalMinutes
 	if refreshInterval == 0 {
 		refreshInterval = 5
 	}
+	if m.readOnly() && refreshInterval < publicModeRefreshMinutes {
+		refreshInterval = publicModeRefreshMinutes
+	}
+
+	// Outside work hours the interval stretches to the night-mode interval
+	duration := m.WorkHours.refreshDelay(time.Now(), time.Duration(refreshInterval)*time.Minute) + offset
 
-	tabName := tab.Config.Name
 	return func() tea.Msg {
-		duration := time.Duration(refreshInterval) * time.Minute
 		time.Sleep(duration)
-		return tabRefreshMsg{tabName: tabName}
+		// Named on waking, so a tab renamed meanwhile keeps refreshing
+		return tabRefreshMsg{tabName: tab.Config.Name}
 	}
 }
 
@@ -1,11 +1,18 @@
 package ui
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	"github.com/bjess9/pr-compass/internal/ui/types"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/google/go-github/v55/github"
 )
 
 // TestTabConfig tests tab configuration creation and conversion
@@ -245,6 +252,35 @@ func TestMultiTabConfiguration(t *testing.T) {
 	}
 }
 
+// TestRequireIssueLinkInheritance tests that tabs inherit the global issue link policy unless they set their own
+func TestRequireIssueLinkInheritance(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	configYAML := `require_issue_link: true
+tabs:
+  - name: Product
+    mode: repos
+    repos: [org/app]
+  - name: Sandbox
+    mode: repos
+    repos: [org/sandbox]
+    require_issue_link: false
+`
+	if err := os.WriteFile(configPath, []byte(configYAML), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	multiConfig, err := LoadMultiTabConfigFromPath(configPath)
+	if err != nil {
+		t.Fatalf("LoadMultiTabConfigFromPath() error = %v", err)
+	}
+	if !multiConfig.Tabs[0].RequireIssueLink {
+		t.Error("Expected Product tab to inherit require_issue_link")
+	}
+	if multiConfig.Tabs[1].RequireIssueLink {
+		t.Error("Expected Sandbox tab's own require_issue_link: false to win")
+	}
+}
+
 // TestTabStateInitialization tests tab state creation
 func TestTabStateInitialization(t *testing.T) {
 	config := &TabConfig{
@@ -367,3 +403,160 @@ func TestTabManagerEdgeCases(t *testing.T) {
 		t.Error("Expected failure when switching to invalid tab index 10")
 	}
 }
+
+// TestEnhancementProgress verifies progress counting, failure tracking and ETA
+func TestEnhancementProgress(t *testing.T) {
+	var progress EnhancementProgress
+	if progress.Active() {
+		t.Error("Zero-value progress should not be active")
+	}
+
+	start := time.Now()
+	progress.Start(4, start)
+	progress.Record(1, nil)
+	progress.Record(2, fmt.Errorf("rate limited"))
+
+	if !progress.Active() {
+		t.Error("Expected run to be active with PRs outstanding")
+	}
+	if progress.Completed != 1 || progress.Failed != 1 {
+		t.Errorf("Expected 1 completed and 1 failed, got %d and %d", progress.Completed, progress.Failed)
+	}
+	if !progress.HasFailed(2) || progress.HasFailed(1) {
+		t.Error("Expected only PR 2 to be recorded as failed")
+	}
+
+	// Two PRs took 10s, so the remaining two should take about 10s
+	if eta := progress.ETA(start.Add(10 * time.Second)); eta != 10*time.Second {
+		t.Errorf("Expected ETA of 10s, got %v", eta)
+	}
+
+	progress.Record(3, nil)
+	progress.Record(4, nil)
+	if progress.Active() {
+		t.Error("Expected run to finish once every PR is processed")
+	}
+}
+
+// TestEnhancementUpdateTracksProgress verifies enhancement results feed the tab's progress
+func TestEnhancementUpdateTracksProgress(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test", Mode: "repos", Repos: []string{"org/repo"}})
+	tab.PRs = []*github.PullRequest{{Number: github.Int(1)}, {Number: github.Int(2)}}
+	tab.FilteredPRs = tab.PRs
+	tab.Progress.Start(2, time.Now())
+
+	model.Update(types.PrEnhancementUpdateMsg{PrData: types.EnhancedData{Number: 1}})
+	if !strings.Contains(model.renderActiveTabContent(tab), "1/2") {
+		t.Error("Expected progress bar with 1/2 in the status area")
+	}
+
+	model.Update(types.PrEnhancementUpdateMsg{PrData: types.EnhancedData{Number: 2}, Error: fmt.Errorf("timeout")})
+	if tab.Progress.Active() {
+		t.Error("Expected run to be finished")
+	}
+	if tab.StatusMsg != "Enhanced 1 PRs, 1 failed" {
+		t.Errorf("Expected failure summary in status, got %q", tab.StatusMsg)
+	}
+}
+
+// TestEnhancementRestoredFromCache tests that a restart reuses enhanced data
+// for PRs whose head commit is unchanged and only fetches the rest
+func TestEnhancementRestoredFromCache(t *testing.T) {
+	prCache, err := cache.NewPRCacheWithDir(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewPRCacheWithDir() error = %v", err)
+	}
+	updated := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	newPR := func(number int, sha string) *github.PullRequest {
+		return &github.PullRequest{
+			Number:    github.Int(number),
+			UpdatedAt: &github.Timestamp{Time: updated},
+			Head:      &github.PullRequestBranch{SHA: github.String(sha)},
+			Base:      &github.PullRequestBranch{Repo: &github.Repository{FullName: github.String("org/repo")}},
+		}
+	}
+	services.CacheEnhancedBatch(prCache, []*github.PullRequest{newPR(1, "aaa"), newPR(2, "bbb")},
+		[]types.EnhancedData{{Number: 1, ChecksStatus: "failure", Additions: 40, EnhancedAt: updated}, {Number: 2, EnhancedAt: updated}},
+		[]error{nil, nil})
+
+	// After the restart PR 2 has a new commit
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test", Mode: "repos", Repos: []string{"org/repo"}})
+	tab.PRCache = prCache
+	tab.FilterMode = "quick"
+	tab.FilterValue = "failing"
+	tab.PRs = []*github.PullRequest{newPR(1, "aaa"), newPR(2, "ccc")}
+
+	if cmd := model.startEnhancementForTab(tab); cmd == nil {
+		t.Fatal("Expected the changed PR to be enhanced")
+	}
+	if data, restored := tab.EnhancedData[1]; !restored || data.Additions != 40 {
+		t.Errorf("Expected PR 1 restored from the cache, got %+v", tab.EnhancedData)
+	}
+	if _, queued := tab.EnhancementQueue[1]; queued || !tab.EnhancementQueue[2] {
+		t.Errorf("Expected only PR 2 to be fetched, got queue %v", tab.EnhancementQueue)
+	}
+	if len(tab.FilteredPRs) != 1 || tab.FilteredPRs[0].GetNumber() != 1 {
+		t.Errorf("Expected restored checks to feed the Failing filter, got %d PRs", len(tab.FilteredPRs))
+	}
+}
+
+// TestReopenClosedTab tests undoing tab closes in reverse order with state intact
+func TestReopenClosedTab(t *testing.T) {
+	manager := NewTabManager("test-token")
+	for _, name := range []string{"Tab 1", "Tab 2", "Tab 3"} {
+		manager.AddTab(&TabConfig{Name: name, Mode: "repos", Repos: []string{"org/api"}})
+	}
+	manager.Tabs[1].AppliedFilter = "author=bob"
+
+	if _, ok := manager.ReopenClosedTab(); ok {
+		t.Error("Expected nothing to reopen before any close")
+	}
+
+	manager.CloseTab(1)
+	manager.CloseTab(0)
+	if names := manager.GetTabNames(); len(names) != 1 || names[0] != "Tab 3" {
+		t.Fatalf("Expected only Tab 3 left, got %v", names)
+	}
+
+	tab, ok := manager.ReopenClosedTab()
+	if !ok || tab.Config.Name != "Tab 1" || manager.ActiveTabIdx != 0 {
+		t.Fatalf("Expected Tab 1 reopened first at index 0, got %v (active %d)", manager.GetTabNames(), manager.ActiveTabIdx)
+	}
+	if tab.Ctx.Err() != nil {
+		t.Error("Expected a reopened tab to get a live context")
+	}
+
+	tab, ok = manager.ReopenClosedTab()
+	if !ok || tab.AppliedFilter != "author=bob" || manager.ActiveTabIdx != 1 {
+		t.Errorf("Expected Tab 2 back at index 1 with its filter, got %v (active %d)", manager.GetTabNames(), manager.ActiveTabIdx)
+	}
+	if names := manager.GetTabNames(); names[0] != "Tab 1" || names[1] != "Tab 2" || names[2] != "Tab 3" {
+		t.Errorf("Expected the original tab order, got %v", names)
+	}
+}
+
+// TestHotkeyCloseAndReopenTab tests ctrl+w and ctrl+z
+func TestHotkeyCloseAndReopenTab(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	first := model.TabManager.AddTab(&TabConfig{Name: "Tab 1", Mode: "repos", Repos: []string{"org/api"}})
+	second := model.TabManager.AddTab(&TabConfig{Name: "Tab 2", Mode: "repos", Repos: []string{"org/web"}})
+	second.Loaded = true
+	model.TabManager.SwitchToTab(1)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlW})
+	if model.TabManager.GetTabCount() != 1 || first.StatusMsg != `Closed tab "Tab 2" - ctrl+z to reopen` {
+		t.Fatalf("Expected Tab 2 closed with an undo hint, got %d tabs / %q", model.TabManager.GetTabCount(), first.StatusMsg)
+	}
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	if model.TabManager.GetActiveTab() != second || cmd != nil {
+		t.Errorf("Expected the loaded Tab 2 back and active without a refetch")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	if second.StatusMsg != "No closed tabs to reopen" {
+		t.Errorf("Expected a notice with nothing to reopen, got %q", second.StatusMsg)
+	}
+}
@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// viewerLoginMsg delivers the login of the user the token belongs to
+type viewerLoginMsg struct {
+	tabName     string // Tab waiting to filter to the user's PRs; "" at startup
+	quickFilter bool   // The tab asked through the Mine quick filter rather than p
+	assign      bool   // The tab asked to (un)assign the user rather than filter
+	login       string
+	err         error
+}
+
+// viewerLoginCmd looks up the current user's login. Startup resolves it
+// once; a tab that asks before that lands (or after it failed) looks again.
+func (m *MultiTabModel) viewerLoginCmd(tabName string) tea.Cmd {
+	if m.readOnly() {
+		return nil
+	}
+
+	token := m.TabManager.Token
+	var prCache *cache.PRCache
+	if len(m.TabManager.Tabs) > 0 {
+		prCache = m.TabManager.Tabs[0].PRCache // All tabs share the cache directory
+	}
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 15*time.Second)
+		defer cancel()
+
+		login, err := github.FetchViewerLogin(ctx, token, prCache)
+		return viewerLoginMsg{tabName: tabName, login: login, err: err}
+	}
+}
+
+// handleViewerLogin remembers the current user and applies the "my PRs"
+// filter, or asks about self-assignment, if a tab asked for it and is still
+// active
+func (m *MultiTabModel) handleViewerLogin(msg viewerLoginMsg) (tea.Model, tea.Cmd) {
+	tab := m.TabManager.GetActiveTab()
+	waiting := msg.tabName != "" && tab != nil && tab.Config.Name == msg.tabName
+	if msg.err != nil {
+		if waiting {
+			tab.StatusMsg = fmt.Sprintf("Couldn't identify you: %v", msg.err)
+		}
+		return m, nil
+	}
+
+	m.viewerLogin = msg.login
+	if waiting && msg.quickFilter {
+		m.toggleQuickFilter(tab, "mine")
+	} else if waiting && msg.assign {
+		m.confirmSelfAssign(tab)
+	} else if waiting {
+		m.applyMyPRs(tab)
+	}
+	return m, nil
+}
+
+// toggleMyPRs filters the tab to PRs the current user opened, or clears
+// that filter
+func (m *MultiTabModel) toggleMyPRs(tab *TabState) tea.Cmd {
+	if tab.FilterMode == "mine" {
+		tab.FilterMode = ""
+		tab.FilterValue = ""
+		tab.FilteredPRs = tab.PRs
+		tab.StatusMsg = "Filter cleared"
+		m.updateTableRows(tab)
+		return nil
+	}
+	if m.readOnly() {
+		tab.StatusMsg = "Read-only mode: set GITHUB_TOKEN so PR Compass knows who you are"
+		return nil
+	}
+	if m.viewerLogin != "" {
+		m.applyMyPRs(tab)
+		return nil
+	}
+
+	tab.StatusMsg = "Looking up your login..."
+	return m.viewerLoginCmd(tab.Config.Name)
+}
+
+// applyMyPRs narrows the tab to PRs authored by the current user
+func (m *MultiTabModel) applyMyPRs(tab *TabState) {
+	tab.FilterMode = "mine"
+	tab.FilterValue = m.viewerLogin
+	tab.FilteredPRs = m.applyFilter(tab.PRs, "mine", m.viewerLogin)
+	tab.StatusMsg = fmt.Sprintf("🙋 My PRs as %s (%d) - p to clear", m.viewerLogin, len(tab.FilteredPRs))
+	m.updateTableRows(tab)
+}
@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+func authoredPR(number int, author string) *gh.PullRequest {
+	return &gh.PullRequest{Number: gh.Int(number), Title: gh.String("PR"), User: &gh.User{Login: gh.String(author)}}
+}
+
+// TestHotkeyMyPRs tests that p filters to the current user's PRs once their login is known
+func TestHotkeyMyPRs(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"org/api"}})
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: []*gh.PullRequest{
+		authoredPR(1, "Alice"), authoredPR(2, "alicia"), authoredPR(3, "bob"),
+	}})
+
+	// Pressed before startup resolved the login, p looks it up and then filters
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	if cmd == nil || tab.FilterMode != "" {
+		t.Fatalf("Expected a login lookup before filtering, got filter %q", tab.FilterMode)
+	}
+	model.Update(viewerLoginMsg{tabName: "Test Tab", login: "alice"})
+	if tab.FilterMode != "mine" || len(tab.FilteredPRs) != 1 || !strings.HasPrefix(tab.StatusMsg, "🙋 My PRs as alice (1)") {
+		t.Errorf("Expected only PR 1, got %q with %d PRs / %q", tab.FilterMode, len(tab.FilteredPRs), tab.StatusMsg)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	if tab.FilterMode != "" || len(tab.FilteredPRs) != 3 {
+		t.Errorf("Expected p to clear the filter, got %q with %d PRs", tab.FilterMode, len(tab.FilteredPRs))
+	}
+}
+
+// TestViewerLoginAtStartup tests that the login resolved at startup is reused without filtering anything
+func TestViewerLoginAtStartup(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"org/api"}})
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: []*gh.PullRequest{authoredPR(1, "alice"), authoredPR(2, "bob")}})
+
+	model.Update(viewerLoginMsg{login: "bob"})
+	if tab.FilterMode != "" {
+		t.Errorf("Expected the startup lookup not to filter, got %q", tab.FilterMode)
+	}
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")}); cmd != nil || len(tab.FilteredPRs) != 1 {
+		t.Errorf("Expected p to filter right away, got %d PRs", len(tab.FilteredPRs))
+	}
+	if query, _ := searchQueryForTab(tab); !strings.Contains(query, "author:bob") {
+		t.Errorf("Expected the search URL to keep the filter, got %q", query)
+	}
+}
@@ -0,0 +1,194 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// noteMarker prefixes PRs with a private note
+const noteMarker = "📌"
+
+// noteEditorHeight is the number of lines the note editor shows
+const noteEditorHeight = 6
+
+// Note is private review context kept for a PR, never sent to GitHub
+type Note struct {
+	Text      string    `json:"text"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NoteStore keeps private notes keyed by PR ("owner/repo#123"), persisted
+// locally so context like "waiting for perf numbers" stays out of public comments
+type NoteStore struct {
+	mu    sync.Mutex
+	path  string // Empty path keeps notes in memory only
+	notes map[string]Note
+}
+
+// NewNoteStore creates a note store backed by the given file. A missing or
+// unreadable file starts with no notes.
+func NewNoteStore(path string) *NoteStore {
+	store := &NoteStore{
+		path:  path,
+		notes: make(map[string]Note),
+	}
+
+	if path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			var saved map[string]Note
+			if json.Unmarshal(data, &saved) == nil && saved != nil {
+				store.notes = saved
+			}
+		}
+	}
+
+	return store
+}
+
+// Get returns the note on a PR, if any
+func (s *NoteStore) Get(pr *gh.PullRequest) (Note, bool) {
+	if s == nil || pr == nil {
+		return Note{}, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	note, ok := s.notes[services.PRKey(pr)]
+	return note, ok
+}
+
+// Set replaces the note on a PR; empty text deletes it
+func (s *NoteStore) Set(pr *gh.PullRequest, text string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := services.PRKey(pr)
+	if text == "" {
+		delete(s.notes, key)
+	} else {
+		s.notes[key] = Note{Text: text, UpdatedAt: time.Now()}
+	}
+	return s.save()
+}
+
+// save writes notes to disk; the caller must hold the lock
+func (s *NoteStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(s.notes, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode notes: %w", err)
+	}
+	if err := cache.WriteFile(s.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to save notes: %w", err)
+	}
+	return nil
+}
+
+// getNotesFilePath returns the path private PR notes are saved to
+func getNotesFilePath() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/.prcompass_notes.json", homeDir)
+}
+
+// noteEditor is the overlay for editing the private note on one PR. While
+// open it receives every key press, so enter starts a new line.
+type noteEditor struct {
+	pr    *gh.PullRequest
+	input textarea.Model
+}
+
+// openNoteEditor edits the selected PR's note, prefilled with the current one.
+// Notes are local, so this works in read-only mode too.
+func (m *MultiTabModel) openNoteEditor(tab *TabState) {
+	pr := tab.SelectedPR()
+	if pr == nil {
+		tab.StatusMsg = "No PR selected"
+		return
+	}
+
+	input := textarea.New()
+	input.Placeholder = "Private note - only stored on this machine"
+	input.ShowLineNumbers = false
+	input.SetWidth(m.commentWidth())
+	input.SetHeight(noteEditorHeight)
+	input.Cursor.SetMode(cursor.CursorStatic) // Blink messages aren't routed to the editor
+	current, _ := m.TabManager.Notes.Get(pr)
+	input.SetValue(current.Text)
+	input.Focus()
+
+	m.pendingNote = &noteEditor{pr: pr, input: input}
+	tab.StatusMsg = fmt.Sprintf("%s Note on %s - ctrl+s to save, esc to discard", noteMarker, services.PRKey(pr))
+}
+
+// handleNoteKey edits, saves or discards the pending note
+func (m *MultiTabModel) handleNoteKey(tab *TabState, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	editor := m.pendingNote
+
+	switch msg.String() {
+	case "esc":
+		m.pendingNote = nil
+		tab.StatusMsg = "Note unchanged"
+		return m, nil
+	case "ctrl+s":
+		text := strings.TrimSpace(editor.input.Value())
+		if err := m.TabManager.Notes.Set(editor.pr, text); err != nil {
+			tab.StatusMsg = err.Error()
+			return m, nil
+		}
+		m.pendingNote = nil
+		m.refreshAllRows()
+		if text == "" {
+			tab.StatusMsg = fmt.Sprintf("Cleared note on %s", services.PRKey(editor.pr))
+		} else {
+			tab.StatusMsg = fmt.Sprintf("%s Saved note on %s", noteMarker, services.PRKey(editor.pr))
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	editor.input, cmd = editor.input.Update(msg)
+	return m, cmd
+}
+
+// renderNoteEditor renders the note overlay in place of the table
+func (m *MultiTabModel) renderNoteEditor() string {
+	editor := m.pendingNote
+	width := m.commentWidth()
+
+	title := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.TextBright)).
+		Render(clipText(fmt.Sprintf("%s Private note on #%d %s", noteMarker, editor.pr.GetNumber(), editor.pr.GetTitle()), width))
+	footer := mutedStyle.Render("enter new line · ctrl+s save (empty clears) · esc discard")
+	return repoInfoStyle.Width(width + 4).Render(title + "\n" + editor.input.View() + "\n" + footer)
+}
+
+// noteLines lays out a PR's private note for the detail pane, or nothing
+// when it has none
+func (m *MultiTabModel) noteLines(pr *gh.PullRequest, width int, now time.Time) []string {
+	note, ok := m.TabManager.Notes.Get(pr)
+	if !ok {
+		return nil
+	}
+	lines := []string{fmt.Sprintf("%s Note (private, updated %s):", noteMarker, formatAge(now.Sub(note.UpdatedAt)))}
+	for _, line := range strings.Split(note.Text, "\n") {
+		lines = append(lines, clipText("   "+line, width))
+	}
+	return append(lines, "")
+}
@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestNoteStorePersistence tests that notes survive a reload and empty text clears them
+func TestNoteStorePersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.json")
+	pr := blockerTestPR(7)
+
+	store := NewNoteStore(path)
+	if err := store.Set(pr, "waiting for perf numbers\nask about the cache"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	note, ok := NewNoteStore(path).Get(pr)
+	if !ok || note.Text != "waiting for perf numbers\nask about the cache" || note.UpdatedAt.IsZero() {
+		t.Fatalf("Expected note after reload, got %+v (found=%v)", note, ok)
+	}
+
+	if err := store.Set(pr, ""); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if _, ok := NewNoteStore(path).Get(pr); ok {
+		t.Error("Expected empty text to clear the note")
+	}
+
+	var none *NoteStore
+	if _, ok := none.Get(pr); ok {
+		t.Error("Expected nil store to report no note")
+	}
+}
+
+// TestHotkeyNote tests writing, showing, editing and clearing a private note
+func TestHotkeyNote(t *testing.T) {
+	model := NewMultiTabModel("", nil) // Notes are local, so read-only mode can keep them
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Main", Mode: "repos", Repos: []string{"org/billing"}})
+	tab.PRs = []*gh.PullRequest{blockerTestPR(7)}
+	tab.FilteredPRs = tab.PRs
+	tab.Loaded = true
+	model.updateTableRows(tab)
+
+	press := func(msg tea.KeyMsg) { model.Update(msg) }
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	press(runes("N"))
+	if model.pendingNote == nil || !strings.Contains(tab.StatusMsg, "org/billing#7") {
+		t.Fatalf("Expected note editor for the selected PR, got %q", tab.StatusMsg)
+	}
+	if !model.promptOpen() {
+		t.Error("Expected the note editor to count as an open prompt")
+	}
+
+	// Hotkeys and enter are typed into the note
+	press(runes("perf"))
+	press(tea.KeyMsg{Type: tea.KeyEnter})
+	press(runes("q"))
+	if view := model.View(); !strings.Contains(view, "Private note on #7") {
+		t.Error("Expected the editor to replace the table")
+	}
+	press(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if model.pendingNote != nil {
+		t.Fatal("Expected ctrl+s to save the note")
+	}
+	if note, ok := model.TabManager.Notes.Get(tab.PRs[0]); !ok || note.Text != "perf\nq" {
+		t.Fatalf("Expected saved note, got %+v", note)
+	}
+	if title := tab.Table.Rows()[0][0]; !strings.HasPrefix(title, noteMarker) {
+		t.Errorf("Expected note badge in row, got %q", title)
+	}
+	lines := strings.Join(model.detailLines(tab.PRs[0], 80, time.Now()), "\n")
+	if !strings.Contains(lines, "Note (private") || !strings.Contains(lines, "   perf") {
+		t.Errorf("Expected note in the detail pane, got %q", lines)
+	}
+
+	// Reopening prefills the note; esc keeps it unchanged
+	press(runes("N"))
+	if got := model.pendingNote.input.Value(); got != "perf\nq" {
+		t.Errorf("Expected editor prefilled with the note, got %q", got)
+	}
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+	if _, ok := model.TabManager.Notes.Get(tab.PRs[0]); !ok || model.pendingNote != nil {
+		t.Error("Expected esc to close the editor and keep the note")
+	}
+
+	// Saving an empty note clears it
+	press(runes("N"))
+	model.pendingNote.input.Reset()
+	press(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if _, ok := model.TabManager.Notes.Get(tab.PRs[0]); ok {
+		t.Error("Expected empty note to clear it")
+	}
+	if title := tab.Table.Rows()[0][0]; strings.Contains(title, noteMarker) {
+		t.Errorf("Expected badge removed, got %q", title)
+	}
+}
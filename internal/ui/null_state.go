@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// nullStateGuidance explains why a loaded tab has no PRs to show, derived from
+// its fetch and filter state, along with a hint on what to do about it
+func nullStateGuidance(tab *TabState) (string, string) {
+	// PRs were fetched but a filter hid all of them
+	if len(tab.PRs) > 0 {
+		filter := tab.AppliedFilter
+		if tab.FilterMode != "" {
+			filter = fmt.Sprintf("%s=%s", tab.FilterMode, tab.FilterValue)
+		}
+		if filter == "" {
+			filter = "(unknown)"
+		}
+		return fmt.Sprintf("No PRs match filter %s", filter), "Press c to clear filters"
+	}
+
+	cfg := tab.Config
+
+	// The tab's scope resolved to nothing - almost always a config problem
+	if scope := tab.EmptyScope; scope != nil {
+		switch scope.Mode {
+		case "teams":
+			teams := plural(len(scope.Sources), "Team", "Teams") + " " + strings.Join(scope.Sources, ", ")
+			return fmt.Sprintf("%s resolved to 0 repos — check config", teams),
+				fmt.Sprintf("Check the team slugs and that your token can read %s's teams", cfg.Organization)
+		case "topics":
+			topics := plural(len(scope.Sources), "Topic", "Topics") + " " + strings.Join(scope.Sources, ", ")
+			return fmt.Sprintf("%s matched 0 repos in %s — check config", topics, cfg.TopicOrg),
+				"Check the topic names and topic_org in your config"
+		case "organization":
+			return fmt.Sprintf("Organization %s has no repos updated in the last 60 days", cfg.Organization),
+				"Check the organization name, or use repos mode for less active repos"
+		}
+	}
+
+	var message string
+	switch cfg.Mode {
+	case "organization":
+		message = fmt.Sprintf("No open PRs in organization %s", cfg.Organization)
+	case "teams":
+		message = fmt.Sprintf("No open PRs in repos of %s %s",
+			plural(len(cfg.Teams), "team", "teams"), strings.Join(cfg.Teams, ", "))
+	case "search":
+		message = fmt.Sprintf("No open PRs match search %q", cfg.SearchQuery)
+	case "topics":
+		message = fmt.Sprintf("No open PRs in repos tagged %s", strings.Join(cfg.Topics, ", "))
+	default:
+		switch len(cfg.Repos) {
+		case 0:
+			return "No repos configured for this tab", "Add repos to this tab in your config file"
+		case 1:
+			message = fmt.Sprintf("No open PRs in %s", cfg.Repos[0])
+		default:
+			message = fmt.Sprintf("No open PRs in these %d repos", len(cfg.Repos))
+		}
+	}
+
+	// A milestone or board narrows the scope further
+	switch {
+	case cfg.Project != "" && cfg.ProjectStatus != "":
+		return message + fmt.Sprintf(" in %s: %s", cfg.Project, cfg.ProjectStatus), "Check the project and project_status in your config • r to refresh"
+	case cfg.Project != "":
+		return message + " on board " + cfg.Project, "Check the project title in your config • r to refresh"
+	case strings.EqualFold(cfg.Milestone, noMilestone):
+		return message + " without a milestone", "Press r to refresh"
+	case cfg.Milestone != "":
+		return message + " in milestone " + cfg.Milestone, "Check the milestone title in your config • r to refresh"
+	}
+
+	// Config exclusions can make a busy scope look empty
+	if !cfg.IncludeDrafts || len(cfg.ExcludeAuthors) > 0 || len(cfg.ExcludeTitles) > 0 {
+		return message, "Some PRs may be hidden by include_drafts/exclude_* settings • r to refresh"
+	}
+	return message, "Press r to refresh"
+}
+
+// nullStateView renders the guidance shown in place of an empty table
+func nullStateView(tab *TabState) string {
+	message, hint := nullStateGuidance(tab)
+	return "\n" + titleStyle.Render("🧭 "+message) + "\n\n" + mutedStyle.Render("💡 "+hint) + "\n"
+}
+
+// plural picks the singular or plural form of a word for a count
+func plural(count int, singular, pluralForm string) string {
+	if count == 1 {
+		return singular
+	}
+	return pluralForm
+}
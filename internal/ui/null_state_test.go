@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestNullStateGuidance verifies empty-table guidance is derived from fetch and filter state
+func TestNullStateGuidance(t *testing.T) {
+	somePRs := []*gh.PullRequest{{Number: gh.Int(1)}}
+
+	tests := []struct {
+		name            string
+		tab             *TabState
+		expectedMessage string
+		expectedHint    string
+	}{
+		{
+			name: "text filter hides everything",
+			tab: &TabState{
+				Config:        &TabConfig{Mode: "repos", Repos: []string{"org/a"}, IncludeDrafts: true},
+				PRs:           somePRs,
+				AppliedFilter: "author=bob",
+			},
+			expectedMessage: "No PRs match filter author=bob",
+			expectedHint:    "Press c to clear",
+		},
+		{
+			name: "toggle filter hides everything",
+			tab: &TabState{
+				Config:      &TabConfig{Mode: "repos", Repos: []string{"org/a"}, IncludeDrafts: true},
+				PRs:         somePRs,
+				FilterMode:  "draft",
+				FilterValue: "true",
+			},
+			expectedMessage: "No PRs match filter draft=true",
+			expectedHint:    "Press c to clear",
+		},
+		{
+			name: "repos with no open PRs",
+			tab: &TabState{
+				Config: &TabConfig{Mode: "repos", Repos: make([]string, 12), IncludeDrafts: true},
+			},
+			expectedMessage: "No open PRs in these 12 repos",
+			expectedHint:    "Press r to refresh",
+		},
+		{
+			name: "exclusions may hide PRs",
+			tab: &TabState{
+				Config: &TabConfig{Mode: "search", SearchQuery: "is:pr label:infra", ExcludeTitles: []string{"chore"}},
+			},
+			expectedMessage: `No open PRs match search "is:pr label:infra"`,
+			expectedHint:    "exclude_*",
+		},
+		{
+			name: "team resolved to no repos",
+			tab: &TabState{
+				Config:     &TabConfig{Mode: "teams", Organization: "acme", Teams: []string{"platfrom"}},
+				EmptyScope: &github.NoRepositoriesError{Mode: "teams", Sources: []string{"platfrom"}},
+			},
+			expectedMessage: "Team platfrom resolved to 0 repos — check config",
+			expectedHint:    "acme",
+		},
+		{
+			name: "no repos configured",
+			tab: &TabState{
+				Config: &TabConfig{Mode: "repos"},
+			},
+			expectedMessage: "No repos configured for this tab",
+			expectedHint:    "Add repos",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, hint := nullStateGuidance(tt.tab)
+			if message != tt.expectedMessage {
+				t.Errorf("Expected message %q, got %q", tt.expectedMessage, message)
+			}
+			if !strings.Contains(hint, tt.expectedHint) {
+				t.Errorf("Expected hint containing %q, got %q", tt.expectedHint, hint)
+			}
+		})
+	}
+}
+
+// TestEmptyScopeShownAsGuidance verifies an empty team scope doesn't put the tab in an error state
+func TestEmptyScopeShownAsGuidance(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Platform", Mode: "teams", Organization: "acme", Teams: []string{"platfrom"}})
+
+	model.Update(tabPrsMsg{
+		tabName: "Platform",
+		err:     &github.NoRepositoriesError{Mode: "teams", Sources: []string{"platfrom"}},
+	})
+
+	if tab.Error != nil {
+		t.Errorf("Expected no error state, got %v", tab.Error)
+	}
+	if view := model.renderActiveTabContent(tab); !strings.Contains(view, "resolved to 0 repos") {
+		t.Error("Expected guidance in place of the empty table")
+	}
+}
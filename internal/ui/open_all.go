@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// openAllLimit caps how many browser tabs one open-all starts, so a large
+// unfiltered list doesn't flood the browser
+const openAllLimit = 10
+
+// confirmOpenAll asks before opening the tab's listed PRs in the browser,
+// in table order and up to openAllLimit of them
+func (m *MultiTabModel) confirmOpenAll(tab *TabState) {
+	var urls []string
+	for _, pr := range tab.FilteredPRs {
+		if url := pr.GetHTMLURL(); url != "" {
+			urls = append(urls, url)
+		}
+	}
+	if len(urls) == 0 {
+		tab.StatusMsg = "No PRs to open"
+		return
+	}
+
+	prompt := fmt.Sprintf("Open %d PRs in the browser?", len(urls))
+	if len(urls) > openAllLimit {
+		prompt = fmt.Sprintf("Open the first %d of %d PRs in the browser? Filter to narrow the list.", openAllLimit, len(urls))
+		urls = urls[:openAllLimit]
+	}
+
+	cmds := make([]tea.Cmd, 0, len(urls))
+	for _, url := range urls {
+		cmds = append(cmds, openURLCmd(url))
+	}
+	m.pendingConfirm = &confirmPrompt{
+		prompt:    prompt,
+		apply:     func() { tab.StatusMsg = fmt.Sprintf("Opening %d PRs", len(urls)) },
+		onConfirm: tea.Batch(cmds...),
+	}
+	tab.StatusMsg = m.pendingConfirm.prompt + " (y/n)"
+}
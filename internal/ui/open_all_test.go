@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestOpenAll tests opening the listed PRs after confirming, up to the cap
+func TestOpenAll(t *testing.T) {
+	model, tab := presetTestModel(t)
+	press := func(msg tea.KeyMsg) tea.Cmd {
+		_, cmd := model.Update(msg)
+		return cmd
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyCtrlO})
+	if model.pendingConfirm != nil || tab.StatusMsg != "No PRs to open" {
+		t.Fatalf("Expected nothing to open without URLs, got %q", tab.StatusMsg)
+	}
+
+	tab.FilteredPRs = nil
+	for i := 1; i <= openAllLimit+2; i++ {
+		tab.FilteredPRs = append(tab.FilteredPRs, &gh.PullRequest{Number: gh.Int(i), HTMLURL: gh.String(fmt.Sprintf("https://github.com/org/api/pull/%d", i))})
+	}
+	press(tea.KeyMsg{Type: tea.KeyCtrlO})
+	if model.pendingConfirm == nil || !strings.Contains(tab.StatusMsg, "first 10 of 12") {
+		t.Fatalf("Expected a capped confirmation, got %q", tab.StatusMsg)
+	}
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if tab.StatusMsg != "Cancelled" {
+		t.Errorf("Expected declining to cancel, got %q", tab.StatusMsg)
+	}
+
+	// The commands aren't run, which would open a browser
+	tab.FilteredPRs = tab.FilteredPRs[:5]
+	press(tea.KeyMsg{Type: tea.KeyCtrlO})
+	if cmd := press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")}); cmd == nil || tab.StatusMsg != "Opening 5 PRs" {
+		t.Errorf("Expected 5 PRs to open, got %q", tab.StatusMsg)
+	}
+}
@@ -0,0 +1,186 @@
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// openWithFields are the PR fields an open_with command can use, e.g.
+// "gh pr view {{.Number}} --repo {{.Repo}} --web"
+type openWithFields struct {
+	PR     string // "owner/name#123"
+	URL    string // The PR's web page
+	Number int
+	Repo   string // "owner/name"
+	Owner  string
+	Name   string
+	Branch string // Head branch
+	Base   string // Base branch
+	Title  string
+	Author string
+}
+
+// newOpenWithFields fills the open_with fields from a PR
+func newOpenWithFields(pr *gh.PullRequest) openWithFields {
+	repo := repoFullName(pr)
+	owner, name, _ := strings.Cut(repo, "/")
+	return openWithFields{
+		PR:     services.PRKey(pr),
+		URL:    pr.GetHTMLURL(),
+		Number: pr.GetNumber(),
+		Repo:   repo,
+		Owner:  owner,
+		Name:   name,
+		Branch: pr.GetHead().GetRef(),
+		Base:   pr.GetBase().GetRef(),
+		Title:  pr.GetTitle(),
+		Author: pr.GetUser().GetLogin(),
+	}
+}
+
+// splitCommand splits an open_with command into arguments on spaces outside
+// quotes and {{ }} actions. Quotes group words and are dropped.
+func splitCommand(command string) ([]string, error) {
+	var args []string
+	var arg strings.Builder
+	inArg, actions := false, 0
+	var quote rune
+	runes := []rune(command)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case actions == 0 && quote == 0 && (r == '"' || r == '\''):
+			quote, inArg = r, true
+			continue
+		case quote != 0 && r == quote && actions == 0:
+			quote = 0
+			continue
+		case actions == 0 && quote == 0 && unicode.IsSpace(r):
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+			continue
+		case r == '{' && i+1 < len(runes) && runes[i+1] == '{':
+			actions++
+			arg.WriteString("{{")
+			i++
+			inArg = true
+			continue
+		case r == '}' && actions > 0 && i+1 < len(runes) && runes[i+1] == '}':
+			actions--
+			arg.WriteString("}}")
+			i++
+			continue
+		}
+		arg.WriteRune(r)
+		inArg = true
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if actions > 0 {
+		return nil, fmt.Errorf("unclosed {{ action")
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
+
+// openWithArgs renders the arguments of an open_with command for a PR. Each
+// argument is filled in on its own, so a title with spaces stays one
+// argument, and no shell is involved.
+func openWithArgs(command string, pr *gh.PullRequest) ([]string, error) {
+	parts, err := splitCommand(command)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("no command given")
+	}
+
+	fields := newOpenWithFields(pr)
+	args := make([]string, len(parts))
+	for i, part := range parts {
+		tmpl, err := template.New("open_with").Option("missingkey=error").Parse(part)
+		if err != nil {
+			return nil, err
+		}
+		var rendered bytes.Buffer
+		if err := tmpl.Execute(&rendered, fields); err != nil {
+			return nil, err
+		}
+		args[i] = rendered.String()
+	}
+	return args, nil
+}
+
+// validateOpenWith checks an open_with command by filling it in for a
+// sample PR, catching unknown fields as well as syntax errors
+func validateOpenWith(command string) error {
+	if command == "" {
+		return nil
+	}
+	sample := &gh.PullRequest{
+		Number:  gh.Int(1),
+		HTMLURL: gh.String("https://github.com/owner/name/pull/1"),
+		Title:   gh.String("Sample"),
+		User:    &gh.User{Login: gh.String("octocat")},
+		Head:    &gh.PullRequestBranch{Ref: gh.String("feature")},
+		Base:    &gh.PullRequestBranch{Ref: gh.String("main"), Repo: &gh.Repository{FullName: gh.String("owner/name")}},
+	}
+	if _, err := openWithArgs(command, sample); err != nil {
+		return fmt.Errorf("open_with: %w", err)
+	}
+	return nil
+}
+
+// openWithDoneMsg reports how an open_with command went for a tab
+type openWithDoneMsg struct {
+	tabName string
+	pr      string
+	program string
+	err     error
+}
+
+// openWithCmd runs the open_with command for a PR. PR Compass hands the
+// terminal over until it exits, so terminal editors work as well as GUI
+// ones and gh.
+func (m *MultiTabModel) openWithCmd(tab *TabState, pr *gh.PullRequest) tea.Cmd {
+	args, err := openWithArgs(m.OpenWith, pr)
+	if err != nil {
+		tab.StatusMsg = fmt.Sprintf("❌ open_with: %v", err)
+		return nil
+	}
+	tabName, key := tab.Config.Name, services.PRKey(pr)
+	m.Log.Info("Opening PR with command", "pr", key, "program", args[0])
+	return tea.ExecProcess(exec.Command(args[0], args[1:]...), func(err error) tea.Msg { // #nosec G204 - the user's own configured command
+		return openWithDoneMsg{tabName: tabName, pr: key, program: args[0], err: err}
+	})
+}
+
+// handleOpenWithDone shows how the open_with command went
+func (m *MultiTabModel) handleOpenWithDone(msg openWithDoneMsg) (tea.Model, tea.Cmd) {
+	for _, tab := range m.TabManager.Tabs {
+		if tab.Config.Name != msg.tabName {
+			continue
+		}
+		if msg.err != nil {
+			tab.StatusMsg = fmt.Sprintf("❌ %s failed for %s: %v", msg.program, msg.pr, msg.err)
+			m.Log.Warn("open_with command failed", "pr", msg.pr, "program", msg.program, "err", msg.err)
+		} else {
+			tab.StatusMsg = fmt.Sprintf("Opened %s with %s", msg.pr, msg.program)
+		}
+	}
+	return m, nil
+}
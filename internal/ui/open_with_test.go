@@ -0,0 +1,83 @@
+package ui
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestSplitCommand tests splitting open_with commands on spaces outside
+// quotes and template actions
+func TestSplitCommand(t *testing.T) {
+	tests := []struct {
+		command  string
+		expected []string
+	}{
+		{"code  --goto {{.Name}}", []string{"code", "--goto", "{{.Name}}"}},
+		{`gh pr view {{printf "%d" .Number}} --web`, []string{"gh", "pr", "view", `{{printf "%d" .Number}}`, "--web"}},
+		{`sh -c 'cd ~/src/{{.Name}} && make'`, []string{"sh", "-c", "cd ~/src/{{.Name}} && make"}},
+		{`echo ""`, []string{"echo", ""}},
+	}
+	for _, tt := range tests {
+		if args, err := splitCommand(tt.command); err != nil || !reflect.DeepEqual(args, tt.expected) {
+			t.Errorf("splitCommand(%q) = %q, %v; want %q", tt.command, args, err, tt.expected)
+		}
+	}
+
+	for _, command := range []string{`code "unterminated`, "code {{.Name"} {
+		if _, err := splitCommand(command); err == nil {
+			t.Errorf("Expected splitCommand(%q) to fail", command)
+		}
+	}
+}
+
+// TestOpenWithArgs tests filling PR fields into each argument on its own
+func TestOpenWithArgs(t *testing.T) {
+	pr := labeledPR(42)
+	pr.Title = gh.String("Fix the build; rm -rf /")
+	pr.Base = &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/api")}}
+
+	args, err := openWithArgs("notify {{.Repo}} {{.Owner}}/{{.Name}}#{{.Number}} {{.Title}}", pr)
+	expected := []string{"notify", "org/api", "org/api#42", "Fix the build; rm -rf /"}
+	if err != nil || !reflect.DeepEqual(args, expected) {
+		t.Errorf("openWithArgs() = %q, %v; want %q", args, err, expected)
+	}
+}
+
+// TestValidateOpenWith tests that bad templates and unknown fields are caught at load
+func TestValidateOpenWith(t *testing.T) {
+	if err := validateOpenWith(""); err != nil {
+		t.Errorf("Expected no command to be valid, got %v", err)
+	}
+	if err := validateOpenWith("gh pr view {{.Number}} --repo {{.Repo}} --web"); err != nil {
+		t.Errorf("Expected a valid command, got %v", err)
+	}
+	for _, command := range []string{"code {{.Path}}", "code {{.Name", "   ", `code 'x`} {
+		if err := validateOpenWith(command); err == nil || !strings.HasPrefix(err.Error(), "open_with:") {
+			t.Errorf("Expected validateOpenWith(%q) to fail, got %v", command, err)
+		}
+	}
+}
+
+// TestOpenWithDone tests reporting how the command went in the tab
+func TestOpenWithDone(t *testing.T) {
+	model, tab := mergeTestModel("test-token")
+
+	model.handleOpenWithDone(openWithDoneMsg{tabName: "Main", pr: "org/api#12", program: "code"})
+	if tab.StatusMsg != "Opened org/api#12 with code" {
+		t.Errorf("Unexpected status %q", tab.StatusMsg)
+	}
+	model.handleOpenWithDone(openWithDoneMsg{tabName: "Main", pr: "org/api#12", program: "code", err: errors.New("exit status 1")})
+	if !strings.Contains(tab.StatusMsg, "code failed for org/api#12: exit status 1") {
+		t.Errorf("Expected the failure shown, got %q", tab.StatusMsg)
+	}
+
+	// A command failing to render is reported without running anything
+	model.OpenWith = "code {{.Name"
+	if cmd := model.openWithCmd(tab, tab.PRs[0]); cmd != nil || !strings.HasPrefix(tab.StatusMsg, "❌ open_with:") {
+		t.Errorf("Expected the command refused, got %q", tab.StatusMsg)
+	}
+}
@@ -0,0 +1,95 @@
+package ui
+
+import "fmt"
+
+// Palette names accepted by the palette config setting
+const (
+	paletteDefault    = "default"
+	paletteColorBlind = "colorblind"
+)
+
+// statusTheme holds the colors and icons that tell passing from failing
+// states apart
+type statusTheme struct {
+	// Colors
+	Success string
+	Warning string
+	Error   string
+	Info    string
+	Accent  string
+
+	// Icons, each followed by a text label wherever they're shown
+	Passed    string
+	Failed    string
+	Attention string
+	Running   string
+	Skipped   string
+	Unknown   string
+
+	// TypeColors prefixes the Type column with a colored circle
+	TypeColors bool
+}
+
+// defaultTheme leans on green and red; its colors come from the color theme
+var defaultTheme = statusTheme{
+	Success: darkColors.Success,
+	Warning: darkColors.Warning,
+	Error:   darkColors.Error,
+	Info:    darkColors.Info,
+	Accent:  darkColors.Accent,
+
+	Passed:    "✅",
+	Failed:    "❌",
+	Attention: "⚠️",
+	Running:   "🔄",
+	Skipped:   "⚪",
+	Unknown:   "❓",
+
+	TypeColors: true,
+}
+
+// colorBlindTheme avoids red/green distinctions for deuteranopia and
+// protanopia: colors come from the Okabe-Ito set (blue for good, vermillion
+// for bad, which differ in brightness too) and states are told apart by
+// shape and text rather than emoji color
+var colorBlindTheme = statusTheme{
+	Success: "#56B4E9", // Sky blue
+	Warning: "#F0E442", // Yellow
+	Error:   "#D55E00", // Vermillion
+	Info:    "#0072B2", // Blue
+	Accent:  "#56B4E9",
+
+	Passed:    "[✓]",
+	Failed:    "[✗]",
+	Attention: "[!]",
+	Running:   "[~]",
+	Skipped:   "[-]",
+	Unknown:   "[?]",
+
+	TypeColors: false,
+}
+
+// theme is the active status theme
+var theme = defaultTheme
+
+// validatePalette checks the configured palette name
+func validatePalette(name string) error {
+	switch name {
+	case "", paletteDefault, paletteColorBlind:
+		return nil
+	}
+	return fmt.Errorf("palette must be %q or %q, got %q", paletteDefault, paletteColorBlind, name)
+}
+
+// applyPalette switches the status colors and icons, taking the default
+// palette's colors from the active color theme, and restyles the shared
+// styles. Unknown names fall back to the default.
+func applyPalette(name string) {
+	theme = defaultTheme
+	theme.Success, theme.Warning, theme.Error = colors.Success, colors.Warning, colors.Error
+	theme.Info, theme.Accent = colors.Info, colors.Accent
+	if name == paletteColorBlind {
+		theme = colorBlindTheme
+	}
+	restyle()
+}
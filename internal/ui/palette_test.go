@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestColorBlindPalette tests that the color-blind palette swaps colored
+// emoji for shapes with text and drops red/green colors
+func TestColorBlindPalette(t *testing.T) {
+	applyPalette(paletteColorBlind)
+	defer applyPalette(paletteDefault)
+
+	pr := &gh.PullRequest{Number: gh.Int(1), Title: gh.String("fix: handle nil config")}
+	enhanced := map[int]types.EnhancedData{1: {Mergeable: "clean", ChecksStatus: "failure", ReviewStatus: "approved"}}
+	row := createTableRowsWithEnhancement([]*gh.PullRequest{pr}, enhanced)[0]
+
+	if row[1] != "fix" {
+		t.Errorf("Expected Type column without a colored circle, got %q", row[1])
+	}
+	if row[4] != "[✗] Failed Checks [✗] CI" {
+		t.Errorf("Expected shape-coded status, got %q", row[4])
+	}
+	if row[5] != "[✓] Approved" {
+		t.Errorf("Expected shape-coded review, got %q", row[5])
+	}
+	for _, cell := range row {
+		if strings.ContainsAny(cell, "✅❌🔴🟢") {
+			t.Errorf("Expected no color-coded emoji, got %q", cell)
+		}
+	}
+	if theme.Success == darkColors.Success || theme.Error == darkColors.Error {
+		t.Error("Expected the palette to replace red/green status colors")
+	}
+
+	applyPalette(paletteDefault)
+	row = createTableRowsWithEnhancement([]*gh.PullRequest{pr}, enhanced)[0]
+	if row[4] != "❌ Failed Checks ❌ CI" || row[1] != "🔴 fix" {
+		t.Errorf("Expected the default palette restored, got %q and %q", row[4], row[1])
+	}
+}
+
+// TestValidatePalette tests the palette config setting
+func TestValidatePalette(t *testing.T) {
+	for _, name := range []string{"", "default", "colorblind"} {
+		if err := validatePalette(name); err != nil {
+			t.Errorf("validatePalette(%q) error = %v", name, err)
+		}
+	}
+	if err := validatePalette("neon"); err == nil {
+		t.Error("Expected unknown palette to be rejected")
+	}
+}
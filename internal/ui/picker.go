@@ -0,0 +1,110 @@
+package ui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// multiPickerRows is how many options a picker overlay shows at once
+const multiPickerRows = 10
+
+// multiPicker is a filterable multi-select list shared by the picker
+// overlays. The overlay owning it handles enter and esc.
+type multiPicker struct {
+	options  []string
+	query    string
+	cursor   int // Index into matches()
+	selected map[string]bool
+}
+
+// newMultiPicker returns a picker over options with the given ones selected
+func newMultiPicker(options, selected []string) multiPicker {
+	picker := multiPicker{options: options, selected: make(map[string]bool, len(selected))}
+	for _, option := range selected {
+		picker.selected[option] = true
+	}
+	return picker
+}
+
+// matches returns the options containing the query, case-insensitively
+func (p *multiPicker) matches() []string {
+	query := strings.ToLower(p.query)
+	var matches []string
+	for _, option := range p.options {
+		if strings.Contains(strings.ToLower(option), query) {
+			matches = append(matches, option)
+		}
+	}
+	return matches
+}
+
+// chosen returns the selected options in option order
+func (p *multiPicker) chosen() []string {
+	var chosen []string
+	for _, option := range p.options {
+		if p.selected[option] {
+			chosen = append(chosen, option)
+		}
+	}
+	return chosen
+}
+
+// handleKey filters, moves through or toggles the options
+func (p *multiPicker) handleKey(msg tea.KeyMsg) {
+	matches := p.matches()
+
+	switch msg.Type {
+	case tea.KeyUp, tea.KeyCtrlP:
+		if p.cursor > 0 {
+			p.cursor--
+		}
+	case tea.KeyDown, tea.KeyCtrlN:
+		if p.cursor < len(matches)-1 {
+			p.cursor++
+		}
+	case tea.KeySpace:
+		if len(matches) > 0 {
+			option := matches[p.cursor]
+			p.selected[option] = !p.selected[option]
+		}
+	case tea.KeyBackspace:
+		if runes := []rune(p.query); len(runes) > 0 {
+			p.query = string(runes[:len(runes)-1])
+			p.cursor = 0
+		}
+	case tea.KeyCtrlU:
+		p.query = ""
+		p.cursor = 0
+	case tea.KeyRunes:
+		p.query += string(msg.Runes)
+		p.cursor = 0
+	}
+}
+
+// renderOptions renders the matching options around the cursor with their
+// checkboxes
+func (p *multiPicker) renderOptions() []string {
+	matches := p.matches()
+	if len(matches) == 0 {
+		return []string{mutedStyle.Render("  No matches")}
+	}
+
+	// Keep the cursor in view
+	first := 0
+	if p.cursor >= multiPickerRows {
+		first = p.cursor - multiPickerRows + 1
+	}
+	var lines []string
+	for i := first; i < len(matches) && i < first+multiPickerRows; i++ {
+		pointer, check := "  ", "[ ]"
+		if i == p.cursor {
+			pointer = "▸ "
+		}
+		if p.selected[matches[i]] {
+			check = "[x]"
+		}
+		lines = append(lines, pointer+check+" "+matches[i])
+	}
+	return lines
+}
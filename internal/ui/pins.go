@@ -0,0 +1,219 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// pinMarker prefixes pinned PRs
+const pinMarker = "⭐"
+
+// alertPinned is the terminal alert event for a change to a pinned PR
+const alertPinned = "pinned"
+
+// PinStore keeps the PRs the user pinned, keyed by PR ("owner/repo#123")
+// with when they were pinned, persisted locally across restarts
+type PinStore struct {
+	mu   sync.Mutex
+	path string // Empty path keeps pins in memory only
+	pins map[string]time.Time
+}
+
+// NewPinStore creates a pin store backed by the given file. A missing or
+// unreadable file starts with no pins.
+func NewPinStore(path string) *PinStore {
+	store := &PinStore{
+		path: path,
+		pins: make(map[string]time.Time),
+	}
+
+	if path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			var saved map[string]time.Time
+			if json.Unmarshal(data, &saved) == nil && saved != nil {
+				store.pins = saved
+			}
+		}
+	}
+
+	return store
+}
+
+// Pinned reports whether a PR is pinned
+func (s *PinStore) Pinned(pr *gh.PullRequest) bool {
+	if s == nil || pr == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, pinned := s.pins[services.PRKey(pr)]
+	return pinned
+}
+
+// Toggle pins a PR or unpins it, returning whether it is pinned now
+func (s *PinStore) Toggle(pr *gh.PullRequest) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := services.PRKey(pr)
+	_, pinned := s.pins[key]
+	if pinned {
+		delete(s.pins, key)
+	} else {
+		s.pins[key] = time.Now()
+	}
+	return !pinned, s.save()
+}
+
+// Unpin removes a PR's pin, if any
+func (s *PinStore) Unpin(pr *gh.PullRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := services.PRKey(pr)
+	if _, pinned := s.pins[key]; !pinned {
+		return nil
+	}
+	delete(s.pins, key)
+	return s.save()
+}
+
+// save writes pins to disk; the caller must hold the lock
+func (s *PinStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(s.pins, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode pins: %w", err)
+	}
+	if err := cache.WriteFile(s.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to save pins: %w", err)
+	}
+	return nil
+}
+
+// getPinsFilePath returns the path pinned PRs are saved to
+func getPinsFilePath() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/.prcompass_pins.json", homeDir)
+}
+
+// pinnedFirst moves pinned PRs to the front, keeping the order within
+// pinned and unpinned PRs
+func pinnedFirst(prs []*gh.PullRequest, pins *PinStore) []*gh.PullRequest {
+	sorted := make([]*gh.PullRequest, len(prs))
+	copy(sorted, prs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return pins.Pinned(sorted[i]) && !pins.Pinned(sorted[j])
+	})
+	return sorted
+}
+
+// togglePin pins the selected PR or unpins it. Pins are local, so this
+// works in read-only mode too.
+func (m *MultiTabModel) togglePin(tab *TabState) {
+	pr := tab.SelectedPR()
+	if pr == nil {
+		tab.StatusMsg = "No PR selected"
+		return
+	}
+	if isHistoryPR(pr) {
+		tab.StatusMsg = "Merged and closed PRs won't change, so there's nothing to pin"
+		return
+	}
+
+	pinned, err := m.TabManager.Pins.Toggle(pr)
+	switch {
+	case err != nil:
+		tab.StatusMsg = fmt.Sprintf("Pin not saved: %v", err)
+	case pinned:
+		tab.StatusMsg = fmt.Sprintf("%s Pinned %s - sorted first, changes alert you", pinMarker, services.PRKey(pr))
+	default:
+		tab.StatusMsg = fmt.Sprintf("Unpinned %s", services.PRKey(pr))
+	}
+	m.resortTab(tab)
+}
+
+// pinChange describes what changed on a pinned PR between two enhancements,
+// e.g. "✅ approved by @maria, ❌ checks failed", or "" if nothing notable did
+func pinChange(before, after types.EnhancedData) string {
+	var changes []string
+	if change := reviewChange(before, after); change != "" {
+		changes = append(changes, change)
+	}
+	if before.ChecksStatus != after.ChecksStatus {
+		switch after.ChecksStatus {
+		case "failure":
+			changes = append(changes, theme.Failed+" checks failed")
+		case "success":
+			changes = append(changes, theme.Passed+" checks passed")
+		}
+	}
+	if after.Mergeable == "conflicts" && before.Mergeable != "conflicts" {
+		changes = append(changes, theme.Attention+" has conflicts")
+	}
+	return strings.Join(changes, ", ")
+}
+
+// pinChangeCmd notifies about a change to a pinned PR on the desktop and in
+// the terminal alerts
+func (m *MultiTabModel) pinChangeCmd(pr *gh.PullRequest, change string) tea.Cmd {
+	if change == "" {
+		return nil
+	}
+	summary := fmt.Sprintf("%s %s %s", pinMarker, services.PRKey(pr), change)
+	m.Log.Info("Pinned PR changed", "pr", services.PRKey(pr), "change", change)
+
+	cmds := []tea.Cmd{notifyCmd(pinMarker+" "+services.PRKey(pr)+" "+pr.GetTitle(), change)}
+	if m.Alerts.Wants(alertPinned) {
+		cmds = append(cmds, m.alert(1, summary))
+	}
+	return tea.Batch(cmds...)
+}
+
+// pinnedEnhancementCmd notifies about a pinned PR's refreshed details
+func (m *MultiTabModel) pinnedEnhancementCmd(tab *TabState, before, after types.EnhancedData) tea.Cmd {
+	for _, pr := range tab.PRs {
+		if pr.GetNumber() == after.Number && m.TabManager.Pins.Pinned(pr) {
+			return m.pinChangeCmd(pr, pinChange(before, after))
+		}
+	}
+	return nil
+}
+
+// pinnedCompletedCmd notifies about pinned PRs that merged or closed, and
+// unpins them since they won't change anymore
+func (m *MultiTabModel) pinnedCompletedCmd(completed []CompletedPR) tea.Cmd {
+	var cmds []tea.Cmd
+	for _, entry := range completed {
+		if !m.TabManager.Pins.Pinned(entry.PR) {
+			continue
+		}
+		change := "closed"
+		if entry.PR.GetMerged() {
+			change = "merged"
+		}
+		if err := m.TabManager.Pins.Unpin(entry.PR); err != nil {
+			m.Log.Warn("Pin not removed", "pr", services.PRKey(entry.PR), "err", err)
+		}
+		cmds = append(cmds, m.pinChangeCmd(entry.PR, change+" and unpinned"))
+	}
+	return tea.Batch(cmds...)
+}
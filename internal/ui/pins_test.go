@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestPinStore tests that pins persist keyed by repo and number
+func TestPinStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pins.json")
+	store := NewPinStore(path)
+	if pinned, err := store.Toggle(labeledPR(7)); err != nil || !pinned {
+		t.Fatalf("Toggle() = %v, %v; want pinned", pinned, err)
+	}
+
+	reloaded := NewPinStore(path)
+	if !reloaded.Pinned(labeledPR(7)) || reloaded.Pinned(labeledPR(8)) {
+		t.Error("Expected only the pinned PR to be pinned after a restart")
+	}
+	if pinned, _ := reloaded.Toggle(labeledPR(7)); pinned || NewPinStore(path).Pinned(labeledPR(7)) {
+		t.Error("Expected toggling again to unpin the PR")
+	}
+}
+
+// TestPinnedPRs tests that pinned PRs sort first, are enhanced first and on
+// every listing, and describe their changes
+func TestPinnedPRs(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"org/api"}})
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: []*gh.PullRequest{labeledPR(1), labeledPR(2), labeledPR(3)}})
+
+	tab.Table.SetCursor(2)
+	model.togglePin(tab)
+	if tab.FilteredPRs[0].GetNumber() != 3 || tab.SelectedPR().GetNumber() != 3 {
+		t.Errorf("Expected the pinned PR first and still selected, got #%d first", tab.FilteredPRs[0].GetNumber())
+	}
+	if cell := tab.Table.Rows()[0][0]; cell[:len(pinMarker)] != pinMarker {
+		t.Errorf("Expected a pin badge, got %q", cell)
+	}
+
+	// Unchanged PRs enhanced since the listing are skipped, except pinned ones
+	for _, number := range []int{1, 2, 3} {
+		tab.EnhancedData[number] = types.EnhancedData{Number: number, EnhancedAt: time.Now()}
+		tab.EnhancedFor[number] = time.Time{}
+		delete(tab.EnhancementQueue, number)
+	}
+	tab.Progress = EnhancementProgress{}
+	tab.LastRefreshTime = time.Now().Add(time.Second)
+	model.startEnhancementForTab(tab)
+	if len(tab.EnhancementQueue) != 1 || !tab.EnhancementQueue[3] {
+		t.Errorf("Expected only the pinned PR to be enhanced again, got %v", tab.EnhancementQueue)
+	}
+
+	before := types.EnhancedData{ReviewStatus: "pending", ChecksStatus: "pending", Mergeable: "clean"}
+	after := types.EnhancedData{ReviewStatus: "pending", ChecksStatus: "failure", Mergeable: "conflicts"}
+	if change := pinChange(before, after); change != theme.Failed+" checks failed, "+theme.Attention+" has conflicts" {
+		t.Errorf("Unexpected change %q", change)
+	}
+	if change := pinChange(after, after); change != "" {
+		t.Errorf("Expected no change, got %q", change)
+	}
+	if cmd := model.pinnedEnhancementCmd(tab, before, types.EnhancedData{Number: 1, ChecksStatus: "failure"}); cmd != nil {
+		t.Error("Expected no notification for an unpinned PR")
+	}
+
+	merged := labeledPR(3)
+	merged.Merged = gh.Bool(true)
+	if cmd := model.pinnedCompletedCmd([]CompletedPR{{PR: merged}}); cmd == nil || model.TabManager.Pins.Pinned(merged) {
+		t.Error("Expected a merged pinned PR to notify and be unpinned")
+	}
+}
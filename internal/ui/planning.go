@@ -0,0 +1,316 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// noMilestone is the milestone filter value matching PRs without one, as
+// the milestone config option spells it
+const noMilestone = "none"
+
+// projectsLoadingStatus is shown while Y waits for a tab's Projects boards
+const projectsLoadingStatus = "Reading Projects boards…"
+
+// projectItemsMsg delivers the Projects boards of a tab's PRs
+type projectItemsMsg struct {
+	tabName string
+	keys    []string // PR keys, aligned with items
+	items   [][]github.ProjectItem
+	err     error
+}
+
+// withMilestoneColumn appends the Milestone column, taking its width from
+// the PR title column as far as its minimum allows
+func withMilestoneColumn(columns []table.Column, terminalWidth int) []table.Column {
+	width := max(10, (terminalWidth-12)*8/100)
+
+	result := make([]table.Column, len(columns), len(columns)+1)
+	copy(result, columns)
+	result[0].Width = max(24, result[0].Width-width-2) // Cell padding too
+	return append(result, table.Column{Title: "🎯 Milestone", Width: width})
+}
+
+// withProjectColumn appends the Project column, taking its width from the
+// PR title column as far as its minimum allows
+func withProjectColumn(columns []table.Column, terminalWidth int) []table.Column {
+	width := max(14, (terminalWidth-12)*11/100)
+
+	result := make([]table.Column, len(columns), len(columns)+1)
+	copy(result, columns)
+	result[0].Width = max(24, result[0].Width-width-2) // Cell padding too
+	return append(result, table.Column{Title: "📋 Project", Width: width})
+}
+
+// milestoneCell shows a PR's milestone for the table
+func milestoneCell(pr *gh.PullRequest) string {
+	if title := pr.GetMilestone().GetTitle(); title != "" {
+		return title
+	}
+	return "-"
+}
+
+// projectCell lists a PR's Projects boards with their Status column, e.g.
+// "Roadmap: In Review"
+func projectCell(pr *gh.PullRequest, opts tableRowOptions) string {
+	items, known := opts.ProjectItems[services.PRKey(pr)]
+	if !known {
+		if opts.ProjectLoading {
+			return "⏳"
+		}
+		return "-"
+	}
+	if len(items) == 0 {
+		return "-"
+	}
+	cards := make([]string, len(items))
+	for i, item := range items {
+		cards[i] = item.String()
+	}
+	return strings.Join(cards, ", ")
+}
+
+// milestonesByFrequency returns the milestones of the PRs, most common
+// first, followed by noMilestone if any PR has none
+func milestonesByFrequency(prs []*gh.PullRequest) []string {
+	counts := make(map[string]int)
+	var milestones []string
+	unplanned := false
+	for _, pr := range prs {
+		title := pr.GetMilestone().GetTitle()
+		if title == "" {
+			unplanned = true
+			continue
+		}
+		if counts[title] == 0 {
+			milestones = append(milestones, title)
+		}
+		counts[title]++
+	}
+
+	sort.SliceStable(milestones, func(i, j int) bool {
+		if counts[milestones[i]] != counts[milestones[j]] {
+			return counts[milestones[i]] > counts[milestones[j]]
+		}
+		return milestones[i] < milestones[j]
+	})
+	if unplanned && len(milestones) > 0 {
+		milestones = append(milestones, noMilestone)
+	}
+	return milestones
+}
+
+// pickMilestone offers the milestones present in the tab and filters to PRs
+// in the chosen one; with a milestone filter active it clears the filter instead
+func (m *MultiTabModel) pickMilestone(tab *TabState) {
+	if tab.FilterMode == "milestone" {
+		tab.FilterMode = ""
+		tab.FilterValue = ""
+		tab.FilteredPRs = tab.PRs
+		tab.StatusMsg = "Filter cleared"
+		m.updateTableRows(tab)
+		return
+	}
+
+	milestones := milestonesByFrequency(tab.PRs)
+	if len(milestones) == 0 {
+		tab.StatusMsg = "No milestones on PRs in this tab"
+		return
+	}
+
+	m.pendingChoice = &choicePrompt{
+		label:   "🎯 Filter by milestone:",
+		options: milestones,
+		onChoose: func(milestone string) tea.Cmd {
+			tab.FilterMode = "milestone"
+			tab.FilterValue = milestone
+			tab.FilteredPRs = m.applyFilter(tab.PRs, "milestone", milestone)
+			tab.StatusMsg = fmt.Sprintf("Milestone: %s (%d) - y to clear", milestone, len(tab.FilteredPRs))
+			m.updateTableRows(tab)
+			return nil
+		},
+	}
+	tab.StatusMsg = m.pendingChoice.status()
+}
+
+// projectCardsByFrequency returns the board columns the tab's PRs are in,
+// as "Board: Status", most common first
+func (m *MultiTabModel) projectCardsByFrequency(prs []*gh.PullRequest) []string {
+	counts := make(map[string]int)
+	var cards []string
+	for _, pr := range prs {
+		for _, item := range m.projectItems[services.PRKey(pr)] {
+			card := item.String()
+			if counts[card] == 0 {
+				cards = append(cards, card)
+			}
+			counts[card]++
+		}
+	}
+
+	sort.SliceStable(cards, func(i, j int) bool {
+		if counts[cards[i]] != counts[cards[j]] {
+			return counts[cards[i]] > counts[cards[j]]
+		}
+		return cards[i] < cards[j]
+	})
+	return cards
+}
+
+// filterPRsOnBoard keeps the PRs with a card in the board column, as
+// "Board: Status" or just the board for cards without a status
+func (m *MultiTabModel) filterPRsOnBoard(prs []*gh.PullRequest, card string) []*gh.PullRequest {
+	var filtered []*gh.PullRequest
+	for _, pr := range prs {
+		for _, item := range m.projectItems[services.PRKey(pr)] {
+			if strings.EqualFold(item.String(), card) {
+				filtered = append(filtered, pr)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// knowsProjectItems reports whether any of the tab's PRs has had its
+// Projects boards looked up
+func (m *MultiTabModel) knowsProjectItems(tab *TabState) bool {
+	for _, pr := range tab.PRs {
+		if _, known := m.projectItems[services.PRKey(pr)]; known {
+			return true
+		}
+	}
+	return false
+}
+
+// pickProjectStatus offers the board columns the tab's PRs are in and
+// filters to PRs in the chosen one, looking the boards up first if needed;
+// with a project filter active it clears the filter instead
+func (m *MultiTabModel) pickProjectStatus(tab *TabState) tea.Cmd {
+	if tab.FilterMode == "project" {
+		tab.FilterMode = ""
+		tab.FilterValue = ""
+		tab.FilteredPRs = tab.PRs
+		tab.StatusMsg = "Filter cleared"
+		m.updateTableRows(tab)
+		return nil
+	}
+	if !tab.Config.OnGitHub() {
+		tab.StatusMsg = "Projects boards are only on GitHub"
+		return nil
+	}
+	if m.readOnly() {
+		tab.StatusMsg = "Projects boards need a token"
+		return nil
+	}
+	if !m.knowsProjectItems(tab) {
+		tab.StatusMsg = projectsLoadingStatus
+		return m.fetchProjectItemsCmd(tab)
+	}
+
+	cards := m.projectCardsByFrequency(tab.PRs)
+	if len(cards) == 0 {
+		tab.StatusMsg = "No PRs in this tab are on a Projects board"
+		return nil
+	}
+
+	m.pendingChoice = &choicePrompt{
+		label:   "📋 Filter by board column:",
+		options: cards,
+		onChoose: func(card string) tea.Cmd {
+			tab.FilterMode = "project"
+			tab.FilterValue = card
+			tab.FilteredPRs = m.filterPRsOnBoard(tab.PRs, card)
+			tab.StatusMsg = fmt.Sprintf("Project: %s (%d) - Y to clear", card, len(tab.FilteredPRs))
+			m.updateTableRows(tab)
+			return nil
+		},
+	}
+	tab.StatusMsg = m.pendingChoice.status()
+	return nil
+}
+
+// projectItemsCmd refreshes the Projects boards of a GitHub tab's PRs when
+// the tab shows them or filters by them
+func (m *MultiTabModel) projectItemsCmd(tab *TabState) tea.Cmd {
+	if !tab.Config.ShowsProjectColumn() && tab.FilterMode != "project" {
+		return nil
+	}
+	if !tab.Config.OnGitHub() || m.readOnly() {
+		return nil
+	}
+	return m.fetchProjectItemsCmd(tab)
+}
+
+// fetchProjectItemsCmd looks up the boards of the tab's PRs, marking the
+// tab as loading, unless a lookup for it is already in flight
+func (m *MultiTabModel) fetchProjectItemsCmd(tab *TabState) tea.Cmd {
+	name := tab.Config.Name
+	if m.projectItemsLoading[name] || len(tab.PRs) == 0 {
+		return nil
+	}
+	m.projectItemsLoading[name] = true
+	if tab.Config.ShowsProjectColumn() {
+		m.updateTableRows(tab) // Unknown boards show as loading
+	}
+
+	prs := append([]*gh.PullRequest(nil), tab.PRs...)
+	keys := make([]string, len(prs))
+	for i, pr := range prs {
+		keys[i] = services.PRKey(pr)
+	}
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 30*time.Second)
+		defer cancel()
+
+		items, err := github.FetchProjectItems(ctx, token, prs)
+		return projectItemsMsg{tabName: name, keys: keys, items: items, err: err}
+	}
+}
+
+// handleProjectItems stores the boards of a tab's PRs and redraws the tab,
+// refiltering it and opening the board picker if Y was waiting for them
+func (m *MultiTabModel) handleProjectItems(msg projectItemsMsg) (tea.Model, tea.Cmd) {
+	delete(m.projectItemsLoading, msg.tabName)
+	var tab *TabState
+	for _, candidate := range m.TabManager.Tabs {
+		if candidate.Config.Name == msg.tabName {
+			tab = candidate
+			break
+		}
+	}
+
+	if msg.err != nil {
+		m.Log.Warn("Projects boards not read", "tab", msg.tabName, "err", msg.err)
+		if tab != nil {
+			tab.StatusMsg = fmt.Sprintf("%s Couldn't read Projects boards: %v", theme.Attention, msg.err)
+			m.updateTableRows(tab)
+		}
+		return m, nil
+	}
+	for i, key := range msg.keys {
+		m.projectItems[key] = append([]github.ProjectItem{}, msg.items[i]...)
+	}
+	if tab == nil || !tab.Loaded {
+		return m, nil
+	}
+
+	if tab.FilterMode == "project" {
+		tab.FilteredPRs = m.filterPRsOnBoard(tab.PRs, tab.FilterValue)
+	}
+	m.updateTableRows(tab)
+	if tab.StatusMsg == projectsLoadingStatus {
+		return m, m.pickProjectStatus(tab)
+	}
+	return m, nil
+}
@@ -0,0 +1,96 @@
+package ui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+func milestonePR(number int, milestone string) *gh.PullRequest {
+	pr := labeledPR(number)
+	if milestone != "" {
+		pr.Milestone = &gh.Milestone{Title: gh.String(milestone)}
+	}
+	return pr
+}
+
+// TestHotkeyMilestoneFilter tests picking a milestone with y, PRs without
+// one included, and clearing it again
+func TestHotkeyMilestoneFilter(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"org/api"}, MilestoneColumn: true})
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: []*gh.PullRequest{
+		milestonePR(1, "v2.4"), milestonePR(2, "v2.5"), milestonePR(3, "v2.4"), milestonePR(4, ""),
+	}})
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if model.pendingChoice == nil || !reflect.DeepEqual(model.pendingChoice.options, []string{"v2.4", "v2.5", "none"}) {
+		t.Fatalf("Expected a picker of v2.4, v2.5 and none, got %+v", model.pendingChoice)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+	if tab.FilterMode != "milestone" || len(tab.FilteredPRs) != 1 || tab.FilteredPRs[0].GetNumber() != 4 {
+		t.Errorf("Expected only the PR without a milestone, got %q with %d PRs", tab.FilterMode, len(tab.FilteredPRs))
+	}
+	if query, _ := searchQueryForTab(tab); !strings.Contains(query, "no:milestone") {
+		t.Errorf("Expected the search URL to keep the filter, got %q", query)
+	}
+	if row := tab.Table.Rows()[0]; row[len(row)-1] != "-" {
+		t.Errorf("Expected an empty Milestone cell, got %q", row[len(row)-1])
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if tab.FilterMode != "" || len(tab.FilteredPRs) != 4 {
+		t.Errorf("Expected y to clear the milestone filter, got %q with %d PRs", tab.FilterMode, len(tab.FilteredPRs))
+	}
+}
+
+// TestHotkeyProjectFilter tests Y looking up the boards first, then picking
+// a board column and showing the cards in the Project column
+func TestHotkeyProjectFilter(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"org/api"}, ProjectColumn: true})
+	prs := []*gh.PullRequest{milestonePR(1, ""), milestonePR(2, ""), milestonePR(3, "")}
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: prs})
+	if !model.projectItemsLoading["Test Tab"] {
+		t.Fatal("Expected the Project column to look the boards up")
+	}
+	if row := tab.Table.Rows()[0]; row[len(row)-1] != "⏳" {
+		t.Errorf("Expected a loading Project cell, got %q", row[len(row)-1])
+	}
+
+	// Y waits for the lookup in flight and opens the picker once it lands
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Y")})
+	if tab.StatusMsg != projectsLoadingStatus || model.pendingChoice != nil {
+		t.Fatalf("Expected Y to wait for the boards, got %q", tab.StatusMsg)
+	}
+	review := github.ProjectItem{Project: "Roadmap", Status: "In Review"}
+	model.Update(projectItemsMsg{
+		tabName: "Test Tab",
+		keys:    []string{services.PRKey(prs[0]), services.PRKey(prs[1]), services.PRKey(prs[2])},
+		items:   [][]github.ProjectItem{{review}, {review, {Project: "Triage"}}, nil},
+	})
+	if model.pendingChoice == nil || !reflect.DeepEqual(model.pendingChoice.options, []string{"Roadmap: In Review", "Triage"}) {
+		t.Fatalf("Expected a picker of the board columns, got %+v", model.pendingChoice)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	if tab.FilterMode != "project" || len(tab.FilteredPRs) != 2 {
+		t.Errorf("Expected the PRs in review, got %q with %d PRs", tab.FilterMode, len(tab.FilteredPRs))
+	}
+	if cell := projectCell(prs[1], model.rowOptions(tab)); cell != "Roadmap: In Review, Triage" {
+		t.Errorf("Unexpected Project cell %q", cell)
+	}
+	if cell := projectCell(prs[2], model.rowOptions(tab)); cell != "-" {
+		t.Errorf("Expected an empty Project cell off every board, got %q", cell)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Y")})
+	if tab.FilterMode != "" || len(tab.FilteredPRs) != 3 {
+		t.Errorf("Expected Y to clear the project filter, got %q with %d PRs", tab.FilterMode, len(tab.FilteredPRs))
+	}
+}
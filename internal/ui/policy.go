@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	"github.com/charmbracelet/bubbles/table"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// withPolicyColumn appends the Policy column, taking its width from the PR
+// title column as far as its minimum allows
+func withPolicyColumn(columns []table.Column, terminalWidth int) []table.Column {
+	width := max(12, (terminalWidth-12)*8/100)
+
+	result := make([]table.Column, len(columns), len(columns)+1)
+	copy(result, columns)
+	result[0].Width = max(24, result[0].Width-width-2) // Cell padding too
+	return append(result, table.Column{Title: "🛡️ Policy", Width: width})
+}
+
+// policyCell shows whether a PR satisfies its base branch's protection
+// rules, or what it still lacks. GitHub evaluates the rules; "-" means the
+// verdict isn't known, e.g. before enhancement or off GitHub.
+func policyCell(pr *gh.PullRequest, enhancedData map[int]types.EnhancedData) string {
+	enhanced, exists := enhancedData[pr.GetNumber()]
+	if !exists {
+		return "-"
+	}
+	switch enhanced.MergeState {
+	case "CLEAN", "HAS_HOOKS", "UNSTABLE":
+		// UNSTABLE only fails checks the rules don't require
+		return theme.Passed + " Met"
+	case "BLOCKED":
+		return policyBlocker(enhanced)
+	case "BEHIND":
+		return theme.Attention + " Behind"
+	case "DIRTY":
+		return theme.Attention + " Conflicts"
+	case "DRAFT":
+		return "📝 Draft"
+	default:
+		return "-" // UNKNOWN while GitHub computes it
+	}
+}
+
+// policyBlocker names the rule blocking a PR: missing approvals, a change
+// request, required checks, or another rule such as resolved conversations
+func policyBlocker(enhanced types.EnhancedData) string {
+	switch enhanced.ReviewDecision {
+	case "REVIEW_REQUIRED":
+		return theme.Running + " Approvals"
+	case "CHANGES_REQUESTED":
+		return theme.Failed + " Changes"
+	}
+	switch enhanced.ChecksStatus {
+	case "failure":
+		return theme.Failed + " Checks"
+	case "pending":
+		return theme.Running + " Checks"
+	}
+	return "🚫 Rules"
+}
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestPolicyCell tests the branch protection verdict and what blocks a PR
+func TestPolicyCell(t *testing.T) {
+	pr := &gh.PullRequest{Number: gh.Int(7)}
+	tests := []struct {
+		name     string
+		enhanced *types.EnhancedData
+		expected string
+	}{
+		{name: "not enhanced", expected: "-"},
+		{name: "rules met", enhanced: &types.EnhancedData{MergeState: "CLEAN"}, expected: theme.Passed + " Met"},
+		{name: "optional checks failing", enhanced: &types.EnhancedData{MergeState: "UNSTABLE", ChecksStatus: "failure"}, expected: theme.Passed + " Met"},
+		{name: "approvals missing", enhanced: &types.EnhancedData{MergeState: "BLOCKED", ReviewDecision: "REVIEW_REQUIRED"}, expected: theme.Running + " Approvals"},
+		{name: "changes requested", enhanced: &types.EnhancedData{MergeState: "BLOCKED", ReviewDecision: "CHANGES_REQUESTED"}, expected: theme.Failed + " Changes"},
+		{name: "required checks running", enhanced: &types.EnhancedData{MergeState: "BLOCKED", ReviewDecision: "APPROVED", ChecksStatus: "pending"}, expected: theme.Running + " Checks"},
+		{name: "another rule", enhanced: &types.EnhancedData{MergeState: "BLOCKED", ReviewDecision: "APPROVED", ChecksStatus: "success"}, expected: "🚫 Rules"},
+		{name: "must be up to date", enhanced: &types.EnhancedData{MergeState: "BEHIND"}, expected: theme.Attention + " Behind"},
+		{name: "not known yet", enhanced: &types.EnhancedData{MergeState: "UNKNOWN"}, expected: "-"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enhancedData := map[int]types.EnhancedData{}
+			if tt.enhanced != nil {
+				enhancedData[7] = *tt.enhanced
+			}
+			if got := policyCell(pr, enhancedData); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestReadyMeansPolicyMet tests that a mergeable PR blocked by branch
+// protection isn't shown as Ready
+func TestReadyMeansPolicyMet(t *testing.T) {
+	pr := &gh.PullRequest{Number: gh.Int(7)}
+	clean := map[int]types.EnhancedData{7: {Mergeable: "clean", ChecksStatus: "success", MergeState: "CLEAN"}}
+	if got := getPRStatusIndicatorEnhanced(pr, clean); got != theme.Passed+" Ready" {
+		t.Errorf("Expected a PR meeting the rules to be Ready, got %q", got)
+	}
+	blocked := map[int]types.EnhancedData{7: {Mergeable: "clean", ChecksStatus: "success", MergeState: "BLOCKED", ReviewDecision: "REVIEW_REQUIRED"}}
+	if got := getPRStatusIndicatorEnhanced(pr, blocked); got != "🚫 Blocked" {
+		t.Errorf("Expected a PR lacking approvals to be Blocked, got %q", got)
+	}
+
+	rows := createTableRowsWithOptions([]*gh.PullRequest{pr}, blocked, tableRowOptions{PolicyColumn: true})
+	if cells := rows[0]; cells[len(cells)-1] != theme.Running+" Approvals" {
+		t.Errorf("Expected the Policy column last, got %q", cells)
+	}
+}
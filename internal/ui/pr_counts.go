@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// truncatedReposShown caps how many repos the truncation banner names
+const truncatedReposShown = 3
+
+// shownPerRepo counts a tab's PRs per repository, keyed in lower case since
+// configured repo names needn't match GitHub's casing
+func shownPerRepo(prs []*gh.PullRequest) map[string]int {
+	shown := make(map[string]int)
+	for _, pr := range prs {
+		shown[strings.ToLower(repoFullName(pr))]++
+	}
+	return shown
+}
+
+// truncationBanner names the repos whose open PRs the page depth cut short,
+// or returns "" if every open PR was listed
+func truncationBanner(tab *TabState) string {
+	var truncated []string
+	for repo, count := range tab.PRCounts {
+		if count.Truncated() {
+			truncated = append(truncated, repo)
+		}
+	}
+	if len(truncated) == 0 {
+		return ""
+	}
+	sort.Slice(truncated, func(i, j int) bool {
+		a, b := tab.PRCounts[truncated[i]], tab.PRCounts[truncated[j]]
+		if a.Open != b.Open {
+			return a.Open > b.Open
+		}
+		return truncated[i] < truncated[j]
+	})
+
+	shown := shownPerRepo(tab.PRs)
+	shown[strings.ToLower(github.SearchResultsKey)] = len(tab.PRs) // Search counts the tab's results as a whole
+	var parts []string
+	for _, repo := range truncated[:min(len(truncated), truncatedReposShown)] {
+		parts = append(parts, fmt.Sprintf("%s showing %d of %d open PRs", repo, shown[strings.ToLower(repo)], tab.PRCounts[repo].Open))
+	}
+	if more := len(truncated) - truncatedReposShown; more > 0 {
+		parts = append(parts, fmt.Sprintf("+%d more repos", more))
+	}
+	return "📉 Partial list: " + strings.Join(parts, ", ") + " • raise max_pages to list more"
+}
+
+// repoCountLine describes how many of a repo's open PRs the tab shows, or
+// returns "" before the repo's PRs have been counted
+func repoCountLine(tab *TabState, repo string) string {
+	for counted, count := range tab.PRCounts {
+		if !strings.EqualFold(counted, repo) {
+			continue
+		}
+		if count.Err != nil {
+			return fmt.Sprintf("⚠️ Couldn't list this repo's open PRs: %v", count.Err)
+		}
+		line := fmt.Sprintf("👁 Showing %d of %d open PRs in this tab", shownPerRepo(tab.PRs)[strings.ToLower(repo)], count.Open)
+		if count.Truncated() {
+			line += fmt.Sprintf(" (only %d listed - max_pages)", count.Fetched)
+		}
+		return line
+	}
+	return ""
+}
@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestTruncationBanner tests the partial list banner and per-repo counts
+func TestTruncationBanner(t *testing.T) {
+	pr := func(repo string) *gh.PullRequest {
+		return &gh.PullRequest{Base: &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String(repo)}}}
+	}
+	tab := &TabState{
+		Config: &TabConfig{Name: "Main", Mode: "repos"},
+		PRs:    []*gh.PullRequest{pr("Org/Busy"), pr("Org/Busy"), pr("org/quiet")},
+		PRCounts: github.PRCounts{
+			"org/busy":  {Open: 812, Fetched: 300},
+			"org/quiet": {Open: 1, Fetched: 1},
+		},
+	}
+
+	banner := truncationBanner(tab)
+	if !strings.Contains(banner, "org/busy showing 2 of 812 open PRs") || strings.Contains(banner, "quiet") {
+		t.Errorf("Expected only the truncated repo in the banner, got %q", banner)
+	}
+	if line := repoCountLine(tab, "Org/Busy"); !strings.Contains(line, "2 of 812") || !strings.Contains(line, "only 300 listed") {
+		t.Errorf("Expected the busy repo's count with its listed total, got %q", line)
+	}
+	if line := repoCountLine(tab, "org/quiet"); line != "👁 Showing 1 of 1 open PRs in this tab" {
+		t.Errorf("Unexpected count line %q", line)
+	}
+
+	tab.PRCounts = github.PRCounts{"org/quiet": {Open: 1, Fetched: 1}}
+	if banner := truncationBanner(tab); banner != "" {
+		t.Errorf("Expected no banner when every open PR is listed, got %q", banner)
+	}
+
+	// Search mode counts its results as a whole
+	tab.PRCounts = github.PRCounts{github.SearchResultsKey: {Open: 450, Fetched: 300}}
+	if banner := truncationBanner(tab); !strings.Contains(banner, "search results showing 3 of 450 open PRs") {
+		t.Errorf("Expected the search results in the banner, got %q", banner)
+	}
+}
@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/bjess9/pr-compass/internal/slack"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// wantsEvent reports whether Slack or the terminal alerts take an event
+// from a tab
+func (m *MultiTabModel) wantsEvent(tab *TabState, kind string) bool {
+	return m.slackWants(tab, kind) || m.Alerts.Wants(kind)
+}
+
+// wantsReviewRequests reports whether any tab reports new review requests,
+// which needs the user's teams looked up at startup
+func (m *MultiTabModel) wantsReviewRequests() bool {
+	for _, tab := range m.TabManager.Tabs {
+		if m.wantsEvent(tab, slack.EventReviewRequested) {
+			return true
+		}
+	}
+	return false
+}
+
+// prEvent describes something that happened to a PR listed in a tab
+func prEvent(kind string, tab *TabState, pr *gh.PullRequest, detail string) slack.Event {
+	return slack.Event{
+		Kind:   kind,
+		Tab:    tab.Config.Name,
+		PR:     services.PRKey(pr),
+		Title:  pr.GetTitle(),
+		Author: pr.GetUser().GetLogin(),
+		URL:    pr.GetHTMLURL(),
+		Detail: detail,
+	}
+}
+
+// eventsCmd posts events to Slack and raises terminal alerts for them, each
+// as configured
+func (m *MultiTabModel) eventsCmd(tab *TabState, events []slack.Event) tea.Cmd {
+	var posted, alerted []slack.Event
+	for _, event := range events {
+		if m.slackWants(tab, event.Kind) {
+			posted = append(posted, event)
+		}
+		if m.Alerts.Wants(event.Kind) {
+			alerted = append(alerted, event)
+		}
+	}
+	return tea.Batch(m.postSlackCmd(tab, posted), m.alertCmd(alerted))
+}
+
+// enhancementEventsCmd reports approvals and newly failing checks that a
+// PR's refreshed details reveal. Only changes seen during the session count,
+// so the first details of a PR report nothing.
+func (m *MultiTabModel) enhancementEventsCmd(tab *TabState, before, after types.EnhancedData) tea.Cmd {
+	var pr *gh.PullRequest
+	for _, listed := range tab.PRs {
+		if listed.GetNumber() == after.Number {
+			pr = listed
+			break
+		}
+	}
+	if pr == nil {
+		return nil
+	}
+
+	var events []slack.Event
+	if after.ReviewStatus == "approved" && before.ReviewStatus != "approved" && m.wantsEvent(tab, slack.EventApproved) {
+		events = append(events, prEvent(slack.EventApproved, tab, pr, reviewersChangedTo(before, after, "APPROVED")))
+	}
+	if after.ChecksStatus == "failure" && before.ChecksStatus != "failure" && m.wantsEvent(tab, slack.EventChecksFailed) {
+		events = append(events, prEvent(slack.EventChecksFailed, tab, pr, ""))
+	}
+	return m.eventsCmd(tab, events)
+}
+
+// reviewRequestEventsCmd reports the tab's PRs that started requesting a
+// review from the current user or their teams since the last listing. The
+// first listing, and the first once the user's teams are known, only set
+// the baseline.
+func (m *MultiTabModel) reviewRequestEventsCmd(tab *TabState) tea.Cmd {
+	if !m.wantsEvent(tab, slack.EventReviewRequested) {
+		return nil
+	}
+	reviewers := m.viewerReviewers
+	if reviewers == nil && m.viewerLogin != "" {
+		reviewers = []string{m.viewerLogin}
+	}
+	if reviewers == nil {
+		return nil // Not known yet
+	}
+
+	reviewersKey := strings.Join(reviewers, ",")
+	baseline := tab.requestedOfMe == nil || tab.requestedOfMeBy != reviewersKey
+	requested := make(map[string]bool)
+	var events []slack.Event
+	for _, pr := range tab.PRs {
+		if !services.ReviewRequestedFrom(pr, reviewers) {
+			continue
+		}
+		key := services.PRKey(pr)
+		requested[key] = true
+		if !baseline && !tab.requestedOfMe[key] {
+			events = append(events, prEvent(slack.EventReviewRequested, tab, pr, ""))
+		}
+	}
+	tab.requestedOfMe = requested
+	tab.requestedOfMeBy = reviewersKey
+	return m.eventsCmd(tab, events)
+}
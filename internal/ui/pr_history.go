@@ -0,0 +1,175 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+const (
+	// defaultHistoryDays is how far back merged and closed PRs are listed
+	// when the tab doesn't say
+	defaultHistoryDays = 7
+
+	// historyLimit caps the merged and closed PRs listed per tab
+	historyLimit = 100
+
+	// historyMarker leads the PR cell of merged and closed rows, which are
+	// styled once rendered like aging rows, see colorAgedRows
+	historyMarker = "\ufeff"
+)
+
+// historyMsg delivers the PRs a tab's scope merged or closed recently
+type historyMsg struct {
+	tabName string
+	prs     []*gh.PullRequest
+	err     error
+}
+
+// validateHistoryDays checks the configured days of merged and closed PRs
+func validateHistoryDays(days int) error {
+	if days < 0 {
+		return fmt.Errorf("history_days must not be negative")
+	}
+	return nil
+}
+
+// historyDays returns how many days of merged and closed PRs the tab lists
+func (tc *TabConfig) historyDays() int {
+	if tc.HistoryDays > 0 {
+		return tc.HistoryDays
+	}
+	return defaultHistoryDays
+}
+
+// isHistoryPR reports whether a listed PR is a merged or closed one
+func isHistoryPR(pr *gh.PullRequest) bool {
+	return pr.GetState() == "closed"
+}
+
+// historyQuery turns the open PR query into one for PRs closed since a day
+func historyQuery(openQuery, since string) string {
+	var terms []string
+	for _, term := range strings.Fields(openQuery) {
+		switch term {
+		case "is:open":
+			terms = append(terms, "is:closed")
+		case "draft:false": // Drafts can be closed too
+		default:
+			terms = append(terms, term)
+		}
+	}
+	return strings.Join(append(terms, "closed:>="+since), " ")
+}
+
+// toggleHistory shows or hides the PRs the tab's scope merged or closed
+// recently, listing them when shown
+func (m *MultiTabModel) toggleHistory(tab *TabState) tea.Cmd {
+	if !tab.Config.OnGitHub() {
+		tab.StatusMsg = "Merged and closed PRs are only available for GitHub tabs"
+		return nil
+	}
+
+	tab.ShowHistory = !tab.ShowHistory
+	m.updateTableRows(tab)
+	tab.Table.SetHeight(m.calculateTableHeight(tab))
+	if !tab.ShowHistory {
+		tab.StatusMsg = "Merged and closed PRs hidden"
+		return nil
+	}
+	if m.readOnly() {
+		tab.StatusMsg = "Merged and closed PRs need GITHUB_TOKEN: they come from the search API"
+		return nil
+	}
+	tab.StatusMsg = fmt.Sprintf("Listing PRs merged or closed in the last %d days...", tab.Config.historyDays())
+	return m.historyCmd(tab)
+}
+
+// historyCmd lists the PRs the tab's scope merged or closed within its
+// history window. It costs two search requests, one more per further page.
+func (m *MultiTabModel) historyCmd(tab *TabState) tea.Cmd {
+	if !tab.ShowHistory || m.readOnly() || !tab.Config.OnGitHub() {
+		return nil
+	}
+
+	token := m.TabManager.Token
+	tabName := tab.Config.Name
+	since := time.Now().AddDate(0, 0, -tab.Config.historyDays()).Format(insightsDateLayout)
+	query := historyQuery(insightsOpenQuery(tab), since)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 30*time.Second)
+		defer cancel()
+
+		prs, err := github.FetchClosedPRs(ctx, token, query, historyLimit)
+		return historyMsg{tabName: tabName, prs: prs, err: err}
+	}
+}
+
+// handleHistory shows a tab's merged and closed PRs below its open ones
+func (m *MultiTabModel) handleHistory(msg historyMsg) (tea.Model, tea.Cmd) {
+	for _, tab := range m.TabManager.Tabs {
+		if tab.Config.Name != msg.tabName {
+			continue
+		}
+		if msg.err != nil {
+			m.Log.Warn("Merged and closed PRs not listed", "tab", msg.tabName, "err", msg.err)
+			tab.StatusMsg = fmt.Sprintf("Merged and closed PRs not listed: %v", msg.err)
+			break
+		}
+		tab.History = msg.prs
+		if tab.ShowHistory {
+			merged, closed := historyCounts(msg.prs)
+			tab.StatusMsg = fmt.Sprintf("🗂️ %d merged, %d closed in the last %d days", merged, closed, tab.Config.historyDays())
+		}
+		m.updateTableRows(tab)
+		break
+	}
+	return m, nil
+}
+
+// historyRows returns the merged and closed PRs the tab shows after its open
+// ones, through the active filter. Filters on open PR state, like size or
+// quick filters, hide them.
+func (m *MultiTabModel) historyRows(tab *TabState) []*gh.PullRequest {
+	if !tab.ShowHistory {
+		return nil
+	}
+	switch tab.FilterMode {
+	case "":
+		return tab.History
+	case "size", "quick", "project", "draft":
+		return nil
+	}
+	if tab.FilterValue == "" {
+		return tab.History
+	}
+	return m.applyFilter(tab.History, tab.FilterMode, tab.FilterValue)
+}
+
+// historyCounts counts merged and closed unmerged PRs
+func historyCounts(prs []*gh.PullRequest) (merged, closed int) {
+	for _, pr := range prs {
+		if !isHistoryPR(pr) {
+			continue
+		}
+		if pr.GetMerged() {
+			merged++
+		} else {
+			closed++
+		}
+	}
+	return merged, closed
+}
+
+// historyStatus describes how a PR left the open list, e.g. "✓ Merged 2d ago"
+func historyStatus(pr *gh.PullRequest, now time.Time) string {
+	if pr.GetMerged() {
+		return "✓ Merged " + formatAge(now.Sub(pr.GetMergedAt().Time))
+	}
+	return "✗ Closed " + formatAge(now.Sub(pr.GetClosedAt().Time))
+}
@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+	"github.com/mattn/go-runewidth"
+)
+
+// closedPR returns a PR that merged or closed unmerged at the given time
+func closedPR(number int, merged bool, at time.Time) *gh.PullRequest {
+	pr := labeledPR(number)
+	pr.State = gh.String("closed")
+	pr.Merged = gh.Bool(merged)
+	pr.ClosedAt = &gh.Timestamp{Time: at}
+	if merged {
+		pr.MergedAt = &gh.Timestamp{Time: at}
+	}
+	return pr
+}
+
+func TestHistoryQuery(t *testing.T) {
+	got := historyQuery("is:pr is:open draft:false org:acme -author:bot", "2026-10-11")
+	if want := "is:pr is:closed org:acme -author:bot closed:>=2026-10-11"; got != want {
+		t.Errorf("historyQuery() = %q, want %q", got, want)
+	}
+}
+
+// TestHistoryRows tests that w lists merged and closed PRs after the open
+// ones, styled apart and counted in the footer, and that filters apply
+func TestHistoryRows(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"org/api"}})
+	now := time.Now()
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: []*gh.PullRequest{labeledPR(1, "bug"), labeledPR(2)}})
+
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")}); cmd == nil || !tab.ShowHistory {
+		t.Fatal("Expected w to show merged and closed PRs and list them")
+	}
+	model.handleHistory(historyMsg{tabName: "Test Tab", prs: []*gh.PullRequest{
+		closedPR(7, true, now.Add(-time.Hour)),
+		closedPR(8, false, now.Add(-48*time.Hour)),
+	}})
+
+	rows := tab.Table.Rows()
+	if len(rows) != 4 || tab.FilteredPRs[2].GetNumber() != 7 || tab.FilteredPRs[3].GetNumber() != 8 {
+		t.Fatalf("Expected the open PRs, then the merged and closed ones, got %d rows", len(rows))
+	}
+	if !strings.HasPrefix(rows[2][0], historyMarker) || rows[2][4] != "✓ Merged 1h ago" || rows[3][4] != "✗ Closed 2d ago" {
+		t.Errorf("Expected marked merged and closed rows, got %q and %q", rows[2], rows[3])
+	}
+	if strings.HasPrefix(rows[0][0], historyMarker) {
+		t.Errorf("Expected open rows unmarked, got %q", rows[0][0])
+	}
+	if runewidth.StringWidth(historyMarker+pinMarker+" PR") != runewidth.StringWidth(pinMarker+" PR") {
+		t.Error("Expected the history marker to take no width")
+	}
+	if footer := renderTableFooter(tab); !strings.Contains(footer, "2 PRs") || !strings.Contains(footer, "1 merged, 1 closed in 7d") {
+		t.Errorf("Expected the footer to count open PRs apart, got %q", footer)
+	}
+	if status := tab.StatusMsg; !strings.Contains(status, "1 merged, 1 closed in the last 7 days") {
+		t.Errorf("Expected the listing summed up, got %q", status)
+	}
+
+	if snapshot := markdownSnapshot(tab.FilteredPRs, tab.EnhancedData, 5); !strings.Contains(snapshot, "| ✓ Merged 1h ago |") {
+		t.Errorf("Expected the markdown copy to say when PRs merged, got:\n%s", snapshot)
+	}
+
+	// A refresh keeps them after the open PRs
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: []*gh.PullRequest{labeledPR(1, "bug")}})
+	if len(tab.FilteredPRs) != 3 || isHistoryPR(tab.FilteredPRs[0]) {
+		t.Errorf("Expected the refreshed open PR, then the merged and closed ones, got %d", len(tab.FilteredPRs))
+	}
+
+	tab.FilterMode, tab.FilterValue = "quick", "failing"
+	model.updateTableRows(tab)
+	for _, pr := range tab.FilteredPRs {
+		if isHistoryPR(pr) {
+			t.Error("Expected filters on open PR state to hide merged and closed PRs")
+		}
+	}
+	tab.FilterMode, tab.FilterValue = "", ""
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+	if tab.ShowHistory || len(tab.Table.Rows()) != 1 {
+		t.Errorf("Expected w again to hide them, got %d rows", len(tab.Table.Rows()))
+	}
+}
+
+func TestColorAgedRowsHistory(t *testing.T) {
+	view := colorAgedRows("header\n" + historyMarker + "#7 Change\n#1 Open")
+	if strings.Contains(view, historyMarker) {
+		t.Error("Expected the history marker to be swapped for a style")
+	}
+	if !strings.HasSuffix(view, "\n#1 Open") {
+		t.Errorf("Expected open rows untouched, got %q", view)
+	}
+}
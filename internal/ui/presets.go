@@ -0,0 +1,224 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+)
+
+// maxFilterPresets is how many presets fit the number keys after the quick
+// filters (6-9)
+const maxFilterPresets = 4
+
+// FilterPreset is a named combination of filters, applied with its number key
+type FilterPreset struct {
+	Name     string   `mapstructure:"name" yaml:"name"`
+	Authors  []string `mapstructure:"authors" yaml:"authors,omitempty"`   // Any of these authors
+	Labels   []string `mapstructure:"labels" yaml:"labels,omitempty"`     // Any of these labels
+	Statuses []string `mapstructure:"statuses" yaml:"statuses,omitempty"` // Any of ready, draft, conflicts
+}
+
+// Filter returns the preset's criteria
+func (p FilterPreset) Filter() services.PresetFilter {
+	return services.PresetFilter{Authors: p.Authors, Labels: p.Labels, Statuses: p.Statuses}
+}
+
+// validateFilterPresets checks that presets fit the number keys, have
+// distinct names and filter on something
+func validateFilterPresets(presets []FilterPreset) error {
+	if len(presets) > maxFilterPresets {
+		return fmt.Errorf("filter_presets: at most %d presets fit the number keys, got %d", maxFilterPresets, len(presets))
+	}
+	seen := make(map[string]bool)
+	for i, preset := range presets {
+		if strings.TrimSpace(preset.Name) == "" {
+			return fmt.Errorf("filter_presets[%d]: name is required", i)
+		}
+		if seen[preset.Name] {
+			return fmt.Errorf("filter_presets[%d]: duplicate name %q", i, preset.Name)
+		}
+		seen[preset.Name] = true
+		if len(preset.Authors)+len(preset.Labels)+len(preset.Statuses) == 0 {
+			return fmt.Errorf("filter_presets[%d] (%s): set authors, labels or statuses", i, preset.Name)
+		}
+		for _, status := range preset.Statuses {
+			switch strings.ToLower(status) {
+			case "ready", "draft", "conflicts":
+			default:
+				return fmt.Errorf("filter_presets[%d] (%s): status must be ready, draft or conflicts, got %q", i, preset.Name, status)
+			}
+		}
+	}
+	return nil
+}
+
+// PresetStore remembers the last preset applied in each tab, keyed by tab
+// name, persisted locally so tabs reopen filtered the same way
+type PresetStore struct {
+	mu     sync.Mutex
+	path   string // Empty path keeps choices in memory only
+	active map[string]string
+}
+
+// NewPresetStore creates a preset store backed by the given file. A missing
+// or unreadable file starts with no presets active.
+func NewPresetStore(path string) *PresetStore {
+	store := &PresetStore{
+		path:   path,
+		active: make(map[string]string),
+	}
+
+	if path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			var saved map[string]string
+			if json.Unmarshal(data, &saved) == nil && saved != nil {
+				store.active = saved
+			}
+		}
+	}
+
+	return store
+}
+
+// Get returns the name of the preset last applied in a tab, or ""
+func (s *PresetStore) Get(tabName string) string {
+	if s == nil {
+		return ""
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.active[tabName]
+}
+
+// Set remembers the preset applied in a tab; an empty name forgets it
+func (s *PresetStore) Set(tabName, preset string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if preset == "" {
+		delete(s.active, tabName)
+	} else {
+		s.active[tabName] = preset
+	}
+	return s.save()
+}
+
+// Rename moves the preset remembered for a tab to its new name
+func (s *PresetStore) Rename(oldName, newName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	preset, ok := s.active[oldName]
+	if !ok {
+		return nil
+	}
+	delete(s.active, oldName)
+	s.active[newName] = preset
+	return s.save()
+}
+
+// save writes active presets to disk; the caller must hold the lock
+func (s *PresetStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(s.active, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode presets: %w", err)
+	}
+	if err := cache.WriteFile(s.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to save presets: %w", err)
+	}
+	return nil
+}
+
+// getPresetsFilePath returns the path active presets are saved to
+func getPresetsFilePath() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/.prcompass_presets.json", homeDir)
+}
+
+// activePreset returns the index of the preset filtering the tab, or -1
+func (m *MultiTabModel) activePreset(tab *TabState) int {
+	if tab.FilterMode != "preset" {
+		return -1
+	}
+	for i, preset := range m.FilterPresets {
+		if preset.Filter().String() == tab.FilterValue {
+			return i
+		}
+	}
+	return -1
+}
+
+// togglePreset applies the preset bound to a number key, or clears it when
+// it is already active. Presets start on the key after the quick filters.
+func (m *MultiTabModel) togglePreset(tab *TabState, key int) {
+	if len(m.FilterPresets) == 0 {
+		tab.StatusMsg = "No filter presets configured - add filter_presets to your config"
+		return
+	}
+	index := key - presetKeyOffset - 1
+	if m.activePreset(tab) == index {
+		m.clearPreset(tab)
+		return
+	}
+	if index >= len(m.FilterPresets) {
+		tab.StatusMsg = fmt.Sprintf("No preset on %d (%d configured)", key, len(m.FilterPresets))
+		return
+	}
+
+	preset := m.FilterPresets[index]
+	m.applyPreset(tab, preset)
+	if err := m.Presets.Set(tab.Config.Name, preset.Name); err != nil {
+		tab.StatusMsg += " • " + err.Error()
+	}
+}
+
+// applyPreset narrows the tab to PRs matching a preset
+func (m *MultiTabModel) applyPreset(tab *TabState, preset FilterPreset) {
+	tab.FilterMode = "preset"
+	tab.FilterValue = preset.Filter().String()
+	tab.FilteredPRs = m.applyFilter(tab.PRs, "preset", tab.FilterValue)
+	tab.StatusMsg = fmt.Sprintf("🎛️  Preset %s (%d) - 0 to clear", preset.Name, len(tab.FilteredPRs))
+	m.updateTableRows(tab)
+}
+
+// clearPreset removes the tab's preset filter and forgets it for next time
+func (m *MultiTabModel) clearPreset(tab *TabState) {
+	if tab.FilterMode == "preset" {
+		tab.FilterMode = ""
+		tab.FilterValue = ""
+		tab.FilteredPRs = tab.PRs
+		m.updateTableRows(tab)
+	}
+	tab.StatusMsg = "Preset cleared"
+	if err := m.Presets.Set(tab.Config.Name, ""); err != nil {
+		tab.StatusMsg = err.Error()
+	}
+}
+
+// restorePresets reapplies the preset each tab had when PR Compass last
+// closed; presets since removed from the config are skipped
+func (m *MultiTabModel) restorePresets() {
+	for _, tab := range m.TabManager.Tabs {
+		name := m.Presets.Get(tab.Config.Name)
+		for _, preset := range m.FilterPresets {
+			if preset.Name == name {
+				// Applied to the PRs once they load
+				tab.FilterMode = "preset"
+				tab.FilterValue = preset.Filter().String()
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+func presetTestModel(t *testing.T) (*MultiTabModel, *TabState) {
+	t.Helper()
+	model := NewMultiTabModel("test-token", nil)
+	model.Presets = NewPresetStore(filepath.Join(t.TempDir(), "presets.json"))
+	model.FilterPresets = []FilterPreset{
+		{Name: "Mine ready", Authors: []string{"alice"}, Statuses: []string{"ready"}},
+		{Name: "Bugs", Labels: []string{"bug"}},
+	}
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Main", Mode: "repos", Repos: []string{"org/api"}})
+	tab.PRs = []*gh.PullRequest{
+		{Number: gh.Int(1), User: &gh.User{Login: gh.String("alice")}},
+		{Number: gh.Int(2), User: &gh.User{Login: gh.String("alice")}, Draft: gh.Bool(true), Labels: []*gh.Label{{Name: gh.String("bug")}}},
+		{Number: gh.Int(3), User: &gh.User{Login: gh.String("bob")}, Labels: []*gh.Label{{Name: gh.String("bug")}}},
+	}
+	tab.FilteredPRs = tab.PRs
+	tab.Loaded = true
+	model.updateTableRows(tab)
+	return model, tab
+}
+
+// TestHotkeyPresets tests applying, switching and clearing presets with the
+// number keys after the quick filters
+func TestHotkeyPresets(t *testing.T) {
+	model, tab := presetTestModel(t)
+	press := func(key string) { model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}) }
+
+	press("6")
+	if tab.FilterMode != "preset" || len(tab.FilteredPRs) != 1 || tab.FilteredPRs[0].GetNumber() != 1 {
+		t.Fatalf("Expected the first preset to show PR 1, got %d PRs", len(tab.FilteredPRs))
+	}
+	if !strings.Contains(tab.StatusMsg, "Mine ready (1)") {
+		t.Errorf("Unexpected status %q", tab.StatusMsg)
+	}
+	if got := model.Presets.Get("Main"); got != "Mine ready" {
+		t.Errorf("Expected preset remembered for the tab, got %q", got)
+	}
+
+	press("7")
+	if len(tab.FilteredPRs) != 2 || model.activePreset(tab) != 1 {
+		t.Errorf("Expected the second preset to replace the first, got %d PRs", len(tab.FilteredPRs))
+	}
+
+	// The same key clears, as does 0
+	press("7")
+	if tab.FilterMode != "" || len(tab.FilteredPRs) != 3 || model.Presets.Get("Main") != "" {
+		t.Errorf("Expected pressing 7 again to clear the preset, got mode %q", tab.FilterMode)
+	}
+	press("6")
+	press("0")
+	if tab.FilterMode != "" || model.Presets.Get("Main") != "" {
+		t.Error("Expected 0 to clear the preset")
+	}
+
+	press("9")
+	if !strings.Contains(tab.StatusMsg, "No preset on 9 (2 configured)") || tab.FilterMode != "" {
+		t.Errorf("Expected unknown preset to be reported, got %q", tab.StatusMsg)
+	}
+
+	model.FilterPresets = nil
+	press("6")
+	if !strings.Contains(tab.StatusMsg, "No filter presets configured") {
+		t.Errorf("Expected hint to configure presets, got %q", tab.StatusMsg)
+	}
+}
+
+// TestRestorePresets tests that tabs reopen with their last preset once PRs load
+func TestRestorePresets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "presets.json")
+	if err := NewPresetStore(path).Set("Main", "Bugs"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	model, tab := presetTestModel(t)
+	model.Presets = NewPresetStore(path)
+	tab.FilteredPRs = nil
+	model.restorePresets()
+	model.setTabPRs(tab, tab.PRs)
+
+	if model.activePreset(tab) != 1 || len(tab.FilteredPRs) != 2 {
+		t.Errorf("Expected the Bugs preset restored, got mode %q with %d PRs", tab.FilterMode, len(tab.FilteredPRs))
+	}
+
+	// Clearing filters forgets the preset for next time
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	if NewPresetStore(path).Get("Main") != "" {
+		t.Error("Expected c to forget the restored preset")
+	}
+}
+
+// TestValidateFilterPresets tests the filter_presets config checks
+func TestValidateFilterPresets(t *testing.T) {
+	valid := []FilterPreset{{Name: "Ready", Statuses: []string{"Ready", "conflicts"}}}
+	if err := validateFilterPresets(valid); err != nil {
+		t.Errorf("Expected valid presets, got %v", err)
+	}
+
+	tests := map[string][]FilterPreset{
+		"missing name":   {{Authors: []string{"alice"}}},
+		"duplicate name": {{Name: "A", Authors: []string{"a"}}, {Name: "A", Labels: []string{"b"}}},
+		"no criteria":    {{Name: "Empty"}},
+		"bad status":     {{Name: "Merged", Statuses: []string{"merged"}}},
+		"too many":       make([]FilterPreset, maxFilterPresets+1),
+	}
+	for name, presets := range tests {
+		if err := validateFilterPresets(presets); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
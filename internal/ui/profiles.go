@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/bjess9/pr-compass/internal/config"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// pickProfile offers the configured profiles. Choosing another one quits
+// the program, which main restarts with that profile's token and tabs.
+func (m *MultiTabModel) pickProfile(tab *TabState) {
+	profiles, err := config.ListProfiles()
+	if err != nil {
+		tab.StatusMsg = fmt.Sprintf("Error listing profiles: %v", err)
+		return
+	}
+	current := config.ActiveProfile()
+	if len(profiles) == 0 || (len(profiles) == 1 && profiles[0] == current) {
+		dir, _ := config.ProfilesDir()
+		tab.StatusMsg = fmt.Sprintf("No other profiles - add NAME.yaml files to %s", dir)
+		return
+	}
+
+	m.pendingChoice = &choicePrompt{
+		label:   fmt.Sprintf("👤 Switch profile (now %s):", current),
+		options: profiles,
+		onChoose: func(profile string) tea.Cmd {
+			if profile == current {
+				tab.StatusMsg = fmt.Sprintf("Already using profile %s", profile)
+				return nil
+			}
+			m.nextProfile = profile
+			return tea.Quit
+		},
+	}
+	tab.StatusMsg = m.pendingChoice.status()
+}
+
+// NextProfile returns the profile chosen in the app to restart with, if any
+func (m *MultiTabModel) NextProfile() (string, bool) {
+	return m.nextProfile, m.nextProfile != ""
+}
+
+// profileInfo names the active profile in the tab bar, unless it's the default
+func (m *MultiTabModel) profileInfo() string {
+	if profile := config.ActiveProfile(); profile != config.DefaultProfile {
+		return " │ Profile: " + profile
+	}
+	return ""
+}
@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/config"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestProfileSwitch tests picking another profile with P
+func TestProfileSwitch(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	dir := filepath.Join(home, ".config", "pr-compass", "profiles")
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "oss.yaml"), []byte("mode: search\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	model, tab := presetTestModel(t)
+
+	// Only the current profile: nothing to switch to
+	if err := config.UseProfile("oss"); err != nil {
+		t.Fatal(err)
+	}
+	defer config.UseProfile("") // #nosec G104 - resets to the default
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")})
+	if model.pendingChoice != nil || !strings.Contains(tab.StatusMsg, "No other profiles") {
+		t.Fatalf("Expected no picker with a single profile, got %q", tab.StatusMsg)
+	}
+	if !strings.Contains(model.renderTabBar(), "Profile: oss") {
+		t.Error("Expected the tab bar to name the active profile")
+	}
+
+	if err := os.WriteFile(filepath.Join(home, ".prcompass_config.yaml"), []byte("mode: repos\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")})
+	if model.pendingChoice == nil || model.pendingChoice.options[0] != config.DefaultProfile {
+		t.Fatalf("Expected a picker offering the default profile, got %q", tab.StatusMsg)
+	}
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if profile, switching := model.NextProfile(); !switching || profile != config.DefaultProfile {
+		t.Errorf("Expected to switch to the default profile, got %q", profile)
+	}
+	if cmd == nil {
+		t.Fatal("Expected choosing a profile to quit for the restart")
+	}
+	if _, quit := cmd().(tea.QuitMsg); !quit {
+		t.Error("Expected choosing a profile to quit for the restart")
+	}
+}
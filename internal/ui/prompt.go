@@ -0,0 +1,112 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// textPrompt collects a line of free text. While active it receives every
+// key press, so hotkeys can't interfere with typing.
+type textPrompt struct {
+	label    string
+	value    string
+	history  *inputHistory             // Optional; recalled with up/down
+	onSubmit func(value string) string // Applies the value and returns a status message
+}
+
+// status renders the prompt with its current value for the status line
+func (p *textPrompt) status() string {
+	return p.label + ": " + p.value + "_"
+}
+
+// handleInputKey edits or resolves the pending text prompt
+func (m *MultiTabModel) handleInputKey(tab *TabState, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	prompt := m.pendingInput
+
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.pendingInput = nil
+		if prompt.history != nil {
+			prompt.history.add(prompt.value)
+		}
+		tab.StatusMsg = prompt.onSubmit(prompt.value)
+		return m, nil
+	case tea.KeyEsc, tea.KeyCtrlC:
+		m.pendingInput = nil
+		tab.StatusMsg = "Cancelled"
+		return m, nil
+	case tea.KeyUp:
+		if prompt.history != nil {
+			prompt.value = prompt.history.prev(prompt.value)
+		}
+	case tea.KeyDown:
+		if prompt.history != nil {
+			prompt.value = prompt.history.next(prompt.value)
+		}
+	case tea.KeyBackspace:
+		if runes := []rune(prompt.value); len(runes) > 0 {
+			prompt.value = string(runes[:len(runes)-1])
+		}
+	case tea.KeyCtrlU:
+		prompt.value = ""
+	case tea.KeySpace:
+		prompt.value += " "
+	case tea.KeyRunes:
+		prompt.value += string(msg.Runes)
+	}
+
+	tab.StatusMsg = prompt.status()
+	return m, nil
+}
+
+// choicePrompt picks one of a few options. Like textPrompt it receives every
+// key press while active.
+type choicePrompt struct {
+	label    string
+	options  []string
+	cursor   int
+	onChoose func(option string) tea.Cmd // Applies the choice and returns follow-up work
+}
+
+// status renders the options with the highlighted one in brackets
+func (p *choicePrompt) status() string {
+	parts := make([]string, len(p.options))
+	for i, option := range p.options {
+		if i == p.cursor {
+			parts[i] = fmt.Sprintf("[%d %s]", i+1, option)
+		} else {
+			parts[i] = fmt.Sprintf(" %d %s ", i+1, option)
+		}
+	}
+	return fmt.Sprintf("%s %s  (←/→ or 1-%d, enter to confirm, esc to cancel)", p.label, strings.Join(parts, " "), len(p.options))
+}
+
+// handleChoiceKey moves through or resolves the pending choice prompt
+func (m *MultiTabModel) handleChoiceKey(tab *TabState, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	prompt := m.pendingChoice
+
+	switch key := msg.String(); key {
+	case "enter":
+		m.pendingChoice = nil
+		return m, prompt.onChoose(prompt.options[prompt.cursor])
+	case "esc", "ctrl+c", "q":
+		m.pendingChoice = nil
+		tab.StatusMsg = "Cancelled"
+		return m, nil
+	case "left", "h", "shift+tab":
+		prompt.cursor = (prompt.cursor + len(prompt.options) - 1) % len(prompt.options)
+	case "right", "l", "tab":
+		prompt.cursor = (prompt.cursor + 1) % len(prompt.options)
+	default:
+		if n, err := strconv.Atoi(key); err == nil && n >= 1 && n <= len(prompt.options) {
+			m.pendingChoice = nil
+			return m, prompt.onChoose(prompt.options[n-1])
+		}
+	}
+
+	tab.StatusMsg = prompt.status()
+	return m, nil
+}
@@ -0,0 +1,160 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// quickFilterBarHeight is the line the quick filter bar takes above the table
+const quickFilterBarHeight = 1
+
+// presetKeyOffset is how many number keys the quick filters take before
+// filter presets start
+var presetKeyOffset = len(services.QuickFilters)
+
+// quickFilterLabels name the quick filters on the bar
+var quickFilterLabels = map[string]string{
+	"mine":      "Mine",
+	"review":    "Needs review",
+	"failing":   "Failing",
+	"drafts":    "Drafts",
+	"conflicts": "Conflicts",
+}
+
+// activeQuickFilters returns the quick filters narrowing the tab, in bar order
+func activeQuickFilters(tab *TabState) []string {
+	if tab.FilterMode != "quick" {
+		return nil
+	}
+	names, _ := services.ParseQuickFilters(tab.FilterValue)
+	return names
+}
+
+// numberKey handles the number row: 1-5 toggle quick filters, the keys after
+// them apply filter presets and 0 clears both
+func (m *MultiTabModel) numberKey(tab *TabState, number int) tea.Cmd {
+	switch {
+	case number == 0:
+		m.clearPreset(tab)
+		if tab.FilterMode == "quick" {
+			m.setQuickFilters(tab, nil)
+		}
+		tab.StatusMsg = "Quick filters and preset cleared"
+		return nil
+	case number <= presetKeyOffset:
+		return m.toggleQuickFilter(tab, services.QuickFilters[number-1])
+	default:
+		m.togglePreset(tab, number)
+		return nil
+	}
+}
+
+// toggleQuickFilter adds a quick filter to those narrowing the tab, or
+// removes it. Quick filters combine with each other but replace other filters.
+func (m *MultiTabModel) toggleQuickFilter(tab *TabState, name string) tea.Cmd {
+	active := activeQuickFilters(tab)
+	enabling := !slices.Contains(active, name)
+	if name == "mine" && enabling && m.viewerLogin == "" {
+		if m.readOnly() {
+			tab.StatusMsg = "Read-only mode: set GITHUB_TOKEN so PR Compass knows who you are"
+			return nil
+		}
+		tab.StatusMsg = "Looking up your login..."
+		lookup := m.viewerLoginCmd(tab.Config.Name)
+		return func() tea.Msg {
+			msg := lookup().(viewerLoginMsg)
+			msg.quickFilter = true
+			return msg
+		}
+	}
+
+	if tab.FilterMode == "preset" {
+		_ = m.Presets.Set(tab.Config.Name, "") // Replaced, so don't restore it
+	}
+	var names []string
+	for _, candidate := range services.QuickFilters {
+		if candidate == name && enabling || candidate != name && slices.Contains(active, candidate) {
+			names = append(names, candidate)
+		}
+	}
+	m.setQuickFilters(tab, names)
+	return nil
+}
+
+// setQuickFilters narrows the tab to PRs in every given quick filter's
+// slice, or clears quick filters when there are none
+func (m *MultiTabModel) setQuickFilters(tab *TabState, names []string) {
+	if len(names) == 0 {
+		tab.FilterMode = ""
+		tab.FilterValue = ""
+		tab.FilteredPRs = tab.PRs
+		tab.StatusMsg = "Quick filters cleared"
+		m.updateTableRows(tab)
+		return
+	}
+
+	tab.FilterMode = "quick"
+	tab.FilterValue = services.EncodeQuickFilters(names, m.viewerLogin)
+	tab.FilteredPRs = m.filterQuick(tab.PRs, tab.EnhancedData, tab.FilterValue)
+	labels := make([]string, len(names))
+	for i, name := range names {
+		labels[i] = quickFilterLabels[name]
+	}
+	tab.StatusMsg = fmt.Sprintf("⚡ %s (%d) - 0 to clear", strings.Join(labels, " + "), len(tab.FilteredPRs))
+	m.updateTableRows(tab)
+}
+
+// filterQuick applies quick filters, attaching enhanced data since reviews,
+// checks and mergeability are only known after enhancement
+func (m *MultiTabModel) filterQuick(prs []*gh.PullRequest, enhancedData map[int]types.EnhancedData, value string) []*gh.PullRequest {
+	prData := make([]*types.PRData, len(prs))
+	for i, pr := range prs {
+		prData[i] = &types.PRData{PullRequest: pr}
+		if enhanced, exists := enhancedData[pr.GetNumber()]; exists {
+			enhancedCopy := enhanced
+			prData[i].Enhanced = &enhancedCopy
+		}
+	}
+	return m.controller.ApplyFilter(prData, types.FilterOptions{Mode: "quick", Value: value}).FilteredPRs
+}
+
+// renderQuickFilterBar renders the number-key row above the table: each
+// quick filter with how many of the tab's PRs it matches, then the
+// configured presets. Active entries are highlighted.
+func (m *MultiTabModel) renderQuickFilterBar(tab *TabState) string {
+	active := activeQuickFilters(tab)
+	activeStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.Background)).Background(lipgloss.Color(theme.Accent))
+
+	var chips []string
+	for i, name := range services.QuickFilters {
+		chip := fmt.Sprintf("%d %s", i+1, quickFilterLabels[name])
+		if name != "mine" || m.viewerLogin != "" {
+			value := services.EncodeQuickFilters([]string{name}, m.viewerLogin)
+			chip += fmt.Sprintf(" %d", len(m.filterQuick(tab.PRs, tab.EnhancedData, value)))
+		}
+		if slices.Contains(active, name) {
+			chips = append(chips, activeStyle.Render(" "+chip+" "))
+		} else {
+			chips = append(chips, mutedStyle.Render(" "+chip+" "))
+		}
+	}
+
+	activePreset := m.activePreset(tab)
+	for i, preset := range m.FilterPresets {
+		chip := fmt.Sprintf("%d %s", presetKeyOffset+i+1, preset.Name)
+		if i == activePreset {
+			chips = append(chips, activeStyle.Render(" "+chip+" "))
+		} else {
+			chips = append(chips, mutedStyle.Render(" "+chip+" "))
+		}
+	}
+
+	return lipgloss.NewStyle().MaxWidth(m.Width-containerChrome).Render("⚡"+strings.Join(chips, "")) + "\n"
+}
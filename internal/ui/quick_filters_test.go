@@ -0,0 +1,78 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestQuickFilters tests toggling and combining quick filters with 1-5
+func TestQuickFilters(t *testing.T) {
+	model, tab := presetTestModel(t)
+	model.viewerLogin = "alice"
+	tab.EnhancedData = map[int]types.EnhancedData{
+		1: {Number: 1, ChecksStatus: "failure", ReviewStatus: "approved"},
+		3: {Number: 3, ChecksStatus: "failure", ReviewStatus: "no_review"},
+	}
+	press := func(key string) { model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}) }
+
+	press("3")
+	if tab.FilterMode != "quick" || len(tab.FilteredPRs) != 2 {
+		t.Fatalf("Expected Failing to show PRs 1 and 3, got %d PRs", len(tab.FilteredPRs))
+	}
+	press("1")
+	if len(tab.FilteredPRs) != 1 || tab.FilteredPRs[0].GetNumber() != 1 || !strings.Contains(tab.StatusMsg, "Mine + Failing (1)") {
+		t.Errorf("Expected Mine and Failing to combine into PR 1, got %d PRs (%q)", len(tab.FilteredPRs), tab.StatusMsg)
+	}
+
+	bar := model.renderQuickFilterBar(tab)
+	for _, want := range []string{"1 Mine 2", "2 Needs review 1", "3 Failing 2", "4 Drafts 1", "5 Conflicts 0", "6 Mine ready", "7 Bugs"} {
+		if !strings.Contains(bar, want) {
+			t.Errorf("Expected %q on the quick filter bar, got %q", want, bar)
+		}
+	}
+
+	press("3")
+	if tab.FilterValue != "mine=alice" || len(tab.FilteredPRs) != 2 {
+		t.Errorf("Expected toggling Failing off to leave Mine, got %q", tab.FilterValue)
+	}
+
+	// A preset replaces quick filters, and a quick filter replaces a preset
+	press("7")
+	if tab.FilterMode != "preset" {
+		t.Errorf("Expected the preset to replace quick filters, got %q", tab.FilterMode)
+	}
+	press("4")
+	if tab.FilterValue != "drafts" || model.Presets.Get("Main") != "" {
+		t.Errorf("Expected Drafts alone and the preset forgotten, got %q", tab.FilterValue)
+	}
+
+	// Refreshes and enhancement keep the filter applied
+	model.setTabPRs(tab, append(tab.PRs, &gh.PullRequest{Number: gh.Int(4), Draft: gh.Bool(true)}))
+	if len(tab.FilteredPRs) != 2 {
+		t.Errorf("Expected the new draft to be included after refresh, got %d PRs", len(tab.FilteredPRs))
+	}
+
+	press("0")
+	if tab.FilterMode != "" || len(tab.FilteredPRs) != 4 {
+		t.Errorf("Expected 0 to clear quick filters, got mode %q", tab.FilterMode)
+	}
+}
+
+// TestQuickFilterMineLooksUpLogin tests that Mine waits for the current user's login
+func TestQuickFilterMineLooksUpLogin(t *testing.T) {
+	model, tab := presetTestModel(t)
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	if cmd == nil || tab.FilterMode != "" {
+		t.Fatal("Expected Mine to look up the login before filtering")
+	}
+
+	model.Update(viewerLoginMsg{tabName: "Main", quickFilter: true, login: "bob"})
+	if tab.FilterValue != "mine=bob" || len(tab.FilteredPRs) != 1 {
+		t.Errorf("Expected bob's PR once the login arrived, got %q with %d PRs", tab.FilterValue, len(tab.FilteredPRs))
+	}
+}
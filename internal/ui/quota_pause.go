@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// defaultEnhancementQuotaFloor is the remaining GraphQL quota below which
+// enhancement pauses when the config doesn't set a floor
+const defaultEnhancementQuotaFloor = 100
+
+// enhancementQuotaResource is the API quota enhancement draws from
+const enhancementQuotaResource = "graphql"
+
+// observedQuota reports the quota GitHub last returned for a resource;
+// tests replace it
+var observedQuota = github.ObservedRateLimit
+
+// quotaResumeMsg arrives when the quota window that paused enhancement resets
+type quotaResumeMsg struct{}
+
+// validateQuotaFloor checks the configured enhancement quota floor
+func validateQuotaFloor(floor int) error {
+	if floor < 0 {
+		return fmt.Errorf("enhancement_quota_floor must not be negative")
+	}
+	return nil
+}
+
+// quotaFloor returns the configured floor, or the default when unset
+func (m *MultiTabModel) quotaFloor() int {
+	if m.EnhancementQuotaFloor > 0 {
+		return m.EnhancementQuotaFloor
+	}
+	return defaultEnhancementQuotaFloor
+}
+
+// lowQuota reports whether GitHub last reported less enhancement quota than
+// the floor, and when that quota resets. A window that already reset counts
+// as replenished.
+func (m *MultiTabModel) lowQuota(now time.Time) (github.RateLimit, bool) {
+	limit, ok := observedQuota(enhancementQuotaResource)
+	if !ok || !limit.Reset.After(now) {
+		return limit, false
+	}
+	return limit, limit.Remaining < m.quotaFloor()
+}
+
+// pauseEnhancementForQuota stops a tab's enhancement run until the quota
+// resets, keeping what was enhanced so far. It returns the command that
+// resumes enhancement, or nil when a resume is already scheduled.
+func (m *MultiTabModel) pauseEnhancementForQuota(tab *TabState, limit github.RateLimit) tea.Cmd {
+	// The run resumes as a new one, counting only the PRs still missing
+	tab.Progress = EnhancementProgress{}
+
+	if m.quotaPausedUntil.Equal(limit.Reset) {
+		return nil
+	}
+	m.quotaPausedUntil = limit.Reset
+	m.Log.Warn("Enhancement paused for API quota", "tab", tab.Config.Name, "remaining", limit.Remaining, "resets", limit.Reset.Format("15:04:05"))
+	return tea.Tick(time.Until(limit.Reset), func(time.Time) tea.Msg {
+		return quotaResumeMsg{}
+	})
+}
+
+// handleQuotaResume restarts enhancement for the active tab once the quota
+// window has reset
+func (m *MultiTabModel) handleQuotaResume() (tea.Model, tea.Cmd) {
+	m.quotaPausedUntil = time.Time{}
+	m.Log.Info("Enhancement resumed, API quota reset")
+	if tab := m.TabManager.GetActiveTab(); tab != nil {
+		return m, m.startEnhancementForTab(tab)
+	}
+	return m, nil
+}
+
+// quotaPauseBanner explains why PR details stopped loading and when they
+// resume, or returns "" while enhancement isn't paused
+func (m *MultiTabModel) quotaPauseBanner(now time.Time) string {
+	if m.quotaPausedUntil.IsZero() || !m.quotaPausedUntil.After(now) {
+		return ""
+	}
+	limit, _ := observedQuota(enhancementQuotaResource)
+	return fmt.Sprintf("⏸️  Detail loading paused: low API quota (%d left) - resumes at %s • lists keep refreshing",
+		limit.Remaining, m.quotaPausedUntil.Local().Format("15:04"))
+}
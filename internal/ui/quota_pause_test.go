@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// withObservedQuota makes the model see the given GraphQL quota
+func withObservedQuota(t *testing.T, limit github.RateLimit) {
+	t.Helper()
+	original := observedQuota
+	observedQuota = func(string) (github.RateLimit, bool) { return limit, true }
+	t.Cleanup(func() { observedQuota = original })
+}
+
+// TestLowQuotaPausesEnhancement tests that enhancement pauses below the quota floor and resumes after the reset
+func TestLowQuotaPausesEnhancement(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Main", Mode: "repos", Repos: []string{"org/api"}})
+	tab.PRs = []*gh.PullRequest{{Number: gh.Int(1), Title: gh.String("Fix")}}
+	tab.Progress.Start(3, time.Now())
+
+	reset := time.Now().Add(20 * time.Minute)
+	withObservedQuota(t, github.RateLimit{Limit: 5000, Remaining: 40, Reset: reset})
+
+	if cmd := model.startEnhancementForTab(tab); cmd == nil {
+		t.Error("Expected a command resuming enhancement after the reset")
+	}
+	if len(tab.EnhancementQueue) != 0 || tab.Progress.Active() {
+		t.Error("Expected no enhancement and no active run while quota is low")
+	}
+	if cmd := model.startEnhancementForTab(tab); cmd != nil {
+		t.Error("Expected the resume to be scheduled only once")
+	}
+	if bar := model.renderTabBar(); !strings.Contains(bar, "Detail loading paused: low API quota (40 left)") ||
+		!strings.Contains(bar, reset.Format("15:04")) {
+		t.Errorf("Expected quota pause banner, got:\n%s", bar)
+	}
+
+	// Above the floor, or once the window reset, enhancement runs again
+	withObservedQuota(t, github.RateLimit{Limit: 5000, Remaining: 4999, Reset: time.Now().Add(time.Hour)})
+	model.TabManager.SwitchToTab(0)
+	if _, cmd := model.handleQuotaResume(); cmd == nil || len(tab.EnhancementQueue) != 1 {
+		t.Error("Expected enhancement to resume")
+	}
+	if bar := model.renderTabBar(); strings.Contains(bar, "Detail loading paused") {
+		t.Errorf("Expected no pause banner after resuming, got:\n%s", bar)
+	}
+}
+
+// TestQuotaFloor tests the configurable floor and its validation
+func TestQuotaFloor(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	withObservedQuota(t, github.RateLimit{Remaining: 150, Reset: time.Now().Add(time.Hour)})
+
+	if _, low := model.lowQuota(time.Now()); low {
+		t.Error("Expected 150 requests to be above the default floor")
+	}
+	model.EnhancementQuotaFloor = 200
+	if _, low := model.lowQuota(time.Now()); !low {
+		t.Error("Expected 150 requests to be below a floor of 200")
+	}
+	if _, low := model.lowQuota(time.Now().Add(2 * time.Hour)); low {
+		t.Error("Expected a quota whose window reset to count as replenished")
+	}
+
+	if err := validateQuotaFloor(-1); err == nil {
+		t.Error("Expected a negative floor to be rejected")
+	}
+}
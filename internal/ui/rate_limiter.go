@@ -16,13 +16,21 @@ type GlobalRateLimiter struct {
 	resetTime         time.Time
 	lastUpdate        time.Time
 
-	// Request queue for prioritization
+	// Incoming requests, moved into per-tab queues by the processor
 	requestQueue  chan *RateLimitedRequest
 	priorityQueue chan *RateLimitedRequest
 
+	// Per-tab fairness: tabs take turns in a weighted round-robin so one
+	// busy tab can't starve the others
+	tabQueues  map[string]*tabQueue // tab name -> waiting requests
+	tabRing    []string             // Tabs with waiting requests, in turn order
+	ringPos    int                  // Index in tabRing of the tab whose turn it is
+	tabWeights map[string]int       // tab name -> requests dispatched per turn
+
 	// Tab coordination
 	activeRequests map[string]int // tab name -> active request count
 	maxConcurrent  int
+	maxPerTab      int // Concurrent requests one tab may hold while others wait
 
 	// Shared resources
 	sharedCache *SharedCache
@@ -35,6 +43,15 @@ type RateLimitedRequest struct {
 	RequestFunc func(context.Context) error
 	ResultChan  chan error
 	Timeout     time.Duration
+	Ctx         context.Context // Cancels the request while queued or running; nil for none
+}
+
+// requestContext returns the request's context, or the background one when unset
+func (req *RateLimitedRequest) requestContext() context.Context {
+	if req.Ctx != nil {
+		return req.Ctx
+	}
+	return context.Background()
 }
 
 // RequestPriority defines the priority levels for requests
@@ -47,6 +64,13 @@ const (
 	PriorityUrgent // User-initiated actions
 )
 
+// tabQueue holds one tab's requests waiting for dispatch
+type tabQueue struct {
+	urgent []*RateLimitedRequest // PriorityHigh and above
+	normal []*RateLimitedRequest
+	credit int // Normal requests left in the tab's current turn
+}
+
 // SharedCache manages cached data across all tabs to reduce API calls
 type SharedCache struct {
 	mu               sync.RWMutex
@@ -86,22 +110,30 @@ type CachedEnhancementData struct {
 
 // NewGlobalRateLimiter creates a new global rate limiter
 func NewGlobalRateLimiter() *GlobalRateLimiter {
-	limiter := &GlobalRateLimiter{
+	limiter := newGlobalRateLimiter()
+
+	// Start the request processor
+	go limiter.processRequests()
+
+	return limiter
+}
+
+// newGlobalRateLimiter creates a rate limiter without starting its processor
+func newGlobalRateLimiter() *GlobalRateLimiter {
+	return &GlobalRateLimiter{
 		requestsPerHour:   5000, // GitHub's limit for authenticated users
 		requestsRemaining: 5000,
 		resetTime:         time.Now().Add(time.Hour),
 		lastUpdate:        time.Now(),
 		requestQueue:      make(chan *RateLimitedRequest, 100),
 		priorityQueue:     make(chan *RateLimitedRequest, 50),
+		tabQueues:         make(map[string]*tabQueue),
+		tabWeights:        make(map[string]int),
 		activeRequests:    make(map[string]int),
 		maxConcurrent:     10, // Conservative limit for concurrent requests
+		maxPerTab:         5,  // Half the slots, so other tabs always get a share
 		sharedCache:       NewSharedCache(),
 	}
-
-	// Start the request processor
-	go limiter.processRequests()
-
-	return limiter
 }
 
 // NewSharedCache creates a new shared cache
@@ -126,11 +158,17 @@ func (rl *GlobalRateLimiter) RequestWithRateLimit(req *RateLimitedRequest) error
 	}
 
 	// Choose the appropriate queue based on priority
+	queue := rl.requestQueue
+	if req.Priority >= PriorityHigh {
+		queue = rl.priorityQueue
+	}
+
+	ctx := req.requestContext()
 	select {
-	case rl.priorityQueue <- req:
-		// High priority request queued
-	case rl.requestQueue <- req:
-		// Normal priority request queued
+	case queue <- req:
+		// Request queued
+	case <-ctx.Done():
+		return ctx.Err()
 	case <-time.After(req.Timeout):
 		return errors.NewGitHubRateLimitError("", nil)
 	}
@@ -139,6 +177,8 @@ func (rl *GlobalRateLimiter) RequestWithRateLimit(req *RateLimitedRequest) error
 	select {
 	case err := <-req.ResultChan:
 		return err
+	case <-ctx.Done():
+		return ctx.Err()
 	case <-time.After(req.Timeout):
 		return errors.NewGitHubRateLimitError("", nil)
 	}
@@ -167,6 +207,8 @@ func (rl *GlobalRateLimiter) processNextRequest() {
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 
+	rl.drainIncoming()
+
 	// Check if we can make more requests
 	totalActive := 0
 	for _, count := range rl.activeRequests {
@@ -181,20 +223,9 @@ func (rl *GlobalRateLimiter) processNextRequest() {
 		return // Too close to rate limit
 	}
 
-	// Try priority queue first, then normal queue
-	var req *RateLimitedRequest
-	select {
-	case req = <-rl.priorityQueue:
-		// Got priority request
-	case req = <-rl.requestQueue:
-		// Got normal request
-	default:
-		return // No requests waiting
-	}
-
-	// Safety check
+	req := rl.nextRequest()
 	if req == nil {
-		return
+		return // No requests waiting
 	}
 
 	// Track active request
@@ -204,6 +235,169 @@ func (rl *GlobalRateLimiter) processNextRequest() {
 	go rl.executeRequest(req)
 }
 
+// SetTabWeight sets how many normal requests a tab may dispatch per
+// round-robin turn (minimum 1)
+func (rl *GlobalRateLimiter) SetTabWeight(tabName string, weight int) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if weight < 1 {
+		weight = 1
+	}
+	rl.tabWeights[tabName] = weight
+}
+
+// QueuedRequests returns how many requests each tab has waiting
+func (rl *GlobalRateLimiter) QueuedRequests() map[string]int {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+
+	queued := make(map[string]int, len(rl.tabQueues))
+	for name, q := range rl.tabQueues {
+		queued[name] = len(q.urgent) + len(q.normal)
+	}
+	return queued
+}
+
+// drainIncoming moves newly submitted requests into their tab's queue; the
+// caller must hold the lock
+func (rl *GlobalRateLimiter) drainIncoming() {
+	for {
+		var req *RateLimitedRequest
+		var ok bool
+		select {
+		case req, ok = <-rl.priorityQueue:
+		case req, ok = <-rl.requestQueue:
+		default:
+			return
+		}
+		if !ok || req == nil {
+			return // Channel closed during shutdown
+		}
+		rl.enqueue(req)
+	}
+}
+
+// enqueue adds a request to its tab's queue, giving the tab a place in the
+// rotation if it had nothing waiting; the caller must hold the lock
+func (rl *GlobalRateLimiter) enqueue(req *RateLimitedRequest) {
+	q, ok := rl.tabQueues[req.TabName]
+	if !ok {
+		q = &tabQueue{credit: rl.tabWeight(req.TabName)}
+		rl.tabQueues[req.TabName] = q
+		rl.tabRing = append(rl.tabRing, req.TabName)
+	}
+
+	if req.Priority >= PriorityHigh {
+		q.urgent = append(q.urgent, req)
+	} else {
+		q.normal = append(q.normal, req)
+	}
+}
+
+// nextRequest picks the next request to dispatch. Urgent requests from any
+// tab go first; normal requests are taken in a weighted round-robin over
+// tabs. The caller must hold the lock.
+func (rl *GlobalRateLimiter) nextRequest() *RateLimitedRequest {
+	if req := rl.takeUrgent(); req != nil {
+		return req
+	}
+	return rl.takeNormal()
+}
+
+// takeUrgent takes the first urgent request found from the tab whose turn
+// it is onwards, without using up anyone's turn
+func (rl *GlobalRateLimiter) takeUrgent() *RateLimitedRequest {
+	for step := 0; step < len(rl.tabRing); step++ {
+		name := rl.tabRing[(rl.ringPos+step)%len(rl.tabRing)]
+		q := rl.tabQueues[name]
+		if len(q.urgent) == 0 || rl.atTabShare(name) {
+			continue
+		}
+
+		req := q.urgent[0]
+		q.urgent = q.urgent[1:]
+		rl.removeIfIdle(name)
+		return req
+	}
+	return nil
+}
+
+// takeNormal takes a normal request from the tab whose turn it is, passing
+// the turn on when the tab has nothing waiting, is at its concurrency share,
+// or has used up its weight
+func (rl *GlobalRateLimiter) takeNormal() *RateLimitedRequest {
+	for step := 0; step < len(rl.tabRing); step++ {
+		name := rl.tabRing[rl.ringPos]
+		q := rl.tabQueues[name]
+		if len(q.normal) == 0 || rl.atTabShare(name) {
+			rl.endTurn(q, name)
+			continue
+		}
+
+		req := q.normal[0]
+		q.normal = q.normal[1:]
+		q.credit--
+		if q.credit <= 0 {
+			rl.endTurn(q, name)
+		}
+		rl.removeIfIdle(name)
+		return req
+	}
+	return nil
+}
+
+// endTurn passes the turn to the next tab in the ring
+func (rl *GlobalRateLimiter) endTurn(q *tabQueue, name string) {
+	q.credit = rl.tabWeight(name)
+	rl.ringPos = (rl.ringPos + 1) % len(rl.tabRing)
+}
+
+// removeIfIdle drops a tab from the rotation once it has nothing waiting
+func (rl *GlobalRateLimiter) removeIfIdle(name string) {
+	q := rl.tabQueues[name]
+	if len(q.urgent) > 0 || len(q.normal) > 0 {
+		return
+	}
+	delete(rl.tabQueues, name)
+
+	for i, ringName := range rl.tabRing {
+		if ringName != name {
+			continue
+		}
+		rl.tabRing = append(rl.tabRing[:i], rl.tabRing[i+1:]...)
+		if i < rl.ringPos {
+			rl.ringPos--
+		}
+		break
+	}
+	if rl.ringPos >= len(rl.tabRing) {
+		rl.ringPos = 0
+	}
+}
+
+// atTabShare reports whether a tab already holds its share of concurrent
+// requests while other tabs are waiting
+func (rl *GlobalRateLimiter) atTabShare(name string) bool {
+	if rl.activeRequests[name] < rl.maxPerTab {
+		return false
+	}
+	for other := range rl.tabQueues {
+		if other != name {
+			return true
+		}
+	}
+	return false
+}
+
+// tabWeight returns the configured weight for a tab, defaulting to 1
+func (rl *GlobalRateLimiter) tabWeight(name string) int {
+	if weight, ok := rl.tabWeights[name]; ok {
+		return weight
+	}
+	return 1
+}
+
 // executeRequest executes a rate-limited request
 func (rl *GlobalRateLimiter) executeRequest(req *RateLimitedRequest) {
 	defer func() {
@@ -216,17 +410,31 @@ func (rl *GlobalRateLimiter) executeRequest(req *RateLimitedRequest) {
 		rl.mu.Unlock()
 	}()
 
-	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), req.Timeout)
+	// A request cancelled while it waited isn't sent
+	if err := req.requestContext().Err(); err != nil {
+		select {
+		case req.ResultChan <- err:
+		default:
+		}
+		return
+	}
+
+	// Create context with timeout, cancelled along with the request's
+	ctx, cancel := context.WithTimeout(req.requestContext(), req.Timeout)
 	defer cancel()
 
 	// Execute the request
 	err := req.RequestFunc(ctx)
 
-	// Update rate limit counters
-	rl.mu.Lock()
-	rl.requestsRemaining--
-	rl.mu.Unlock()
+	// Update rate limit counters, preferring the quota GitHub reported:
+	// conditional requests answered with 304 don't use any of it
+	if limit, ok := observedQuota("core"); ok {
+		rl.UpdateFromGitHubHeaders(limit.Remaining, limit.Reset)
+	} else {
+		rl.mu.Lock()
+		rl.requestsRemaining--
+		rl.mu.Unlock()
+	}
 
 	// Send result
 	select {
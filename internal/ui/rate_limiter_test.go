@@ -2,10 +2,13 @@ package ui
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"sync"
 	"testing"
 	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
 )
 
 // TestGlobalRateLimiter tests the global rate limiter functionality
@@ -115,6 +118,35 @@ func TestRateLimitedRequest(t *testing.T) {
 	}
 }
 
+// TestRateLimiterFollowsObservedQuota tests that requests count against the
+// quota GitHub reported, so revalidated pages don't use up the budget
+func TestRateLimiterFollowsObservedQuota(t *testing.T) {
+	limiter := NewGlobalRateLimiter()
+	defer func() {
+		close(limiter.requestQueue)
+		close(limiter.priorityQueue)
+	}()
+	reset := time.Now().Add(20 * time.Minute).Truncate(time.Second)
+	withObservedQuota(t, github.RateLimit{Limit: 5000, Remaining: 4990, Reset: reset})
+
+	for i := 0; i < 3; i++ {
+		err := limiter.RequestWithRateLimit(&RateLimitedRequest{
+			TabName:     "test-tab",
+			Priority:    PriorityNormal,
+			Timeout:     time.Second,
+			ResultChan:  make(chan error, 1),
+			RequestFunc: func(ctx context.Context) error { return nil },
+		})
+		if err != nil {
+			t.Fatalf("RequestWithRateLimit() error = %v", err)
+		}
+	}
+
+	if remaining, resetTime := limiter.GetRateLimitStatus(); remaining != 4990 || !resetTime.Equal(reset) {
+		t.Errorf("Expected the observed quota (4990), got %d resetting at %v", remaining, resetTime)
+	}
+}
+
 // TestRateLimitPrioritization tests that high priority requests are processed first
 func TestRateLimitPrioritization(t *testing.T) {
 	limiter := NewGlobalRateLimiter()
@@ -363,3 +395,138 @@ func TestSharedCacheTabUsage(t *testing.T) {
 		t.Error("Expected tab2 to be tracked in TabsUsing")
 	}
 }
+
+// dispatchOrder drains the limiter's queues and returns the tab of each request in dispatch order
+func dispatchOrder(limiter *GlobalRateLimiter) []string {
+	var order []string
+	for req := limiter.nextRequest(); req != nil; req = limiter.nextRequest() {
+		order = append(order, req.TabName)
+	}
+	return order
+}
+
+func queueRequests(limiter *GlobalRateLimiter, tabName string, priority RequestPriority, count int) {
+	for i := 0; i < count; i++ {
+		limiter.enqueue(&RateLimitedRequest{TabName: tabName, Priority: priority})
+	}
+}
+
+// TestRateLimiterRoundRobinAcrossTabs tests that a busy tab can't starve a small one
+func TestRateLimiterRoundRobinAcrossTabs(t *testing.T) {
+	limiter := newGlobalRateLimiter()
+	queueRequests(limiter, "big-org", PriorityNormal, 5)
+	queueRequests(limiter, "small", PriorityNormal, 2)
+
+	got := strings.Join(dispatchOrder(limiter), ",")
+	want := "big-org,small,big-org,small,big-org,big-org,big-org"
+	if got != want {
+		t.Errorf("dispatch order = %s, want %s", got, want)
+	}
+	if len(limiter.tabRing) != 0 || len(limiter.tabQueues) != 0 {
+		t.Errorf("expected empty rotation after draining, got ring %v", limiter.tabRing)
+	}
+}
+
+// TestRateLimiterWeightedTurns tests that tab weights set how many requests each turn dispatches
+func TestRateLimiterWeightedTurns(t *testing.T) {
+	limiter := newGlobalRateLimiter()
+	limiter.SetTabWeight("fast", 2)
+	queueRequests(limiter, "fast", PriorityNormal, 4)
+	queueRequests(limiter, "slow", PriorityNormal, 3)
+
+	got := strings.Join(dispatchOrder(limiter), ",")
+	want := "fast,fast,slow,fast,fast,slow,slow"
+	if got != want {
+		t.Errorf("dispatch order = %s, want %s", got, want)
+	}
+}
+
+// TestRateLimiterUrgentFirst tests that urgent requests jump ahead without costing a turn
+func TestRateLimiterUrgentFirst(t *testing.T) {
+	limiter := newGlobalRateLimiter()
+	queueRequests(limiter, "a", PriorityNormal, 2)
+	queueRequests(limiter, "b", PriorityNormal, 1)
+	queueRequests(limiter, "b", PriorityUrgent, 1)
+
+	order := dispatchOrder(limiter)
+	if got := strings.Join(order, ","); got != "b,a,b,a" {
+		t.Errorf("dispatch order = %s, want b,a,b,a", got)
+	}
+}
+
+// TestRateLimiterTabShare tests that a tab holding its share of slots waits while others have work
+func TestRateLimiterTabShare(t *testing.T) {
+	limiter := newGlobalRateLimiter()
+	limiter.activeRequests["big-org"] = limiter.maxPerTab
+	queueRequests(limiter, "big-org", PriorityNormal, 3)
+	queueRequests(limiter, "small", PriorityNormal, 1)
+
+	if req := limiter.nextRequest(); req == nil || req.TabName != "small" {
+		t.Fatalf("expected small tab's request while big-org is at its share, got %v", req)
+	}
+
+	// With no one else waiting the busy tab may use the remaining slots
+	if req := limiter.nextRequest(); req == nil || req.TabName != "big-org" {
+		t.Fatalf("expected big-org request once it is the only tab waiting, got %v", req)
+	}
+	if queued := limiter.QueuedRequests()["big-org"]; queued != 2 {
+		t.Errorf("expected 2 queued big-org requests, got %d", queued)
+	}
+}
+
+// TestRateLimitedRequestCancel tests that cancelling a request's context,
+// as quitting does, ends it both while queued and while running
+func TestRateLimitedRequestCancel(t *testing.T) {
+	t.Run("queued", func(t *testing.T) {
+		limiter := newGlobalRateLimiter() // No processor, so the request stays queued
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(50*time.Millisecond, cancel)
+
+		err := limiter.RequestWithRateLimit(&RateLimitedRequest{
+			TabName:     "test-tab",
+			Timeout:     5 * time.Second,
+			ResultChan:  make(chan error, 1),
+			Ctx:         ctx,
+			RequestFunc: func(ctx context.Context) error { return nil },
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("in flight", func(t *testing.T) {
+		limiter := NewGlobalRateLimiter()
+		defer func() {
+			close(limiter.requestQueue)
+			close(limiter.priorityQueue)
+		}()
+		ctx, cancel := context.WithCancel(context.Background())
+		started := make(chan struct{})
+		finished := make(chan error, 1)
+
+		go func() {
+			finished <- limiter.RequestWithRateLimit(&RateLimitedRequest{
+				TabName:    "test-tab",
+				Timeout:    5 * time.Second,
+				ResultChan: make(chan error, 1),
+				Ctx:        ctx,
+				RequestFunc: func(ctx context.Context) error {
+					close(started)
+					<-ctx.Done()
+					return ctx.Err()
+				},
+			})
+		}()
+		<-started
+		cancel()
+
+		select {
+		case err := <-finished:
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("Expected context.Canceled, got %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Expected the request to end once cancelled")
+		}
+	})
+}
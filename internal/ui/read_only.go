@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bjess9/pr-compass/internal/github"
+)
+
+// publicModeRefreshMinutes is the minimum auto-refresh interval without a
+// token, keeping a handful of tabs inside the unauthenticated hourly budget
+const publicModeRefreshMinutes = 30
+
+// readOnly reports whether the model runs without a token against public repos only
+func (m *MultiTabModel) readOnly() bool {
+	return m.TabManager != nil && m.TabManager.Token == ""
+}
+
+// readOnlyBanner warns that the unauthenticated budget limits what PR Compass can do
+func readOnlyBanner() string {
+	return fmt.Sprintf("🔓 Public read-only mode: %d API requests/hour, PR details and actions disabled • set GITHUB_TOKEN for full access",
+		github.UnauthenticatedRequestsPerHour)
+}
+
+// readOnlyActionMsg explains why an action that writes to GitHub is unavailable
+const readOnlyActionMsg = "Read-only mode: set GITHUB_TOKEN to approve, merge, comment on or request reviews for PRs"
+
+// gitHubOnlyMsg explains why an action is unavailable on a GitLab or Gitea tab
+const gitHubOnlyMsg = "Not available for GitLab or Gitea PRs: actions and details use the GitHub API"
+
+// errGitHubOnly is recorded as the details error for PRs on non-GitHub tabs
+var errGitHubOnly = errors.New("reviews and details are only available for GitHub pull requests")
+
+// writeUnavailable reports whether actions that write to GitHub are
+// unavailable on the tab, explaining why in the status line
+func (m *MultiTabModel) writeUnavailable(tab *TabState) bool {
+	switch {
+	case m.readOnly():
+		tab.StatusMsg = readOnlyActionMsg
+	case !tab.Config.OnGitHub():
+		tab.StatusMsg = gitHubOnlyMsg
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,200 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+const (
+	// defaultRecentlyCompletedMinutes is how long merged and closed PRs stay
+	// below the table when the config doesn't say
+	defaultRecentlyCompletedMinutes = 15
+
+	// recentlyCompletedLookups caps the PRs that left a list looked up per
+	// refresh, so a tab whose scope changed doesn't spend much quota
+	recentlyCompletedLookups = 10
+
+	// recentlyCompletedLines caps the lines the section takes below the table
+	recentlyCompletedLines = 3
+)
+
+// CompletedPR is a PR that merged or closed while the session was running
+type CompletedPR struct {
+	PR *gh.PullRequest // As fetched after it left the list
+	At time.Time       // When it was merged or closed
+}
+
+// completedPRsMsg delivers the current state of PRs that left a tab's list
+type completedPRsMsg struct {
+	tabName string
+	prs     []*gh.PullRequest
+}
+
+// validateRecentlyCompletedMinutes checks the configured time merged and
+// closed PRs stay visible
+func validateRecentlyCompletedMinutes(minutes int) error {
+	if minutes < 0 {
+		return fmt.Errorf("recently_completed_minutes must not be negative")
+	}
+	return nil
+}
+
+// recentlyCompletedWindow returns how long merged and closed PRs stay visible
+func (m *MultiTabModel) recentlyCompletedWindow() time.Duration {
+	if m.RecentlyCompletedMinutes > 0 {
+		return time.Duration(m.RecentlyCompletedMinutes) * time.Minute
+	}
+	return defaultRecentlyCompletedMinutes * time.Minute
+}
+
+// vanishedPRs returns the PRs of the previous list missing from the current one
+func vanishedPRs(previous, current []*gh.PullRequest) []*gh.PullRequest {
+	present := make(map[string]bool, len(current))
+	for _, pr := range current {
+		present[services.PRKey(pr)] = true
+	}
+
+	var vanished []*gh.PullRequest
+	for _, pr := range previous {
+		if !present[services.PRKey(pr)] {
+			vanished = append(vanished, pr)
+		}
+	}
+	return vanished
+}
+
+// completedLookupCmd looks up PRs that left a tab's list, since a PR also
+// leaves when it stops matching the tab, e.g. after a label change
+func (m *MultiTabModel) completedLookupCmd(tab *TabState, vanished []*gh.PullRequest) tea.Cmd {
+	if len(vanished) == 0 || m.readOnly() || !tab.Config.OnGitHub() {
+		return nil
+	}
+	vanished = vanished[:min(len(vanished), recentlyCompletedLookups)]
+
+	token := m.TabManager.Token
+	tabName := tab.Config.Name
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 20*time.Second)
+		defer cancel()
+
+		var current []*gh.PullRequest
+		for _, pr := range vanished {
+			fetched, err := github.FetchPullRequest(ctx, token, pr)
+			if err != nil {
+				continue // Deleted or inaccessible; nothing to show
+			}
+			current = append(current, fetched)
+		}
+		return completedPRsMsg{tabName: tabName, prs: current}
+	}
+}
+
+// handleCompletedPRs adds PRs that merged or closed within the window to
+// the tab's recently completed section
+func (m *MultiTabModel) handleCompletedPRs(msg completedPRsMsg) (tea.Model, tea.Cmd) {
+	var tab *TabState
+	for _, candidate := range m.TabManager.Tabs {
+		if candidate.Config.Name == msg.tabName {
+			tab = candidate
+			break
+		}
+	}
+	if tab == nil {
+		return m, nil // Closed meanwhile
+	}
+
+	now := time.Now()
+	var completed []CompletedPR
+	for _, pr := range msg.prs {
+		// Open PRs merely stopped matching the tab, and PRs that closed long
+		// ago were missing from a stale cached list
+		at := pr.GetClosedAt().Time
+		if pr.GetState() != "closed" || now.Sub(at) > m.recentlyCompletedWindow() {
+			continue
+		}
+		if pr.GetMerged() {
+			at = pr.GetMergedAt().Time
+		}
+		completed = append(completed, CompletedPR{PR: pr, At: at})
+	}
+	m.addRecentlyCompleted(tab, completed)
+	m.recordCompletedActivity(tab, completed)
+	return m, m.pinnedCompletedCmd(completed)
+}
+
+// addRecentlyCompleted adds PRs to a tab's recently completed section,
+// skipping PRs already in it or listed again
+func (m *MultiTabModel) addRecentlyCompleted(tab *TabState, completed []CompletedPR) {
+	listed := make(map[string]bool)
+	for _, pr := range tab.PRs {
+		listed[services.PRKey(pr)] = true
+	}
+	for _, existing := range tab.RecentlyCompleted {
+		listed[services.PRKey(existing.PR)] = true
+	}
+	for _, entry := range completed {
+		if !listed[services.PRKey(entry.PR)] {
+			tab.RecentlyCompleted = append(tab.RecentlyCompleted, entry)
+		}
+	}
+	sort.SliceStable(tab.RecentlyCompleted, func(i, j int) bool {
+		return tab.RecentlyCompleted[i].At.After(tab.RecentlyCompleted[j].At)
+	})
+	tab.Table.SetHeight(m.calculateTableHeight(tab))
+}
+
+// pruneRecentlyCompleted drops PRs past the window and PRs back in the
+// list, e.g. after being reopened
+func (m *MultiTabModel) pruneRecentlyCompleted(tab *TabState, now time.Time) {
+	listed := make(map[string]bool, len(tab.PRs))
+	for _, pr := range tab.PRs {
+		listed[services.PRKey(pr)] = true
+	}
+
+	kept := tab.RecentlyCompleted[:0]
+	for _, completed := range tab.RecentlyCompleted {
+		if now.Sub(completed.At) <= m.recentlyCompletedWindow() && !listed[services.PRKey(completed.PR)] {
+			kept = append(kept, completed)
+		}
+	}
+	tab.RecentlyCompleted = kept
+}
+
+// recentlyCompletedHeight is the lines the section takes below the table,
+// none while the table lists merged and closed PRs itself
+func recentlyCompletedHeight(tab *TabState) int {
+	if tab.ShowHistory {
+		return 0
+	}
+	return min(len(tab.RecentlyCompleted), recentlyCompletedLines)
+}
+
+// renderRecentlyCompleted renders merged and closed PRs dimmed below the
+// table, newest first, e.g. "✓ Merged 4m ago  org/api#12 Add retries @alice"
+func (m *MultiTabModel) renderRecentlyCompleted(tab *TabState, now time.Time) string {
+	var lines []string
+	for i, completed := range tab.RecentlyCompleted[:recentlyCompletedHeight(tab)] {
+		pr := completed.PR
+		verb := "✗ Closed"
+		if pr.GetMerged() {
+			verb = "✓ Merged"
+		}
+		line := fmt.Sprintf("%s %s  %s %s @%s", verb, formatAge(now.Sub(completed.At)), services.PRKey(pr), pr.GetTitle(), pr.GetUser().GetLogin())
+		if more := len(tab.RecentlyCompleted) - recentlyCompletedLines; i == recentlyCompletedLines-1 && more > 0 {
+			line += fmt.Sprintf("  (+%d more)", more)
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return "\n" + mutedStyle.Faint(true).MaxWidth(m.Width-containerChrome).Render(strings.Join(lines, "\n"))
+}
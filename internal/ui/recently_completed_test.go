@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestRecentlyCompleted tests that PRs merging or closing during the session
+// stay visible below the table until the window passes
+func TestRecentlyCompleted(t *testing.T) {
+	model, tab := mergeTestModel("test-token")
+	model.Width = 120
+	previous := tab.PRs
+	now := time.Now()
+	state := func(number int, state string, merged bool, closedAgo time.Duration) *gh.PullRequest {
+		pr := *previous[number-12]
+		pr.State = gh.String(state)
+		pr.Merged = gh.Bool(merged)
+		if state == "closed" {
+			pr.ClosedAt = &gh.Timestamp{Time: now.Add(-closedAgo)}
+			pr.MergedAt = pr.ClosedAt
+		}
+		return &pr
+	}
+
+	// Both PRs left the list; one merged, the other only stopped matching the tab
+	tab.PRs = nil
+	if vanished := vanishedPRs(previous, tab.PRs); len(vanished) != 2 {
+		t.Fatalf("Expected both PRs to have left the list, got %d", len(vanished))
+	}
+	heightBefore := model.calculateTableHeight(tab)
+	model.Update(completedPRsMsg{tabName: "Main", prs: []*gh.PullRequest{state(12, "closed", true, 4*time.Minute), state(13, "open", false, 0)}})
+	if len(tab.RecentlyCompleted) != 1 || tab.RecentlyCompleted[0].PR.GetNumber() != 12 {
+		t.Fatalf("Expected only the merged PR, got %+v", tab.RecentlyCompleted)
+	}
+	if model.calculateTableHeight(tab) != heightBefore-1 {
+		t.Error("Expected the table to make room for the section")
+	}
+	if section := model.renderRecentlyCompleted(tab, now); !strings.Contains(section, "✓ Merged 4m ago  org/api#12 Add retries @alice") {
+		t.Errorf("Expected the merged PR below the table, got %q", section)
+	}
+
+	// Looked up again, or closed long ago (e.g. missing from a stale cached list)
+	model.Update(completedPRsMsg{tabName: "Main", prs: []*gh.PullRequest{state(12, "closed", true, 4*time.Minute), state(13, "closed", false, time.Hour)}})
+	if len(tab.RecentlyCompleted) != 1 {
+		t.Errorf("Expected no duplicates or old closes, got %+v", tab.RecentlyCompleted)
+	}
+
+	// Reopened PRs leave the section, as do PRs past the window
+	model.Update(completedPRsMsg{tabName: "Main", prs: []*gh.PullRequest{state(13, "closed", false, time.Minute)}})
+	tab.PRs = previous[1:]
+	model.pruneRecentlyCompleted(tab, now)
+	if len(tab.RecentlyCompleted) != 1 || tab.RecentlyCompleted[0].PR.GetNumber() != 12 {
+		t.Errorf("Expected the reopened PR dropped, got %+v", tab.RecentlyCompleted)
+	}
+	model.RecentlyCompletedMinutes = 5
+	model.pruneRecentlyCompleted(tab, now.Add(2*time.Minute))
+	if len(tab.RecentlyCompleted) != 0 || model.renderRecentlyCompleted(tab, now) != "" {
+		t.Errorf("Expected the section emptied after the window, got %+v", tab.RecentlyCompleted)
+	}
+
+	if err := validateRecentlyCompletedMinutes(-1); err == nil {
+		t.Error("Expected negative minutes to be rejected")
+	}
+}
+
+// TestRecentlyCompletedLookupGuards tests that lookups need a token and a GitHub tab
+func TestRecentlyCompletedLookupGuards(t *testing.T) {
+	model, tab := mergeTestModel("")
+	if cmd := model.completedLookupCmd(tab, tab.PRs); cmd != nil {
+		t.Error("Expected no lookups in read-only mode")
+	}
+
+	model, tab = mergeTestModel("test-token")
+	if cmd := model.completedLookupCmd(tab, nil); cmd != nil {
+		t.Error("Expected no lookups when no PR left the list")
+	}
+	if cmd := model.completedLookupCmd(tab, tab.PRs); cmd == nil {
+		t.Error("Expected a lookup for PRs that left the list")
+	}
+}
@@ -0,0 +1,199 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// repoMetadataMsg delivers repository metadata fetched for the repo info popup
+type repoMetadataMsg struct {
+	repo     string
+	metadata *cache.RepoMetadata
+	err      error
+}
+
+// repoFullName returns the base repository of a PR as "owner/name"
+func repoFullName(pr *gh.PullRequest) string {
+	if pr == nil || pr.GetBase() == nil || pr.GetBase().GetRepo() == nil {
+		return ""
+	}
+	return pr.GetBase().GetRepo().GetFullName()
+}
+
+// repoMetadataCmd fetches metadata for the selected PR's repository unless it
+// is already known or being fetched
+func (m *MultiTabModel) repoMetadataCmd(tab *TabState) tea.Cmd {
+	repo := repoFullName(tab.SelectedPR())
+	if repo == "" {
+		return nil
+	}
+	if _, known := m.repoMetadata[repo]; known {
+		return nil
+	}
+	if m.repoMetadataLoading[repo] {
+		return nil
+	}
+	return m.fetchRepoMetadataCmd(repo, tab.PRCache)
+}
+
+// stackMetadataConcurrency caps repo metadata fetches in flight for the
+// Language and Topics columns, so org-wide tabs don't burst the API
+const stackMetadataConcurrency = 4
+
+// stackMetadataCmd fetches metadata for repos in a tab showing the Language
+// and Topics columns. Each arrival starts the next fetch until all are known.
+func (m *MultiTabModel) stackMetadataCmd(tab *TabState) tea.Cmd {
+	if !tab.Config.ShowsStackColumns() || m.readOnly() {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	for _, repo := range loadedRepos(tab) {
+		if len(m.repoMetadataLoading) >= stackMetadataConcurrency {
+			break
+		}
+		_, known := m.repoMetadata[repo]
+		if known || m.repoMetadataLoading[repo] || m.repoMetadataErrors[repo] != nil {
+			continue
+		}
+		cmds = append(cmds, m.fetchRepoMetadataCmd(repo, tab.PRCache))
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	m.updateTableRows(tab) // Show loading markers
+	return tea.Batch(cmds...)
+}
+
+// fetchRepoMetadataCmd fetches metadata for one repository, marking it as loading
+func (m *MultiTabModel) fetchRepoMetadataCmd(repo string, prCache *cache.PRCache) tea.Cmd {
+	m.repoMetadataLoading[repo] = true
+
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 10*time.Second)
+		defer cancel()
+
+		metadata, err := github.FetchRepoMetadata(ctx, token, repo, prCache)
+		return repoMetadataMsg{repo: repo, metadata: metadata, err: err}
+	}
+}
+
+// handleRepoMetadata stores fetched repository metadata for the popup and
+// the Language and Topics columns, then continues any pending column fetches
+func (m *MultiTabModel) handleRepoMetadata(msg repoMetadataMsg) (tea.Model, tea.Cmd) {
+	delete(m.repoMetadataLoading, msg.repo)
+	if msg.err != nil {
+		m.repoMetadataErrors[msg.repo] = msg.err
+	} else {
+		delete(m.repoMetadataErrors, msg.repo)
+		m.repoMetadata[msg.repo] = msg.metadata
+	}
+
+	var cmds []tea.Cmd
+	for _, tab := range m.TabManager.Tabs {
+		if !tab.Config.ShowsStackColumns() {
+			continue
+		}
+		m.updateTableRows(tab)
+		if cmd := m.stackMetadataCmd(tab); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	if len(cmds) == 0 {
+		return m, nil
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// rowOptions adds model-wide data, like repo metadata, to the tab's row options
+func (m *MultiTabModel) rowOptions(tab *TabState) tableRowOptions {
+	opts := tab.rowOptions()
+	opts.RequiredChecks = m.requiredChecks
+	opts.TeamMembers = m.teamMembers
+	if columns := tab.Table.Columns(); len(columns) > 0 {
+		opts.TitleWidth = columns[0].Width
+	}
+	if tab.Config.TicketColumn {
+		opts.TicketColumn = true
+		opts.Jira = m.Jira
+		opts.JiraIssues = m.jiraIssues
+		opts.JiraLoading = m.jiraLoading
+	}
+	if tab.Config.ShowsProjectColumn() {
+		opts.ProjectItems = m.projectItems
+		opts.ProjectLoading = m.projectItemsLoading[tab.Config.Name]
+	}
+	if tab.Config.ShowsStackColumns() {
+		opts.StackColumns = true
+		opts.RepoMetadata = m.repoMetadata
+		opts.RepoLoading = m.repoMetadataLoading
+	}
+	return opts
+}
+
+// renderRepoInfo renders the popup describing the selected PR's repository and author
+func (m *MultiTabModel) renderRepoInfo(tab *TabState) string {
+	repo := repoFullName(tab.SelectedPR())
+	if repo == "" {
+		return ""
+	}
+
+	var lines []string
+	switch metadata, known := m.repoMetadata[repo]; {
+	case !tab.Config.OnGitHub():
+		lines = []string{mutedStyle.Render("Repository and author details are only available on GitHub")}
+	case known:
+		lines = repoInfoLines(metadata)
+	case m.repoMetadataErrors[repo] != nil:
+		lines = []string{"🚫 " + m.repoMetadataErrors[repo].Error()}
+	default:
+		lines = []string{"⏳ Loading repository info..."}
+	}
+
+	if line := repoCountLine(tab, repo); line != "" {
+		lines = append(lines, line)
+	}
+	if tab.Config.OnGitHub() {
+		lines = append(lines, m.authorInfoLines(tab.SelectedPR().GetUser().GetLogin(), time.Now())...)
+	}
+	if blocker, ok := m.TabManager.Blockers.Get(tab.SelectedPR()); ok {
+		lines = append(lines, fmt.Sprintf("%s Blocked on: %s (set %s)", blockedMarker, blocker.Note, formatAge(time.Since(blocker.SetAt))))
+	}
+
+	title := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.TextBright)).Render("📦 " + repo)
+	return "\n" + repoInfoStyle.Render(title+"\n"+strings.Join(lines, "\n"))
+}
+
+// repoInfoLines formats repository metadata as popup lines
+func repoInfoLines(metadata *cache.RepoMetadata) []string {
+	valueOr := func(value, fallback string) string {
+		if value == "" {
+			return fallback
+		}
+		return value
+	}
+
+	lines := []string{}
+	if metadata.Description != "" {
+		lines = append(lines, mutedStyle.Render(metadata.Description))
+	}
+	lines = append(lines,
+		fmt.Sprintf("🔤 Language: %s", valueOr(metadata.Language, "unknown")),
+		fmt.Sprintf("🌿 Default branch: %s", valueOr(metadata.DefaultBranch, "unknown")),
+		fmt.Sprintf("📋 Open PRs: %d", metadata.OpenPRs),
+		fmt.Sprintf("🏷️  Topics: %s", valueOr(strings.Join(metadata.Topics, ", "), "none")),
+	)
+	if metadata.Archived {
+		lines = append(lines, "🗄️  Archived")
+	}
+	return lines
+}
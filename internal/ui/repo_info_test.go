@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestRepoInfoPopup tests that 'i' shows metadata for the selected PR's repository
+func TestRepoInfoPopup(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	noStack := false // Column fetches would prefetch the second repo
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Org", Mode: "organization", Organization: "acme", StackColumns: &noStack})
+	tab.PRs = []*gh.PullRequest{
+		{Number: gh.Int(1), Title: gh.String("PR one"), Base: &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("acme/billing")}}},
+		{Number: gh.Int(2), Title: gh.String("PR two"), Base: &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("acme/search")}}},
+	}
+	tab.FilteredPRs = tab.PRs
+	tab.Loaded = true
+	model.updateTableRows(tab)
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")})
+	if !tab.ShowRepoInfo || cmd == nil {
+		t.Fatal("Expected 'i' to open the popup and fetch repo metadata")
+	}
+	if !strings.Contains(model.renderActiveTabContent(tab), "Loading repository info") {
+		t.Error("Expected loading state while metadata is fetched")
+	}
+
+	model.Update(repoMetadataMsg{repo: "acme/billing", metadata: &cache.RepoMetadata{
+		FullName:      "acme/billing",
+		Language:      "Go",
+		DefaultBranch: "main",
+		OpenPRs:       9,
+		Topics:        []string{"payments"},
+	}})
+
+	view := model.renderActiveTabContent(tab)
+	for _, expected := range []string{"acme/billing", "Language: Go", "Default branch: main", "Open PRs: 9", "payments"} {
+		if !strings.Contains(view, expected) {
+			t.Errorf("Expected popup to contain %q", expected)
+		}
+	}
+
+	// Known repos aren't refetched; moving to a new repo fetches it
+	if cmd := model.repoMetadataCmd(tab); cmd != nil {
+		t.Error("Expected no refetch for cached repo metadata")
+	}
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")}); cmd == nil {
+		t.Error("Expected metadata fetch after selecting a PR in another repo")
+	}
+
+	// Failures are shown in the popup
+	model.Update(repoMetadataMsg{repo: "acme/search", err: fmt.Errorf("not found")})
+	if !strings.Contains(model.renderActiveTabContent(tab), "not found") {
+		t.Error("Expected fetch error in the popup")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")})
+	if tab.ShowRepoInfo {
+		t.Error("Expected second 'i' to close the popup")
+	}
+}
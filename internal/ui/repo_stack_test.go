@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestShowsStackColumns tests the per-mode default and explicit override
+func TestShowsStackColumns(t *testing.T) {
+	off, on := false, true
+	tests := []struct {
+		config   TabConfig
+		expected bool
+	}{
+		{TabConfig{Mode: "organization"}, true},
+		{TabConfig{Mode: "search"}, true},
+		{TabConfig{Mode: "repos"}, false},
+		{TabConfig{Mode: "organization", StackColumns: &off}, false},
+		{TabConfig{Mode: "repos", StackColumns: &on}, true},
+	}
+	for _, tt := range tests {
+		if got := tt.config.ShowsStackColumns(); got != tt.expected {
+			t.Errorf("ShowsStackColumns() for mode %q = %v, expected %v", tt.config.Mode, got, tt.expected)
+		}
+	}
+}
+
+// TestRepoStackColumns tests throttled metadata loading, cells and filters in an org tab
+func TestRepoStackColumns(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Org", Mode: "organization", Organization: "acme"})
+
+	var prs []*gh.PullRequest
+	for i := 1; i <= stackMetadataConcurrency+2; i++ {
+		prs = append(prs, &gh.PullRequest{
+			Number: gh.Int(i),
+			Title:  gh.String(fmt.Sprintf("PR %d", i)),
+			Base:   &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String(fmt.Sprintf("acme/repo%d", i))}},
+		})
+	}
+
+	_, cmd := model.Update(tabPrsMsg{tabName: "Org", prs: prs})
+	if cmd == nil || len(model.repoMetadataLoading) != stackMetadataConcurrency {
+		t.Fatalf("Expected %d metadata fetches in flight, got %d", stackMetadataConcurrency, len(model.repoMetadataLoading))
+	}
+	if columns := tab.Table.Columns(); len(columns) != 12 || columns[10].Title != "🧰 Language" {
+		t.Fatalf("Expected Language and Topics columns, got %d columns", len(columns))
+	}
+	if row := tab.Table.Rows()[0]; row[10] != "⏳" {
+		t.Errorf("Expected loading marker while metadata loads, got %q", row[10])
+	}
+
+	// Each arrival fills the cells and starts the next fetch
+	_, cmd = model.Update(repoMetadataMsg{repo: "acme/repo1", metadata: &cache.RepoMetadata{Language: "Go", Topics: []string{"payments", "grpc"}}})
+	if cmd == nil || !model.repoMetadataLoading[fmt.Sprintf("acme/repo%d", stackMetadataConcurrency+1)] {
+		t.Error("Expected the next repo's metadata to be fetched")
+	}
+	if row := tab.Table.Rows()[0]; row[10] != "Go" || row[11] != "payments, grpc" {
+		t.Errorf("Expected language and topics cells, got %q / %q", row[10], row[11])
+	}
+	model.Update(repoMetadataMsg{repo: "acme/repo2", metadata: &cache.RepoMetadata{Language: "TypeScript"}})
+
+	// Filter by language through the prompt
+	for _, key := range []string{"L", "g"} {
+		model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if len(tab.FilteredPRs) != 1 || tab.FilteredPRs[0].GetNumber() != 1 {
+		t.Errorf("Expected language filter to keep only the Go repo's PR, got %d PRs", len(tab.FilteredPRs))
+	}
+	if query, _ := searchQueryForTab(tab); !strings.Contains(query, "language:g") {
+		t.Errorf("Expected language filter in search query, got %q", query)
+	}
+}
+
+// TestRepoStackColumnsHiddenInRepoTabs tests that repo-list tabs keep the default columns
+func TestRepoStackColumnsHiddenInRepoTabs(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Repos", Mode: "repos", Repos: []string{"acme/api"}})
+	pr := &gh.PullRequest{Number: gh.Int(1), Base: &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("acme/api")}}}
+
+	model.Update(tabPrsMsg{tabName: "Repos", prs: []*gh.PullRequest{pr}})
+	if len(model.repoMetadataLoading) != 0 {
+		t.Error("Expected no metadata fetches for a repos tab")
+	}
+	if len(tab.Table.Columns()) != 10 || len(tab.Table.Rows()[0]) != 10 {
+		t.Errorf("Expected 10 columns, got %d", len(tab.Table.Columns()))
+	}
+
+	// Hiding a column skips the stack columns this tab doesn't have
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("-")})
+	if !model.layout.IsHidden("created") {
+		t.Errorf("Expected the first visible column in priority order to be hidden, got %v", model.layout.HiddenColumns)
+	}
+}
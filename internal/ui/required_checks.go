@@ -0,0 +1,115 @@
+package ui
+
+import (
+	"context"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// requiredChecksConcurrency caps base branch lookups in flight, as with
+// repo metadata for the stack columns
+const requiredChecksConcurrency = 4
+
+// requiredChecksMsg delivers the status checks a base branch requires
+type requiredChecksMsg struct {
+	branch string // "owner/name@branch"
+	checks []string
+	err    error
+}
+
+// requiredChecksKey names a PR's base branch as "owner/name@branch"
+func requiredChecksKey(pr *gh.PullRequest) string {
+	repo := repoFullName(pr)
+	if repo == "" || pr.GetBase().GetRef() == "" {
+		return ""
+	}
+	return repo + "@" + pr.GetBase().GetRef()
+}
+
+// requiredChecksCmd fetches the required checks of the base branches in a
+// GitHub tab, so PRs missing a required check don't show as Ready. Each
+// arrival starts the next fetch until all are known.
+func (m *MultiTabModel) requiredChecksCmd(tab *TabState) tea.Cmd {
+	if !tab.Config.OnGitHub() || m.readOnly() {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	for _, pr := range tab.PRs {
+		if len(m.requiredChecksLoading) >= requiredChecksConcurrency {
+			break
+		}
+		branch := requiredChecksKey(pr)
+		if branch == "" {
+			continue
+		}
+		_, known := m.requiredChecks[branch]
+		if known || m.requiredChecksLoading[branch] || m.requiredChecksErrors[branch] != nil {
+			continue
+		}
+		cmds = append(cmds, m.fetchRequiredChecksCmd(branch, repoFullName(pr), pr.GetBase().GetRef()))
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	return tea.Batch(cmds...)
+}
+
+// fetchRequiredChecksCmd fetches one base branch's required checks, marking it as loading
+func (m *MultiTabModel) fetchRequiredChecksCmd(branch, repo, ref string) tea.Cmd {
+	m.requiredChecksLoading[branch] = true
+
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 10*time.Second)
+		defer cancel()
+
+		checks, err := github.FetchRequiredChecks(ctx, token, repo, ref)
+		return requiredChecksMsg{branch: branch, checks: checks, err: err}
+	}
+}
+
+// handleRequiredChecks stores a base branch's required checks, redraws the
+// tabs that may show them and continues any pending lookups. Failed lookups
+// aren't retried, leaving those PRs' status as it was.
+func (m *MultiTabModel) handleRequiredChecks(msg requiredChecksMsg) (tea.Model, tea.Cmd) {
+	delete(m.requiredChecksLoading, msg.branch)
+	if msg.err != nil {
+		m.requiredChecksErrors[msg.branch] = msg.err
+	} else {
+		m.requiredChecks[msg.branch] = msg.checks
+	}
+
+	var cmds []tea.Cmd
+	for _, tab := range m.TabManager.Tabs {
+		if !tab.Config.OnGitHub() || !tab.Loaded {
+			continue
+		}
+		if msg.err == nil {
+			m.updateTableRows(tab)
+		}
+		if cmd := m.requiredChecksCmd(tab); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	if len(cmds) == 0 {
+		return m, nil
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// missingRequiredChecks returns the checks a PR's base branch requires that
+// never reported on its head commit, or nil until both are known
+func missingRequiredChecks(pr *gh.PullRequest, enhancedData map[int]types.EnhancedData, required map[string][]string) []string {
+	enhanced, enhancedKnown := enhancedData[pr.GetNumber()]
+	checks, requiredKnown := required[requiredChecksKey(pr)]
+	// Failed enhancements leave a placeholder without check data
+	if !enhancedKnown || enhanced.EnhancedAt.IsZero() || !requiredKnown {
+		return nil
+	}
+	return github.MissingChecks(checks, enhanced.ReportedChecks)
+}
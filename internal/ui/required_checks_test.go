@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestMissingRequiredChecksStatus tests that mergeable PRs missing a required
+// check stop showing as Ready once the base branch's checks are known
+func TestMissingRequiredChecksStatus(t *testing.T) {
+	model, tab := detailTestModel("test-token")
+	pr := tab.PRs[0]
+	pr.Base.Ref = gh.String("main")
+	tab.EnhancedData = map[int]types.EnhancedData{
+		12: {Number: 12, Mergeable: "clean", ChecksStatus: "success", ReportedChecks: []string{"build"}, EnhancedAt: time.Now()},
+	}
+	model.updateTableRows(tab)
+	if status := tab.Table.Rows()[0][4]; !strings.HasPrefix(status, "✅ Ready") {
+		t.Fatalf("Expected Ready before required checks are known, got %q", status)
+	}
+
+	if cmd := model.requiredChecksCmd(tab); cmd == nil || !model.requiredChecksLoading["org/api@main"] {
+		t.Fatal("Expected a lookup of org/api@main's required checks")
+	}
+	if cmd := model.requiredChecksCmd(tab); cmd != nil {
+		t.Error("Expected no second lookup while the first is in flight")
+	}
+
+	model.handleRequiredChecks(requiredChecksMsg{branch: "org/api@main", checks: []string{"build", "license/cla"}})
+	if status := tab.Table.Rows()[0][4]; !strings.HasPrefix(status, "⚠️ Missing Checks") {
+		t.Errorf("Expected Missing Checks, got %q", status)
+	}
+
+	model.requiredChecks["org/api@main"] = []string{"build"}
+	model.updateTableRows(tab)
+	if status := tab.Table.Rows()[0][4]; !strings.HasPrefix(status, "✅ Ready") {
+		t.Errorf("Expected Ready once every required check reported, got %q", status)
+	}
+
+	// Read-only sessions can't look up branch protection
+	if cmd := NewMultiTabModel("", nil).requiredChecksCmd(tab); cmd != nil {
+		t.Error("Expected no lookup without a token")
+	}
+}
+
+// TestDetailPaneMissingChecks tests listing required checks that never reported
+func TestDetailPaneMissingChecks(t *testing.T) {
+	model, tab := detailTestModel("test-token")
+	pr := tab.PRs[0]
+	model.CheckHints = []CheckHint{{Checks: "license/*", URL: "https://cla.example.com"}}
+	model.prDetails["org/api#12"] = &github.PRDetails{MissingChecks: []string{"license/cla", "deploy/preview"}, FetchedAt: time.Now()}
+
+	text := strings.Join(model.detailLines(pr, 100, time.Now()), "\n")
+	for _, want := range []string{"🧩 Required checks never reported:", "⚠️ license/cla", "👉 https://cla.example.com", "⚠️ deploy/preview"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected %q in detail pane, got:\n%s", want, text)
+		}
+	}
+
+	model.prDetails["org/api#12"] = &github.PRDetails{RequiredChecksErr: errors.New("forbidden"), FetchedAt: time.Now()}
+	if text := strings.Join(model.detailLines(pr, 100, time.Now()), "\n"); strings.Contains(text, "Required checks") {
+		t.Errorf("Expected no section when required checks are unknown, got:\n%s", text)
+	}
+}
@@ -0,0 +1,82 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// rerunChecksResultMsg reports how many failed checks were sent to run again
+type rerunChecksResultMsg struct {
+	tabName string
+	key     string // services.PRKey of the PR whose checks re-ran
+	count   int
+	err     error
+}
+
+// confirmRerunChecks asks before re-running the failed checks on the selected PR
+func (m *MultiTabModel) confirmRerunChecks(tab *TabState) {
+	if m.writeUnavailable(tab) {
+		return
+	}
+	pr := tab.SelectedPR()
+	if pr == nil {
+		tab.StatusMsg = "No PR selected"
+		return
+	}
+
+	m.pendingConfirm = &confirmPrompt{
+		prompt:    fmt.Sprintf("Re-run failed checks on %s %q?", services.PRKey(pr), pr.GetTitle()),
+		onConfirm: m.rerunChecksCmd(tab.Config.Name, pr),
+	}
+	tab.StatusMsg = m.pendingConfirm.prompt + " (y/n)"
+}
+
+// rerunChecksCmd re-runs the failed checks on one PR's head commit
+func (m *MultiTabModel) rerunChecksCmd(tabName string, pr *gh.PullRequest) tea.Cmd {
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 30*time.Second)
+		defer cancel()
+
+		count, err := github.RerunFailedChecks(ctx, token, pr)
+		return rerunChecksResultMsg{tabName: tabName, key: services.PRKey(pr), count: count, err: err}
+	}
+}
+
+// handleRerunChecksResult reports a re-run and drops the PR's cached checks,
+// which are queued again now
+func (m *MultiTabModel) handleRerunChecksResult(msg rerunChecksResultMsg) (tea.Model, tea.Cmd) {
+	var status string
+	switch {
+	case msg.err != nil && msg.count > 0:
+		status = fmt.Sprintf("Re-ran %d failed checks on %s, then failed: %v", msg.count, msg.key, msg.err)
+	case msg.err != nil:
+		status = fmt.Sprintf("Re-run on %s failed: %v", msg.key, msg.err)
+	case msg.count == 0:
+		status = fmt.Sprintf("No failed checks to re-run on %s", msg.key)
+	default:
+		status = fmt.Sprintf("🔁 Re-running %d failed checks on %s", msg.count, msg.key)
+	}
+	if msg.count > 0 {
+		delete(m.checkRuns, msg.key)
+		delete(m.prDetails, msg.key)
+	}
+
+	var cmds []tea.Cmd
+	for _, tab := range m.TabManager.Tabs {
+		if tab.Config.Name != msg.tabName {
+			continue
+		}
+		tab.StatusMsg = status
+		if msg.count > 0 && tab == m.TabManager.GetActiveTab() {
+			cmds = append(cmds, m.followSelection(tab))
+		}
+	}
+	return m, tea.Batch(cmds...)
+}
@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestRerunChecks tests confirming a re-run and reporting its result
+func TestRerunChecks(t *testing.T) {
+	model, tab := detailTestModel("test-token")
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("F")})
+	if model.pendingConfirm == nil || !strings.Contains(tab.StatusMsg, "Re-run failed checks on org/api#12") {
+		t.Fatalf("Expected a confirmation prompt, got %q", tab.StatusMsg)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if model.pendingConfirm != nil {
+		t.Fatal("Expected n to cancel the re-run")
+	}
+
+	model.Update(rerunChecksResultMsg{tabName: "Main", key: "org/api#12", err: errors.New("forbidden")})
+	if !strings.Contains(tab.StatusMsg, "Re-run on org/api#12 failed: forbidden") {
+		t.Errorf("Expected the failure reported, got %q", tab.StatusMsg)
+	}
+	model.Update(rerunChecksResultMsg{tabName: "Main", key: "org/api#12"})
+	if tab.StatusMsg != "No failed checks to re-run on org/api#12" {
+		t.Errorf("Expected nothing to re-run, got %q", tab.StatusMsg)
+	}
+
+	// Queued checks replace the ones the open panel showed
+	tab.ShowChecks = true
+	model.checkRuns["org/api#12"] = checkRunList{fetchedAt: time.Now()}
+	_, cmd := model.Update(rerunChecksResultMsg{tabName: "Main", key: "org/api#12", count: 2})
+	if tab.StatusMsg != "🔁 Re-running 2 failed checks on org/api#12" {
+		t.Errorf("Expected the re-run reported, got %q", tab.StatusMsg)
+	}
+	if cmd == nil || !model.checkRunsLoading["org/api#12"] {
+		t.Error("Expected the checks panel to fetch the queued checks")
+	}
+}
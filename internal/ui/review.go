@@ -0,0 +1,210 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	gh "github.com/google/go-github/v55/github"
+)
+
+const reviewFormHeight = 5
+
+// Review events accepted by GitHub, in the order the form cycles through them
+const (
+	reviewApprove        = "APPROVE"
+	reviewRequestChanges = "REQUEST_CHANGES"
+	reviewComment        = "COMMENT"
+)
+
+var reviewEvents = []string{reviewApprove, reviewRequestChanges, reviewComment}
+
+// reviewForm is the overlay for reviewing one PR: a verdict and an optional
+// body. While open it receives every key press.
+type reviewForm struct {
+	tabName string
+	pr      *gh.PullRequest
+	event   int // Index into reviewEvents
+	input   textarea.Model
+}
+
+// submittedReview is a verdict submitted from this session, shown in the
+// Review column until enhancement data catches up with it
+type submittedReview struct {
+	event string
+	at    time.Time
+}
+
+// indicator is the Review column cell for the verdict
+func (r submittedReview) indicator() string {
+	if r.event == reviewRequestChanges {
+		return theme.Running + " Changes"
+	}
+	return theme.Passed + " Approved"
+}
+
+// reviewResultMsg reports the outcome of reviewing a single PR
+type reviewResultMsg struct {
+	tabName string
+	key     string // services.PRKey of the reviewed PR
+	event   string
+	err     error
+}
+
+// reviewEventLabel names a review event in the form
+func reviewEventLabel(event string) string {
+	switch event {
+	case reviewRequestChanges:
+		return theme.Running + " Request changes"
+	case reviewComment:
+		return "💬 Comment"
+	default:
+		return theme.Passed + " Approve"
+	}
+}
+
+// openReviewForm starts a review of the selected PR, set to approve
+func (m *MultiTabModel) openReviewForm(tab *TabState) {
+	if m.writeUnavailable(tab) {
+		return
+	}
+	pr := tab.SelectedPR()
+	if pr == nil {
+		tab.StatusMsg = "No PR selected"
+		return
+	}
+
+	input := textarea.New()
+	input.Placeholder = "Optional for approvals (Markdown supported)"
+	input.ShowLineNumbers = false
+	input.CharLimit = maxCommentLength
+	input.SetWidth(m.commentWidth())
+	input.SetHeight(reviewFormHeight)
+	input.Cursor.SetMode(cursor.CursorStatic) // Blink messages aren't routed to the form
+	input.Focus()
+
+	m.reviewForm = &reviewForm{tabName: tab.Config.Name, pr: pr, input: input}
+	tab.StatusMsg = fmt.Sprintf("Reviewing %s - tab to change the verdict, ctrl+s to submit, esc to discard", services.PRKey(pr))
+}
+
+// handleReviewKey edits the form, changes its verdict, submits or discards it
+func (m *MultiTabModel) handleReviewKey(tab *TabState, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	form := m.reviewForm
+
+	switch msg.String() {
+	case "esc":
+		m.reviewForm = nil
+		tab.StatusMsg = "Review discarded"
+		return m, nil
+	case "tab":
+		form.event = (form.event + 1) % len(reviewEvents)
+		return m, nil
+	case "shift+tab":
+		form.event = (form.event + len(reviewEvents) - 1) % len(reviewEvents)
+		return m, nil
+	case "ctrl+s":
+		event := reviewEvents[form.event]
+		body := strings.TrimSpace(form.input.Value())
+		if body == "" && event != reviewApprove {
+			tab.StatusMsg = "GitHub needs a comment for this review - type one or press tab to approve"
+			return m, nil
+		}
+		m.reviewForm = nil
+		key := services.PRKey(form.pr)
+		if event != reviewComment {
+			// A comment-only review leaves the verdict as it was
+			m.TabManager.Reviews[key] = submittedReview{event: event, at: time.Now()}
+			m.refreshAllRows()
+		}
+		tab.StatusMsg = fmt.Sprintf("Submitting review of %s...", key)
+		return m, m.reviewCmd(form.tabName, form.pr, event, body)
+	}
+
+	var cmd tea.Cmd
+	form.input, cmd = form.input.Update(msg)
+	return m, cmd
+}
+
+// reviewCmd submits a review of one PR
+func (m *MultiTabModel) reviewCmd(tabName string, pr *gh.PullRequest, event, body string) tea.Cmd {
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 10*time.Second)
+		defer cancel()
+
+		err := github.SubmitReview(ctx, token, pr, event, body)
+		return reviewResultMsg{tabName: tabName, key: services.PRKey(pr), event: event, err: err}
+	}
+}
+
+// handleReviewResult confirms or reverts the verdict shown for a submitted
+// review, re-enhancing the PR so its Review column reflects GitHub
+func (m *MultiTabModel) handleReviewResult(msg reviewResultMsg) (tea.Model, tea.Cmd) {
+	var status string
+	switch msg.event {
+	case reviewRequestChanges:
+		status = fmt.Sprintf("%s Requested changes on %s", theme.Running, msg.key)
+	case reviewComment:
+		status = fmt.Sprintf("💬 Reviewed %s", msg.key)
+	default:
+		status = fmt.Sprintf("%s Approved %s", theme.Passed, msg.key)
+	}
+	if msg.err != nil {
+		delete(m.TabManager.Reviews, msg.key)
+		m.refreshAllRows()
+		status = fmt.Sprintf("Review of %s failed: %v", msg.key, msg.err)
+	} else {
+		// The review timeline now has a new entry
+		delete(m.prDetails, msg.key)
+	}
+
+	var cmd tea.Cmd
+	for _, tab := range m.TabManager.Tabs {
+		if tab.Config.Name != msg.tabName {
+			continue
+		}
+		tab.StatusMsg = status
+		// Enhancement updates are delivered to the active tab
+		if msg.err == nil && tab == m.TabManager.GetActiveTab() {
+			cmd = m.reenhancePR(tab, msg.key)
+		}
+	}
+	return m, cmd
+}
+
+// refreshAllRows rebuilds every tab's rows after shared row state changes,
+// since the same PR can appear in several tabs
+func (m *MultiTabModel) refreshAllRows() {
+	for _, tab := range m.TabManager.Tabs {
+		m.updateTableRows(tab)
+	}
+}
+
+// renderReviewForm renders the review overlay in place of the table
+func (m *MultiTabModel) renderReviewForm() string {
+	form := m.reviewForm
+	width := m.commentWidth()
+
+	title := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.TextBright)).
+		Render(clipText(fmt.Sprintf("📝 Review #%d %s", form.pr.GetNumber(), form.pr.GetTitle()), width))
+
+	// Bracket the chosen verdict, as choice prompts do
+	verdicts := make([]string, len(reviewEvents))
+	for i, event := range reviewEvents {
+		if i == form.event {
+			verdicts[i] = lipgloss.NewStyle().Bold(true).Render("[" + reviewEventLabel(event) + "]")
+		} else {
+			verdicts[i] = mutedStyle.Render(" " + reviewEventLabel(event) + " ")
+		}
+	}
+
+	footer := mutedStyle.Render("tab verdict · enter new line · ctrl+s submit · esc discard")
+	return repoInfoStyle.Width(width + 4).Render(title + "\n" + strings.Join(verdicts, " ") + "\n" + form.input.View() + "\n" + footer)
+}
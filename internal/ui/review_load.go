@@ -0,0 +1,164 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/ui/components"
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	"github.com/charmbracelet/lipgloss"
+	gh "github.com/google/go-github/v55/github"
+)
+
+const (
+	// reviewLoadRows caps the people listed per column of the review load pane
+	reviewLoadRows = 5
+
+	// reviewLoadBarWidth is the cells the longest bar takes
+	reviewLoadBarWidth = 12
+)
+
+// loadCount is one person's share of a tab's open PRs
+type loadCount struct {
+	Name   string
+	PRs    int
+	AvgAge time.Duration
+}
+
+// reviewLoad sums up the review workload of a tab's open PRs, for leads
+// balancing who reviews what
+type reviewLoad struct {
+	Open   int
+	AvgAge time.Duration
+
+	// Mean time from opening to the first review by someone other than the
+	// author, over the Reviewed PRs whose reviews have loaded, and how many
+	// loaded PRs nobody reviewed yet
+	Turnaround    time.Duration
+	Reviewed      int
+	AwaitingFirst int
+
+	Authors   []loadCount // Open PRs per author, most first
+	Reviewers []loadCount // Pending review requests per user or team, most first
+}
+
+// computeReviewLoad aggregates open PRs per author and requested reviewer
+func computeReviewLoad(prs []*gh.PullRequest, enhancedData map[int]types.EnhancedData, now time.Time) reviewLoad {
+	var load reviewLoad
+	var totalAge, turnaround time.Duration
+	authors := make(map[string]*loadCount)
+	reviewers := make(map[string]*loadCount)
+	add := func(counts map[string]*loadCount, name string, age time.Duration) {
+		if counts[name] == nil {
+			counts[name] = &loadCount{Name: name}
+		}
+		counts[name].PRs++
+		counts[name].AvgAge += age // Summed here, averaged below
+	}
+
+	for _, pr := range prs {
+		if isHistoryPR(pr) {
+			continue
+		}
+		load.Open++
+		age := now.Sub(pr.GetCreatedAt().Time)
+		totalAge += age
+		add(authors, pr.GetUser().GetLogin(), age)
+		for _, reviewer := range pr.RequestedReviewers {
+			add(reviewers, reviewer.GetLogin(), age)
+		}
+		for _, team := range pr.RequestedTeams {
+			add(reviewers, "team:"+team.GetSlug(), age)
+		}
+
+		enhanced, ok := enhancedData[pr.GetNumber()]
+		switch {
+		case !ok || enhanced.EnhancedAt.IsZero():
+		case enhanced.FirstReviewAt.IsZero():
+			load.AwaitingFirst++
+		default:
+			load.Reviewed++
+			turnaround += enhanced.FirstReviewAt.Sub(pr.GetCreatedAt().Time)
+		}
+	}
+
+	if load.Open > 0 {
+		load.AvgAge = totalAge / time.Duration(load.Open)
+	}
+	if load.Reviewed > 0 {
+		load.Turnaround = turnaround / time.Duration(load.Reviewed)
+	}
+	load.Authors = sortedLoad(authors)
+	load.Reviewers = sortedLoad(reviewers)
+	return load
+}
+
+// sortedLoad averages the summed ages and sorts people by PRs, most first
+func sortedLoad(counts map[string]*loadCount) []loadCount {
+	sorted := make([]loadCount, 0, len(counts))
+	for _, count := range counts {
+		count.AvgAge /= time.Duration(count.PRs)
+		sorted = append(sorted, *count)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].PRs != sorted[j].PRs {
+			return sorted[i].PRs > sorted[j].PRs
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
+
+// loadColumn charts the people with the most PRs as bars, e.g.
+// "alice  ██████ 6 · 4.1d"
+func loadColumn(heading string, counts []loadCount, showAge bool) string {
+	lines := []string{lipgloss.NewStyle().Bold(true).Render(heading)}
+	if len(counts) == 0 {
+		return strings.Join(append(lines, mutedStyle.Render("None")), "\n")
+	}
+
+	shown := counts[:min(len(counts), reviewLoadRows)]
+	nameWidth := 0
+	for _, count := range shown {
+		nameWidth = max(nameWidth, lipgloss.Width(count.Name))
+	}
+	for _, count := range shown {
+		bar := components.Bar(count.PRs, counts[0].PRs, reviewLoadBarWidth)
+		line := fmt.Sprintf("%-*s  %-*s %d", nameWidth, count.Name, reviewLoadBarWidth, bar, count.PRs)
+		if showAge {
+			line += mutedStyle.Render(" · " + formatSpan(count.AvgAge))
+		}
+		lines = append(lines, line)
+	}
+	if more := len(counts) - len(shown); more > 0 {
+		lines = append(lines, mutedStyle.Render(fmt.Sprintf("+%d more", more)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderReviewLoad renders the review load pane: open PRs per author with
+// their average age, and pending review requests per reviewer, as bar charts
+func (m *MultiTabModel) renderReviewLoad(tab *TabState, now time.Time) string {
+	load := computeReviewLoad(tab.FilteredPRs, tab.EnhancedData, now)
+
+	heading := fmt.Sprintf("📊 Review load · %d open · avg age %s", load.Open, formatSpan(load.AvgAge))
+	if load.Open == 0 {
+		heading = "📊 Review load · no open PRs"
+	}
+	if load.Reviewed > 0 {
+		heading += fmt.Sprintf(" · first review after %s avg", formatSpan(load.Turnaround))
+	}
+	if load.AwaitingFirst > 0 {
+		heading += fmt.Sprintf(" · %d awaiting a first review", load.AwaitingFirst)
+	}
+	title := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.TextBright)).Render(heading)
+
+	columns := lipgloss.JoinHorizontal(lipgloss.Top,
+		loadColumn("Authors", load.Authors, true),
+		"    ",
+		loadColumn("Requested reviewers", load.Reviewers, true),
+	)
+	return "\n" + repoInfoStyle.Render(title+"\n"+columns)
+}
@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// loadPR returns a PR opened some days ago by author, requesting reviewers
+func loadPR(number int, author string, days int, now time.Time, reviewers ...string) *gh.PullRequest {
+	pr := labeledPR(number)
+	pr.User = &gh.User{Login: gh.String(author)}
+	pr.CreatedAt = &gh.Timestamp{Time: now.Add(-time.Duration(days) * 24 * time.Hour)}
+	for _, reviewer := range reviewers {
+		if slug, ok := strings.CutPrefix(reviewer, "team:"); ok {
+			pr.RequestedTeams = append(pr.RequestedTeams, &gh.Team{Slug: gh.String(slug)})
+		} else {
+			pr.RequestedReviewers = append(pr.RequestedReviewers, &gh.User{Login: gh.String(reviewer)})
+		}
+	}
+	return pr
+}
+
+// TestComputeReviewLoad tests the counts per author and reviewer, the
+// average ages and the first review turnaround
+func TestComputeReviewLoad(t *testing.T) {
+	now := time.Date(2026, 10, 10, 12, 0, 0, 0, time.UTC)
+	merged := closedPR(5, true, now)
+	prs := []*gh.PullRequest{
+		loadPR(1, "alice", 2, now, "bob", "team:core"),
+		loadPR(2, "alice", 4, now, "bob"),
+		loadPR(3, "bob", 6, now, "carol"),
+		merged,
+	}
+	enhanced := map[int]types.EnhancedData{
+		1: {EnhancedAt: now, FirstReviewAt: prs[0].GetCreatedAt().Add(2 * time.Hour)},
+		2: {EnhancedAt: now, FirstReviewAt: prs[1].GetCreatedAt().Add(4 * time.Hour)},
+		3: {EnhancedAt: now},
+	}
+
+	load := computeReviewLoad(prs, enhanced, now)
+	if load.Open != 3 || load.AvgAge != 4*24*time.Hour {
+		t.Errorf("Expected 3 open PRs averaging 4d, got %d averaging %v", load.Open, load.AvgAge)
+	}
+	if load.Reviewed != 2 || load.Turnaround != 3*time.Hour || load.AwaitingFirst != 1 {
+		t.Errorf("Expected 2 reviewed after 3h on average and 1 awaiting, got %+v", load)
+	}
+	if len(load.Authors) != 2 || load.Authors[0] != (loadCount{Name: "alice", PRs: 2, AvgAge: 3 * 24 * time.Hour}) {
+		t.Errorf("Expected alice first with 2 PRs, got %+v", load.Authors)
+	}
+	want := []string{"bob", "carol", "team:core"}
+	if len(load.Reviewers) != len(want) || load.Reviewers[0].PRs != 2 {
+		t.Fatalf("Expected bob first among %v, got %+v", want, load.Reviewers)
+	}
+	for i, name := range want {
+		if load.Reviewers[i].Name != name {
+			t.Errorf("Reviewers[%d] = %s, want %s", i, load.Reviewers[i].Name, name)
+		}
+	}
+}
+
+// TestReviewLoadPane tests toggling the review load pane with Q
+func TestReviewLoadPane(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"org/api"}})
+	now := time.Now()
+	prs := []*gh.PullRequest{loadPR(1, "alice", 2, now, "bob")}
+	for i := 2; i <= reviewLoadRows+2; i++ {
+		prs = append(prs, loadPR(i, "dev"+string(rune('a'+i)), 1, now))
+	}
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: prs})
+
+	if strings.Contains(model.View(), "Review load") {
+		t.Fatal("Expected the review load pane hidden at first")
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Q")})
+	view := model.View()
+	for _, want := range []string{"📊 Review load · 7 open", "Requested reviewers", "bob", "█", "+2 more"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q in the review load pane, got:\n%s", want, view)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// viewerReviewersMsg delivers the login and teams whose review requests
+// count as the current user's
+type viewerReviewersMsg struct {
+	tabName   string
+	reviewers []string // Login first, then teams as "org/slug"
+	teamsErr  error    // Teams couldn't be listed; only direct requests match
+	err       error
+}
+
+// toggleNeedsMyReview filters the tab to PRs requesting a review from the
+// current user or one of their teams, or clears that filter. The user and
+// their teams are looked up once per session.
+func (m *MultiTabModel) toggleNeedsMyReview(tab *TabState) tea.Cmd {
+	if tab.FilterMode == "requested" {
+		tab.FilterMode = ""
+		tab.FilterValue = ""
+		tab.FilteredPRs = tab.PRs
+		tab.StatusMsg = "Filter cleared"
+		m.updateTableRows(tab)
+		return nil
+	}
+	if m.readOnly() {
+		tab.StatusMsg = "Read-only mode: set GITHUB_TOKEN so PR Compass knows who you are"
+		return nil
+	}
+	if m.viewerReviewers != nil {
+		m.applyNeedsMyReview(tab)
+		return nil
+	}
+
+	tab.StatusMsg = "Looking up your review requests..."
+	return m.viewerReviewersCmd(tab.Config.Name, tab.PRCache)
+}
+
+// viewerReviewersCmd looks up the current user's login and teams for the
+// tab waiting to filter on them; "" when Slack events need them at startup
+func (m *MultiTabModel) viewerReviewersCmd(tabName string, prCache *cache.PRCache) tea.Cmd {
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 15*time.Second)
+		defer cancel()
+
+		login, err := github.FetchViewerLogin(ctx, token, prCache)
+		if err != nil {
+			return viewerReviewersMsg{tabName: tabName, err: err}
+		}
+		teams, teamsErr := github.FetchViewerTeams(ctx, token)
+		return viewerReviewersMsg{tabName: tabName, reviewers: append([]string{login}, teams...), teamsErr: teamsErr}
+	}
+}
+
+// handleViewerReviewers remembers who the current user is and applies the
+// filter if the tab that asked is still active
+func (m *MultiTabModel) handleViewerReviewers(msg viewerReviewersMsg) (tea.Model, tea.Cmd) {
+	tab := m.TabManager.GetActiveTab()
+	if msg.err != nil {
+		if tab != nil && tab.Config.Name == msg.tabName {
+			tab.StatusMsg = fmt.Sprintf("Couldn't identify you: %v", msg.err)
+		}
+		return m, nil
+	}
+	m.viewerReviewers = msg.reviewers
+	m.viewerLogin = msg.reviewers[0]
+	m.viewerTeamsErr = msg.teamsErr
+
+	if tab != nil && tab.Config.Name == msg.tabName {
+		m.applyNeedsMyReview(tab)
+	}
+	return m, nil
+}
+
+// applyNeedsMyReview narrows the tab to PRs waiting on the current user
+func (m *MultiTabModel) applyNeedsMyReview(tab *TabState) {
+	tab.FilterMode = "requested"
+	tab.FilterValue = strings.Join(m.viewerReviewers, ",")
+	tab.FilteredPRs = m.applyFilter(tab.PRs, "requested", tab.FilterValue)
+	tab.StatusMsg = fmt.Sprintf("👀 Needs my review (%d) - n to clear", len(tab.FilteredPRs))
+	if m.viewerTeamsErr != nil {
+		tab.StatusMsg += " • team requests not included (needs read:org)"
+	}
+	m.updateTableRows(tab)
+}
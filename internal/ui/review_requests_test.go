@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+func reviewRequestPR(number int, users []string, teams []string) *gh.PullRequest {
+	pr := &gh.PullRequest{
+		Number: gh.Int(number),
+		Title:  gh.String("PR"),
+		Base: &gh.PullRequestBranch{Repo: &gh.Repository{
+			FullName: gh.String("org/api"),
+			Owner:    &gh.User{Login: gh.String("org")},
+		}},
+	}
+	for _, login := range users {
+		pr.RequestedReviewers = append(pr.RequestedReviewers, &gh.User{Login: gh.String(login)})
+	}
+	for _, slug := range teams {
+		pr.RequestedTeams = append(pr.RequestedTeams, &gh.Team{Slug: gh.String(slug)})
+	}
+	return pr
+}
+
+// TestHotkeyNeedsMyReview tests that n looks up the current user once and toggles the filter
+func TestHotkeyNeedsMyReview(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"org/api"}})
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: []*gh.PullRequest{
+		reviewRequestPR(1, []string{"alice"}, nil),
+		reviewRequestPR(2, nil, []string{"backend"}),
+		reviewRequestPR(3, []string{"bob"}, []string{"frontend"}),
+	}})
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if cmd == nil || tab.FilterMode != "" {
+		t.Fatalf("Expected a lookup of the current user before filtering, got filter %q", tab.FilterMode)
+	}
+
+	model.Update(viewerReviewersMsg{tabName: "Test Tab", reviewers: []string{"alice", "org/backend"}})
+	if tab.FilterMode != "requested" || len(tab.FilteredPRs) != 2 || !strings.HasPrefix(tab.StatusMsg, "👀 Needs my review (2)") {
+		t.Errorf("Expected PRs 1 and 2 to need my review, got %q with %d PRs / %q", tab.FilterMode, len(tab.FilteredPRs), tab.StatusMsg)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if tab.FilterMode != "" || len(tab.FilteredPRs) != 3 {
+		t.Errorf("Expected n to clear the filter, got %q with %d PRs", tab.FilterMode, len(tab.FilteredPRs))
+	}
+
+	// The user is known now, so the filter applies right away
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")}); cmd != nil || tab.FilterMode != "requested" {
+		t.Errorf("Expected the filter without another lookup, got %q", tab.FilterMode)
+	}
+}
+
+// TestNeedsMyReviewWithoutTeams tests that failing to list teams still filters by direct requests
+func TestNeedsMyReviewWithoutTeams(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"org/api"}})
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: []*gh.PullRequest{
+		reviewRequestPR(1, []string{"alice"}, nil),
+		reviewRequestPR(2, nil, []string{"backend"}),
+	}})
+
+	model.Update(viewerReviewersMsg{tabName: "Test Tab", reviewers: []string{"alice"}, teamsErr: errors.New("403")})
+	if len(tab.FilteredPRs) != 1 || !strings.Contains(tab.StatusMsg, "team requests not included") {
+		t.Errorf("Expected only the direct request with a note, got %d PRs / %q", len(tab.FilteredPRs), tab.StatusMsg)
+	}
+
+	public := NewMultiTabModel("", nil)
+	publicTab := public.TabManager.AddTab(&TabConfig{Name: "Public", Mode: "repos", Repos: []string{"org/api"}})
+	if cmd := public.toggleNeedsMyReview(publicTab); cmd != nil || !strings.HasPrefix(publicTab.StatusMsg, "Read-only mode") {
+		t.Errorf("Expected read-only mode to explain the missing token, got %q", publicTab.StatusMsg)
+	}
+}
@@ -0,0 +1,213 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	gh "github.com/google/go-github/v55/github"
+)
+
+const teamReviewerPrefix = "team:"
+
+// reviewerPicker is the overlay for requesting reviewers on one PR. While open
+// it receives every key press; typing narrows the list. Its options are
+// logins, then teams as "team:<slug>".
+type reviewerPicker struct {
+	tabName string
+	pr      *gh.PullRequest
+	multiPicker
+}
+
+// reviewerCandidatesMsg delivers the members and teams of a PR's owner
+type reviewerCandidatesMsg struct {
+	tabName    string
+	owner      string
+	key        string // services.PRKey of the PR the picker is for
+	candidates *github.ReviewerCandidates
+	err        error
+}
+
+// reviewRequestResultMsg reports the outcome of requesting reviewers on a PR
+type reviewRequestResultMsg struct {
+	tabName   string
+	key       string
+	reviewers []string
+	updated   *gh.PullRequest // The PR with its current review requests
+	err       error
+}
+
+// openReviewerPicker opens the picker for the selected PR, fetching the
+// owner's members and teams first unless they are already known
+func (m *MultiTabModel) openReviewerPicker(tab *TabState) tea.Cmd {
+	if m.writeUnavailable(tab) {
+		return nil
+	}
+	pr := tab.SelectedPR()
+	if pr == nil {
+		tab.StatusMsg = "No PR selected"
+		return nil
+	}
+
+	owner, _, _ := strings.Cut(pr.GetBase().GetRepo().GetFullName(), "/")
+	if candidates, known := m.reviewerCandidates[owner]; known {
+		m.showReviewerPicker(tab, pr, candidates)
+		return nil
+	}
+
+	tab.StatusMsg = fmt.Sprintf("Loading reviewers for %s...", owner)
+	token := m.TabManager.Token
+	tabName := tab.Config.Name
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 15*time.Second)
+		defer cancel()
+
+		candidates, err := github.FetchReviewerCandidates(ctx, token, pr)
+		return reviewerCandidatesMsg{tabName: tabName, owner: owner, key: services.PRKey(pr), candidates: candidates, err: err}
+	}
+}
+
+// showReviewerPicker opens the picker with the given candidates. The PR's
+// author can't review their own PR, so they aren't offered.
+func (m *MultiTabModel) showReviewerPicker(tab *TabState, pr *gh.PullRequest, candidates *github.ReviewerCandidates) {
+	var options []string
+	for _, login := range candidates.Users {
+		if !strings.EqualFold(login, pr.GetUser().GetLogin()) {
+			options = append(options, login)
+		}
+	}
+	for _, team := range candidates.Teams {
+		options = append(options, teamReviewerPrefix+team)
+	}
+	if len(options) == 0 {
+		tab.StatusMsg = "No reviewers available for " + services.PRKey(pr)
+		return
+	}
+
+	m.reviewerPicker = &reviewerPicker{tabName: tab.Config.Name, pr: pr, multiPicker: newMultiPicker(options, nil)}
+	tab.StatusMsg = fmt.Sprintf("👥 Requesting reviewers for %s", services.PRKey(pr))
+}
+
+// handleReviewerCandidates stores fetched candidates and opens the picker if
+// the PR is still selected
+func (m *MultiTabModel) handleReviewerCandidates(msg reviewerCandidatesMsg) (tea.Model, tea.Cmd) {
+	tab := m.TabManager.GetActiveTab()
+	if msg.err != nil {
+		if tab != nil && tab.Config.Name == msg.tabName {
+			tab.StatusMsg = fmt.Sprintf("Couldn't list reviewers: %v", msg.err)
+		}
+		return m, nil
+	}
+	m.reviewerCandidates[msg.owner] = msg.candidates
+
+	if tab == nil || tab.Config.Name != msg.tabName || m.promptOpen() {
+		return m, nil
+	}
+	if pr := tab.SelectedPR(); pr != nil && services.PRKey(pr) == msg.key {
+		m.showReviewerPicker(tab, pr, msg.candidates)
+	}
+	return m, nil
+}
+
+// promptOpen reports whether a prompt or overlay is taking key presses
+func (m *MultiTabModel) promptOpen() bool {
+	return m.pendingConfirm != nil || m.pendingInput != nil || m.pendingChoice != nil ||
+		m.pendingComment != nil || m.reviewForm != nil || m.pendingNote != nil || m.reviewerPicker != nil || m.labelPicker != nil ||
+		m.diffView != nil
+}
+
+// handleReviewerKey filters, moves through, selects or submits the picker
+func (m *MultiTabModel) handleReviewerKey(tab *TabState, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	picker := m.reviewerPicker
+	matches := picker.matches()
+
+	switch msg.Type {
+	case tea.KeyEsc, tea.KeyCtrlC:
+		m.reviewerPicker = nil
+		tab.StatusMsg = "Cancelled"
+		return m, nil
+	case tea.KeyEnter:
+		reviewers := picker.chosen()
+		if len(reviewers) == 0 && len(matches) > 0 {
+			reviewers = []string{matches[picker.cursor]}
+		}
+		if len(reviewers) == 0 {
+			return m, nil
+		}
+		m.reviewerPicker = nil
+		tab.StatusMsg = fmt.Sprintf("Requesting review from %s on %s...", strings.Join(reviewers, ", "), services.PRKey(picker.pr))
+		return m, m.reviewRequestCmd(picker.tabName, picker.pr, reviewers)
+	default:
+		picker.handleKey(msg)
+	}
+	return m, nil
+}
+
+// reviewRequestCmd requests reviews from users and "team:" entries on one PR
+func (m *MultiTabModel) reviewRequestCmd(tabName string, pr *gh.PullRequest, reviewers []string) tea.Cmd {
+	var users, teams []string
+	for _, reviewer := range reviewers {
+		if team, isTeam := strings.CutPrefix(reviewer, teamReviewerPrefix); isTeam {
+			teams = append(teams, team)
+		} else {
+			users = append(users, reviewer)
+		}
+	}
+
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 10*time.Second)
+		defer cancel()
+
+		updated, err := github.RequestReviewers(ctx, token, pr, users, teams)
+		return reviewRequestResultMsg{tabName: tabName, key: services.PRKey(pr), reviewers: reviewers, updated: updated, err: err}
+	}
+}
+
+// handleReviewRequestResult reports a review request and copies the PR's new
+// review requests into every tab, so the Review column reflects them
+func (m *MultiTabModel) handleReviewRequestResult(msg reviewRequestResultMsg) (tea.Model, tea.Cmd) {
+	status := fmt.Sprintf("👥 Requested review from %s on %s", strings.Join(msg.reviewers, ", "), msg.key)
+	if msg.err != nil {
+		status = fmt.Sprintf("Review request on %s failed: %v", msg.key, msg.err)
+	} else if msg.updated != nil {
+		for _, tab := range m.TabManager.Tabs {
+			for _, pr := range tab.PRs {
+				if services.PRKey(pr) == msg.key {
+					pr.RequestedReviewers = msg.updated.RequestedReviewers
+					pr.RequestedTeams = msg.updated.RequestedTeams
+				}
+			}
+		}
+		// The detail pane lists requested reviewers
+		delete(m.prDetails, msg.key)
+		m.refreshAllRows()
+	}
+
+	for _, tab := range m.TabManager.Tabs {
+		if tab.Config.Name == msg.tabName {
+			tab.StatusMsg = status
+		}
+	}
+	return m, nil
+}
+
+// renderReviewerPicker renders the picker overlay in place of the table
+func (m *MultiTabModel) renderReviewerPicker() string {
+	picker := m.reviewerPicker
+	width := m.commentWidth()
+
+	title := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.TextBright)).
+		Render(clipText(fmt.Sprintf("👥 Request reviewers for #%d %s", picker.pr.GetNumber(), picker.pr.GetTitle()), width))
+	lines := []string{title, "Filter: " + picker.query + "_"}
+	lines = append(lines, picker.renderOptions()...)
+
+	footer := fmt.Sprintf("%d selected · type to filter · ↑↓ move · space select · enter request · esc cancel", len(picker.chosen()))
+	lines = append(lines, mutedStyle.Render(clipText(footer, width)))
+	return repoInfoStyle.Width(width + 4).Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestHotkeyRequestReviewers tests loading candidates, filtering and multi-select
+func TestHotkeyRequestReviewers(t *testing.T) {
+	model, tab := mergeTestModel("test-token")
+	press := func(msg tea.KeyMsg) tea.Cmd {
+		_, cmd := model.Update(msg)
+		return cmd
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	if cmd := press(runes("R")); cmd == nil || !strings.Contains(tab.StatusMsg, "Loading reviewers for org") {
+		t.Fatalf("Expected candidates to be fetched first, got %q", tab.StatusMsg)
+	}
+	model.Update(reviewerCandidatesMsg{
+		tabName:    "Main",
+		owner:      "org",
+		key:        "org/api#12",
+		candidates: &github.ReviewerCandidates{Users: []string{"alice", "bob", "carol"}, Teams: []string{"platform"}},
+	})
+	picker := model.reviewerPicker
+	if picker == nil {
+		t.Fatal("Expected picker to open once candidates arrive")
+	}
+	// The author can't review their own PR
+	if strings.Join(picker.options, ",") != "bob,carol,team:platform" {
+		t.Errorf("Expected author left out, got %v", picker.options)
+	}
+
+	// Typing filters; hotkeys like q stay inside the picker
+	press(runes("plat"))
+	press(tea.KeyMsg{Type: tea.KeySpace})
+	press(tea.KeyMsg{Type: tea.KeyCtrlU})
+	press(tea.KeyMsg{Type: tea.KeyDown})
+	press(tea.KeyMsg{Type: tea.KeySpace})
+	if got := picker.chosen(); strings.Join(got, ",") != "carol,team:platform" {
+		t.Errorf("Expected carol and the platform team selected, got %v", got)
+	}
+	if view := model.View(); !strings.Contains(view, "[x] carol") {
+		t.Error("Expected picker to replace the table")
+	}
+
+	if cmd := press(tea.KeyMsg{Type: tea.KeyEnter}); cmd == nil || model.reviewerPicker != nil {
+		t.Fatal("Expected enter to send the review request")
+	}
+	if !strings.Contains(tab.StatusMsg, "carol, team:platform") {
+		t.Errorf("Expected request status naming reviewers, got %q", tab.StatusMsg)
+	}
+
+	// Candidates are remembered per owner
+	if cmd := press(runes("R")); cmd != nil || model.reviewerPicker == nil {
+		t.Error("Expected cached candidates to open the picker right away")
+	}
+	press(runes("q"))
+	if press(tea.KeyMsg{Type: tea.KeyEsc}); model.reviewerPicker != nil || tab.StatusMsg != "Cancelled" {
+		t.Errorf("Expected esc to cancel, got %q", tab.StatusMsg)
+	}
+}
+
+// TestReviewRequestResult tests that the Review column picks up new requests
+func TestReviewRequestResult(t *testing.T) {
+	model, tab := mergeTestModel("test-token")
+	updated := &gh.PullRequest{
+		RequestedReviewers: []*gh.User{{Login: gh.String("carol")}},
+		RequestedTeams:     []*gh.Team{{Slug: gh.String("platform")}},
+	}
+
+	model.Update(reviewRequestResultMsg{tabName: "Main", key: "org/api#12", reviewers: []string{"carol"}, err: errors.New("forbidden")})
+	if !strings.Contains(tab.StatusMsg, "failed: forbidden") || len(tab.PRs[0].RequestedReviewers) != 0 {
+		t.Errorf("Expected failure without changes, got %q", tab.StatusMsg)
+	}
+
+	model.Update(reviewRequestResultMsg{tabName: "Main", key: "org/api#12", reviewers: []string{"carol", "team:platform"}, updated: updated})
+	if got := tab.Table.Rows()[0][reviewColumn]; got != "⏳ 0/2" {
+		t.Errorf("Expected two pending requests in Review column, got %q", got)
+	}
+	if got := tab.Table.Rows()[1][reviewColumn]; got == "⏳ 0/2" {
+		t.Error("Expected other PRs unchanged")
+	}
+}
+
+// TestRequestReviewersReadOnly tests that read-only mode refuses review requests
+func TestRequestReviewersReadOnly(t *testing.T) {
+	model, tab := mergeTestModel("")
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("R")})
+	if model.reviewerPicker != nil || tab.StatusMsg != readOnlyActionMsg {
+		t.Errorf("Expected read-only refusal, got %q", tab.StatusMsg)
+	}
+}
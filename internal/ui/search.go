@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bjess9/pr-compass/internal/ui/services"
+)
+
+// searchHighlight is a combining low line placed after each matched rune.
+// It underlines the match without adding width or ANSI codes, which the
+// table would miscount when truncating cells.
+const searchHighlight = '̲'
+
+// startSearch opens the / search prompt, which narrows rows as you type
+func (m *MultiTabModel) startSearch(tab *TabState) {
+	tab.FilterMode = "search"
+	tab.FilterValue = ""
+	tab.inputHistory("search").reset()
+	m.updateSearch(tab)
+	tab.StatusMsg = filterPromptStatus(tab, "Search titles, branches, authors, repos:")
+}
+
+// updateSearch narrows the tab to PRs matching the query typed so far
+func (m *MultiTabModel) updateSearch(tab *TabState) {
+	tab.FilteredPRs = m.applyFilter(tab.PRs, "search", tab.FilterValue)
+	m.updateTableRows(tab)
+	tab.StatusMsg = fmt.Sprintf("Search: %s_ (%d)", tab.FilterValue, len(tab.FilteredPRs))
+}
+
+// cancelSearch closes the search prompt and shows every PR again
+func (m *MultiTabModel) cancelSearch(tab *TabState) {
+	tab.FilterMode = ""
+	tab.FilterValue = ""
+	tab.FilteredPRs = tab.PRs
+	m.updateTableRows(tab)
+	tab.StatusMsg = "Search cancelled"
+}
+
+// searchQuery returns the search being typed or applied in the tab, if any
+func (ts *TabState) searchQuery() string {
+	if ts.FilterMode == "search" {
+		return ts.FilterValue
+	}
+	if value, ok := strings.CutPrefix(ts.AppliedFilter, "search="); ok {
+		return value
+	}
+	return ""
+}
+
+// highlightMatches underlines the runes of text matched by the query's terms
+func highlightMatches(text, query string) string {
+	marked := make(map[int]bool)
+	for _, term := range strings.Fields(query) {
+		if positions, ok := services.FuzzyMatch(term, text); ok {
+			for _, pos := range positions {
+				marked[pos] = true
+			}
+		}
+	}
+	if len(marked) == 0 {
+		return text
+	}
+
+	var b strings.Builder
+	for i, r := range []rune(text) {
+		b.WriteRune(r)
+		if marked[i] && r != ' ' {
+			b.WriteRune(searchHighlight)
+		}
+	}
+	return b.String()
+}
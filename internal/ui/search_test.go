@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestHotkeySearch tests that / narrows rows while typing, including hotkey letters
+func TestHotkeySearch(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"org/api"}})
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: []*gh.PullRequest{
+		{Number: gh.Int(1), Title: gh.String("Retry failed webhooks"), User: &gh.User{Login: gh.String("alice")},
+			Base: &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/api")}}},
+		{Number: gh.Int(2), Title: gh.String("Bump lodash"), User: &gh.User{Login: gh.String("bob")},
+			Head: &gh.PullRequestBranch{Ref: gh.String("fix/webhook-timeout")},
+			Base: &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/web")}}},
+		{Number: gh.Int(3), Title: gh.String("Add dark mode"), User: &gh.User{Login: gh.String("carol")},
+			Base: &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/web")}}},
+	}})
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	if tab.FilterMode != "search" {
+		t.Fatalf("Expected search mode, got %q", tab.FilterMode)
+	}
+
+	for _, key := range []string{"w", "e", "b", "h"} {
+		model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+	}
+	if len(tab.FilteredPRs) != 2 || tab.StatusMsg != "Search: webh_ (2)" {
+		t.Errorf("Expected title and branch matches, got %d PRs / %q", len(tab.FilteredPRs), tab.StatusMsg)
+	}
+	if title := tab.Table.Rows()[0][0]; !strings.Contains(title, "w"+string(searchHighlight)) {
+		t.Errorf("Expected the match to be underlined, got %q", title)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeySpace})
+	for _, key := range []string{"b", "o", "b"} {
+		model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+	}
+	if len(tab.FilteredPRs) != 1 || tab.FilteredPRs[0].GetNumber() != 2 {
+		t.Errorf("Expected only bob's PR, got %d PRs", len(tab.FilteredPRs))
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if tab.FilterMode != "" || tab.AppliedFilter != "search=webh bob" || len(tab.FilteredPRs) != 1 {
+		t.Errorf("Expected the search to stay applied, got mode %q, filter %q", tab.FilterMode, tab.AppliedFilter)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if tab.FilterMode != "" || len(tab.FilteredPRs) != 3 || tab.StatusMsg != "Search cancelled" {
+		t.Errorf("Expected esc to show every PR again, got %d PRs / %q", len(tab.FilteredPRs), tab.StatusMsg)
+	}
+}
+
+// TestHighlightMatches tests underlining matched runes
+func TestHighlightMatches(t *testing.T) {
+	u := string(searchHighlight)
+	if got, want := highlightMatches("org api", "ap"), "org a"+u+"p"+u+"i"; got != want {
+		t.Errorf("highlightMatches() = %q, want %q", got, want)
+	}
+	if got := highlightMatches("bob", "zzz"); got != "bob" {
+		t.Errorf("Expected no highlight without a match, got %q", got)
+	}
+}
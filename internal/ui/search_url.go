@@ -0,0 +1,248 @@
+package ui
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+)
+
+// githubSearchPath is the GitHub web search for pull requests, relative to
+// the web root (github.com or an Enterprise Server instance)
+const githubSearchPath = "search?type=pullrequests&q="
+
+// botSearchAuthors are the GitHub Apps exclude_bots hides, in search syntax
+var botSearchAuthors = []string{"app/renovate", "app/dependabot", "app/github-actions"}
+
+// searchQueryForTab builds a GitHub search query equivalent to the tab's
+// scope, config exclusions and active filter. Parts of the view that GitHub
+// search can't express are returned as notes so the user knows the link is
+// approximate.
+func searchQueryForTab(tab *TabState) (string, []string) {
+	cfg := tab.Config
+	var terms, notes []string
+
+	if cfg.Mode != "search" || !strings.Contains(cfg.SearchQuery, "is:pr") {
+		terms = append(terms, "is:pr")
+	}
+	if cfg.Mode != "search" || !strings.Contains(cfg.SearchQuery, "is:open") {
+		terms = append(terms, "is:open")
+	}
+
+	switch cfg.Mode {
+	case "search":
+		terms = append(terms, cfg.SearchQuery)
+	case "organization":
+		terms = append(terms, "org:"+cfg.Organization)
+	case "teams", "topics":
+		// Search has no team or topic qualifier, so list the repos PRs were found in
+		if repos := loadedRepos(tab); len(repos) > 0 {
+			for _, repo := range repos {
+				terms = append(terms, "repo:"+repo)
+			}
+			notes = append(notes, fmt.Sprintf("%s scope as repos with open PRs", cfg.Mode))
+		} else {
+			org := cfg.Organization
+			if cfg.Mode == "topics" {
+				org = cfg.TopicOrg
+			}
+			terms = append(terms, "org:"+org)
+			notes = append(notes, fmt.Sprintf("%s scope widened to org", cfg.Mode))
+		}
+	default:
+		for _, repo := range cfg.Repos {
+			terms = append(terms, "repo:"+repo)
+		}
+	}
+
+	// Config exclusions
+	if cfg.ExcludeBots {
+		for _, bot := range botSearchAuthors {
+			terms = append(terms, "-author:"+bot)
+		}
+	}
+	for _, author := range cfg.ExcludeAuthors {
+		terms = append(terms, "-author:"+author)
+	}
+	if len(cfg.ExcludeTitles) > 0 || cfg.ExcludeBots {
+		notes = append(notes, "title exclusions")
+	}
+	if !cfg.IncludeDrafts {
+		terms = append(terms, "draft:false")
+	}
+	if cfg.Milestone != "" {
+		terms = append(terms, milestoneSearchTerm(cfg.Milestone))
+	}
+	if cfg.Project != "" {
+		// Search only knows boards by number, not title or column
+		notes = append(notes, "project board scope")
+	}
+
+	// Active filter
+	mode, value := activeFilter(tab)
+	switch mode {
+	case "":
+	case "author", "mine":
+		terms = append(terms, "author:"+value)
+	case "title":
+		terms = append(terms, searchTerm(value), "in:title")
+	case "type":
+		if value == "none" {
+			notes = append(notes, "untyped title filter")
+		} else {
+			terms = append(terms, searchTerm(value), "in:title")
+		}
+	case "language":
+		terms = append(terms, "language:"+searchTerm(value))
+	case "label":
+		terms = append(terms, "label:"+searchTerm(value))
+	case "milestone":
+		terms = append(terms, milestoneSearchTerm(value))
+	case "requested":
+		// review-requested also matches requests to the user's teams
+		login, _, _ := strings.Cut(value, ",")
+		terms = append(terms, "review-requested:"+login)
+	case "draft":
+		terms = append(terms, "draft:"+value)
+	case "quick":
+		quickTerms, quickNotes := quickSearchTerms(value)
+		terms = append(terms, quickTerms...)
+		notes = append(notes, quickNotes...)
+	case "preset":
+		presetTerms, presetNotes := presetSearchTerms(services.ParsePresetFilter(value))
+		terms = append(terms, presetTerms...)
+		notes = append(notes, presetNotes...)
+	case "status":
+		switch strings.ToLower(value) {
+		case "draft":
+			terms = append(terms, "draft:true")
+		case "ready":
+			terms = append(terms, "draft:false")
+		default:
+			notes = append(notes, "status="+value+" filter")
+		}
+	default:
+		notes = append(notes, mode+" filter")
+	}
+
+	return strings.Join(terms, " "), notes
+}
+
+// activeFilter returns the filter currently narrowing the tab's PRs, if any
+func activeFilter(tab *TabState) (string, string) {
+	if mode, value, ok := strings.Cut(tab.AppliedFilter, "="); ok {
+		return mode, value
+	}
+	switch tab.FilterMode {
+	case "draft", "type", "size", "label", "milestone", "project", "requested", "mine", "preset", "quick":
+		return tab.FilterMode, tab.FilterValue
+	}
+	return "", ""
+}
+
+// milestoneSearchTerm qualifies a search by milestone title, or to PRs
+// without one for noMilestone
+func milestoneSearchTerm(milestone string) string {
+	if strings.EqualFold(milestone, noMilestone) {
+		return "no:milestone"
+	}
+	return "milestone:" + searchTerm(milestone)
+}
+
+// quickSearchTerms translates active quick filters into search qualifiers.
+// Search can't tell which PRs have merge conflicts, so that one is noted.
+func quickSearchTerms(value string) ([]string, []string) {
+	var terms, notes []string
+	names, viewer := services.ParseQuickFilters(value)
+	for _, name := range names {
+		switch name {
+		case "mine":
+			terms = append(terms, "author:"+viewer)
+		case "review":
+			terms = append(terms, "draft:false", "-review:approved", "-review:changes_requested")
+		case "failing":
+			terms = append(terms, "status:failure")
+		case "drafts":
+			terms = append(terms, "draft:true")
+		default:
+			notes = append(notes, name+" quick filter")
+		}
+	}
+	return terms, notes
+}
+
+// presetSearchTerms translates a filter preset into search qualifiers.
+// Repeated author qualifiers match any author and comma-separated labels
+// any label. Search can only tell drafts apart, so status sets that need
+// mergeability are noted.
+func presetSearchTerms(preset services.PresetFilter) ([]string, []string) {
+	var terms, notes []string
+	for _, author := range preset.Authors {
+		terms = append(terms, "author:"+author)
+	}
+	if len(preset.Labels) > 0 {
+		labels := make([]string, len(preset.Labels))
+		for i, label := range preset.Labels {
+			labels[i] = searchTerm(label)
+		}
+		terms = append(terms, "label:"+strings.Join(labels, ","))
+	}
+
+	statuses := make(map[string]bool)
+	for _, status := range preset.Statuses {
+		statuses[strings.ToLower(status)] = true
+	}
+	switch {
+	case len(statuses) == 0 || len(statuses) == 3:
+	case statuses["draft"] && len(statuses) == 1:
+		terms = append(terms, "draft:true")
+	case !statuses["draft"]:
+		terms = append(terms, "draft:false")
+		if len(statuses) == 1 {
+			notes = append(notes, "preset status filter")
+		}
+	default:
+		notes = append(notes, "preset status filter")
+	}
+	return terms, notes
+}
+
+// loadedRepos returns the sorted repos of the tab's fetched PRs
+func loadedRepos(tab *TabState) []string {
+	seen := make(map[string]bool)
+	var repos []string
+	for _, pr := range tab.PRs {
+		if repo := repoFullName(pr); repo != "" && !seen[repo] {
+			seen[repo] = true
+			repos = append(repos, repo)
+		}
+	}
+	sort.Strings(repos)
+	return repos
+}
+
+// searchTerm quotes a value containing spaces so search treats it as a phrase
+func searchTerm(value string) string {
+	if strings.ContainsAny(value, " \t") {
+		return fmt.Sprintf("%q", value)
+	}
+	return value
+}
+
+// searchURLForTab returns the GitHub search URL for the tab's current view,
+// with notes on anything the URL can't reproduce
+func searchURLForTab(tab *TabState) (string, []string) {
+	query, notes := searchQueryForTab(tab)
+	return github.WebURL() + githubSearchPath + url.QueryEscape(query), notes
+}
+
+// searchURLStatus describes an exported search URL for the status line
+func searchURLStatus(action string, notes []string) string {
+	if len(notes) == 0 {
+		return action + " GitHub search URL for this view"
+	}
+	return fmt.Sprintf("%s GitHub search URL (approximate: %s)", action, strings.Join(notes, ", "))
+}
@@ -0,0 +1,155 @@
+package ui
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestSearchQueryForTab verifies tab scope, exclusions and filters map to GitHub search syntax
+func TestSearchQueryForTab(t *testing.T) {
+	prInRepo := func(repo string) *gh.PullRequest {
+		return &gh.PullRequest{Base: &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String(repo)}}}
+	}
+
+	tests := []struct {
+		name          string
+		tab           *TabState
+		expectedQuery string
+		expectedNotes []string
+	}{
+		{
+			name: "repos with author filter",
+			tab: &TabState{
+				Config:        &TabConfig{Mode: "repos", Repos: []string{"org/a", "org/b"}, IncludeDrafts: true},
+				AppliedFilter: "author=alice",
+			},
+			expectedQuery: "is:pr is:open repo:org/a repo:org/b author:alice",
+		},
+		{
+			name: "organization without drafts and with exclusions",
+			tab: &TabState{
+				Config: &TabConfig{Mode: "organization", Organization: "org", ExcludeAuthors: []string{"ci-user"}},
+			},
+			expectedQuery: "is:pr is:open org:org -author:ci-user draft:false",
+		},
+		{
+			name: "search keeps the user's query",
+			tab: &TabState{
+				Config:      &TabConfig{Mode: "search", SearchQuery: "is:pr is:open label:infra", IncludeDrafts: true},
+				FilterMode:  "type",
+				FilterValue: "fix",
+			},
+			expectedQuery: "is:pr is:open label:infra fix in:title",
+		},
+		{
+			name: "teams scope uses repos of loaded PRs",
+			tab: &TabState{
+				Config:     &TabConfig{Mode: "teams", Organization: "org", Teams: []string{"core"}, IncludeDrafts: true},
+				PRs:        []*gh.PullRequest{prInRepo("org/z"), prInRepo("org/y"), prInRepo("org/z")},
+				FilterMode: "draft", FilterValue: "true",
+			},
+			expectedQuery: "is:pr is:open repo:org/y repo:org/z draft:true",
+			expectedNotes: []string{"teams scope as repos with open PRs"},
+		},
+		{
+			name: "label filter",
+			tab: &TabState{
+				Config:      &TabConfig{Mode: "repos", Repos: []string{"org/a"}, IncludeDrafts: true},
+				FilterMode:  "label",
+				FilterValue: "needs review",
+			},
+			expectedQuery: `is:pr is:open repo:org/a label:"needs review"`,
+		},
+		{
+			name: "needs my review filter",
+			tab: &TabState{
+				Config:      &TabConfig{Mode: "repos", Repos: []string{"org/a"}, IncludeDrafts: true},
+				FilterMode:  "requested",
+				FilterValue: "alice,org/backend",
+			},
+			expectedQuery: "is:pr is:open repo:org/a review-requested:alice",
+		},
+		{
+			name: "filter preset",
+			tab: &TabState{
+				Config:      &TabConfig{Mode: "repos", Repos: []string{"org/a"}, IncludeDrafts: true},
+				FilterMode:  "preset",
+				FilterValue: "author=alice,bob;label=bug,needs review;status=ready",
+			},
+			expectedQuery: `is:pr is:open repo:org/a author:alice author:bob label:bug,"needs review" draft:false`,
+			expectedNotes: []string{"preset status filter"},
+		},
+		{
+			name: "quick filters",
+			tab: &TabState{
+				Config:      &TabConfig{Mode: "repos", Repos: []string{"org/a"}, IncludeDrafts: true},
+				FilterMode:  "quick",
+				FilterValue: "mine=alice,failing,conflicts",
+			},
+			expectedQuery: "is:pr is:open repo:org/a author:alice status:failure",
+			expectedNotes: []string{"conflicts quick filter"},
+		},
+		{
+			name: "bots and size filter are approximated",
+			tab: &TabState{
+				Config:      &TabConfig{Mode: "topics", TopicOrg: "org", Topics: []string{"backend"}, ExcludeBots: true, IncludeDrafts: true},
+				FilterMode:  "size",
+				FilterValue: "400",
+			},
+			expectedQuery: "is:pr is:open org:org -author:app/renovate -author:app/dependabot -author:app/github-actions",
+			expectedNotes: []string{"topics scope widened to org", "title exclusions", "size filter"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, notes := searchQueryForTab(tt.tab)
+			if query != tt.expectedQuery {
+				t.Errorf("query = %q, want %q", query, tt.expectedQuery)
+			}
+			if strings.Join(notes, "|") != strings.Join(tt.expectedNotes, "|") {
+				t.Errorf("notes = %v, want %v", notes, tt.expectedNotes)
+			}
+		})
+	}
+}
+
+// TestSearchURLForTab verifies the query is escaped into a GitHub search URL
+func TestSearchURLForTab(t *testing.T) {
+	tab := &TabState{
+		Config:        &TabConfig{Mode: "repos", Repos: []string{"org/a"}, IncludeDrafts: true},
+		AppliedFilter: "title=fix flaky",
+	}
+
+	searchURL, _ := searchURLForTab(tab)
+	parsed, err := url.Parse(searchURL)
+	if err != nil {
+		t.Fatalf("invalid URL %q: %v", searchURL, err)
+	}
+	if parsed.Host != "github.com" || parsed.Query().Get("type") != "pullrequests" {
+		t.Errorf("unexpected search URL %q", searchURL)
+	}
+	if got := parsed.Query().Get("q"); got != `is:pr is:open repo:org/a "fix flaky" in:title` {
+		t.Errorf("q = %q", got)
+	}
+}
+
+// TestClipboardCopiedStatus verifies the success or fallback status is shown after copying
+func TestClipboardCopiedStatus(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Main", Mode: "repos", Repos: []string{"org/a"}})
+
+	model.handleClipboardCopied(clipboardCopiedMsg{tabName: "Main", copied: "Copied", failed: "Clipboard unavailable - search URL: x", err: errors.New("no clipboard")})
+	if tab.StatusMsg != "Clipboard unavailable - search URL: x" {
+		t.Errorf("expected fallback status on clipboard failure, got %q", tab.StatusMsg)
+	}
+
+	model.handleClipboardCopied(clipboardCopiedMsg{tabName: "Main", copied: searchURLStatus("Copied", []string{"size filter"})})
+	if tab.StatusMsg != "Copied GitHub search URL (approximate: size filter)" {
+		t.Errorf("unexpected status %q", tab.StatusMsg)
+	}
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+// DuplicateGroup is a set of PRs across different repositories that share a
+// near-identical title or head branch (e.g. an automated multi-repo rollout)
+type DuplicateGroup struct {
+	Key string            // Normalized title or branch the PRs were grouped by
+	PRs []*gh.PullRequest // PRs in the group, ordered by repository
+}
+
+// Size returns the number of PRs in the group
+func (g DuplicateGroup) Size() int {
+	return len(g.PRs)
+}
+
+// genericBranches are head branch names too common to indicate a shared rollout
+var genericBranches = map[string]bool{
+	"main":    true,
+	"master":  true,
+	"develop": true,
+	"dev":     true,
+	"patch-1": true,
+	"patch-2": true,
+}
+
+// PRKey returns a key that uniquely identifies a PR across repositories
+func PRKey(pr *gh.PullRequest) string {
+	repo := ""
+	if pr.GetBase() != nil && pr.GetBase().GetRepo() != nil {
+		repo = pr.GetBase().GetRepo().GetFullName()
+	}
+	return fmt.Sprintf("%s#%d", repo, pr.GetNumber())
+}
+
+// DetectDuplicateGroups groups PRs from different repositories that have
+// near-identical titles or the same non-generic head branch. Only groups
+// spanning at least two repositories are returned, largest first.
+func DetectDuplicateGroups(prs []*gh.PullRequest) []DuplicateGroup {
+	byKey := make(map[string][]*gh.PullRequest)
+	var keyOrder []string
+
+	add := func(key string, pr *gh.PullRequest) {
+		if _, exists := byKey[key]; !exists {
+			keyOrder = append(keyOrder, key)
+		}
+		byKey[key] = append(byKey[key], pr)
+	}
+
+	for _, pr := range prs {
+		if title := normalizeTitle(pr.GetTitle()); title != "" {
+			add("title:"+title, pr)
+		}
+		if pr.GetHead() != nil {
+			branch := strings.ToLower(pr.GetHead().GetRef())
+			if branch != "" && !genericBranches[branch] {
+				add("branch:"+branch, pr)
+			}
+		}
+	}
+
+	// A PR may match by both title and branch - keep it in the first (largest) group only
+	var groups []DuplicateGroup
+	for _, key := range keyOrder {
+		members := byKey[key]
+		if countRepos(members) < 2 {
+			continue
+		}
+		groups = append(groups, DuplicateGroup{Key: key[strings.Index(key, ":")+1:], PRs: members})
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].Size() > groups[j].Size()
+	})
+
+	seen := make(map[string]bool)
+	var result []DuplicateGroup
+	for _, group := range groups {
+		var members []*gh.PullRequest
+		for _, pr := range group.PRs {
+			if !seen[PRKey(pr)] {
+				members = append(members, pr)
+			}
+		}
+		if countRepos(members) < 2 {
+			continue
+		}
+		for _, pr := range members {
+			seen[PRKey(pr)] = true
+		}
+		sort.SliceStable(members, func(i, j int) bool {
+			return PRKey(members[i]) < PRKey(members[j])
+		})
+		result = append(result, DuplicateGroup{Key: group.Key, PRs: members})
+	}
+
+	return result
+}
+
+// FindDuplicateGroup returns the group containing the given PR, if any
+func FindDuplicateGroup(groups []DuplicateGroup, pr *gh.PullRequest) (DuplicateGroup, bool) {
+	key := PRKey(pr)
+	for _, group := range groups {
+		for _, member := range group.PRs {
+			if PRKey(member) == key {
+				return group, true
+			}
+		}
+	}
+	return DuplicateGroup{}, false
+}
+
+// normalizeTitle reduces a title to a comparable form: lowercase, punctuation
+// and digits stripped (version bumps differ per repo), whitespace collapsed
+func normalizeTitle(title string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case unicode.IsLetter(r):
+			b.WriteRune(r)
+		case unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r):
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// countRepos counts the distinct base repositories in a list of PRs
+func countRepos(prs []*gh.PullRequest) int {
+	repos := make(map[string]bool)
+	for _, pr := range prs {
+		if pr.GetBase() != nil && pr.GetBase().GetRepo() != nil {
+			repos[pr.GetBase().GetRepo().GetFullName()] = true
+		}
+	}
+	return len(repos)
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"testing"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+func duplicateTestPR(number int, repo, title, branch string) *gh.PullRequest {
+	return &gh.PullRequest{
+		Number: gh.Int(number),
+		Title:  gh.String(title),
+		Head:   &gh.PullRequestBranch{Ref: gh.String(branch)},
+		Base: &gh.PullRequestBranch{
+			Repo: &gh.Repository{FullName: gh.String(repo)},
+		},
+	}
+}
+
+func TestDetectDuplicateGroups(t *testing.T) {
+	prs := []*gh.PullRequest{
+		duplicateTestPR(1, "org/api", "Bump lodash to 4.17.21", "rollout/lodash"),
+		duplicateTestPR(7, "org/web", "bump lodash to 4.17.20", "rollout/lodash"),
+		duplicateTestPR(3, "org/worker", "Bump Lodash to 4.17.21.", "deps-lodash"),
+		duplicateTestPR(4, "org/api", "Fix login redirect", "main"),
+		duplicateTestPR(5, "org/web", "Unrelated change", "main"),
+	}
+
+	groups := DetectDuplicateGroups(prs)
+	if len(groups) != 1 {
+		t.Fatalf("Expected 1 duplicate group, got %d", len(groups))
+	}
+
+	if groups[0].Size() != 3 {
+		t.Errorf("Expected lodash group of 3 PRs, got %d", groups[0].Size())
+	}
+
+	// Generic branch names like "main" must not group unrelated PRs
+	if _, ok := FindDuplicateGroup(groups, prs[3]); ok {
+		t.Error("PRs sharing only a generic branch should not be grouped")
+	}
+
+	if _, ok := FindDuplicateGroup(groups, prs[1]); !ok {
+		t.Error("Expected PR #7 to be found in the lodash group")
+	}
+}
+
+func TestDetectDuplicateGroups_SameRepoIsNotDuplicate(t *testing.T) {
+	prs := []*gh.PullRequest{
+		duplicateTestPR(1, "org/api", "Update README", "docs-1"),
+		duplicateTestPR(2, "org/api", "Update README", "docs-2"),
+	}
+
+	if groups := DetectDuplicateGroups(prs); len(groups) != 0 {
+		t.Errorf("Expected no groups for PRs within one repo, got %d", len(groups))
+	}
+}
+
+func TestPRKey(t *testing.T) {
+	pr := duplicateTestPR(42, "org/api", "Title", "branch")
+	if key := PRKey(pr); key != "org/api#42" {
+		t.Errorf("Expected key 'org/api#42', got %q", key)
+	}
+
+	// Missing base should not panic
+	if key := PRKey(&gh.PullRequest{Number: gh.Int(1)}); key != "#1" {
+		t.Errorf("Expected key '#1', got %q", key)
+	}
+}
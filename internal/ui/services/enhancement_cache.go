@@ -0,0 +1,157 @@
+package services
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// EnhancedCacheTTL is how long enhancement results stay cached, both for
+// headless commands like `status` and to skip refetching unchanged PRs
+// after a restart
+const EnhancedCacheTTL = 24 * time.Hour
+
+// enhancedCacheMu serializes the read-modify-write of per-repository entries,
+// as enhancement batches finish concurrently. It only covers this process:
+// on a shared sqlite or redis cache, two processes writing a repository's
+// entry at once can each drop the other's results. Those PRs are enhanced
+// again on their next refresh, so a lost update costs API calls, not data.
+var enhancedCacheMu sync.Mutex
+
+// enhancedCacheKey names a repository's cache entry
+func enhancedCacheKey(prCache *cache.PRCache, pr *gh.PullRequest) string {
+	return prCache.GenerateFetcherKey("enhanced", pr.GetBase().GetRepo().GetFullName())
+}
+
+// enhancedEntryKey names a PR's results within its repository's entry. The
+// head SHA is part of it, so a push invalidates results fetched before it.
+func enhancedEntryKey(pr *gh.PullRequest) string {
+	return strconv.Itoa(pr.GetNumber()) + "@" + pr.GetHead().GetSHA()
+}
+
+// CacheEnhancedBatch stores successful enhancement results, grouped by
+// repository, so they can be read later without API calls
+func CacheEnhancedBatch(prCache *cache.PRCache, prs []*gh.PullRequest, results []types.EnhancedData, errs []error) {
+	if prCache == nil {
+		return
+	}
+
+	byRepo := make(map[string][]int)
+	for i, pr := range prs {
+		if errs[i] == nil {
+			key := enhancedCacheKey(prCache, pr)
+			byRepo[key] = append(byRepo[key], i)
+		}
+	}
+
+	enhancedCacheMu.Lock()
+	defer enhancedCacheMu.Unlock()
+
+	for key, indexes := range byRepo {
+		entries, found := prCache.GetEnhancedPRData(key)
+		if !found {
+			entries = make(map[string]cache.EnhancedPRData)
+		}
+		for _, i := range indexes {
+			// Results for earlier head commits are superseded
+			prefix := strconv.Itoa(prs[i].GetNumber()) + "@"
+			for entryKey := range entries {
+				if strings.HasPrefix(entryKey, prefix) {
+					delete(entries, entryKey)
+				}
+			}
+			entries[enhancedEntryKey(prs[i])] = cache.EnhancedPRData{
+				Number:          prs[i].GetNumber(),
+				HeadSHA:         prs[i].GetHead().GetSHA(),
+				ReviewStatus:    results[i].ReviewStatus,
+				ChecksStatus:    results[i].ChecksStatus,
+				ReportedChecks:  results[i].ReportedChecks,
+				MergeableStatus: results[i].Mergeable,
+				Comments:        results[i].Comments,
+				ReviewComments:  results[i].ReviewComments,
+				Additions:       results[i].Additions,
+				Deletions:       results[i].Deletions,
+				ChangedFiles:    results[i].ChangedFiles,
+				Author:          prs[i].GetUser().GetLogin(),
+				Title:           prs[i].GetTitle(),
+				UpdatedAt:       prs[i].GetUpdatedAt().Time,
+				EnhancedAt:      results[i].EnhancedAt,
+				ReviewDecision:  results[i].ReviewDecision,
+				MergeState:      results[i].MergeState,
+				Reviewers:       results[i].Reviewers,
+				FirstReviewAt:   results[i].FirstReviewAt,
+			}
+		}
+		_ = prCache.SetEnhancedPRData(key, entries, EnhancedCacheTTL) // ignore cache errors
+	}
+}
+
+// CachedEnhancedData returns a PR's cached enhancement results, provided
+// its head commit is the same and it hasn't been updated since they were
+// fetched. Reviews and comments bump the update time without a new commit.
+func CachedEnhancedData(prCache *cache.PRCache, pr *gh.PullRequest) (cache.EnhancedPRData, bool) {
+	if prCache == nil {
+		return cache.EnhancedPRData{}, false
+	}
+
+	entries, found := prCache.GetEnhancedPRData(enhancedCacheKey(prCache, pr))
+	if !found {
+		return cache.EnhancedPRData{}, false
+	}
+	return cachedEntry(entries, pr)
+}
+
+// CachedEnhancedBatch returns the cached enhancement results still valid
+// for the given PRs, keyed by PR number. Each repository's entry is read
+// once.
+func CachedEnhancedBatch(prCache *cache.PRCache, prs []*gh.PullRequest) map[int]types.EnhancedData {
+	restored := make(map[int]types.EnhancedData)
+	if prCache == nil {
+		return restored
+	}
+
+	byRepo := make(map[string]map[string]cache.EnhancedPRData)
+	for _, pr := range prs {
+		key := enhancedCacheKey(prCache, pr)
+		entries, read := byRepo[key]
+		if !read {
+			entries, _ = prCache.GetEnhancedPRData(key)
+			byRepo[key] = entries
+		}
+		if data, found := cachedEntry(entries, pr); found {
+			restored[pr.GetNumber()] = types.EnhancedData{
+				Number:         data.Number,
+				Comments:       data.Comments,
+				ReviewComments: data.ReviewComments,
+				ReviewStatus:   data.ReviewStatus,
+				ChecksStatus:   data.ChecksStatus,
+				ReportedChecks: data.ReportedChecks,
+				Mergeable:      data.MergeableStatus,
+				Additions:      data.Additions,
+				Deletions:      data.Deletions,
+				ChangedFiles:   data.ChangedFiles,
+				EnhancedAt:     data.EnhancedAt,
+				Reviewers:      data.Reviewers,
+				ReviewDecision: data.ReviewDecision,
+				MergeState:     data.MergeState,
+				Behind:         data.MergeState == "BEHIND",
+				FirstReviewAt:  data.FirstReviewAt,
+			}
+		}
+	}
+	return restored
+}
+
+// cachedEntry looks up a PR's results in its repository's entry
+func cachedEntry(entries map[string]cache.EnhancedPRData, pr *gh.PullRequest) (cache.EnhancedPRData, bool) {
+	data, found := entries[enhancedEntryKey(pr)]
+	if !found || !data.UpdatedAt.Equal(pr.GetUpdatedAt().Time) {
+		return cache.EnhancedPRData{}, false
+	}
+	return data, true
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	gh "github.com/google/go-github/v55/github"
+)
+
+func TestEnhancedDataCacheRoundTrip(t *testing.T) {
+	prCache, err := cache.NewPRCacheWithDir(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create cache: %v", err)
+	}
+
+	updated := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	newPR := func(repo string, number int) *gh.PullRequest {
+		return &gh.PullRequest{
+			Number:    gh.Int(number),
+			UpdatedAt: &gh.Timestamp{Time: updated},
+			Base:      &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String(repo)}},
+		}
+	}
+	prs := []*gh.PullRequest{newPR("org/api", 1), newPR("org/api", 2), newPR("org/web", 1)}
+
+	CacheEnhancedBatch(prCache, prs[:2], []types.EnhancedData{{ChecksStatus: "failure"}, {}}, []error{nil, errors.New("boom")})
+	CacheEnhancedBatch(prCache, prs[2:], []types.EnhancedData{{ChecksStatus: "success"}}, []error{nil})
+
+	if data, found := CachedEnhancedData(prCache, prs[0]); !found || data.ChecksStatus != "failure" {
+		t.Errorf("Expected cached failure for org/api#1, got %+v (found %v)", data, found)
+	}
+	if _, found := CachedEnhancedData(prCache, prs[1]); found {
+		t.Error("Expected failed enhancements not to be cached")
+	}
+	if data, found := CachedEnhancedData(prCache, prs[2]); !found || data.ChecksStatus != "success" {
+		t.Errorf("Expected cached success for org/web#1, got %+v (found %v)", data, found)
+	}
+
+	prs[0].UpdatedAt = &gh.Timestamp{Time: updated.Add(time.Minute)}
+	if _, found := CachedEnhancedData(prCache, prs[0]); found {
+		t.Error("Expected results to be ignored once the PR was updated")
+	}
+}
+
+func TestCachedEnhancedBatchKeyedByHeadSHA(t *testing.T) {
+	prCache, err := cache.NewPRCacheWithDir(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create cache: %v", err)
+	}
+
+	updated := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	enhancedAt := updated.Add(time.Hour)
+	newPR := func(number int, sha string) *gh.PullRequest {
+		return &gh.PullRequest{
+			Number:    gh.Int(number),
+			UpdatedAt: &gh.Timestamp{Time: updated},
+			Head:      &gh.PullRequestBranch{SHA: gh.String(sha)},
+			Base:      &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/api")}},
+		}
+	}
+	prs := []*gh.PullRequest{newPR(1, "aaa"), newPR(2, "bbb")}
+	results := []types.EnhancedData{
+		{Number: 1, ReviewStatus: "approved", ReportedChecks: []string{"build"}, Additions: 120, Deletions: 30, ChangedFiles: 4, Comments: 2, EnhancedAt: enhancedAt},
+		{Number: 2, ChecksStatus: "pending", EnhancedAt: enhancedAt},
+	}
+	CacheEnhancedBatch(prCache, prs, results, []error{nil, nil})
+
+	restored := CachedEnhancedBatch(prCache, prs)
+	if len(restored) != 2 {
+		t.Fatalf("Expected both PRs restored, got %+v", restored)
+	}
+	first := restored[1]
+	if first.Additions != 120 || first.Deletions != 30 || first.ChangedFiles != 4 || first.Comments != 2 ||
+		first.ReviewStatus != "approved" || len(first.ReportedChecks) != 1 || !first.EnhancedAt.Equal(enhancedAt) {
+		t.Errorf("Expected PR 1's results to round-trip, got %+v", first)
+	}
+
+	// A push changes the head SHA, and the new results replace the old ones
+	pushed := newPR(2, "ccc")
+	if restored := CachedEnhancedBatch(prCache, []*gh.PullRequest{pushed}); len(restored) != 0 {
+		t.Errorf("Expected a new head commit to miss the cache, got %+v", restored)
+	}
+	CacheEnhancedBatch(prCache, []*gh.PullRequest{pushed}, []types.EnhancedData{{Number: 2, ChecksStatus: "success"}}, []error{nil})
+	entries, _ := prCache.GetEnhancedPRData(enhancedCacheKey(prCache, pushed))
+	if _, stale := entries["2@bbb"]; stale || len(entries) != 2 {
+		t.Errorf("Expected results for the old head commit to be dropped, got %v", entries)
+	}
+	if data, found := CachedEnhancedData(prCache, pushed); !found || data.ChecksStatus != "success" {
+		t.Errorf("Expected the new head commit's results, got %+v (found %v)", data, found)
+	}
+
+	if restored := CachedEnhancedBatch(nil, prs); len(restored) != 0 {
+		t.Error("Expected nothing restored without a cache")
+	}
+}
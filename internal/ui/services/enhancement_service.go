@@ -28,14 +28,8 @@ func NewEnhancementService(token string) EnhancementService {
 		prCtx, prCancel := context.WithTimeout(batchCtx, 10*time.Second)
 		defer prCancel()
 
-		// Get GitHub client
-		client, err := github.NewClient(token)
-		if err != nil {
-			return types.EnhancedData{Number: pr.GetNumber()}, err
-		}
-
 		// Fetch enhanced data for this PR
-		return fetchEnhancedPRData(prCtx, client, pr)
+		return FetchEnhancedData(prCtx, token, pr)
 	}
 
 	// Create batch manager with 5 concurrent workers for optimal performance
@@ -64,14 +58,8 @@ func (s *enhancementService) EnhancePR(ctx context.Context, pr *gh.PullRequest)
 	prCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	// Get GitHub client
-	client, err := github.NewClient(s.token)
-	if err != nil {
-		return nil, err
-	}
-
 	// Fetch enhanced data
-	enhancedData, err := fetchEnhancedPRData(prCtx, client, pr)
+	enhancedData, err := FetchEnhancedData(prCtx, s.token, pr)
 	if err != nil {
 		return nil, err
 	}
@@ -84,26 +72,32 @@ func (s *enhancementService) EnhancePR(ctx context.Context, pr *gh.PullRequest)
 	return &enhancedData, nil
 }
 
-// EnhancePRs processes multiple PRs for enhancement
+// EnhancePRs enhances multiple PRs in the background, one GraphQL query per
+// page of PRs, calling back once per PR
 func (s *enhancementService) EnhancePRs(ctx context.Context, prs []*gh.PullRequest, callback func(*types.EnhancedData, error)) error {
-	// Process PRs through batch manager
-	for _, pr := range prs {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
-			// Submit PR for enhancement
-			go func(pr *gh.PullRequest) {
-				// Simplified approach - directly enhance PR
-				enhancedData, err := s.EnhancePR(ctx, pr)
-				if err != nil {
-					callback(nil, err)
-				} else {
-					callback(enhancedData, nil)
-				}
-			}(pr)
-		}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
 	}
+	if len(prs) == 0 {
+		return nil
+	}
+
+	go func() {
+		results, errs := FetchEnhancedBatch(ctx, s.token, prs)
+		for i := range prs {
+			if errs[i] != nil {
+				callback(nil, errs[i])
+				continue
+			}
+			enhanced := results[i]
+			s.mutex.Lock()
+			s.enhancedData[enhanced.Number] = &enhanced
+			s.mutex.Unlock()
+			callback(&enhanced, nil)
+		}
+	}()
 
 	return nil
 }
@@ -124,77 +118,147 @@ func (s *enhancementService) GetEnhancedData(prNumber int) (*types.EnhancedData,
 	return enhanced, exists
 }
 
-// fetchEnhancedPRData fetches detailed PR information from GitHub API
-func fetchEnhancedPRData(ctx context.Context, client *gh.Client, pr *gh.PullRequest) (types.EnhancedData, error) {
+// FetchEnhancedData fetches the same details the TUI shows for a PR, without
+// caching, for headless callers that enhance each PR once
+func FetchEnhancedData(ctx context.Context, token string, pr *gh.PullRequest) (types.EnhancedData, error) {
+	results, errs := FetchEnhancedBatch(ctx, token, []*gh.PullRequest{pr})
+	return results[0], errs[0]
+}
+
+// FetchEnhancedBatch fetches enhanced data for a page of PRs with one GraphQL
+// query per github.SummaryBatchSize PRs. Results and errors are aligned with
+// prs; a failed PR's result carries only its number.
+func FetchEnhancedBatch(ctx context.Context, token string, prs []*gh.PullRequest) ([]types.EnhancedData, []error) {
+	results := make([]types.EnhancedData, len(prs))
+	errs := make([]error, len(prs))
+
+	var valid []*gh.PullRequest
+	var positions []int
+	for i, pr := range prs {
+		if err := validateEnhanceable(pr); err != nil {
+			errs[i] = err
+			continue
+		}
+		results[i].Number = pr.GetNumber()
+		valid = append(valid, pr)
+		positions = append(positions, i)
+	}
+	if len(valid) == 0 {
+		return results, errs
+	}
+
+	summaries, summaryErrs := github.FetchPRSummaries(ctx, token, valid)
+	for j, i := range positions {
+		if summaryErrs[j] != nil {
+			errs[i] = summaryErrs[j]
+			continue
+		}
+		results[i] = enhancedFromSummary(prs[i].GetNumber(), summaries[j])
+		results[i].FirstReviewAt = firstReviewAt(summaries[j].Reviews, prs[i].GetUser().GetLogin())
+	}
+	return results, errs
+}
+
+// validateEnhanceable checks that a PR names the repository it belongs to
+func validateEnhanceable(pr *gh.PullRequest) error {
 	// Validate PR structure to avoid nil pointer panics
 	if pr == nil {
-		return types.EnhancedData{}, fmt.Errorf("PR is nil")
+		return fmt.Errorf("PR is nil")
 	}
 	if pr.GetBase() == nil || pr.GetBase().GetRepo() == nil {
-		return types.EnhancedData{}, fmt.Errorf("PR base or repository is nil for PR #%d", pr.GetNumber())
+		return fmt.Errorf("PR base or repository is nil for PR #%d", pr.GetNumber())
 	}
 	if pr.GetBase().GetRepo().GetOwner() == nil {
-		return types.EnhancedData{}, fmt.Errorf("PR repository owner is nil for PR #%d", pr.GetNumber())
+		return fmt.Errorf("PR repository owner is nil for PR #%d", pr.GetNumber())
 	}
 
-	owner := pr.GetBase().GetRepo().GetOwner().GetLogin()
-	repo := pr.GetBase().GetRepo().GetName()
-	number := pr.GetNumber()
-
 	// Additional validation for required fields
-	if owner == "" {
-		return types.EnhancedData{}, fmt.Errorf("PR owner is empty for PR #%d", number)
+	if pr.GetBase().GetRepo().GetOwner().GetLogin() == "" {
+		return fmt.Errorf("PR owner is empty for PR #%d", pr.GetNumber())
 	}
-	if repo == "" {
-		return types.EnhancedData{}, fmt.Errorf("PR repository name is empty for PR #%d", number)
-	}
-
-	// Get detailed PR data
-	detailedPR, _, err := client.PullRequests.Get(ctx, owner, repo, number)
-	if err != nil {
-		return types.EnhancedData{}, err
+	if pr.GetBase().GetRepo().GetName() == "" {
+		return fmt.Errorf("PR repository name is empty for PR #%d", pr.GetNumber())
 	}
+	return nil
+}
 
-	// Get review status
-	reviews, _, err := client.PullRequests.ListReviews(ctx, owner, repo, number, nil)
-	reviewStatus := "unknown"
-	if err == nil {
-		reviewStatus = determineReviewStatus(reviews)
+// enhancedFromSummary converts a PR summary into the enhanced data the UI shows
+func enhancedFromSummary(number int, summary *github.PRSummary) types.EnhancedData {
+	reviews := make([]*gh.PullRequestReview, 0, len(summary.Reviews))
+	for _, review := range summary.Reviews {
+		reviews = append(reviews, &gh.PullRequestReview{
+			User:  &gh.User{Login: gh.String(review.Reviewer)},
+			State: gh.String(review.State),
+		})
 	}
 
-	// Get checks status
-	checksStatus := "unknown"
-	if pr.GetHead() != nil {
-		if sha := pr.GetHead().GetSHA(); sha != "" {
-			checks, _, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, sha, nil)
-			if err == nil && checks != nil {
-				checksStatus = determineChecksStatus(checks.CheckRuns)
-			}
+	// Branch protection knows best: REVIEW_REQUIRED means approvals so far
+	// aren't enough yet
+	reviewStatus := determineReviewStatus(reviews)
+	switch summary.ReviewDecision {
+	case "APPROVED":
+		reviewStatus = "approved"
+	case "CHANGES_REQUESTED":
+		reviewStatus = "changes_requested"
+	case "REVIEW_REQUIRED":
+		if reviewStatus == "approved" {
+			reviewStatus = "pending"
 		}
 	}
 
-	// Determine mergeable status
 	mergeableStatus := "unknown"
-	if detailedPR.Mergeable != nil {
-		if *detailedPR.Mergeable {
-			mergeableStatus = "clean"
-		} else {
-			mergeableStatus = "conflicts"
-		}
+	switch summary.Mergeable {
+	case "MERGEABLE":
+		mergeableStatus = "clean"
+	case "CONFLICTING":
+		mergeableStatus = "conflicts"
 	}
 
 	return types.EnhancedData{
 		Number:         number,
-		Comments:       detailedPR.GetComments(),
-		ReviewComments: detailedPR.GetReviewComments(),
+		Comments:       summary.Comments,
+		ReviewComments: summary.ReviewComments,
 		ReviewStatus:   reviewStatus,
-		ChecksStatus:   checksStatus,
+		ChecksStatus:   checksStatusFromRollup(summary.ChecksState),
+		ReportedChecks: summary.ReportedChecks,
 		Mergeable:      mergeableStatus,
-		Additions:      detailedPR.GetAdditions(),
-		Deletions:      detailedPR.GetDeletions(),
-		ChangedFiles:   detailedPR.GetChangedFiles(),
+		Behind:         summary.MergeState == "BEHIND",
+		Additions:      summary.Additions,
+		Deletions:      summary.Deletions,
+		ChangedFiles:   summary.ChangedFiles,
 		EnhancedAt:     time.Now(),
-	}, nil
+		Reviewers:      reviewerVerdicts(summary.Reviews),
+		ReviewDecision: summary.ReviewDecision,
+		MergeState:     summary.MergeState,
+	}
+}
+
+// reviewerVerdicts returns each reviewer's latest verdict. A comment after
+// an approval or change request doesn't withdraw it, as on GitHub.
+func reviewerVerdicts(reviews []github.ReviewEvent) map[string]string {
+	verdicts := make(map[string]string)
+	for _, review := range reviews {
+		if review.State == "COMMENTED" && verdicts[review.Reviewer] != "" {
+			continue
+		}
+		verdicts[review.Reviewer] = review.State
+	}
+	return verdicts
+}
+
+// firstReviewAt returns when someone other than the author first reviewed a
+// PR, or the zero time before anyone did
+func firstReviewAt(reviews []github.ReviewEvent, author string) time.Time {
+	var first time.Time
+	for _, review := range reviews {
+		if review.Reviewer == author || review.SubmittedAt.IsZero() {
+			continue
+		}
+		if first.IsZero() || review.SubmittedAt.Before(first) {
+			first = review.SubmittedAt
+		}
+	}
+	return first
 }
 
 // determineReviewStatus analyzes review data to determine overall status
@@ -233,31 +297,18 @@ func determineReviewStatus(reviews []*gh.PullRequestReview) string {
 	return "pending"
 }
 
-// determineChecksStatus analyzes check runs to determine overall status
-func determineChecksStatus(checkRuns []*gh.CheckRun) string {
-	if len(checkRuns) == 0 {
+// checksStatusFromRollup maps the rollup of a PR's checks and commit statuses
+func checksStatusFromRollup(state string) string {
+	switch state {
+	case "":
 		return "none"
-	}
-
-	hasFailure := false
-	hasPending := false
-
-	for _, check := range checkRuns {
-		switch check.GetStatus() {
-		case "completed":
-			if check.GetConclusion() == "failure" || check.GetConclusion() == "cancelled" {
-				hasFailure = true
-			}
-		case "in_progress", "queued":
-			hasPending = true
-		}
-	}
-
-	if hasFailure {
+	case "SUCCESS":
+		return "success"
+	case "FAILURE", "ERROR":
 		return "failure"
-	}
-	if hasPending {
+	case "PENDING", "EXPECTED":
 		return "pending"
+	default:
+		return "unknown"
 	}
-	return "success"
 }
@@ -2,9 +2,11 @@ package services
 
 import (
 	"context"
+	"reflect"
 	"testing"
 	"time"
 
+	"github.com/bjess9/pr-compass/internal/github"
 	"github.com/bjess9/pr-compass/internal/ui/types"
 	gh "github.com/google/go-github/v55/github"
 )
@@ -382,116 +384,89 @@ func TestDetermineReviewStatus(t *testing.T) {
 	}
 }
 
-func TestDetermineChecksStatus(t *testing.T) {
+func TestChecksStatusFromRollup(t *testing.T) {
+	tests := []struct {
+		state    string
+		expected string
+	}{
+		{state: "", expected: "none"},
+		{state: "SUCCESS", expected: "success"},
+		{state: "FAILURE", expected: "failure"},
+		{state: "ERROR", expected: "failure"},
+		{state: "PENDING", expected: "pending"},
+		{state: "EXPECTED", expected: "pending"},
+		{state: "SOMETHING_NEW", expected: "unknown"},
+	}
+
+	for _, tt := range tests {
+		if result := checksStatusFromRollup(tt.state); result != tt.expected {
+			t.Errorf("checksStatusFromRollup(%q) = %v, want %v", tt.state, result, tt.expected)
+		}
+	}
+}
+
+// TestEnhancedFromSummary tests that branch protection's review decision
+// overrides the reviews themselves
+func TestEnhancedFromSummary(t *testing.T) {
+	approved := []github.ReviewEvent{{Reviewer: "carol", State: "APPROVED"}}
 	tests := []struct {
 		name     string
-		checks   []*gh.CheckRun
+		summary  github.PRSummary
 		expected string
 	}{
-		{
-			name:     "no checks",
-			checks:   []*gh.CheckRun{},
-			expected: "none",
-		},
-		{
-			name:     "nil checks",
-			checks:   nil,
-			expected: "none",
-		},
-		{
-			name: "all successful",
-			checks: []*gh.CheckRun{
-				{
-					Status:     gh.String("completed"),
-					Conclusion: gh.String("success"),
-				},
-				{
-					Status:     gh.String("completed"),
-					Conclusion: gh.String("success"),
-				},
-			},
-			expected: "success",
-		},
-		{
-			name: "one failure",
-			checks: []*gh.CheckRun{
-				{
-					Status:     gh.String("completed"),
-					Conclusion: gh.String("success"),
-				},
-				{
-					Status:     gh.String("completed"),
-					Conclusion: gh.String("failure"),
-				},
-			},
-			expected: "failure",
-		},
-		{
-			name: "cancelled check counts as failure",
-			checks: []*gh.CheckRun{
-				{
-					Status:     gh.String("completed"),
-					Conclusion: gh.String("cancelled"),
-				},
-			},
-			expected: "failure",
-		},
-		{
-			name: "pending checks",
-			checks: []*gh.CheckRun{
-				{
-					Status: gh.String("in_progress"),
-				},
-			},
-			expected: "pending",
-		},
-		{
-			name: "queued checks",
-			checks: []*gh.CheckRun{
-				{
-					Status: gh.String("queued"),
-				},
-			},
-			expected: "pending",
-		},
-		{
-			name: "mixed status - failure takes precedence",
-			checks: []*gh.CheckRun{
-				{
-					Status:     gh.String("completed"),
-					Conclusion: gh.String("success"),
-				},
-				{
-					Status: gh.String("in_progress"),
-				},
-				{
-					Status:     gh.String("completed"),
-					Conclusion: gh.String("failure"),
-				},
-			},
-			expected: "failure",
-		},
-		{
-			name: "mixed status - pending when no failures",
-			checks: []*gh.CheckRun{
-				{
-					Status:     gh.String("completed"),
-					Conclusion: gh.String("success"),
-				},
-				{
-					Status: gh.String("in_progress"),
-				},
-			},
-			expected: "pending",
-		},
+		{name: "no decision falls back to reviews", summary: github.PRSummary{Reviews: approved}, expected: "approved"},
+		{name: "more approvals required", summary: github.PRSummary{ReviewDecision: "REVIEW_REQUIRED", Reviews: approved}, expected: "pending"},
+		{name: "no reviews yet", summary: github.PRSummary{ReviewDecision: "REVIEW_REQUIRED"}, expected: "no_review"},
+		{name: "changes requested", summary: github.PRSummary{ReviewDecision: "CHANGES_REQUESTED", Reviews: approved}, expected: "changes_requested"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := determineChecksStatus(tt.checks)
-			if result != tt.expected {
-				t.Errorf("determineChecksStatus() = %v, want %v", result, tt.expected)
+			if got := enhancedFromSummary(7, &tt.summary); got.ReviewStatus != tt.expected || got.Number != 7 {
+				t.Errorf("Expected review status %q for #7, got %q for #%d", tt.expected, got.ReviewStatus, got.Number)
 			}
 		})
 	}
+
+	data := enhancedFromSummary(7, &github.PRSummary{Mergeable: "CONFLICTING", ChecksState: "FAILURE", Additions: 10, ReviewComments: 3})
+	if data.Mergeable != "conflicts" || data.ChecksStatus != "failure" || data.Additions != 10 || data.ReviewComments != 3 {
+		t.Errorf("Unexpected enhanced data: %+v", data)
+	}
+	if data.Behind || !enhancedFromSummary(7, &github.PRSummary{Mergeable: "MERGEABLE", MergeState: "BEHIND"}).Behind {
+		t.Error("Expected only a BEHIND merge state to mark the branch behind")
+	}
+	if data := enhancedFromSummary(7, &github.PRSummary{ReviewDecision: "REVIEW_REQUIRED", MergeState: "BLOCKED"}); data.ReviewDecision != "REVIEW_REQUIRED" || data.MergeState != "BLOCKED" {
+		t.Errorf("Expected branch protection's verdict to be kept, got %+v", data)
+	}
+}
+
+// TestReviewerVerdicts tests that later comments don't withdraw a verdict
+func TestReviewerVerdicts(t *testing.T) {
+	verdicts := reviewerVerdicts([]github.ReviewEvent{
+		{Reviewer: "maria", State: "APPROVED"},
+		{Reviewer: "maria", State: "COMMENTED"},
+		{Reviewer: "bob", State: "COMMENTED"},
+		{Reviewer: "carol", State: "APPROVED"},
+		{Reviewer: "carol", State: "DISMISSED"},
+	})
+	expected := map[string]string{"maria": "APPROVED", "bob": "COMMENTED", "carol": "DISMISSED"}
+	if !reflect.DeepEqual(verdicts, expected) {
+		t.Errorf("Expected %v, got %v", expected, verdicts)
+	}
+}
+
+func TestFirstReviewAt(t *testing.T) {
+	first := time.Date(2026, 10, 2, 9, 0, 0, 0, time.UTC)
+	reviews := []github.ReviewEvent{
+		{Reviewer: "alice", State: "COMMENTED", SubmittedAt: first.Add(-time.Hour)}, // The author's own
+		{Reviewer: "bob", State: "PENDING"},                                         // Not submitted
+		{Reviewer: "carol", State: "COMMENTED", SubmittedAt: first.Add(time.Hour)},
+		{Reviewer: "bob", State: "APPROVED", SubmittedAt: first},
+	}
+	if got := firstReviewAt(reviews, "alice"); !got.Equal(first) {
+		t.Errorf("firstReviewAt() = %v, want %v", got, first)
+	}
+	if got := firstReviewAt(reviews[:2], "alice"); !got.IsZero() {
+		t.Errorf("Expected no first review without others' reviews, got %v", got)
+	}
 }
@@ -2,9 +2,13 @@ package services
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/bjess9/pr-compass/internal/github"
 	"github.com/bjess9/pr-compass/internal/ui/types"
+	gh "github.com/google/go-github/v55/github"
 )
 
 // filterService implements the FilterService interface
@@ -36,13 +40,7 @@ func (s *filterService) FilterPRs(prs []*types.PRData, filter types.FilterOption
 			include = strings.Contains(author, valueLower)
 
 		case "status":
-			status := "ready"
-			if pr.GetDraft() {
-				status = "draft"
-			} else if pr.GetMergeableState() == "dirty" {
-				status = "conflicts"
-			}
-			include = strings.Contains(status, valueLower)
+			include = strings.Contains(BasicStatus(pr.PullRequest), valueLower)
 
 		case "draft":
 			include = pr.GetDraft() == (filter.Value == "true")
@@ -57,6 +55,79 @@ func (s *filterService) FilterPRs(prs []*types.PRData, filter types.FilterOption
 				repo = strings.ToLower(pr.GetBase().GetRepo().GetName())
 			}
 			include = strings.Contains(repo, valueLower)
+
+		case "language":
+			// Repository primary language, known once repo metadata loads
+			include = pr.RepoLanguage != "" && strings.Contains(strings.ToLower(pr.RepoLanguage), valueLower)
+
+		case "topic":
+			for _, topic := range pr.RepoTopics {
+				if strings.Contains(strings.ToLower(topic), valueLower) {
+					include = true
+					break
+				}
+			}
+
+		case "type":
+			// "none" matches titles without a conventional-commit prefix
+			kind, _ := ParseTitleType(pr.GetTitle())
+			if kind == "" {
+				kind = "none"
+			}
+			include = kind == valueLower
+
+		case "unlinked":
+			// PRs that don't reference any issue or ticket
+			include = !HasIssueLink(pr.GetTitle(), pr.GetBody(), pr.GetHead().GetRef())
+
+		case "label":
+			for _, label := range pr.Labels {
+				if strings.EqualFold(label.GetName(), filter.Value) {
+					include = true
+					break
+				}
+			}
+
+		case "milestone":
+			// In the milestone, or without one for "none"
+			include = github.InMilestone(pr.PullRequest, filter.Value)
+
+		case "mine":
+			// Authored by exactly this login
+			include = strings.EqualFold(pr.GetUser().GetLogin(), filter.Value)
+
+		case "requested":
+			// Review requested from any of the comma-separated logins and org/team slugs
+			include = ReviewRequestedFrom(pr.PullRequest, strings.Split(filter.Value, ","))
+
+		case "preset":
+			// Value encodes a saved combination of authors, labels and statuses
+			include = ParsePresetFilter(filter.Value).Matches(pr.PullRequest)
+
+		case "quick":
+			// Every active quick filter, see EncodeQuickFilters
+			names, viewer := ParseQuickFilters(filter.Value)
+			include = len(names) > 0
+			for _, name := range names {
+				if !MatchesQuickFilter(pr, name, viewer) {
+					include = false
+					break
+				}
+			}
+
+		case "search":
+			// Free-text fuzzy search across title, branch, author and repo
+			include = MatchesSearch(filter.Value, SearchFields(pr.PullRequest))
+
+		case "size":
+			// Value holds the review size budget in changed lines
+			budget, err := strconv.Atoi(filter.Value)
+			include = err == nil && ExceedsSizeBudget(pr.Enhanced, budget)
+
+		case "stale":
+			// Value holds the days without updates after which a PR is stale
+			days, err := strconv.Atoi(filter.Value)
+			include = err == nil && NotUpdatedFor(pr.PullRequest, days, time.Now())
 		}
 
 		if include {
@@ -75,11 +146,83 @@ func (s *filterService) ValidateFilter(filter types.FilterOptions) error {
 		"draft":  true,
 		"title":  true,
 		"repo":   true,
+		"size":   true,
+		"stale":  true,
+		"type":   true,
+		"search": true,
+		"label":  true,
+
+		"milestone": true,
+		"requested": true,
+		"mine":      true,
+		"preset":    true,
+		"quick":     true,
+
+		"unlinked": true,
+		"language": true,
+		"topic":    true,
 	}
 
 	if filter.Mode != "" && !validModes[filter.Mode] {
 		return fmt.Errorf("invalid filter mode: %s", filter.Mode)
 	}
 
+	if filter.Mode == "size" {
+		if budget, err := strconv.Atoi(filter.Value); err != nil || budget <= 0 {
+			return fmt.Errorf("invalid size budget: %s", filter.Value)
+		}
+	}
+
+	if filter.Mode == "stale" {
+		if days, err := strconv.Atoi(filter.Value); err != nil || days <= 0 {
+			return fmt.Errorf("invalid stale days: %s", filter.Value)
+		}
+	}
+
 	return nil
 }
+
+// ExceedsSizeBudget reports whether a PR changes more lines than the review size budget.
+// PRs without enhanced data are never flagged since their size is not yet known.
+func ExceedsSizeBudget(enhanced *types.EnhancedData, budget int) bool {
+	if enhanced == nil || budget <= 0 {
+		return false
+	}
+	return enhanced.Additions+enhanced.Deletions > budget
+}
+
+// NotUpdatedFor reports whether a PR has gone at least days without updates.
+// PRs without an update time are never flagged.
+func NotUpdatedFor(pr *gh.PullRequest, days int, now time.Time) bool {
+	updated := pr.GetUpdatedAt().Time
+	if updated.IsZero() || days <= 0 {
+		return false
+	}
+	return now.Sub(updated) >= time.Duration(days)*24*time.Hour
+}
+
+// ReviewRequestedFrom reports whether a PR waits on a review from one of the
+// reviewers, given as logins and "org/team-slug" entries. Team requests only
+// match teams of the organization owning the PR's repository.
+func ReviewRequestedFrom(pr *gh.PullRequest, reviewers []string) bool {
+	owner := pr.GetBase().GetRepo().GetOwner().GetLogin()
+	for _, reviewer := range reviewers {
+		if org, slug, isTeam := strings.Cut(reviewer, "/"); isTeam {
+			if !strings.EqualFold(org, owner) {
+				continue
+			}
+			for _, team := range pr.RequestedTeams {
+				if strings.EqualFold(team.GetSlug(), slug) {
+					return true
+				}
+			}
+			continue
+		}
+		for _, user := range pr.RequestedReviewers {
+			if strings.EqualFold(user.GetLogin(), reviewer) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -1,7 +1,9 @@
 package services
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/bjess9/pr-compass/internal/ui/types"
 	gh "github.com/google/go-github/v55/github"
@@ -328,6 +330,31 @@ func TestFilterService_ValidateFilter(t *testing.T) {
 			filter:  types.FilterOptions{Mode: "repo", Value: "backend"},
 			wantErr: false,
 		},
+		{
+			name:    "valid size filter",
+			filter:  types.FilterOptions{Mode: "size", Value: "400"},
+			wantErr: false,
+		},
+		{
+			name:    "size filter requires positive budget",
+			filter:  types.FilterOptions{Mode: "size", Value: "lots"},
+			wantErr: true,
+		},
+		{
+			name:    "valid stale filter",
+			filter:  types.FilterOptions{Mode: "stale", Value: "7"},
+			wantErr: false,
+		},
+		{
+			name:    "stale filter requires positive days",
+			filter:  types.FilterOptions{Mode: "stale", Value: "0"},
+			wantErr: true,
+		},
+		{
+			name:    "valid type filter",
+			filter:  types.FilterOptions{Mode: "type", Value: "feat"},
+			wantErr: false,
+		},
 		{
 			name:    "empty mode is valid",
 			filter:  types.FilterOptions{Mode: "", Value: "anything"},
@@ -353,4 +380,244 @@ func TestFilterService_ValidateFilter(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestFilterService_FilterPRs_SizeBudget(t *testing.T) {
+	service := NewFilterService()
+
+	prs := []*types.PRData{
+		{
+			PullRequest: &gh.PullRequest{Number: gh.Int(1)},
+			Enhanced:    &types.EnhancedData{Number: 1, Additions: 350, Deletions: 100},
+		},
+		{
+			PullRequest: &gh.PullRequest{Number: gh.Int(2)},
+			Enhanced:    &types.EnhancedData{Number: 2, Additions: 20, Deletions: 5},
+		},
+		{
+			// Not yet enhanced - size unknown, never flagged
+			PullRequest: &gh.PullRequest{Number: gh.Int(3)},
+		},
+	}
+
+	result := service.FilterPRs(prs, types.FilterOptions{Mode: "size", Value: "400"})
+	if len(result) != 1 || result[0].GetNumber() != 1 {
+		t.Fatalf("Expected only PR #1 over budget, got %d PRs", len(result))
+	}
+
+	// Invalid budget value matches nothing
+	result = service.FilterPRs(prs, types.FilterOptions{Mode: "size", Value: "abc"})
+	if len(result) != 0 {
+		t.Errorf("Expected no PRs for invalid budget, got %d", len(result))
+	}
+}
+
+func TestFilterService_FilterPRs_Stale(t *testing.T) {
+	service := NewFilterService()
+	now := time.Now()
+
+	prs := []*types.PRData{
+		{PullRequest: &gh.PullRequest{Number: gh.Int(1), UpdatedAt: &gh.Timestamp{Time: now.Add(-10 * 24 * time.Hour)}}},
+		{PullRequest: &gh.PullRequest{Number: gh.Int(2), UpdatedAt: &gh.Timestamp{Time: now.Add(-2 * 24 * time.Hour)}}},
+		// Update time unknown, never flagged
+		{PullRequest: &gh.PullRequest{Number: gh.Int(3)}},
+	}
+
+	result := service.FilterPRs(prs, types.FilterOptions{Mode: "stale", Value: "7"})
+	if len(result) != 1 || result[0].GetNumber() != 1 {
+		t.Fatalf("Expected only PR #1 stale, got %d PRs", len(result))
+	}
+
+	result = service.FilterPRs(prs, types.FilterOptions{Mode: "stale", Value: "2"})
+	if len(result) != 2 {
+		t.Errorf("Expected PRs not updated in 2 days, got %d", len(result))
+	}
+}
+
+func TestFilterService_FilterPRs_Type(t *testing.T) {
+	service := NewFilterService()
+
+	prs := []*types.PRData{
+		{PullRequest: &gh.PullRequest{Number: gh.Int(1), Title: gh.String("feat(api): add search")}},
+		{PullRequest: &gh.PullRequest{Number: gh.Int(2), Title: gh.String("fix: crash on empty config")}},
+		{PullRequest: &gh.PullRequest{Number: gh.Int(3), Title: gh.String("Update README")}},
+	}
+
+	result := service.FilterPRs(prs, types.FilterOptions{Mode: "type", Value: "FIX"})
+	if len(result) != 1 || result[0].GetNumber() != 2 {
+		t.Fatalf("Expected only PR #2 for type fix, got %d PRs", len(result))
+	}
+
+	result = service.FilterPRs(prs, types.FilterOptions{Mode: "type", Value: "none"})
+	if len(result) != 1 || result[0].GetNumber() != 3 {
+		t.Errorf("Expected only untyped PR #3 for type none, got %d PRs", len(result))
+	}
+}
+
+func TestExceedsSizeBudget(t *testing.T) {
+	tests := []struct {
+		name     string
+		enhanced *types.EnhancedData
+		budget   int
+		expected bool
+	}{
+		{"nil enhanced data", nil, 400, false},
+		{"budget disabled", &types.EnhancedData{Additions: 1000}, 0, false},
+		{"exactly at budget", &types.EnhancedData{Additions: 300, Deletions: 100}, 400, false},
+		{"over budget", &types.EnhancedData{Additions: 300, Deletions: 101}, 400, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExceedsSizeBudget(tt.enhanced, tt.budget); got != tt.expected {
+				t.Errorf("ExceedsSizeBudget() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFilterService_FilterPRs_RepoStack(t *testing.T) {
+	service := NewFilterService()
+	prs := []*types.PRData{
+		{PullRequest: &gh.PullRequest{Number: gh.Int(1)}, RepoLanguage: "Go", RepoTopics: []string{"payments", "grpc"}},
+		{PullRequest: &gh.PullRequest{Number: gh.Int(2)}, RepoLanguage: "TypeScript", RepoTopics: []string{"frontend"}},
+		{PullRequest: &gh.PullRequest{Number: gh.Int(3)}}, // Metadata not loaded yet
+	}
+
+	if got := service.FilterPRs(prs, types.FilterOptions{Mode: "language", Value: "go"}); len(got) != 1 || got[0].GetNumber() != 1 {
+		t.Errorf("Expected language filter to match the Go repo only, got %d PRs", len(got))
+	}
+	if got := service.FilterPRs(prs, types.FilterOptions{Mode: "topic", Value: "FRONT"}); len(got) != 1 || got[0].GetNumber() != 2 {
+		t.Errorf("Expected topic filter to match case-insensitively, got %d PRs", len(got))
+	}
+	for _, mode := range []string{"language", "topic"} {
+		if err := service.ValidateFilter(types.FilterOptions{Mode: mode, Value: "x"}); err != nil {
+			t.Errorf("Expected %s to be a valid filter mode, got %v", mode, err)
+		}
+	}
+}
+
+func TestFilterService_FilterPRs_Label(t *testing.T) {
+	service := NewFilterService()
+	prs := []*types.PRData{
+		{PullRequest: &gh.PullRequest{Number: gh.Int(1), Labels: []*gh.Label{{Name: gh.String("bug")}, {Name: gh.String("backend")}}}},
+		{PullRequest: &gh.PullRequest{Number: gh.Int(2), Labels: []*gh.Label{{Name: gh.String("frontend")}}}},
+		{PullRequest: &gh.PullRequest{Number: gh.Int(3)}},
+	}
+
+	if got := service.FilterPRs(prs, types.FilterOptions{Mode: "label", Value: "Backend"}); len(got) != 1 || got[0].GetNumber() != 1 {
+		t.Errorf("Expected label filter to match PR 1 case-insensitively, got %d PRs", len(got))
+	}
+	if got := service.FilterPRs(prs, types.FilterOptions{Mode: "label", Value: "back"}); len(got) != 0 {
+		t.Errorf("Expected label filter to need the whole label, got %d PRs", len(got))
+	}
+	if err := service.ValidateFilter(types.FilterOptions{Mode: "label", Value: "bug"}); err != nil {
+		t.Errorf("Expected label to be a valid filter mode, got %v", err)
+	}
+}
+
+func TestFilterService_FilterPRs_Requested(t *testing.T) {
+	service := NewFilterService()
+	base := func(owner string) *gh.PullRequestBranch {
+		return &gh.PullRequestBranch{Repo: &gh.Repository{Owner: &gh.User{Login: gh.String(owner)}}}
+	}
+	prs := []*types.PRData{
+		{PullRequest: &gh.PullRequest{Number: gh.Int(1), Base: base("myorg"), RequestedReviewers: []*gh.User{{Login: gh.String("Alice")}}}},
+		{PullRequest: &gh.PullRequest{Number: gh.Int(2), Base: base("myorg"), RequestedTeams: []*gh.Team{{Slug: gh.String("backend")}}}},
+		{PullRequest: &gh.PullRequest{Number: gh.Int(3), Base: base("other"), RequestedTeams: []*gh.Team{{Slug: gh.String("backend")}}}},
+		{PullRequest: &gh.PullRequest{Number: gh.Int(4), Base: base("myorg"), RequestedReviewers: []*gh.User{{Login: gh.String("bob")}}}},
+	}
+
+	got := service.FilterPRs(prs, types.FilterOptions{Mode: "requested", Value: "alice,myorg/backend"})
+	if len(got) != 2 || got[0].GetNumber() != 1 || got[1].GetNumber() != 2 {
+		t.Errorf("Expected PRs 1 and 2 (user and same-org team requests), got %d PRs", len(got))
+	}
+	if err := service.ValidateFilter(types.FilterOptions{Mode: "requested", Value: "alice"}); err != nil {
+		t.Errorf("Expected requested to be a valid filter mode, got %v", err)
+	}
+}
+
+func TestFilterService_FilterPRs_Preset(t *testing.T) {
+	service := NewFilterService()
+	pr := func(number int, author, label string, draft bool) *types.PRData {
+		p := &gh.PullRequest{Number: gh.Int(number), User: &gh.User{Login: gh.String(author)}, Draft: gh.Bool(draft)}
+		if label != "" {
+			p.Labels = []*gh.Label{{Name: gh.String(label)}}
+		}
+		return &types.PRData{PullRequest: p}
+	}
+	prs := []*types.PRData{
+		pr(1, "alice", "backend", false),
+		pr(2, "bob", "backend", true),
+		pr(3, "carol", "backend", false),
+		pr(4, "Alice", "frontend", false),
+	}
+
+	preset := PresetFilter{Authors: []string{"alice", "bob"}, Labels: []string{"Backend"}, Statuses: []string{"ready"}}
+	value := preset.String()
+	if value != "author=alice,bob;label=Backend;status=ready" {
+		t.Errorf("Unexpected encoding %q", value)
+	}
+	if parsed := ParsePresetFilter(value); parsed.String() != value {
+		t.Errorf("Expected encoding to round-trip, got %q", parsed.String())
+	}
+
+	got := service.FilterPRs(prs, types.FilterOptions{Mode: "preset", Value: value})
+	if len(got) != 1 || got[0].GetNumber() != 1 {
+		t.Errorf("Expected only PR 1 to meet every criterion, got %d PRs", len(got))
+	}
+	got = service.FilterPRs(prs, types.FilterOptions{Mode: "preset", Value: "author=alice"})
+	if len(got) != 2 {
+		t.Errorf("Expected author-only preset to match PRs 1 and 4, got %d PRs", len(got))
+	}
+	if err := service.ValidateFilter(types.FilterOptions{Mode: "preset", Value: value}); err != nil {
+		t.Errorf("Expected preset to be a valid filter mode, got %v", err)
+	}
+}
+
+func TestFilterService_FilterPRs_Quick(t *testing.T) {
+	service := NewFilterService()
+	pr := func(number int, author string, draft bool, enhanced *types.EnhancedData) *types.PRData {
+		p := &gh.PullRequest{Number: gh.Int(number), User: &gh.User{Login: gh.String(author)}, Draft: gh.Bool(draft)}
+		return &types.PRData{PullRequest: p, Enhanced: enhanced}
+	}
+	prs := []*types.PRData{
+		pr(1, "alice", false, &types.EnhancedData{ReviewStatus: "no_review", ChecksStatus: "failure"}),
+		pr(2, "alice", false, &types.EnhancedData{ReviewStatus: "approved", ChecksStatus: "success", Mergeable: "conflicts"}),
+		pr(3, "bob", true, nil),
+		pr(4, "bob", false, nil),
+	}
+	numbers := func(got []*types.PRData) []int {
+		var n []int
+		for _, p := range got {
+			n = append(n, p.GetNumber())
+		}
+		return n
+	}
+
+	value := EncodeQuickFilters([]string{"mine", "failing"}, "alice")
+	if value != "mine=alice,failing" {
+		t.Errorf("Unexpected encoding %q", value)
+	}
+	if names, viewer := ParseQuickFilters(value); len(names) != 2 || viewer != "alice" {
+		t.Errorf("Expected encoding to round-trip, got %v %q", names, viewer)
+	}
+
+	tests := map[string][]int{
+		"mine=ALICE":         {1, 2},
+		"mine=alice,failing": {1},
+		"review":             {1, 4},
+		"drafts":             {3},
+		"conflicts":          {2},
+		"drafts,review":      nil,
+		"mine=":              nil,
+	}
+	for value, want := range tests {
+		got := numbers(service.FilterPRs(prs, types.FilterOptions{Mode: "quick", Value: value}))
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("%s: expected PRs %v, got %v", value, want, got)
+		}
+	}
+	if err := service.ValidateFilter(types.FilterOptions{Mode: "quick", Value: "drafts"}); err != nil {
+		t.Errorf("Expected quick to be a valid filter mode, got %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"strings"
+	"unicode"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+// FuzzyMatch reports whether the runes of query appear in text in order,
+// ignoring case, and returns the rune positions they matched for
+// highlighting. A contiguous match is preferred over a scattered one.
+func FuzzyMatch(query, text string) ([]int, bool) {
+	q := lowerRunes(query)
+	if len(q) == 0 {
+		return nil, true
+	}
+	t := lowerRunes(text)
+
+	for start := 0; start+len(q) <= len(t); start++ {
+		if runesEqual(t[start:start+len(q)], q) {
+			positions := make([]int, len(q))
+			for i := range q {
+				positions[i] = start + i
+			}
+			return positions, true
+		}
+	}
+
+	positions := make([]int, 0, len(q))
+	for i, r := range t {
+		if len(positions) < len(q) && r == q[len(positions)] {
+			positions = append(positions, i)
+		}
+	}
+	if len(positions) < len(q) {
+		return nil, false
+	}
+	return positions, true
+}
+
+// SearchFields are the parts of a PR free-text search looks at: title,
+// branch, author and repository
+func SearchFields(pr *gh.PullRequest) []string {
+	return []string{
+		pr.GetTitle(),
+		pr.GetHead().GetRef(),
+		pr.GetUser().GetLogin(),
+		pr.GetBase().GetRepo().GetFullName(),
+	}
+}
+
+// MatchesSearch reports whether every whitespace-separated term of query
+// fuzzy-matches at least one of the fields, so "alice retry" finds Alice's
+// PR about retries
+func MatchesSearch(query string, fields []string) bool {
+	for _, term := range strings.Fields(query) {
+		matched := false
+		for _, field := range fields {
+			if _, ok := FuzzyMatch(term, field); ok {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// lowerRunes lowercases rune by rune, keeping positions aligned with the input
+func lowerRunes(s string) []rune {
+	runes := []rune(s)
+	for i, r := range runes {
+		runes[i] = unicode.ToLower(r)
+	}
+	return runes
+}
+
+func runesEqual(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
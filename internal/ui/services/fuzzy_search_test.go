@@ -0,0 +1,52 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	tests := []struct {
+		query, text string
+		want        []int
+		ok          bool
+	}{
+		{"", "anything", nil, true},
+		{"Retry", "feat: retry webhooks", []int{6, 7, 8, 9, 10}, true},
+		{"rtw", "retry webhooks", []int{0, 2, 6}, true},
+		{"fix", "feat: add cache", nil, false},
+		{"wh", "Webhook", []int{0, 3}, true},
+	}
+
+	for _, tt := range tests {
+		got, ok := FuzzyMatch(tt.query, tt.text)
+		if ok != tt.ok || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("FuzzyMatch(%q, %q) = %v, %v; want %v, %v", tt.query, tt.text, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestMatchesSearch(t *testing.T) {
+	pr := &gh.PullRequest{
+		Title: gh.String("Retry failed webhooks"),
+		User:  &gh.User{Login: gh.String("alice")},
+		Head:  &gh.PullRequestBranch{Ref: gh.String("feature/PAY-1042-backoff")},
+		Base:  &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/payments")}},
+	}
+	fields := SearchFields(pr)
+
+	for query, want := range map[string]bool{
+		"webhook":       true,
+		"pay1042":       true,
+		"alice retry":   true,
+		"pymnts":        true,
+		"alice billing": false,
+		"bob":           false,
+	} {
+		if got := MatchesSearch(query, fields); got != want {
+			t.Errorf("MatchesSearch(%q) = %v, want %v", query, got, want)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package services
+
+import "regexp"
+
+// issueReferencePatterns match ways a PR can point at the issue or ticket it addresses
+var issueReferencePatterns = []*regexp.Regexp{
+	// "#123", "org/repo#123", including after closing keywords like "Fixes #123"
+	regexp.MustCompile(`(^|[^\w&])([\w.-]+/[\w.-]+)?#\d+\b`),
+	// Issue URLs on GitHub
+	regexp.MustCompile(`github\.com/[\w.-]+/[\w.-]+/issues/\d+`),
+	// Jira-style ticket keys such as ABC-123
+	ticketKeyPattern,
+}
+
+// ticketKeyPattern matches Jira-style ticket keys such as ABC-123
+var ticketKeyPattern = regexp.MustCompile(`\b[A-Z][A-Z0-9]{1,9}-\d+\b`)
+
+// notTicketKeys are common "WORD-123" tokens that aren't ticket keys
+var notTicketKeys = regexp.MustCompile(`\b(UTF|SHA|ISO|RFC|CVE)-\d+\b`)
+
+// HasIssueLink reports whether a PR's title, body or branch name references
+// an issue or ticket
+func HasIssueLink(title, body, branch string) bool {
+	for _, text := range []string{title, body, branch} {
+		text = notTicketKeys.ReplaceAllString(text, "")
+		for _, pattern := range issueReferencePatterns {
+			if pattern.MatchString(text) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// TicketKeys returns the Jira-style ticket keys referenced in the texts, such
+// as a PR's title and branch name, in order of appearance without repeats
+func TicketKeys(texts ...string) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, text := range texts {
+		text = notTicketKeys.ReplaceAllString(text, "")
+		for _, key := range ticketKeyPattern.FindAllString(text, -1) {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
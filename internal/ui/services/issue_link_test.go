@@ -0,0 +1,41 @@
+package services
+
+import "testing"
+
+func TestHasIssueLink(t *testing.T) {
+	tests := []struct {
+		name   string
+		title  string
+		body   string
+		branch string
+		want   bool
+	}{
+		{name: "closing keyword", title: "Fix login", body: "Fixes #123", want: true},
+		{name: "cross-repo reference", body: "Part of org/platform#42", want: true},
+		{name: "issue URL", body: "See https://github.com/org/api/issues/7 for context", want: true},
+		{name: "ticket key in title", title: "PAY-1042: retry webhooks", want: true},
+		{name: "ticket key in branch", title: "Retry webhooks", branch: "feature/PAY-1042-retries", want: true},
+		{name: "no reference", title: "Retry webhooks", body: "Adds exponential backoff", branch: "retries", want: false},
+		{name: "encoding names are not tickets", title: "Decode UTF-8 and SHA-256 input", want: false},
+		{name: "HTML entities are not issues", body: "Escape &#39; in names", want: false},
+		{name: "lowercase words are not tickets", title: "Add fix-12 helper", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasIssueLink(tt.title, tt.body, tt.branch); got != tt.want {
+				t.Errorf("HasIssueLink(%q, %q, %q) = %v, want %v", tt.title, tt.body, tt.branch, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTicketKeys(t *testing.T) {
+	got := TicketKeys("PAY-1042: retry webhooks with SHA-256 signatures", "feature/PAY-1042-OPS-7")
+	if len(got) != 2 || got[0] != "PAY-1042" || got[1] != "OPS-7" {
+		t.Errorf("TicketKeys() = %v, want [PAY-1042 OPS-7]", got)
+	}
+	if got := TicketKeys("Retry webhooks", "retries"); len(got) != 0 {
+		t.Errorf("Expected no keys, got %v", got)
+	}
+}
@@ -6,7 +6,7 @@ import (
 
 	"github.com/bjess9/pr-compass/internal/cache"
 	"github.com/bjess9/pr-compass/internal/config"
-	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/provider"
 	"github.com/bjess9/pr-compass/internal/ui/types"
 	gh "github.com/google/go-github/v55/github"
 )
@@ -27,16 +27,8 @@ func NewPRService(token string, cache *cache.PRCache) PRService {
 
 // FetchPRs retrieves PRs based on configuration
 func (s *prService) FetchPRs(ctx context.Context, cfg *config.Config) ([]*types.PRData, error) {
-	var ghPRs []*gh.PullRequest
-	var err error
-
-	// Use optimized fetching with caching if available
-	if s.cache != nil {
-		ghPRs, err = github.FetchPRsFromConfigOptimized(ctx, cfg, s.token, s.cache)
-	} else {
-		ghPRs, err = github.FetchPRsFromConfig(ctx, cfg, s.token)
-	}
-
+	// The configured provider uses the cache when available
+	ghPRs, err := provider.FetchPRs(ctx, cfg, s.token, s.cache)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"strings"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+// PresetFilter combines author, label and status criteria. A PR matches when
+// it meets every criterion set, matching any one value within each.
+type PresetFilter struct {
+	Authors  []string
+	Labels   []string
+	Statuses []string // "ready", "draft" or "conflicts"
+}
+
+// String encodes the preset as a filter value, e.g. "author=alice,bob;status=ready"
+func (p PresetFilter) String() string {
+	var parts []string
+	for _, field := range []struct {
+		key    string
+		values []string
+	}{{"author", p.Authors}, {"label", p.Labels}, {"status", p.Statuses}} {
+		if len(field.values) > 0 {
+			parts = append(parts, field.key+"="+strings.Join(field.values, ","))
+		}
+	}
+	return strings.Join(parts, ";")
+}
+
+// ParsePresetFilter decodes a filter value written by PresetFilter.String
+func ParsePresetFilter(value string) PresetFilter {
+	var p PresetFilter
+	for _, part := range strings.Split(value, ";") {
+		key, values, ok := strings.Cut(part, "=")
+		if !ok || values == "" {
+			continue
+		}
+		switch key {
+		case "author":
+			p.Authors = strings.Split(values, ",")
+		case "label":
+			p.Labels = strings.Split(values, ",")
+		case "status":
+			p.Statuses = strings.Split(values, ",")
+		}
+	}
+	return p
+}
+
+// Matches reports whether a PR meets all of the preset's criteria
+func (p PresetFilter) Matches(pr *gh.PullRequest) bool {
+	if len(p.Authors) > 0 && !containsFold(p.Authors, pr.GetUser().GetLogin()) {
+		return false
+	}
+	if len(p.Labels) > 0 {
+		labeled := false
+		for _, label := range pr.Labels {
+			if containsFold(p.Labels, label.GetName()) {
+				labeled = true
+				break
+			}
+		}
+		if !labeled {
+			return false
+		}
+	}
+	if len(p.Statuses) > 0 && !containsFold(p.Statuses, BasicStatus(pr)) {
+		return false
+	}
+	return true
+}
+
+// BasicStatus classifies a PR as "draft", "conflicts" or "ready" from the list payload
+func BasicStatus(pr *gh.PullRequest) string {
+	switch {
+	case pr.GetDraft():
+		return "draft"
+	case pr.GetMergeableState() == "dirty":
+		return "conflicts"
+	default:
+		return "ready"
+	}
+}
+
+// containsFold reports whether values holds s, ignoring case
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"strings"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+)
+
+// QuickFilters are the built-in slices of the quick filter bar, in key order
+var QuickFilters = []string{"mine", "review", "failing", "drafts", "conflicts"}
+
+// EncodeQuickFilters writes active quick filters as a filter value, e.g.
+// "mine=alice,failing". The mine filter carries the current user's login.
+func EncodeQuickFilters(names []string, viewer string) string {
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = name
+		if name == "mine" {
+			parts[i] += "=" + viewer
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// ParseQuickFilters decodes a filter value written by EncodeQuickFilters
+func ParseQuickFilters(value string) (names []string, viewer string) {
+	for _, part := range strings.Split(value, ",") {
+		name, login, _ := strings.Cut(part, "=")
+		if name == "" {
+			continue
+		}
+		if name == "mine" {
+			viewer = login
+		}
+		names = append(names, name)
+	}
+	return names, viewer
+}
+
+// MatchesQuickFilter reports whether a PR falls in a quick filter's slice.
+// Slices that need enhanced data leave PRs out until it loads, except that
+// "review" assumes a ready PR still needs review.
+func MatchesQuickFilter(pr *types.PRData, name, viewer string) bool {
+	switch name {
+	case "mine":
+		return viewer != "" && strings.EqualFold(pr.GetUser().GetLogin(), viewer)
+	case "review":
+		if pr.GetDraft() {
+			return false
+		}
+		return pr.Enhanced == nil || (pr.Enhanced.ReviewStatus != "approved" && pr.Enhanced.ReviewStatus != "changes_requested")
+	case "failing":
+		return pr.Enhanced != nil && pr.Enhanced.ChecksStatus == "failure"
+	case "drafts":
+		return pr.GetDraft()
+	case "conflicts":
+		return BasicStatus(pr.PullRequest) == "conflicts" || (pr.Enhanced != nil && pr.Enhanced.Mergeable == "conflicts")
+	}
+	return false
+}
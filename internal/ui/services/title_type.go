@@ -0,0 +1,42 @@
+package services
+
+import (
+	"regexp"
+	"strings"
+)
+
+// TitleTypes are the conventional-commit types recognised in PR titles, in display order
+var TitleTypes = []string{"feat", "fix", "perf", "refactor", "docs", "test", "build", "ci", "chore", "style", "revert"}
+
+// titleTypePattern matches "type(scope)!: subject" at the start of a title
+var titleTypePattern = regexp.MustCompile(`^\s*([a-zA-Z]+)(\([^)]*\))?(!)?:`)
+
+// ParseTitleType extracts the conventional-commit type from a PR title, e.g.
+// "feat(api)!: drop v1" returns ("feat", true). Unknown or missing prefixes
+// return an empty type.
+func ParseTitleType(title string) (string, bool) {
+	match := titleTypePattern.FindStringSubmatch(title)
+	if match == nil {
+		return "", false
+	}
+
+	kind := strings.ToLower(match[1])
+	for _, known := range TitleTypes {
+		if kind == known {
+			return kind, match[3] == "!"
+		}
+	}
+	return "", false
+}
+
+// TitleSubject splits a conventional-commit PR title into its scope and the
+// subject after the colon, e.g. "feat(api)!: drop v1" returns ("api", "drop v1").
+// Titles without a known type return no scope and the whole title.
+func TitleSubject(title string) (string, string) {
+	if kind, _ := ParseTitleType(title); kind == "" {
+		return "", strings.TrimSpace(title)
+	}
+	match := titleTypePattern.FindStringSubmatch(title)
+	scope := strings.Trim(match[2], "()")
+	return scope, strings.TrimSpace(title[len(match[0]):])
+}
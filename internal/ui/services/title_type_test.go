@@ -0,0 +1,51 @@
+package services
+
+import "testing"
+
+func TestParseTitleType(t *testing.T) {
+	tests := []struct {
+		title        string
+		wantType     string
+		wantBreaking bool
+	}{
+		{"feat: add dark mode", "feat", false},
+		{"fix(auth): refresh expired tokens", "fix", false},
+		{"feat(api)!: drop v1 endpoints", "feat", true},
+		{"refactor!: split fetcher", "refactor", true},
+		{"Chore: bump deps", "chore", false},
+		{"  docs: typo", "docs", false},
+		{"WIP: something", "", false},
+		{"Update README", "", false},
+		{"feat add thing without colon", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			gotType, gotBreaking := ParseTitleType(tt.title)
+			if gotType != tt.wantType || gotBreaking != tt.wantBreaking {
+				t.Errorf("ParseTitleType(%q) = (%q, %v), want (%q, %v)", tt.title, gotType, gotBreaking, tt.wantType, tt.wantBreaking)
+			}
+		})
+	}
+}
+
+func TestTitleSubject(t *testing.T) {
+	tests := []struct {
+		title       string
+		wantScope   string
+		wantSubject string
+	}{
+		{"feat(api)!: drop v1 endpoints", "api", "drop v1 endpoints"},
+		{"fix: refresh expired tokens", "", "refresh expired tokens"},
+		{"WIP: something", "", "WIP: something"},
+		{" Update README ", "", "Update README"},
+	}
+
+	for _, tt := range tests {
+		scope, subject := TitleSubject(tt.title)
+		if scope != tt.wantScope || subject != tt.wantSubject {
+			t.Errorf("TitleSubject(%q) = (%q, %q), want (%q, %q)", tt.title, scope, subject, tt.wantScope, tt.wantSubject)
+		}
+	}
+}
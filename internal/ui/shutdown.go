@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+)
+
+// ShutdownTimeout bounds how long quitting waits for work in flight
+const ShutdownTimeout = 3 * time.Second
+
+// Shutdown cancels outstanding API calls and waits up to timeout for cache
+// writes already underway, then closes the cache. Notes, blockers, pins, read
+// state, layouts, presets and watches are saved as they change, so they need
+// no flush.
+func (m *MultiTabModel) Shutdown(timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if m.configWatcher != nil {
+		m.configWatcher.Close() // #nosec G104 - nothing left to reload
+	}
+	m.stopMetrics()
+
+	done := make(chan error, 1)
+	go func() {
+		// Stopping enhancement waits for its workers, which the
+		// cancelled context makes return early
+		m.TabManager.Cleanup()
+		done <- m.flushCaches(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("gave up waiting for work in flight after %s", timeout)
+	}
+}
+
+// flushCaches waits for each tab's cache writes, then closes the caches
+func (m *MultiTabModel) flushCaches(ctx context.Context) error {
+	var caches []*cache.PRCache
+	seen := make(map[*cache.PRCache]bool)
+	for _, tab := range m.TabManager.Tabs {
+		if tab.PRCache != nil && !seen[tab.PRCache] {
+			seen[tab.PRCache] = true
+			caches = append(caches, tab.PRCache)
+		}
+	}
+
+	for _, prCache := range caches {
+		if err := prCache.Flush(ctx); err != nil {
+			return fmt.Errorf("cache writes still in progress: %w", err)
+		}
+	}
+	for _, prCache := range caches {
+		if err := prCache.Close(); err != nil {
+			return fmt.Errorf("failed to close cache: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestShutdown tests that quitting cancels API calls and keeps cache writes
+func TestShutdown(t *testing.T) {
+	model, tab := presetTestModel(t)
+	prCache := cache.NewPRCacheWithBackend(cache.NewMemoryBackend())
+	tab.PRCache = prCache
+	if err := prCache.SetPRList("key", []*gh.PullRequest{{Number: gh.Int(1)}}, time.Hour); err != nil {
+		t.Fatalf("SetPRList() error = %v", err)
+	}
+
+	if err := model.Shutdown(time.Second); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+	if model.TabManager.Ctx.Err() == nil || tab.Ctx.Err() == nil {
+		t.Error("Expected the session and tab contexts to be cancelled")
+	}
+	if _, found := prCache.GetPRList("key"); !found {
+		t.Error("Expected the cached list to survive shutdown")
+	}
+}
@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"context"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/slack"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// slackSentMsg reports the outcome of posting an event to Slack
+type slackSentMsg struct {
+	event slack.Event
+	err   error
+}
+
+// applySlackConfig sets up the Slack notifier, or turns it off when the
+// configuration has no slack section
+func (m *MultiTabModel) applySlackConfig(cfg *slack.Config) {
+	m.Slack = nil
+	if cfg == nil {
+		return
+	}
+	notifier, err := slack.NewNotifier(*cfg)
+	if err != nil {
+		m.Log.Warn("Slack notifications off", "err", err)
+		return
+	}
+	m.Slack = notifier
+}
+
+// slackWants reports whether a tab posts an event to Slack
+func (m *MultiTabModel) slackWants(tab *TabState, kind string) bool {
+	return m.Slack != nil && m.Slack.Wants(kind, tab.Config.SlackEvents)
+}
+
+// postSlackCmd posts events to the tab's webhook, one request each
+func (m *MultiTabModel) postSlackCmd(tab *TabState, events []slack.Event) tea.Cmd {
+	if len(events) == 0 {
+		return nil
+	}
+	env := m.Slack.WebhookEnv(tab.Config.SlackWebhookEnv)
+	webhookURL := slack.WebhookURL(env)
+	if webhookURL == "" {
+		m.Log.Warn("Slack webhook not set, events dropped", "tab", tab.Config.Name, "env", env, "events", len(events))
+		return nil
+	}
+
+	notifier := m.Slack
+	cmds := make([]tea.Cmd, len(events))
+	for i, event := range events {
+		cmds[i] = func() tea.Msg {
+			ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 10*time.Second)
+			defer cancel()
+			return slackSentMsg{event: event, err: notifier.Post(ctx, webhookURL, event)}
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// handleSlackSent logs the outcome of a Slack post; failures aren't retried
+func (m *MultiTabModel) handleSlackSent(msg slackSentMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.Log.Warn("Slack message not posted", "event", msg.event.Kind, "pr", msg.event.PR, "err", msg.err)
+	} else {
+		m.Log.Info("Posted to Slack", "event", msg.event.Kind, "pr", msg.event.PR, "tab", msg.event.Tab)
+	}
+	return m, nil
+}
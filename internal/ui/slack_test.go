@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/slack"
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// runSlackCmd runs a Slack command, returning the posted events
+func runSlackCmd(t *testing.T, cmd tea.Cmd) []slack.Event {
+	t.Helper()
+	if cmd == nil {
+		return nil
+	}
+	var events []slack.Event
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			events = append(events, runSlackCmd(t, c)...)
+		}
+	case slackSentMsg:
+		if msg.err != nil {
+			t.Fatalf("Posting %s failed: %v", msg.event.Kind, msg.err)
+		}
+		events = append(events, msg.event)
+	}
+	return events
+}
+
+// TestSlackEvents tests posting approvals, newly failing checks and new
+// review requests to each tab's webhook
+func TestSlackEvents(t *testing.T) {
+	var posted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Text string `json:"text"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		posted = append(posted, body.Text)
+	}))
+	defer server.Close()
+	t.Setenv("TEAM_SLACK", server.URL)
+
+	model := NewMultiTabModel("test-token", nil)
+	model.applySlackConfig(&slack.Config{WebhookEnv: "TEAM_SLACK"})
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"org/api"}})
+	pr := labeledPR(1)
+	pr.HTMLURL = gh.String("https://github.com/org/api/pull/1")
+	tab.PRs = []*gh.PullRequest{pr}
+
+	before := types.EnhancedData{Number: 1, ReviewStatus: "pending", ChecksStatus: "pending"}
+	after := types.EnhancedData{Number: 1, ReviewStatus: "approved", ChecksStatus: "failure", Reviewers: map[string]string{"maria": "APPROVED"}}
+	events := runSlackCmd(t, model.enhancementEventsCmd(tab, before, after))
+	if len(events) != 2 || events[0].Kind != slack.EventApproved || events[0].Detail != "@maria" || events[1].Kind != slack.EventChecksFailed {
+		t.Fatalf("Expected an approval and a check failure, got %+v", events)
+	}
+	if len(posted) != 2 || !strings.Contains(posted[0], "<https://github.com/org/api/pull/1|org/api#1>") {
+		t.Errorf("Unexpected messages %q", posted)
+	}
+	if cmd := model.enhancementEventsCmd(tab, after, after); cmd != nil {
+		t.Error("Expected unchanged details to post nothing")
+	}
+
+	// The first listing only records which PRs already wait on me
+	model.viewerLogin = "me"
+	tab.Config.SlackEvents = []string{slack.EventReviewRequested}
+	requested := labeledPR(2)
+	requested.RequestedReviewers = []*gh.User{{Login: gh.String("me")}}
+	tab.PRs = []*gh.PullRequest{pr, requested}
+	if cmd := model.reviewRequestEventsCmd(tab); cmd != nil {
+		t.Error("Expected the first listing to post nothing")
+	}
+	fresh := labeledPR(3)
+	fresh.RequestedTeams = []*gh.Team{{Slug: gh.String("backend")}}
+	fresh.Base.Repo.Owner = &gh.User{Login: gh.String("org")}
+	tab.PRs = []*gh.PullRequest{pr, requested, fresh}
+	if cmd := model.reviewRequestEventsCmd(tab); cmd != nil {
+		t.Error("Expected team requests not to count before the teams are known")
+	}
+
+	model.viewerReviewers = []string{"me", "org/backend"}
+	runSlackCmd(t, model.reviewRequestEventsCmd(tab)) // New baseline with teams
+	newer := labeledPR(4)
+	newer.RequestedReviewers = requested.RequestedReviewers
+	tab.PRs = append(tab.PRs, newer)
+	events = runSlackCmd(t, model.reviewRequestEventsCmd(tab))
+	if len(events) != 1 || events[0].Kind != slack.EventReviewRequested || events[0].PR != "org/api#4" {
+		t.Errorf("Expected only the new request to be posted, got %+v", events)
+	}
+	if cmd := model.enhancementEventsCmd(tab, before, after); cmd != nil {
+		t.Error("Expected the tab's events to replace the configured ones")
+	}
+}
@@ -0,0 +1,122 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// SortKey selects the order of a tab's PR table
+type SortKey int
+
+const (
+	SortUpdated   SortKey = iota // Last update; the order PRs are fetched in
+	SortCreated                  // Creation time
+	SortComments                 // Conversation and review comments (enhanced)
+	SortAdditions                // Added lines (enhanced)
+	SortReview                   // Review status: approved, pending, changes requested (enhanced)
+	sortKeyCount
+)
+
+// sortKeyNames are shown in the status line when cycling sort keys
+var sortKeyNames = [sortKeyCount]string{"updated", "created", "comments", "additions", "review status"}
+
+func (k SortKey) String() string {
+	if k < 0 || k >= sortKeyCount {
+		return "unknown"
+	}
+	return sortKeyNames[k]
+}
+
+// next returns the key after k, wrapping around
+func (k SortKey) next() SortKey {
+	return (k + 1) % sortKeyCount
+}
+
+// needsEnhancement reports whether the key orders by enhanced data
+func (k SortKey) needsEnhancement() bool {
+	return k == SortComments || k == SortAdditions || k == SortReview
+}
+
+// reviewRanks orders review statuses from least to most ready to merge
+var reviewRanks = map[string]int{
+	"changes_requested": 0,
+	"pending":           1,
+	"approved":          2,
+}
+
+// sortValue returns the value a PR is ordered by, and false when it is not
+// known yet because enhancement hasn't loaded
+func sortValue(pr *gh.PullRequest, enhanced map[int]types.EnhancedData, key SortKey) (int64, bool) {
+	switch key {
+	case SortCreated:
+		return pr.GetCreatedAt().Unix(), true
+	case SortUpdated:
+		return pr.GetUpdatedAt().Unix(), true
+	}
+
+	data, ok := enhanced[pr.GetNumber()]
+	if !ok {
+		return 0, false
+	}
+	switch key {
+	case SortComments:
+		return int64(data.Comments + data.ReviewComments), true
+	case SortAdditions:
+		return int64(data.Additions), true
+	default:
+		rank, known := reviewRanks[data.ReviewStatus]
+		return int64(rank), known
+	}
+}
+
+// sortPRs returns a copy of prs ordered by key. Ties keep their previous
+// order, and PRs whose value isn't known yet go last in either direction.
+func sortPRs(prs []*gh.PullRequest, enhanced map[int]types.EnhancedData, key SortKey, ascending bool) []*gh.PullRequest {
+	sorted := make([]*gh.PullRequest, len(prs))
+	copy(sorted, prs)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		vi, knownI := sortValue(sorted[i], enhanced, key)
+		vj, knownJ := sortValue(sorted[j], enhanced, key)
+		if knownI != knownJ {
+			return knownI
+		}
+		if ascending {
+			return vi < vj
+		}
+		return vi > vj
+	})
+	return sorted
+}
+
+// sortDescription describes a tab's order for the status line
+func sortDescription(tab *TabState) string {
+	direction := "descending"
+	if tab.SortAscending {
+		direction = "ascending"
+	}
+	description := fmt.Sprintf("Sorted by %s (%s)", tab.SortKey, direction)
+	if tab.SortKey.needsEnhancement() && tab.Progress.Active() {
+		description += " - PRs still loading go last"
+	}
+	return description
+}
+
+// resortTab reorders a tab's table after its sort changed, keeping the
+// selected PR selected
+func (m *MultiTabModel) resortTab(tab *TabState) {
+	selected := tab.SelectedPR()
+	m.updateTableRows(tab)
+	if selected == nil {
+		return
+	}
+	for i, pr := range tab.FilteredPRs {
+		if pr == selected {
+			tab.Table.SetCursor(i)
+			break
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+func sortTestPR(number int, created, updated time.Time) *gh.PullRequest {
+	return &gh.PullRequest{
+		Number:    gh.Int(number),
+		Title:     gh.String("PR"),
+		CreatedAt: &gh.Timestamp{Time: created},
+		UpdatedAt: &gh.Timestamp{Time: updated},
+		Base:      &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/api")}},
+	}
+}
+
+func prNumbers(prs []*gh.PullRequest) []int {
+	numbers := make([]int, len(prs))
+	for i, pr := range prs {
+		numbers[i] = pr.GetNumber()
+	}
+	return numbers
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// TestSortPRs tests ordering by each key, with unknown values last
+func TestSortPRs(t *testing.T) {
+	base := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	prs := []*gh.PullRequest{
+		sortTestPR(1, base, base.Add(3*time.Hour)),
+		sortTestPR(2, base.Add(2*time.Hour), base.Add(time.Hour)),
+		sortTestPR(3, base.Add(time.Hour), base.Add(2*time.Hour)),
+	}
+	enhanced := map[int]types.EnhancedData{
+		1: {Comments: 1, ReviewComments: 4, Additions: 10, ReviewStatus: "pending"},
+		2: {Comments: 2, Additions: 300, ReviewStatus: "approved"},
+	}
+
+	tests := []struct {
+		key       SortKey
+		ascending bool
+		want      []int
+	}{
+		{SortUpdated, false, []int{1, 3, 2}},
+		{SortUpdated, true, []int{2, 3, 1}},
+		{SortCreated, false, []int{2, 3, 1}},
+		{SortComments, false, []int{1, 2, 3}},
+		{SortAdditions, false, []int{2, 1, 3}},
+		{SortAdditions, true, []int{1, 2, 3}},
+		{SortReview, false, []int{2, 1, 3}},
+		{SortReview, true, []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		got := prNumbers(sortPRs(prs, enhanced, tt.key, tt.ascending))
+		if !equalInts(got, tt.want) {
+			t.Errorf("sortPRs(%s, ascending=%v) = %v, want %v", tt.key, tt.ascending, got, tt.want)
+		}
+	}
+	if got := prNumbers(prs); !equalInts(got, []int{1, 2, 3}) {
+		t.Errorf("Expected the input to stay unsorted, got %v", got)
+	}
+}
+
+// TestHotkeySort tests cycling sort keys with o and reversing with O
+func TestHotkeySort(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"org/api"}})
+
+	base := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: []*gh.PullRequest{
+		sortTestPR(1, base, base.Add(2*time.Hour)),
+		sortTestPR(2, base.Add(time.Hour), base.Add(time.Hour)),
+	}})
+	if got := prNumbers(tab.FilteredPRs); !equalInts(got, []int{1, 2}) {
+		t.Fatalf("Expected most recently updated first, got %v", got)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	if tab.SortKey != SortCreated || tab.StatusMsg != "Sorted by created (descending)" {
+		t.Errorf("Expected sort by created, got %s / %q", tab.SortKey, tab.StatusMsg)
+	}
+	if got := prNumbers(tab.FilteredPRs); !equalInts(got, []int{2, 1}) {
+		t.Errorf("Expected newest first, got %v", got)
+	}
+	if selected := tab.SelectedPR(); selected == nil || selected.GetNumber() != 1 {
+		t.Errorf("Expected the selection to follow PR #1, got %v", selected)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("O")})
+	if !tab.SortAscending || tab.StatusMsg != "Sorted by created (ascending)" {
+		t.Errorf("Expected ascending order, got %q", tab.StatusMsg)
+	}
+	if got := prNumbers(tab.FilteredPRs); !equalInts(got, []int{1, 2}) {
+		t.Errorf("Expected oldest first, got %v", got)
+	}
+
+	for i := 0; i < int(sortKeyCount)-1; i++ {
+		model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	}
+	if tab.SortKey != SortUpdated {
+		t.Errorf("Expected the cycle to wrap back to updated, got %s", tab.SortKey)
+	}
+}
+
+// TestSortSurvivesRefresh tests that a refreshed PR list keeps the tab's order
+func TestSortSurvivesRefresh(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"org/api"}})
+	tab.SortKey = SortCreated
+	tab.SortAscending = true
+
+	base := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: []*gh.PullRequest{
+		sortTestPR(1, base.Add(time.Hour), base.Add(2*time.Hour)),
+		sortTestPR(2, base, base.Add(time.Hour)),
+	}})
+
+	if got := prNumbers(tab.FilteredPRs); !equalInts(got, []int{2, 1}) {
+		t.Errorf("Expected oldest first after refresh, got %v", got)
+	}
+	if rows := tab.Table.Rows(); len(rows) != 2 {
+		t.Errorf("Expected 2 rows, got %d", len(rows))
+	}
+}
@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/provider"
+)
+
+// showCachedPreview fills a tab with its last cached PR list, however old,
+// so the UI has something to show while the first real fetch runs. The tab
+// stays unloaded and is marked stale until that fetch completes.
+func (m *MultiTabModel) showCachedPreview(tab *TabState) {
+	if tab.Loaded || tab.PRCache == nil {
+		return
+	}
+
+	prs, cachedAt, found := provider.CachedPRs(tab.fetchConfig(), tab.PRCache)
+	if !found || len(prs) == 0 {
+		return
+	}
+
+	m.setTabPRs(tab, tab.showListed(prs))
+	tab.StaleSince = cachedAt
+}
+
+// staleNotice describes a cached preview in the status line
+func staleNotice(cachedAt, now time.Time) string {
+	return fmt.Sprintf("🕰️ Showing cached PRs from %s - refreshing...", formatAge(now.Sub(cachedAt)))
+}
+
+// formatAge renders how long ago something happened, e.g. "5m ago" or "2d ago"
+func formatAge(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd ago", int(d.Hours()/24))
+	}
+}
@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestStartupPreviewReplacedByFetch verifies a stale preview is marked and then replaced by fetched data
+func TestStartupPreviewReplacedByFetch(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Preview", Mode: "repos", Repos: []string{"org/a"}})
+
+	prCache, err := cache.NewPRCacheWithDir(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create cache: %v", err)
+	}
+	tab.PRCache = prCache
+
+	// Nothing cached yet - nothing to preview
+	model.showCachedPreview(tab)
+	if len(tab.PRs) != 0 || !tab.StaleSince.IsZero() {
+		t.Fatal("Expected no preview without cached data")
+	}
+
+	// Simulate a preview from a previous session
+	model.setTabPRs(tab, []*gh.PullRequest{{Number: gh.Int(1), Title: gh.String("old")}})
+	tab.StaleSince = time.Now().Add(-2 * time.Hour)
+
+	view := model.renderActiveTabContent(tab)
+	if !strings.Contains(view, "cached PRs from 2h ago") {
+		t.Errorf("Expected stale notice in view, got:\n%s", view)
+	}
+	if tab.Loaded {
+		t.Error("Preview must not mark the tab as loaded")
+	}
+
+	fresh := []*gh.PullRequest{{Number: gh.Int(1), Title: gh.String("old")}, {Number: gh.Int(2), Title: gh.String("new")}}
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Preview", prs: fresh})
+
+	if !tab.StaleSince.IsZero() {
+		t.Error("Expected stale marker to clear after the real fetch")
+	}
+	if len(tab.FilteredPRs) != 2 || !tab.Loaded {
+		t.Errorf("Expected fetched PRs to replace the preview, got %d PRs (loaded=%v)", len(tab.FilteredPRs), tab.Loaded)
+	}
+}
+
+// TestFormatAge verifies compact relative ages
+func TestFormatAge(t *testing.T) {
+	tests := map[time.Duration]string{
+		30 * time.Second: "just now",
+		5 * time.Minute:  "5m ago",
+		3 * time.Hour:    "3h ago",
+		50 * time.Hour:   "2d ago",
+	}
+	for d, want := range tests {
+		if got := formatAge(d); got != want {
+			t.Errorf("formatAge(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+)
+
+// Ramp defaults when the config doesn't set them
+const (
+	defaultRampTabDelay  = 2 * time.Second
+	defaultRampRepoDelay = 50 * time.Millisecond
+)
+
+// StartupRamp staggers requests that would otherwise fire together, so
+// configs with many tabs over large orgs don't trip GitHub's secondary rate
+// limits: tabs' refresh timers start a delay apart, and a tab's first load
+// starts its repos' requests a delay apart.
+type StartupRamp struct {
+	TabDelaySeconds int `mapstructure:"tab_delay_seconds" yaml:"tab_delay_seconds,omitempty"` // Default 2
+	RepoDelayMs     int `mapstructure:"repo_delay_ms" yaml:"repo_delay_ms,omitempty"`         // Default 50
+}
+
+// Validate checks the delays. A nil StartupRamp is valid and uses the defaults.
+func (r *StartupRamp) Validate() error {
+	if r == nil {
+		return nil
+	}
+	if r.TabDelaySeconds < 0 {
+		return fmt.Errorf("startup_ramp.tab_delay_seconds must not be negative")
+	}
+	if r.RepoDelayMs < 0 {
+		return fmt.Errorf("startup_ramp.repo_delay_ms must not be negative")
+	}
+	return nil
+}
+
+// tabDelay returns the delay between consecutive tabs' refresh timers
+func (r *StartupRamp) tabDelay() time.Duration {
+	if r == nil || r.TabDelaySeconds == 0 {
+		return defaultRampTabDelay
+	}
+	return time.Duration(r.TabDelaySeconds) * time.Second
+}
+
+// repoDelay returns the delay between starting repos within a tab's first load
+func (r *StartupRamp) repoDelay() time.Duration {
+	if r == nil || r.RepoDelayMs == 0 {
+		return defaultRampRepoDelay
+	}
+	return time.Duration(r.RepoDelayMs) * time.Millisecond
+}
@@ -0,0 +1,25 @@
+package ui
+
+import (
+	"testing"
+	"time"
+)
+
+// TestStartupRamp tests the ramp defaults, overrides and validation
+func TestStartupRamp(t *testing.T) {
+	var unset *StartupRamp
+	if unset.tabDelay() != defaultRampTabDelay || unset.repoDelay() != defaultRampRepoDelay {
+		t.Error("Expected defaults without a startup_ramp")
+	}
+
+	ramp := &StartupRamp{TabDelaySeconds: 5, RepoDelayMs: 200}
+	if ramp.tabDelay() != 5*time.Second || ramp.repoDelay() != 200*time.Millisecond {
+		t.Errorf("Expected configured delays, got %v and %v", ramp.tabDelay(), ramp.repoDelay())
+	}
+	if err := ramp.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	if err := (&StartupRamp{RepoDelayMs: -1}).Validate(); err == nil {
+		t.Error("Expected a negative delay to be rejected")
+	}
+}
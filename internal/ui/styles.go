@@ -5,106 +5,120 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-const (
-	// Enhanced dark theme with improved contrast and visual hierarchy
-
-	// Base colors - darker background for better focus
-	BackgroundColor = "#1A1D23" // Deep dark background
-	SurfaceColor    = "#252831" // Slightly lighter surface
-	BorderColor     = "#3B4048" // Subtle borders
-
-	// Primary colors - bright but not overwhelming
-	PrimaryColor   = "#7C9CBF" // Calm blue
-	SecondaryColor = "#9CABCA" // Lighter blue-gray
-	AccentColor    = "#98C379" // Fresh green
-
-	// Status colors with good contrast
-	SuccessColor = "#98C379" // Green
-	WarningColor = "#E5C07B" // Warm yellow
-	ErrorColor   = "#E06C75" // Soft red
-	InfoColor    = "#61AFEF" // Bright blue
-
-	// Text colors - optimized for readability
-	TextPrimary   = "#ABB2BF" // Main text
-	TextSecondary = "#828997" // Secondary text
-	TextMuted     = "#5C6370" // Muted text
-	TextBright    = "#DCDFE4" // Highlighted text
-
-	// UI element colors
-	HeaderBgColor   = "#2C3038" // Header background
-	HeaderFgColor   = "#DCDFE4" // Header text
-	SelectedBgColor = "#4B5263" // Selection background
-	SelectedFgColor = "#FFFFFF" // Selection text
-	HoverBgColor    = "#383C45" // Hover state
+// Shared styles, built from the active theme by restyle
+var (
+	baseStyle     lipgloss.Style
+	headerStyle   lipgloss.Style
+	selectedStyle lipgloss.Style
+	cellStyle     lipgloss.Style
+	helpStyle     lipgloss.Style
+	statusStyle   lipgloss.Style
+	repoInfoStyle lipgloss.Style
+	progressStyle lipgloss.Style
+	readOnlyStyle lipgloss.Style
+	watchStyle    lipgloss.Style
+	titleStyle    lipgloss.Style
+	errorStyle    lipgloss.Style
+	mutedStyle    lipgloss.Style
 )
 
-var (
+func init() {
+	restyle()
+}
+
+// restyle rebuilds the shared styles from the active colors and palette
+func restyle() {
 	// Base container style with improved spacing and borders
 	baseStyle = lipgloss.NewStyle().
-			Background(lipgloss.Color(BackgroundColor)).
-			Foreground(lipgloss.Color(TextPrimary)).
-			Padding(1, 2).
-			BorderStyle(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color(BorderColor))
+		Background(lipgloss.Color(colors.Background)).
+		Foreground(lipgloss.Color(colors.Text)).
+		Padding(1, 2).
+		BorderStyle(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color(colors.Border))
 
 	// Enhanced header with better contrast and spacing
 	headerStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color(HeaderFgColor)).
-			Background(lipgloss.Color(HeaderBgColor)).
-			Padding(0, 1).
-			Align(lipgloss.Center)
+		Bold(true).
+		Foreground(lipgloss.Color(colors.HeaderFg)).
+		Background(lipgloss.Color(colors.HeaderBg)).
+		Padding(0, 1).
+		Align(lipgloss.Center)
 
 	// Improved selection highlighting
 	selectedStyle = lipgloss.NewStyle().
-			Background(lipgloss.Color(SelectedBgColor)).
-			Foreground(lipgloss.Color(SelectedFgColor)).
-			Bold(true).
-			Padding(0, 1)
+		Background(lipgloss.Color(colors.SelectedBg)).
+		Foreground(lipgloss.Color(colors.SelectedFg)).
+		Bold(true).
+		Padding(0, 1)
 
 	// Better cell styling with improved readability
 	cellStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color(TextPrimary)).
-			Padding(0, 1)
+		Foreground(lipgloss.Color(colors.Text)).
+		Padding(0, 1)
 
 	// Enhanced help text styling
 	helpStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color(TextSecondary)).
-			Background(lipgloss.Color(SurfaceColor)).
-			Padding(1, 2).
-			BorderStyle(lipgloss.NormalBorder()).
-			BorderTop(true).
-			BorderForeground(lipgloss.Color(BorderColor)).
-			Margin(1, 0, 0, 0)
+		Foreground(lipgloss.Color(colors.TextSecondary)).
+		Background(lipgloss.Color(colors.Surface)).
+		Padding(1, 2).
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderTop(true).
+		BorderForeground(lipgloss.Color(colors.Border)).
+		Margin(1, 0, 0, 0)
 
 	// Improved status messaging
 	statusStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color(AccentColor)).
-			Bold(false).
-			Margin(0, 0, 1, 0)
+		Foreground(lipgloss.Color(theme.Accent)).
+		Bold(false).
+		Margin(0, 0, 1, 0)
+
+	// Repository metadata popup
+	repoInfoStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color(colors.Text)).
+		Background(lipgloss.Color(colors.Surface)).
+		Padding(0, 2).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color(colors.Primary))
+
+	// Background enhancement progress bar in the status line
+	progressStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color(colors.TextMuted))
+
+	// Warning banner shown when running without a token
+	readOnlyStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color(theme.Warning)).
+		Bold(true)
+
+	// Alert banner for new PRs in watched repos
+	watchStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color(theme.Info)).
+		Bold(true)
 
 	// Enhanced title with gradient-like effect
 	titleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color(TextBright)).
-			Background(lipgloss.Color(HeaderBgColor)).
-			Padding(1, 3).
-			Margin(0, 0, 1, 0).
-			Border(lipgloss.NormalBorder()).
-			BorderBottom(true).
-			BorderForeground(lipgloss.Color(PrimaryColor))
+		Bold(true).
+		Foreground(lipgloss.Color(colors.TextBright)).
+		Background(lipgloss.Color(colors.HeaderBg)).
+		Padding(1, 3).
+		Margin(0, 0, 1, 0).
+		Border(lipgloss.NormalBorder()).
+		BorderBottom(true).
+		BorderForeground(lipgloss.Color(colors.Primary))
 
 	errorStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color(ErrorColor)).
-			Background(lipgloss.Color(SurfaceColor)).
-			Padding(1, 2).
-			BorderStyle(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color(ErrorColor)).
-			Bold(true)
+		Foreground(lipgloss.Color(theme.Error)).
+		Background(lipgloss.Color(colors.Surface)).
+		Padding(1, 2).
+		BorderStyle(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color(theme.Error)).
+		Bold(true)
 
 	mutedStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color(TextMuted))
-)
+		Foreground(lipgloss.Color(colors.TextMuted))
+}
+
+// progressBarWidth is the number of cells in the enhancement progress bar
+const progressBarWidth = 16
 
 func tableStyles() table.Styles {
 	return table.Styles{
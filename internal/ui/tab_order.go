@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// moveActiveTab moves the active tab one place left (delta -1) or right
+// (delta 1), saving the new order to the config file
+func (m *MultiTabModel) moveActiveTab(tab *TabState, delta int) {
+	tm := m.TabManager
+	from := tm.ActiveTabIdx
+	to := from + delta
+	if to < 0 || to >= len(tm.Tabs) {
+		return
+	}
+	neighbour := tm.Tabs[to]
+
+	// Swapping the two entries keeps tabs closed this session where they are
+	_, err := m.writeConfigTabs(m.addTabConfigPath(), func(tabs *yaml.Node) error {
+		i, err := configTabIndex(tabs, tab.Config.Name)
+		if err != nil {
+			return err
+		}
+		j, err := configTabIndex(tabs, neighbour.Config.Name)
+		if err != nil {
+			return err
+		}
+		tabs.Content[i], tabs.Content[j] = tabs.Content[j], tabs.Content[i]
+		return nil
+	})
+	if err != nil {
+		tab.StatusMsg = fmt.Sprintf("Tab not moved: %v", err)
+		return
+	}
+
+	tm.Tabs[from], tm.Tabs[to] = neighbour, tab
+	tm.ActiveTabIdx = to
+	direction := "right"
+	if delta < 0 {
+		direction = "left"
+	}
+	tab.StatusMsg = fmt.Sprintf("Moved tab %q %s", tab.Config.Name, direction)
+}
+
+// startRenameTab asks for a new name for the tab, prefilled with its current
+// one, and saves it to the config file
+func (m *MultiTabModel) startRenameTab(tab *TabState) {
+	m.pendingInput = &textPrompt{
+		label: "Rename tab",
+		value: tab.Config.Name,
+		onSubmit: func(answer string) string {
+			answer = strings.TrimSpace(answer)
+			if answer == "" || answer == tab.Config.Name {
+				return "Tab not renamed"
+			}
+			for _, existing := range m.TabManager.Tabs {
+				if existing.Config.Name == answer {
+					return fmt.Sprintf("Tab not renamed: a tab named %q already exists", answer)
+				}
+			}
+			if err := m.renameTab(tab, answer); err != nil {
+				return fmt.Sprintf("Tab not renamed: %v", err)
+			}
+			return fmt.Sprintf("Renamed tab to %q", answer)
+		},
+	}
+	tab.StatusMsg = m.pendingInput.status()
+}
+
+// renameTab saves the tab's new name to the config file, then moves the
+// state kept under its old name
+func (m *MultiTabModel) renameTab(tab *TabState, name string) error {
+	oldName := tab.Config.Name
+	_, err := m.writeConfigTabs(m.addTabConfigPath(), func(tabs *yaml.Node) error {
+		i, err := configTabIndex(tabs, oldName)
+		if err != nil {
+			return err
+		}
+		configTabName(tabs.Content[i]).Value = name
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	tab.Config.Name = name
+	if scheduler := m.TabManager.refreshScheduler; scheduler != nil {
+		scheduler.RemoveTab(oldName)
+	}
+	m.TabManager.scheduleTab(tab.Config)
+	// The tab is renamed either way
+	_ = m.Presets.Rename(oldName, name)
+	_ = m.Views.Rename(oldName, name)
+	return nil
+}
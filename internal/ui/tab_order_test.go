@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestMoveAndRenameTabs tests reordering and renaming tabs and saving both
+// to the config file
+func TestMoveAndRenameTabs(t *testing.T) {
+	model, main := presetTestModel(t)
+	web := model.TabManager.AddTab(&TabConfig{Name: "Web", Mode: "repos", Repos: []string{"org/web"}})
+	model.configPath = filepath.Join(t.TempDir(), "config.yaml")
+	configYAML := `# Team tabs
+tabs:
+  - name: Main
+    mode: repos
+    repos: [org/api]
+  - name: Old # closed this session
+    mode: repos
+    repos: [org/old]
+  - name: Web
+    mode: repos
+    repos: [org/web]
+`
+	if err := os.WriteFile(model.configPath, []byte(configYAML), 0600); err != nil {
+		t.Fatal(err)
+	}
+	tabNames := func() []string {
+		multiConfig, err := LoadMultiTabConfigFromPath(model.configPath)
+		if err != nil {
+			t.Fatalf("Expected the saved config to load: %v", err)
+		}
+		var names []string
+		for _, tab := range multiConfig.Tabs {
+			names = append(names, tab.Name)
+		}
+		return names
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(">")})
+	if main.FilterValue != ">" || model.TabManager.Tabs[0] != main {
+		t.Fatalf("Expected > typed into the search, got %q", main.FilterValue)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("<")})
+	if model.TabManager.ActiveTabIdx != 0 {
+		t.Fatalf("Expected the first tab to stay put, got %d", model.TabManager.ActiveTabIdx)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(">")})
+	if model.TabManager.Tabs[1] != main || model.TabManager.ActiveTabIdx != 1 {
+		t.Fatalf("Expected Main moved right and still active, got %q", main.StatusMsg)
+	}
+	if got := strings.Join(tabNames(), ","); got != "Web,Old,Main" {
+		t.Errorf("Expected Web,Old,Main in the file, got %s", got)
+	}
+	if string(model.configWritten) == "" {
+		t.Error("Expected the app's own write to skip the next reload")
+	}
+
+	if err := model.Presets.Set("Main", "Bugs"); err != nil {
+		t.Fatal(err)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlE})
+	if model.pendingInput == nil || model.pendingInput.value != "Main" {
+		t.Fatalf("Expected a rename prompt with the current name, got %q", main.StatusMsg)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlU})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(web.Config.Name)})
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !strings.Contains(main.StatusMsg, "already exists") {
+		t.Fatalf("Expected a duplicate name to be rejected, got %q", main.StatusMsg)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlE})
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlU})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("API")})
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if main.Config.Name != "API" || main.StatusMsg != `Renamed tab to "API"` {
+		t.Fatalf("Expected the tab renamed, got %q", main.StatusMsg)
+	}
+	if got := strings.Join(tabNames(), ","); got != "Web,Old,API" {
+		t.Errorf("Expected Web,Old,API in the file, got %s", got)
+	}
+	if model.Presets.Get("API") != "Bugs" || model.Presets.Get("Main") != "" {
+		t.Error("Expected the tab's preset to follow its new name")
+	}
+	data, _ := os.ReadFile(model.configPath)
+	if !strings.Contains(string(data), "# closed this session") {
+		t.Errorf("Expected comments kept, got:\n%s", data)
+	}
+}
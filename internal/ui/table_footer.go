@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// tableFooterHeight is the line the footer takes below the table
+const tableFooterHeight = 1
+
+// renderTableFooter sums up the PRs currently shown, e.g.
+// "23 PRs · +12,410/-3,220 · 5 failing · 7 awaiting review", for a sense of
+// the review workload. It follows filters, as it's computed from the
+// filtered PRs. Line counts only cover PRs whose size has loaded. Columns
+// that don't fit the terminal are noted, with how to scroll to them.
+func renderTableFooter(tab *TabState) string {
+	var additions, deletions, sized, failing, awaiting int
+	var open []*gh.PullRequest
+	for _, pr := range tab.FilteredPRs {
+		if isHistoryPR(pr) {
+			continue // Counted apart
+		}
+		open = append(open, pr)
+		data := &types.PRData{PullRequest: pr}
+		if enhanced, exists := tab.EnhancedData[pr.GetNumber()]; exists {
+			data.Enhanced = &enhanced
+			additions += enhanced.Additions
+			deletions += enhanced.Deletions
+			sized++
+		}
+		if services.MatchesQuickFilter(data, "failing", "") {
+			failing++
+		}
+		if services.MatchesQuickFilter(data, "review", "") {
+			awaiting++
+		}
+	}
+
+	total := len(open)
+	parts := []string{fmt.Sprintf("%d %s", total, plural(total, "PR", "PRs"))}
+	if sized > 0 {
+		lines := fmt.Sprintf("+%s/-%s", groupThousands(additions), groupThousands(deletions))
+		if sized < total {
+			lines += fmt.Sprintf(" (%d/%d sized)", sized, total)
+		}
+		parts = append(parts, lines)
+	}
+	parts = append(parts, fmt.Sprintf("%d failing", failing), fmt.Sprintf("%d awaiting review", awaiting))
+	if unread := tab.Seen.Count(open); unread > 0 {
+		parts = append(parts, fmt.Sprintf("%d unread", unread))
+	}
+	if merged, closed := historyCounts(tab.FilteredPRs); merged+closed > 0 {
+		parts = append(parts, fmt.Sprintf("%d merged, %d closed in %dd", merged, closed, tab.Config.historyDays()))
+	}
+	if tab.morePRs() || tab.moreOpen() {
+		parts = append(parts, "more load as you scroll down")
+	}
+	if tab.ColumnsShown < tab.ColumnsTotal {
+		parts = append(parts, fmt.Sprintf("%d of %d columns, ←/→ scroll", tab.ColumnsShown, tab.ColumnsTotal))
+	}
+	return "\n" + mutedStyle.Render(strings.Join(parts, " · "))
+}
+
+// groupThousands writes a non-negative n with comma thousands separators
+func groupThousands(n int) string {
+	digits := strconv.Itoa(n)
+	var grouped strings.Builder
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+	return grouped.String()
+}
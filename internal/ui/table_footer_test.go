@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestTableFooter tests that the footer sums up the PRs shown and follows filters
+func TestTableFooter(t *testing.T) {
+	model, tab := presetTestModel(t)
+	tab.EnhancedData = map[int]types.EnhancedData{
+		1: {Number: 1, ChecksStatus: "failure", ReviewStatus: "approved", Additions: 12000, Deletions: 3000},
+		3: {Number: 3, ChecksStatus: "success", ReviewStatus: "no_review", Additions: 410, Deletions: 220},
+	}
+
+	footer := renderTableFooter(tab)
+	if !strings.Contains(footer, "3 PRs · +12,410/-3,220 (2/3 sized) · 1 failing · 1 awaiting review") {
+		t.Errorf("Expected totals for all PRs, got %q", footer)
+	}
+
+	// Drafts narrows the footer to PR 2, whose size hasn't loaded
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("4")})
+	footer = renderTableFooter(tab)
+	if !strings.Contains(footer, "1 PR · 0 failing · 0 awaiting review") {
+		t.Errorf("Expected totals for the filtered PR, got %q", footer)
+	}
+	if !strings.Contains(model.renderActiveTabContent(tab), "1 PR · ") {
+		t.Error("Expected the footer below the table")
+	}
+}
+
+// TestGroupThousands tests thousands separators
+func TestGroupThousands(t *testing.T) {
+	for n, want := range map[int]string{0: "0", 999: "999", 1000: "1,000", 12410: "12,410", 1234567: "1,234,567"} {
+		if got := groupThousands(n); got != want {
+			t.Errorf("groupThousands(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -13,14 +14,14 @@ import (
 func TestCreateTableColumns(t *testing.T) {
 	columns := createTableColumns()
 
-	// Should have 9 columns (split Author/Repo into two columns)
-	expectedColumns := 9
+	// Should have 10 columns (split Author/Repo into two columns, title Type column)
+	expectedColumns := 10
 	if len(columns) != expectedColumns {
 		t.Errorf("Expected %d columns, got %d", expectedColumns, len(columns))
 	}
 
-	// Verify column titles (now with 9 columns: split Author/Repo, split Activity, added Created, removed Labels)
-	expectedTitles := []string{"📋 Pull Request", "👤 Author", "📦 Repo", "⚡ Status/CI", "👀 Review", "💬 Comments", "📁 Files", "📅 Created", "🕐 Updated"}
+	// Verify column titles (now with 10 columns: split Author/Repo, split Activity, added Created and Type, removed Labels)
+	expectedTitles := []string{"📋 Pull Request", "🏷️ Type", "👤 Author", "📦 Repo", "⚡ Status/CI", "👀 Review", "💬 Comments", "📁 Files", "📅 Created", "🕐 Updated"}
 	for i, col := range columns {
 		if col.Title != expectedTitles[i] {
 			t.Errorf("Column %d: expected title %q, got %q", i, expectedTitles[i], col.Title)
@@ -40,28 +41,28 @@ func TestCreateTableColumns(t *testing.T) {
 		t.Error("First column should be Pull Request")
 	}
 
-	// Updated column should be last (at index 8 for 9 columns)
-	updatedColumn := columns[8] // Updated now at index 8 (9 columns total)
+	// Updated column should be last (at index 9 for 10 columns)
+	updatedColumn := columns[9] // Updated now at index 9 (10 columns total)
 	if updatedColumn.Title != "🕐 Updated" {
 		t.Error("Last column should be Updated")
 	}
 
-	// Created column should be at index 7
-	createdColumn := columns[7] // Created at index 7
+	// Created column should be at index 8
+	createdColumn := columns[8] // Created at index 8
 	if createdColumn.Title != "📅 Created" {
-		t.Error("Created column should be at index 7")
+		t.Error("Created column should be at index 8")
 	}
 
-	// Comments column should be at index 5
-	commentsColumn := columns[5] // Comments now at index 5
+	// Comments column should be at index 6
+	commentsColumn := columns[6] // Comments now at index 6
 	if commentsColumn.Title != "💬 Comments" {
-		t.Error("Comments column should be at index 5")
+		t.Error("Comments column should be at index 6")
 	}
 
-	// Files column should be at index 6
-	filesColumn := columns[6] // Files now at index 6
+	// Files column should be at index 7
+	filesColumn := columns[7] // Files now at index 7
 	if filesColumn.Title != "📁 Files" {
-		t.Error("Files column should be at index 6")
+		t.Error("Files column should be at index 7")
 	}
 
 }
@@ -113,10 +114,10 @@ func TestCreateTableRows(t *testing.T) {
 		t.Errorf("Expected %d rows, got %d", len(testPRs), len(rows))
 	}
 
-	// Each row should have 9 columns (split Author/Repo, Comments, Files, Created, Updated separate)
+	// Each row should have 10 columns (split Author/Repo, Type, Comments, Files, Created, Updated separate)
 	for i, row := range rows {
-		if len(row) != 9 {
-			t.Errorf("Row %d should have 9 columns, got %d", i, len(row))
+		if len(row) != 10 {
+			t.Errorf("Row %d should have 10 columns, got %d", i, len(row))
 		}
 	}
 
@@ -130,44 +131,49 @@ func TestCreateTableRows(t *testing.T) {
 		t.Error("First column should contain truncated title")
 	}
 
+	// Type column should show the conventional-commit prefix
+	if typeCol := firstRow[1]; typeCol != "🟢 feat" {
+		t.Errorf("Type column should show '🟢 feat', got %q", typeCol)
+	}
+
 	// Author column should show author name
-	authorCol := firstRow[1] // Author at index 1
+	authorCol := firstRow[2] // Author at index 2
 	if authorCol != "developer" {
 		t.Errorf("Author column should show 'developer', got %q", authorCol)
 	}
 
 	// Repo column should show repo name
-	repoCol := firstRow[2] // Repo at index 2
+	repoCol := firstRow[3] // Repo at index 3
 	if !contains(repoCol, "awesome-repo") && !contains(repoCol, "awesome-rep") {
 		t.Errorf("Repo column should contain repo name (possibly truncated), got %q", repoCol)
 	}
 
 	// Status+CI column should have proper merge status indicators (clean text)
-	statusCol := firstRow[3] // Status/CI at index 3
+	statusCol := firstRow[4] // Status/CI at index 4
 	if !contains(statusCol, "Ready") && !contains(statusCol, "Draft") {
 		t.Error("Status+CI column should have status indicators")
 	}
 
 	// Comments column should show total comment count (5 + 12 = 17)
-	commentsCol := firstRow[5] // Comments column at index 5
+	commentsCol := firstRow[6] // Comments column at index 6
 	if commentsCol != "17" {
 		t.Errorf("Comments column should show '17' (5+12 comments), got %q", commentsCol)
 	}
 
 	// Files column should show "-" (placeholder since no enhanced data)
-	filesCol := firstRow[6] // Files column at index 6
+	filesCol := firstRow[7] // Files column at index 7
 	if filesCol != "-" {
 		t.Errorf("Files column should show '-' for basic data, got %q", filesCol)
 	}
 
-	// Created column should have time (index 7)
-	createdCol := firstRow[7] // Created column at index 7
+	// Created column should have time (index 8)
+	createdCol := firstRow[8] // Created column at index 8
 	if createdCol == "" {
 		t.Error("Created column should not be empty")
 	}
 
-	// Updated column should have time (index 8)
-	updatedCol := firstRow[8] // Updated column at index 8
+	// Updated column should have time (index 9)
+	updatedCol := firstRow[9] // Updated column at index 9
 	if updatedCol == "" {
 		t.Error("Updated column should not be empty")
 	}
@@ -328,8 +334,8 @@ func TestCreateTableRowsWithEnhancement(t *testing.T) {
 		t.Errorf("Expected 1 row, got %d", len(rows))
 	}
 
-	if len(rows[0]) != 9 {
-		t.Errorf("Expected 9 columns, got %d", len(rows[0]))
+	if len(rows[0]) != 10 {
+		t.Errorf("Expected 10 columns, got %d", len(rows[0]))
 	}
 
 	// Test with enhanced data
@@ -349,32 +355,99 @@ func TestCreateTableRowsWithEnhancement(t *testing.T) {
 	}
 
 	// Comments column should show "8" (8 comments, no emoji)
-	commentsCol := rowsEnhanced[0][5] // Comments is 6th column (index 5)
+	commentsCol := rowsEnhanced[0][6] // Comments is 7th column (index 6)
 	if commentsCol != "8" {
 		t.Errorf("Expected comments column to be '8', got '%s'", commentsCol)
 	}
 
 	// Files column should show file changes when enhanced data is available
-	filesCol := rowsEnhanced[0][6] // Files is 7th column (index 6)
+	filesCol := rowsEnhanced[0][7] // Files is 8th column (index 7)
 	// This will be empty since we didn't set file change data in the enhanced data
 	if filesCol != "-" {
 		t.Errorf("Expected files column to be '-' when no file data, got '%s'", filesCol)
 	}
 
 	// Status column should show enhanced merge status with CI status
-	statusCol := rowsEnhanced[0][3] // Status+CI is 4th column (index 3)
+	statusCol := rowsEnhanced[0][4] // Status+CI is 5th column (index 4)
 	// Note: This will have clean text status, so we check if it contains the merge status
 	if !contains(statusCol, "Ready") {
 		t.Errorf("Expected status column to contain 'Ready', got '%s'", statusCol)
 	}
 
 	// Review column should show enhanced review status
-	reviewCol := rowsEnhanced[0][4] // Review is 5th column (index 4)
+	reviewCol := rowsEnhanced[0][5] // Review is 6th column (index 5)
 	if reviewCol != "✅ Approved" {
 		t.Errorf("Expected review column to be '✅ Approved', got '%s'", reviewCol)
 	}
 }
 
+// TestFormatTitleType verifies the Type column rendering of title prefixes
+func TestFormatTitleType(t *testing.T) {
+	tests := []struct {
+		title    string
+		expected string
+	}{
+		{"feat: add search", "🟢 feat"},
+		{"fix(ui): off-by-one", "🔴 fix"},
+		{"refactor(api)!: rename endpoints", "🟣 refactor!"},
+		{"Bump lodash", "-"},
+	}
+
+	for _, tt := range tests {
+		if got := formatTitleType(&github.PullRequest{Title: github.String(tt.title)}); got != tt.expected {
+			t.Errorf("formatTitleType(%q) = %q, expected %q", tt.title, got, tt.expected)
+		}
+	}
+}
+
+// TestCreateTableRowsWithSizeBudget tests the "consider splitting" marker on oversized PRs
+func TestCreateTableRowsWithSizeBudget(t *testing.T) {
+	prs := []*github.PullRequest{
+		{Number: github.Int(1), Title: github.String("Huge refactor")},
+		{Number: github.Int(2), Title: github.String("Small fix")},
+	}
+	enhancedData := map[int]types.EnhancedData{
+		1: {Number: 1, ChangedFiles: 30, Additions: 900, Deletions: 200},
+		2: {Number: 2, ChangedFiles: 1, Additions: 10, Deletions: 2},
+	}
+
+	rows := createTableRowsWithOptions(prs, enhancedData, tableRowOptions{SizeBudget: 400})
+
+	if !strings.HasPrefix(rows[0][7], sizeBudgetMarker) {
+		t.Errorf("Expected oversized PR files column to start with %q, got %q", sizeBudgetMarker, rows[0][7])
+	}
+	if strings.Contains(rows[1][7], sizeBudgetMarker) {
+		t.Errorf("Expected small PR files column without marker, got %q", rows[1][7])
+	}
+
+	// No budget configured - no markers
+	rows = createTableRowsWithEnhancement(prs, enhancedData)
+	if strings.Contains(rows[0][7], sizeBudgetMarker) {
+		t.Errorf("Expected no marker without a budget, got %q", rows[0][7])
+	}
+}
+
+func TestCreateTableRowsWithIssueLinkPolicy(t *testing.T) {
+	prs := []*github.PullRequest{
+		{Number: github.Int(1), Title: github.String("Retry webhooks"), Body: github.String("Closes #12")},
+		{Number: github.Int(2), Title: github.String("Tweak logging")},
+	}
+
+	rows := createTableRowsWithOptions(prs, nil, tableRowOptions{RequireIssueLink: true})
+	if strings.Contains(rows[0][0], missingIssueMarker) {
+		t.Errorf("Expected linked PR without marker, got %q", rows[0][0])
+	}
+	if !strings.HasPrefix(rows[1][0], missingIssueMarker) {
+		t.Errorf("Expected unlinked PR to start with %q, got %q", missingIssueMarker, rows[1][0])
+	}
+
+	// No policy configured - no markers
+	rows = createTableRowsWithOptions(prs, nil, tableRowOptions{})
+	if strings.Contains(rows[1][0], missingIssueMarker) {
+		t.Errorf("Expected no marker without a policy, got %q", rows[1][0])
+	}
+}
+
 // TestGetPRCommentCountEnhanced tests enhanced comment count logic
 func TestGetPRCommentCountEnhanced(t *testing.T) {
 	pr := &github.PullRequest{
@@ -482,6 +555,17 @@ func TestGetPRStatusIndicatorEnhanced(t *testing.T) {
 			},
 			expected: "❌ Failed Checks",
 		},
+		{
+			name: "enhanced data shows branch behind its base",
+			enhancedData: map[int]types.EnhancedData{
+				123: {
+					Mergeable:    "clean",
+					ChecksStatus: "success",
+					Behind:       true,
+				},
+			},
+			expected: "⚠️ Behind",
+		},
 	}
 
 	for _, tt := range tests {
@@ -552,7 +636,7 @@ func TestGetPRReviewIndicatorEnhanced(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := getPRReviewIndicatorEnhanced(pr, tt.enhancedData)
+			result := getPRReviewIndicatorEnhanced(pr, tt.enhancedData, defaultStaleDays)
 			if result != tt.expected {
 				t.Errorf("getPRReviewIndicatorEnhanced() = %v, want %v", result, tt.expected)
 			}
@@ -746,17 +830,17 @@ func TestTableCreationNilSafety(t *testing.T) {
 					}
 
 					row := rows[0]
-					if len(row) < 3 {
-						t.Fatalf("Row should have at least 3 columns, got %d", len(row))
+					if len(row) < 4 {
+						t.Fatalf("Row should have at least 4 columns, got %d", len(row))
 					}
 
-					// Check author (column 1)
-					if row[1] != tt.expectAuthor {
-						t.Errorf("Expected author %q, got %q", tt.expectAuthor, row[1])
+					// Check author (column 2)
+					if row[2] != tt.expectAuthor {
+						t.Errorf("Expected author %q, got %q", tt.expectAuthor, row[2])
 					}
 
-					// Check repo (column 2) - extract just the repo name from full path if needed
-					repoCol := row[2]
+					// Check repo (column 3) - extract just the repo name from full path if needed
+					repoCol := row[3]
 					if tt.expectRepo == "Unknown" {
 						if repoCol != "Unknown" {
 							t.Errorf("Expected repo %q, got %q", tt.expectRepo, repoCol)
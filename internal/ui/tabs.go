@@ -2,12 +2,15 @@ package ui
 
 import (
 	"context"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/bjess9/pr-compass/internal/batch"
 	"github.com/bjess9/pr-compass/internal/cache"
 	"github.com/bjess9/pr-compass/internal/config"
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/provider"
 	"github.com/bjess9/pr-compass/internal/ui/services"
 	"github.com/bjess9/pr-compass/internal/ui/types"
 	gh "github.com/google/go-github/v55/github"
@@ -20,6 +23,13 @@ type TabConfig struct {
 	Name string `mapstructure:"name" yaml:"name"` // Display name for the tab
 	Mode string `mapstructure:"mode" yaml:"mode"` // "repos", "organization", "teams", "search", "topics"
 
+	// Code host; "gitlab" lists merge requests from GitLab projects (repos
+	// mode) or groups (organization mode), "gitea" pull requests from a
+	// Gitea or Forgejo instance. Unset means GitHub.
+	Provider  string `mapstructure:"provider" yaml:"provider,omitempty"`
+	GitLabURL string `mapstructure:"gitlab_url" yaml:"gitlab_url,omitempty"` // Self-hosted GitLab base URL
+	GiteaURL  string `mapstructure:"gitea_url" yaml:"gitea_url,omitempty"`   // Gitea or Forgejo base URL
+
 	// Mode-specific configurations
 	Repos        []string `mapstructure:"repos" yaml:"repos,omitempty"`
 	Organization string   `mapstructure:"organization" yaml:"organization,omitempty"`
@@ -34,11 +44,88 @@ type TabConfig struct {
 	ExcludeTitles  []string `mapstructure:"exclude_titles" yaml:"exclude_titles,omitempty"`
 	IncludeDrafts  bool     `mapstructure:"include_drafts" yaml:"include_drafts,omitempty"`
 
+	// Planning scope: only PRs in this milestone ("none" for PRs without
+	// one), and only PRs on this GitHub Projects board, in this Status
+	// column if set
+	Milestone     string `mapstructure:"milestone" yaml:"milestone,omitempty"`
+	Project       string `mapstructure:"project" yaml:"project,omitempty"`
+	ProjectStatus string `mapstructure:"project_status" yaml:"project_status,omitempty"`
+
 	// Tab-specific refresh interval
 	RefreshIntervalMinutes int `mapstructure:"refresh_interval_minutes" yaml:"refresh_interval_minutes,omitempty"`
 
 	// Performance options
-	MaxPRs int `mapstructure:"max_prs" yaml:"max_prs,omitempty"` // Maximum PRs to fetch for this tab
+	MaxPRs   int `mapstructure:"max_prs" yaml:"max_prs,omitempty"`     // Maximum PRs to fetch for this tab
+	MaxPages int `mapstructure:"max_pages" yaml:"max_pages,omitempty"` // Pages of 100 open PRs listed per repo (default 3)
+
+	// Review guidance options
+	ReviewSizeBudget int  `mapstructure:"review_size_budget" yaml:"review_size_budget,omitempty"` // Changed lines before a PR is flagged for splitting (0 disables)
+	RequireIssueLink bool `mapstructure:"require_issue_link" yaml:"require_issue_link,omitempty"` // Flag PRs that don't reference an issue or ticket
+
+	// Days without updates before a PR's row turns yellow, then red (defaults 3 and 5)
+	WarnDays  int `mapstructure:"warn_days" yaml:"warn_days,omitempty"`
+	StaleDays int `mapstructure:"stale_days" yaml:"stale_days,omitempty"`
+
+	// Language and Topics columns from repo metadata. Unset shows them in
+	// org-wide tabs (organization, teams, topics, search).
+	StackColumns *bool `mapstructure:"stack_columns" yaml:"stack_columns,omitempty"`
+
+	// Assignees and Labels columns listing who is assigned to each PR and
+	// how it is labeled, and a Policy column showing whether it meets its
+	// base branch's protection rules
+	AssigneeColumn bool `mapstructure:"assignee_column" yaml:"assignee_column,omitempty"`
+	LabelColumn    bool `mapstructure:"label_column" yaml:"label_column,omitempty"`
+	PolicyColumn   bool `mapstructure:"policy_column" yaml:"policy_column,omitempty"`
+
+	// Milestone and Project columns showing each PR's milestone and its
+	// Projects boards with their Status column
+	MilestoneColumn bool `mapstructure:"milestone_column" yaml:"milestone_column,omitempty"`
+	ProjectColumn   bool `mapstructure:"project_column" yaml:"project_column,omitempty"`
+
+	// Ticket column showing the Jira status of the tickets each PR's title
+	// or branch references; needs the global jira settings
+	TicketColumn bool `mapstructure:"ticket_column" yaml:"ticket_column,omitempty"`
+
+	// Route this tab's Slack events to the webhook in another environment
+	// variable, and post only these events rather than the global list
+	SlackWebhookEnv string   `mapstructure:"slack_webhook_env" yaml:"slack_webhook_env,omitempty"`
+	SlackEvents     []string `mapstructure:"slack_events" yaml:"slack_events,omitempty"`
+
+	// Chart open PRs, merges per day and median age of the tab's scope above
+	// the table, recorded across runs in the cache
+	Insights bool `mapstructure:"insights" yaml:"insights,omitempty"`
+
+	// List PRs merged or closed in the last HistoryDays days (default 7)
+	// below the open ones from the start, as w does
+	History     bool `mapstructure:"history" yaml:"history,omitempty"`
+	HistoryDays int  `mapstructure:"history_days" yaml:"history_days,omitempty"`
+}
+
+// OnGitHub reports whether the tab lists GitHub pull requests. Enhancement,
+// details and write actions use the GitHub API and are off for other providers.
+func (tc *TabConfig) OnGitHub() bool {
+	return provider.Name(tc.ConvertToConfig()) == provider.GitHub
+}
+
+// ShowsStackColumns reports whether the tab shows repo language and topics
+func (tc *TabConfig) ShowsStackColumns() bool {
+	if !tc.OnGitHub() {
+		return false
+	}
+	if tc.StackColumns != nil {
+		return *tc.StackColumns
+	}
+	switch tc.Mode {
+	case "organization", "teams", "topics", "search":
+		return true
+	}
+	return false
+}
+
+// ShowsProjectColumn reports whether the tab shows each PR's Projects
+// boards, which only GitHub has
+func (tc *TabConfig) ShowsProjectColumn() bool {
+	return tc.ProjectColumn && tc.OnGitHub()
 }
 
 // ConvertToConfig converts a TabConfig to the standard Config format
@@ -50,6 +137,9 @@ func (tc *TabConfig) ConvertToConfig() *config.Config {
 
 	return &config.Config{
 		Mode:                   tc.Mode,
+		Provider:               tc.Provider,
+		GitLabURL:              tc.GitLabURL,
+		GiteaURL:               tc.GiteaURL,
 		Repos:                  tc.Repos,
 		Organization:           tc.Organization,
 		Teams:                  tc.Teams,
@@ -60,8 +150,14 @@ func (tc *TabConfig) ConvertToConfig() *config.Config {
 		ExcludeAuthors:         tc.ExcludeAuthors,
 		ExcludeTitles:          tc.ExcludeTitles,
 		IncludeDrafts:          tc.IncludeDrafts,
+		Milestone:              tc.Milestone,
+		Project:                tc.Project,
+		ProjectStatus:          tc.ProjectStatus,
 		RefreshIntervalMinutes: tc.RefreshIntervalMinutes,
 		MaxPRs:                 maxPRs,
+		MaxPages:               tc.MaxPages,
+		ReviewSizeBudget:       tc.ReviewSizeBudget,
+		RequireIssueLink:       tc.RequireIssueLink,
 	}
 }
 
@@ -70,11 +166,36 @@ type TabState struct {
 	Config *TabConfig
 
 	// UI State
-	Table       table.Model
-	ShowHelp    bool
-	FilterMode  string // "", "author", "repo", "status"
-	FilterValue string
-	StatusMsg   string
+	Table          table.Model
+	ShowHelp       bool
+	ShowRepoInfo   bool   // Repo metadata popup follows the selected PR
+	ShowDetails    bool   // Description and review pane follows the selected PR
+	ShowChecks     bool   // Check runs panel follows the selected PR
+	ShowActivity   bool   // Session activity log popup
+	ShowReviewLoad bool   // Review load chart of the tab's open PRs
+	DetailScroll   int    // First visible line of the detail pane
+	FilterMode     string // "", "author", "repo", "status"
+	FilterValue    string
+	StatusMsg      string
+
+	// ColumnOffset is how many columns right of the PR title are scrolled
+	// past with ←/→, and ColumnsShown how many of the ColumnsTotal columns
+	// fit the terminal
+	ColumnOffset int
+	ColumnsShown int
+	ColumnsTotal int
+
+	// AppliedFilter describes the last text filter applied (author=bob), since
+	// FilterMode is cleared once filter input is confirmed
+	AppliedFilter string
+
+	// Table order, cycled with o and reversed with O; defaults to most recently updated first
+	SortKey       SortKey
+	SortAscending bool
+
+	// InputHistory remembers submitted filter values and prompt text per
+	// prompt kind, recalled with up/down while typing
+	InputHistory map[string]*inputHistory
 
 	// Data State
 	PRs         []*gh.PullRequest
@@ -82,11 +203,41 @@ type TabState struct {
 	Loaded      bool
 	Error       error
 
+	// ListedPRs holds every PR the last fetch listed. PRs shows the first
+	// PRLimit of them, raised a page at a time by scrolling to the bottom
+	// (0 until then: max_prs).
+	ListedPRs []*gh.PullRequest
+	PRLimit   int
+
+	// ExtraPages is how many pages past max_pages the tab lists, one more
+	// each time scrolling shows the last PR of a partial list. ListingMore
+	// is set while that page is fetched, and ListedAll once a page adds
+	// nothing, as when search stops at the API's 1,000 results.
+	ExtraPages  int
+	ListingMore bool
+	ListedAll   bool
+
+	// EmptyScope is set when the tab's teams/topics/organization resolved to no repositories
+	EmptyScope *github.NoRepositoriesError
+
+	// PRCounts holds each listed repo's open PR count from the last fresh fetch
+	PRCounts github.PRCounts
+
+	// DismissedIssues is the failed repos (issuesKey) whose panel was
+	// dismissed; failures that differ open it again
+	DismissedIssues string
+
+	// Recorded days of an insights tab, oldest first, and why the last update failed
+	Insights    []cache.InsightsDay
+	InsightsErr error
+
 	// Enhanced data tracking
 	EnhancedData     map[int]types.EnhancedData // PR number -> enhanced data
+	EnhancedFor      map[int]time.Time          // PR number -> update time its enhanced data reflects
 	EnhancementMutex sync.RWMutex
 	Enhancing        bool
 	EnhancedCount    int
+	Progress         EnhancementProgress
 
 	// Background processing
 	BatchManager    *batch.Manager[*gh.PullRequest, types.EnhancedData]
@@ -99,8 +250,42 @@ type TabState struct {
 	// Cache
 	PRCache *cache.PRCache
 
+	// Local "blocked on" annotations (shared with the tab manager)
+	Blockers *BlockerStore
+
+	// Private PR notes (shared with the tab manager)
+	Notes *NoteStore
+
+	// Pinned PRs (shared with the tab manager)
+	Pins *PinStore
+
+	// When each PR was last viewed (shared with the tab manager)
+	Seen *SeenStore
+
+	// Reviews submitted from this session (shared with the tab manager)
+	Reviews map[string]submittedReview
+
+	// Cross-repo duplicate detection (recomputed on every fetch)
+	DuplicateGroups []services.DuplicateGroup
+
+	// PRs that merged or closed during the session, newest first, shown
+	// dimmed below the table for a while
+	RecentlyCompleted []CompletedPR
+
+	// PRs the tab's scope merged or closed within its history window, most
+	// recently closed first, listed after the open ones while ShowHistory
+	History     []*gh.PullRequest
+	ShowHistory bool
+
+	// PRs ("owner/name#123") requesting the current user's review at the last
+	// listing, and whose review requests those were; nil before the first
+	// listing. New entries are reported to Slack and the terminal alerts.
+	requestedOfMe   map[string]bool
+	requestedOfMeBy string
+
 	// State management
 	BackgroundRefreshing bool
+	StaleSince           time.Time // When the cached preview being shown was fetched; zero once fresh
 	LastSelectedPRIndex  int
 	EnhancementQueue     map[int]bool
 
@@ -109,12 +294,139 @@ type TabState struct {
 	LoadTime        time.Time
 }
 
+// EnhancementProgress tracks a tab's current background enhancement run
+type EnhancementProgress struct {
+	Total     int          // PRs that needed enhancement when the run started
+	Completed int          // PRs enhanced successfully
+	Failed    int          // PRs whose enhancement failed (not retried within the run)
+	Started   time.Time    // When the run started, used for the ETA
+	failedPRs map[int]bool // PR numbers that failed in this run
+}
+
+// Active reports whether the run still has PRs outstanding
+func (p *EnhancementProgress) Active() bool {
+	return p.Total > 0 && p.Completed+p.Failed < p.Total
+}
+
+// Start begins a new run for the given number of PRs
+func (p *EnhancementProgress) Start(total int, now time.Time) {
+	*p = EnhancementProgress{Total: total, Started: now, failedPRs: make(map[int]bool)}
+}
+
+// Record counts the outcome of enhancing one PR
+func (p *EnhancementProgress) Record(prNumber int, err error) {
+	if p.Total == 0 {
+		return
+	}
+	if err != nil {
+		if p.failedPRs == nil {
+			p.failedPRs = make(map[int]bool)
+		}
+		p.failedPRs[prNumber] = true
+		p.Failed++
+		return
+	}
+	p.Completed++
+}
+
+// HasFailed reports whether the PR already failed during the current run
+func (p *EnhancementProgress) HasFailed(prNumber int) bool {
+	return p.failedPRs[prNumber]
+}
+
+// ETA estimates the remaining time from the average time per processed PR
+func (p *EnhancementProgress) ETA(now time.Time) time.Duration {
+	processed := p.Completed + p.Failed
+	if processed == 0 || !p.Active() {
+		return 0
+	}
+	perPR := now.Sub(p.Started) / time.Duration(processed)
+	return perPR * time.Duration(p.Total-processed)
+}
+
+// rowOptions returns the display settings used when building this tab's table rows
+func (ts *TabState) rowOptions() tableRowOptions {
+	duplicateCounts := make(map[string]int)
+	for _, group := range ts.DuplicateGroups {
+		for _, pr := range group.PRs {
+			duplicateCounts[services.PRKey(pr)] = group.Size()
+		}
+	}
+
+	warnDays, staleDays := ts.Config.agingDays()
+	return tableRowOptions{
+		SizeBudget:       ts.Config.ReviewSizeBudget,
+		RequireIssueLink: ts.Config.RequireIssueLink,
+		WarnDays:         warnDays,
+		StaleDays:        staleDays,
+		DuplicateCounts:  duplicateCounts,
+		Blockers:         ts.Blockers,
+		Notes:            ts.Notes,
+		Pins:             ts.Pins,
+		Seen:             ts.Seen,
+		Reviews:          ts.Reviews,
+		Highlight:        ts.searchQuery(),
+		AssigneeColumn:   ts.Config.AssigneeColumn,
+		LabelColumn:      ts.Config.LabelColumn,
+		PolicyColumn:     ts.Config.PolicyColumn,
+		MilestoneColumn:  ts.Config.MilestoneColumn,
+		ProjectColumn:    ts.Config.ProjectColumn,
+	}
+}
+
+// columnKeys identifies the columns of this tab's table in layouts, optional
+// columns included
+func (ts *TabState) columnKeys() []string {
+	keys := append([]string{}, columnKeys...)
+	if ts.Config.ShowsStackColumns() {
+		keys = append(keys, stackColumnKeys...)
+	}
+	if ts.Config.AssigneeColumn {
+		keys = append(keys, assigneeColumnKey)
+	}
+	if ts.Config.LabelColumn {
+		keys = append(keys, labelColumnKey)
+	}
+	if ts.Config.PolicyColumn {
+		keys = append(keys, policyColumnKey)
+	}
+	if ts.Config.MilestoneColumn {
+		keys = append(keys, milestoneColumnKey)
+	}
+	if ts.Config.ShowsProjectColumn() {
+		keys = append(keys, projectColumnKey)
+	}
+	if ts.Config.TicketColumn {
+		keys = append(keys, ticketColumnKey)
+	}
+	return keys
+}
+
+// showsColumn reports whether a layout column key exists in this tab's table
+func (ts *TabState) showsColumn(key string) bool {
+	return slices.Contains(ts.columnKeys(), key)
+}
+
+// SelectedPR returns the PR under the table cursor, or nil if nothing is selected
+func (ts *TabState) SelectedPR() *gh.PullRequest {
+	selectedIndex := ts.Table.Cursor()
+	if selectedIndex < 0 || selectedIndex >= len(ts.FilteredPRs) {
+		return nil
+	}
+	return ts.FilteredPRs[selectedIndex]
+}
+
 // TabManager manages multiple tabs and their states
 type TabManager struct {
 	Tabs         []*TabState
 	ActiveTabIdx int
 	Token        string
 
+	// Session context; API calls derive from it, so cancelling it on quit
+	// stops them all
+	Ctx    context.Context
+	Cancel context.CancelFunc
+
 	// Global settings
 	GlobalRefreshInterval int
 
@@ -127,6 +439,38 @@ type TabManager struct {
 
 	// Request coordination
 	refreshScheduler *RefreshScheduler
+
+	// Cache of API responses shared by all tabs, opened from the cache
+	// config; nil gives each tab the default file cache
+	PRCache *cache.PRCache
+
+	// Local "blocked on" annotations, shared by all tabs
+	Blockers *BlockerStore
+
+	// Private PR notes, shared by all tabs
+	Notes *NoteStore
+
+	// Pinned PRs, shared by all tabs
+	Pins *PinStore
+
+	// When each PR was last viewed, shared by all tabs
+	Seen *SeenStore
+
+	// PR key -> the review verdict submitted from this session. The Review
+	// column shows it until enhancement data newer than the review arrives.
+	Reviews map[string]submittedReview
+
+	// Tabs closed this session, most recent last, for undoing accidental closes
+	closedTabs []closedTab
+}
+
+// maxClosedTabs caps how many closed tabs are kept for reopening
+const maxClosedTabs = 10
+
+// closedTab is a closed tab's state and position
+type closedTab struct {
+	tab   *TabState
+	index int
 }
 
 // NewTabState creates a new tab state with the given configuration
@@ -185,6 +529,7 @@ func NewTabState(tabConfig *TabConfig, token string) *TabState {
 		Config:              tabConfig,
 		Table:               t,
 		EnhancedData:        make(map[int]types.EnhancedData),
+		EnhancedFor:         make(map[int]time.Time),
 		BatchManager:        batchManager,
 		Ctx:                 ctx,
 		Cancel:              cancel,
@@ -192,6 +537,7 @@ func NewTabState(tabConfig *TabConfig, token string) *TabState {
 		LastSelectedPRIndex: -1,
 		EnhancementQueue:    make(map[int]bool),
 		LoadTime:            time.Now(),
+		ShowHistory:         tabConfig.History,
 	}
 }
 
@@ -202,15 +548,23 @@ func NewTabManager(token string) *TabManager {
 		InitGlobalRateLimiter()
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
 	manager := &TabManager{
 		Tabs:                  make([]*TabState, 0),
 		ActiveTabIdx:          0,
 		Token:                 token,
+		Ctx:                   ctx,
+		Cancel:                cancel,
 		GlobalRefreshInterval: 5,
 		TabSwitchMode:         false,
 		RateLimiter:           GlobalLimiter,
 		SharedCache:           GlobalLimiter.sharedCache,
 		refreshScheduler:      NewRefreshScheduler(),
+		Blockers:              NewBlockerStore(""),
+		Notes:                 NewNoteStore(""),
+		Pins:                  NewPinStore(""),
+		Seen:                  NewSeenStore(""),
+		Reviews:               make(map[string]submittedReview),
 	}
 
 	return manager
@@ -219,9 +573,25 @@ func NewTabManager(token string) *TabManager {
 // AddTab adds a new tab with the given configuration
 func (tm *TabManager) AddTab(tabConfig *TabConfig) *TabState {
 	tabState := NewTabState(tabConfig, tm.Token)
+	tabState.Cancel()
+	tabState.Ctx, tabState.Cancel = context.WithCancel(tm.Ctx)
+	if tm.PRCache != nil {
+		tabState.PRCache = tm.PRCache
+	}
+	tabState.Blockers = tm.Blockers
+	tabState.Notes = tm.Notes
+	tabState.Pins = tm.Pins
+	tabState.Seen = tm.Seen
+	tabState.Reviews = tm.Reviews
 	tm.Tabs = append(tm.Tabs, tabState)
+	tm.scheduleTab(tabConfig)
+
+	return tabState
+}
 
-	// Register with refresh scheduler for rate limiting coordination
+// scheduleTab registers a tab with the refresh scheduler for rate limiting
+// coordination, replacing any earlier registration under its name
+func (tm *TabManager) scheduleTab(tabConfig *TabConfig) {
 	if tm.refreshScheduler != nil {
 		refreshInterval := time.Duration(tabConfig.RefreshIntervalMinutes) * time.Minute
 		if refreshInterval == 0 {
@@ -237,9 +607,12 @@ func (tm *TabManager) AddTab(tabConfig *TabConfig) *TabState {
 		}
 
 		tm.refreshScheduler.AddTab(tabConfig.Name, refreshInterval, priority)
-	}
 
-	return tabState
+		// Faster-refreshing tabs get a bigger share of each rate limiter round
+		if tm.RateLimiter != nil {
+			tm.RateLimiter.SetTabWeight(tabConfig.Name, int(priority)+1)
+		}
+	}
 }
 
 // GetActiveTab returns the currently active tab
@@ -284,6 +657,12 @@ func (tm *TabManager) CloseTab(index int) bool {
 		tm.Tabs[index].Cancel()
 	}
 
+	// Keep its state so the close can be undone
+	tm.closedTabs = append(tm.closedTabs, closedTab{tab: tm.Tabs[index], index: index})
+	if len(tm.closedTabs) > maxClosedTabs {
+		tm.closedTabs = tm.closedTabs[1:]
+	}
+
 	// Remove the tab
 	tm.Tabs = append(tm.Tabs[:index], tm.Tabs[index+1:]...)
 
@@ -297,6 +676,25 @@ func (tm *TabManager) CloseTab(index int) bool {
 	return true
 }
 
+// ReopenClosedTab restores the most recently closed tab, with its filters,
+// sort and enhanced data, at its old position and makes it active
+func (tm *TabManager) ReopenClosedTab() (*TabState, bool) {
+	if len(tm.closedTabs) == 0 {
+		return nil, false
+	}
+	closed := tm.closedTabs[len(tm.closedTabs)-1]
+	tm.closedTabs = tm.closedTabs[:len(tm.closedTabs)-1]
+
+	// Closing cancelled the tab's context
+	tab := closed.tab
+	tab.Ctx, tab.Cancel = context.WithCancel(tm.Ctx)
+
+	index := min(closed.index, len(tm.Tabs))
+	tm.Tabs = slices.Insert(tm.Tabs, index, tab)
+	tm.ActiveTabIdx = index
+	return tab, true
+}
+
 // GetTabCount returns the number of tabs
 func (tm *TabManager) GetTabCount() int {
 	return len(tm.Tabs)
@@ -311,8 +709,12 @@ func (tm *TabManager) GetTabNames() []string {
 	return names
 }
 
-// Cleanup properly closes all tabs and their resources
+// Cleanup properly closes all tabs and their resources, cancelling
+// outstanding API calls
 func (tm *TabManager) Cleanup() {
+	if tm.Cancel != nil {
+		tm.Cancel()
+	}
 	for _, tab := range tm.Tabs {
 		if tab.Cancel != nil {
 			tab.Cancel()
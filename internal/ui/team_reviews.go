@@ -0,0 +1,147 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// teamMembersConcurrency caps team member lookups in flight, as with
+// required checks
+const teamMembersConcurrency = 4
+
+// teamMembersMsg delivers the members of a team asked to review
+type teamMembersMsg struct {
+	team    string // "org/slug"
+	members []string
+	err     error
+}
+
+// requestedTeamKeys names the teams a PR asks to review as "org/slug".
+// Requested teams belong to the organization owning the repository.
+func requestedTeamKeys(pr *gh.PullRequest) []string {
+	owner, _, _ := strings.Cut(repoFullName(pr), "/")
+	var keys []string
+	for _, team := range pr.RequestedTeams {
+		org := team.GetOrganization().GetLogin()
+		if org == "" {
+			org = owner
+		}
+		if org != "" && team.GetSlug() != "" {
+			keys = append(keys, org+"/"+team.GetSlug())
+		}
+	}
+	return keys
+}
+
+// teamMembersCmd looks up the members of the teams asked to review PRs in a
+// GitHub tab, so the Review column can count who from each team reviewed.
+// Each arrival starts the next lookup until all are known.
+func (m *MultiTabModel) teamMembersCmd(tab *TabState) tea.Cmd {
+	if !tab.Config.OnGitHub() || m.readOnly() {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	for _, pr := range tab.PRs {
+		for _, team := range requestedTeamKeys(pr) {
+			if len(m.teamMembersLoading) >= teamMembersConcurrency {
+				return tea.Batch(cmds...)
+			}
+			_, known := m.teamMembers[team]
+			if known || m.teamMembersLoading[team] || m.teamMembersErrors[team] != nil {
+				continue
+			}
+			cmds = append(cmds, m.fetchTeamMembersCmd(team, tab))
+		}
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	return tea.Batch(cmds...)
+}
+
+// fetchTeamMembersCmd fetches one team's members, marking it as loading
+func (m *MultiTabModel) fetchTeamMembersCmd(team string, tab *TabState) tea.Cmd {
+	m.teamMembersLoading[team] = true
+
+	token := m.TabManager.Token
+	prCache := tab.PRCache
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 10*time.Second)
+		defer cancel()
+
+		org, slug, _ := strings.Cut(team, "/")
+		members, err := github.FetchTeamMembers(ctx, token, org, slug, prCache)
+		return teamMembersMsg{team: team, members: members, err: err}
+	}
+}
+
+// handleTeamMembers stores a team's members, redraws the tabs that may show
+// them and continues any pending lookups. Failed lookups, usually from a
+// token without read:org, aren't retried; those teams show without counts.
+func (m *MultiTabModel) handleTeamMembers(msg teamMembersMsg) (tea.Model, tea.Cmd) {
+	delete(m.teamMembersLoading, msg.team)
+	if msg.err != nil {
+		m.teamMembersErrors[msg.team] = msg.err
+		m.Log.Warn("Team members not listed", "team", msg.team, "err", msg.err)
+	} else {
+		m.teamMembers[msg.team] = msg.members
+	}
+
+	var cmds []tea.Cmd
+	for _, tab := range m.TabManager.Tabs {
+		if !tab.Config.OnGitHub() || !tab.Loaded {
+			continue
+		}
+		if msg.err == nil {
+			m.updateTableRows(tab)
+		}
+		if cmd := m.teamMembersCmd(tab); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	if len(cmds) == 0 {
+		return m, nil
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// teamReviewIndicator shows a PR still waiting on a requested team with how
+// many of its members reviewed and how many other requests are pending, e.g.
+// "⏳ team:platform 1/4 +2". It returns "" when no team is requested, the PR
+// is already approved or sent back, or the team's members or the PR's
+// reviews aren't known, leaving the plain count of pending requests.
+func teamReviewIndicator(pr *gh.PullRequest, enhancedData map[int]types.EnhancedData, teamMembers map[string][]string) string {
+	teams := requestedTeamKeys(pr)
+	if len(teams) == 0 || pr.GetDraft() {
+		return ""
+	}
+	enhanced, enhancedKnown := enhancedData[pr.GetNumber()]
+	members, membersKnown := teamMembers[teams[0]]
+	if !enhancedKnown || enhanced.EnhancedAt.IsZero() || !membersKnown {
+		return ""
+	}
+	if enhanced.ReviewStatus == "approved" || enhanced.ReviewStatus == "changes_requested" {
+		return ""
+	}
+
+	reviewed := 0
+	for _, member := range members {
+		if _, ok := enhanced.Reviewers[member]; ok {
+			reviewed++
+		}
+	}
+	_, slug, _ := strings.Cut(teams[0], "/")
+	indicator := fmt.Sprintf("⏳ team:%s %d/%d", slug, reviewed, len(members))
+	if others := len(pr.RequestedReviewers) + len(teams) - 1; others > 0 {
+		indicator += fmt.Sprintf(" +%d", others)
+	}
+	return indicator
+}
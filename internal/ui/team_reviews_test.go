@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestTeamReviewIndicator tests counting a requested team's reviewers
+func TestTeamReviewIndicator(t *testing.T) {
+	platform := map[string][]string{"org/platform": {"alice", "bob", "carol", "dave"}}
+	pr := func(reviewers ...string) *gh.PullRequest {
+		pr := &gh.PullRequest{
+			Number:         gh.Int(7),
+			Base:           &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("org/api")}},
+			RequestedTeams: []*gh.Team{{Slug: gh.String("platform")}},
+		}
+		for _, login := range reviewers {
+			pr.RequestedReviewers = append(pr.RequestedReviewers, &gh.User{Login: gh.String(login)})
+		}
+		return pr
+	}
+	enhanced := func(status string, reviewers map[string]string) map[int]types.EnhancedData {
+		return map[int]types.EnhancedData{7: {Number: 7, ReviewStatus: status, Reviewers: reviewers, EnhancedAt: time.Now()}}
+	}
+
+	tests := []struct {
+		name     string
+		pr       *gh.PullRequest
+		enhanced map[int]types.EnhancedData
+		members  map[string][]string
+		want     string
+	}{
+		{"nobody reviewed", pr(), enhanced("no_review", nil), platform, "⏳ team:platform 0/4"},
+		{"a member commented", pr("erin"), enhanced("pending", map[string]string{"bob": "COMMENTED", "zoe": "COMMENTED"}), platform, "⏳ team:platform 1/4 +1"},
+		{"approved", pr(), enhanced("approved", map[string]string{"bob": "APPROVED"}), platform, ""},
+		{"members unknown", pr(), enhanced("no_review", nil), nil, ""},
+		{"reviews unknown", pr(), nil, platform, ""},
+		{"no team requested", &gh.PullRequest{Number: gh.Int(7)}, enhanced("no_review", nil), platform, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := teamReviewIndicator(tt.pr, tt.enhanced, tt.members); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestTeamMembersLookup tests looking up requested teams once and showing
+// their counts in the Review column
+func TestTeamMembersLookup(t *testing.T) {
+	model, tab := mergeTestModel("test-token")
+	tab.PRs[0].RequestedTeams = []*gh.Team{{Slug: gh.String("platform")}}
+	tab.PRs[1].RequestedTeams = []*gh.Team{{Slug: gh.String("platform")}, {Slug: gh.String("security")}}
+	tab.EnhancedData[12] = types.EnhancedData{Number: 12, ReviewStatus: "pending", Reviewers: map[string]string{"bob": "COMMENTED"}, EnhancedAt: time.Now()}
+
+	if model.teamMembersCmd(tab) == nil || !model.teamMembersLoading["org/platform"] || !model.teamMembersLoading["org/security"] {
+		t.Fatal("Expected both requested teams looked up")
+	}
+	if model.teamMembersCmd(tab) != nil {
+		t.Error("Expected teams being looked up not to be fetched again")
+	}
+
+	model.Update(teamMembersMsg{team: "org/platform", members: []string{"alice", "bob"}})
+	if got := tab.Table.Rows()[0][reviewColumn]; got != "⏳ team:platform 1/2" {
+		t.Errorf("Expected the team's review count in the Review column, got %q", got)
+	}
+
+	model.Update(teamMembersMsg{team: "org/security", err: errors.New("403 Forbidden")})
+	if model.teamMembersCmd(tab) != nil {
+		t.Error("Expected a team that couldn't be listed not to be retried")
+	}
+}
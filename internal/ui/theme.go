@@ -0,0 +1,207 @@
+package ui
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+)
+
+// Theme names accepted by the theme config setting
+const (
+	themeDark      = "dark"
+	themeLight     = "light"
+	themeSolarized = "solarized"
+	themeCustom    = "custom"
+)
+
+// colorPattern matches the colors lipgloss takes: hex, or an ANSI color number
+var colorPattern = regexp.MustCompile(`^(#[0-9A-Fa-f]{6}|#[0-9A-Fa-f]{3}|[0-9]{1,3})$`)
+
+// ThemeColors holds the colors of the interface. A custom theme sets some of
+// them over its base theme.
+type ThemeColors struct {
+	// Theme the unset colors come from (custom themes only, default dark)
+	Base string `mapstructure:"base" yaml:"base,omitempty"`
+
+	// Backgrounds and borders
+	Background string `mapstructure:"background" yaml:"background,omitempty"`
+	Surface    string `mapstructure:"surface" yaml:"surface,omitempty"`
+	Border     string `mapstructure:"border" yaml:"border,omitempty"`
+
+	// Accents
+	Primary   string `mapstructure:"primary" yaml:"primary,omitempty"`
+	Secondary string `mapstructure:"secondary" yaml:"secondary,omitempty"`
+	Accent    string `mapstructure:"accent" yaml:"accent,omitempty"`
+
+	// Status colors; palette: colorblind replaces them
+	Success string `mapstructure:"success" yaml:"success,omitempty"`
+	Warning string `mapstructure:"warning" yaml:"warning,omitempty"`
+	Error   string `mapstructure:"error" yaml:"error,omitempty"`
+	Info    string `mapstructure:"info" yaml:"info,omitempty"`
+
+	// Text
+	Text          string `mapstructure:"text" yaml:"text,omitempty"`
+	TextSecondary string `mapstructure:"text_secondary" yaml:"text_secondary,omitempty"`
+	TextMuted     string `mapstructure:"text_muted" yaml:"text_muted,omitempty"`
+	TextBright    string `mapstructure:"text_bright" yaml:"text_bright,omitempty"`
+
+	// Table header and selected row
+	HeaderBg   string `mapstructure:"header_bg" yaml:"header_bg,omitempty"`
+	HeaderFg   string `mapstructure:"header_fg" yaml:"header_fg,omitempty"`
+	SelectedBg string `mapstructure:"selected_bg" yaml:"selected_bg,omitempty"`
+	SelectedFg string `mapstructure:"selected_fg" yaml:"selected_fg,omitempty"`
+}
+
+// darkColors is the stock theme, a dark background with soft contrast
+var darkColors = ThemeColors{
+	Background: "#1A1D23",
+	Surface:    "#252831",
+	Border:     "#3B4048",
+
+	Primary:   "#7C9CBF", // Calm blue
+	Secondary: "#9CABCA",
+	Accent:    "#98C379",
+
+	Success: "#98C379",
+	Warning: "#E5C07B",
+	Error:   "#E06C75",
+	Info:    "#61AFEF",
+
+	Text:          "#ABB2BF",
+	TextSecondary: "#828997",
+	TextMuted:     "#5C6370",
+	TextBright:    "#DCDFE4",
+
+	HeaderBg:   "#2C3038",
+	HeaderFg:   "#DCDFE4",
+	SelectedBg: "#4B5263",
+	SelectedFg: "#FFFFFF",
+}
+
+// lightColors suits terminals with a light background: dark text, and
+// status colors deep enough to read on white
+var lightColors = ThemeColors{
+	Background: "#FAFAFA",
+	Surface:    "#F0F0F1",
+	Border:     "#C8CAD0",
+
+	Primary:   "#4078F2",
+	Secondary: "#5A6E9A",
+	Accent:    "#50A14F",
+
+	Success: "#50A14F",
+	Warning: "#A86B00",
+	Error:   "#CA1243",
+	Info:    "#0184BC",
+
+	Text:          "#383A42",
+	TextSecondary: "#5C5F6B",
+	TextMuted:     "#9A9CA5",
+	TextBright:    "#1B1D22",
+
+	HeaderBg:   "#E5E5E6",
+	HeaderFg:   "#1B1D22",
+	SelectedBg: "#C9D6F2",
+	SelectedFg: "#000000",
+}
+
+// solarizedColors is Solarized Dark
+var solarizedColors = ThemeColors{
+	Background: "#002B36", // base03
+	Surface:    "#073642", // base02
+	Border:     "#586E75", // base01
+
+	Primary:   "#268BD2", // Blue
+	Secondary: "#6C71C4", // Violet
+	Accent:    "#859900", // Green
+
+	Success: "#859900",
+	Warning: "#B58900", // Yellow
+	Error:   "#DC322F", // Red
+	Info:    "#2AA198", // Cyan
+
+	Text:          "#839496", // base0
+	TextSecondary: "#93A1A1", // base1
+	TextMuted:     "#586E75",
+	TextBright:    "#EEE8D5", // base2
+
+	HeaderBg:   "#073642",
+	HeaderFg:   "#EEE8D5",
+	SelectedBg: "#586E75",
+	SelectedFg: "#FDF6E3", // base3
+}
+
+// builtinThemes are the themes selectable by name
+var builtinThemes = map[string]ThemeColors{
+	themeDark:      darkColors,
+	themeLight:     lightColors,
+	themeSolarized: solarizedColors,
+}
+
+// colors is the active color theme
+var colors = darkColors
+
+// validateTheme checks the theme name, and the colors of a custom theme
+func validateTheme(name string, custom *ThemeColors) error {
+	switch name {
+	case "", themeDark, themeLight, themeSolarized:
+		if custom != nil {
+			return fmt.Errorf("theme_colors needs theme: %s", themeCustom)
+		}
+		return nil
+	case themeCustom:
+	default:
+		return fmt.Errorf("theme must be %q, %q, %q or %q, got %q", themeDark, themeLight, themeSolarized, themeCustom, name)
+	}
+
+	if custom == nil {
+		return fmt.Errorf("theme: %s needs a theme_colors section", themeCustom)
+	}
+	if _, ok := builtinThemes[custom.Base]; custom.Base != "" && !ok {
+		return fmt.Errorf("theme_colors.base must be %q, %q or %q, got %q", themeDark, themeLight, themeSolarized, custom.Base)
+	}
+	fields := reflect.ValueOf(*custom)
+	for i := 0; i < fields.NumField(); i++ {
+		key := fields.Type().Field(i).Tag.Get("mapstructure")
+		value := fields.Field(i).String()
+		if key == "base" || value == "" {
+			continue
+		}
+		if !colorPattern.MatchString(value) {
+			return fmt.Errorf("theme_colors.%s must be a hex color such as #7C9CBF or an ANSI color number, got %q", key, value)
+		}
+		if n, err := strconv.Atoi(value); err == nil && n > 255 {
+			return fmt.Errorf("theme_colors.%s: ANSI colors go up to 255, got %s", key, value)
+		}
+	}
+	return nil
+}
+
+// themeColors returns the colors of a theme. A custom theme's unset colors
+// come from its base; unknown names fall back to dark.
+func themeColors(name string, custom *ThemeColors) ThemeColors {
+	if name != themeCustom || custom == nil {
+		if builtin, ok := builtinThemes[name]; ok {
+			return builtin
+		}
+		return darkColors
+	}
+
+	resolved := themeColors(custom.Base, nil)
+	set := reflect.ValueOf(custom).Elem()
+	target := reflect.ValueOf(&resolved).Elem()
+	for i := 0; i < set.NumField(); i++ {
+		if value := set.Field(i).String(); value != "" {
+			target.Field(i).SetString(value)
+		}
+	}
+	resolved.Base = ""
+	return resolved
+}
+
+// applyTheme switches the interface colors. The palette, applied next,
+// takes its status colors from them and restyles the shared styles.
+func applyTheme(name string, custom *ThemeColors) {
+	colors = themeColors(name, custom)
+}
@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TestThemeColors tests the built-in themes and custom colors over a base
+func TestThemeColors(t *testing.T) {
+	if themeColors("", nil) != darkColors || themeColors(themeLight, nil) != lightColors {
+		t.Error("Expected themes selected by name, dark by default")
+	}
+
+	custom := themeColors(themeCustom, &ThemeColors{Base: themeSolarized, Primary: "#FF00FF", Error: "160"})
+	if custom.Primary != "#FF00FF" || custom.Error != "160" || custom.Background != solarizedColors.Background || custom.Base != "" {
+		t.Errorf("Expected custom colors over solarized, got %+v", custom)
+	}
+}
+
+// TestValidateTheme tests the theme and theme_colors config settings
+func TestValidateTheme(t *testing.T) {
+	for _, name := range []string{"", "dark", "light", "solarized"} {
+		if err := validateTheme(name, nil); err != nil {
+			t.Errorf("validateTheme(%q) error = %v", name, err)
+		}
+	}
+	if err := validateTheme(themeCustom, &ThemeColors{Base: "light", TextMuted: "#999"}); err != nil {
+		t.Errorf("validateTheme(custom) error = %v", err)
+	}
+
+	invalid := []struct {
+		name   string
+		custom *ThemeColors
+		want   string
+	}{
+		{"neon", nil, "theme must be"},
+		{themeCustom, nil, "needs a theme_colors section"},
+		{themeLight, &ThemeColors{Primary: "#FFFFFF"}, "theme_colors needs theme: custom"},
+		{themeCustom, &ThemeColors{Base: "neon"}, "theme_colors.base"},
+		{themeCustom, &ThemeColors{TextMuted: "grey"}, "theme_colors.text_muted"},
+		{themeCustom, &ThemeColors{Accent: "300"}, "go up to 255"},
+	}
+	for _, tt := range invalid {
+		if err := validateTheme(tt.name, tt.custom); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("validateTheme(%q, %+v) = %v, want %q", tt.name, tt.custom, err, tt.want)
+		}
+	}
+}
+
+// TestApplyTheme tests that a theme restyles the shared styles and the
+// default palette's status colors
+func TestApplyTheme(t *testing.T) {
+	defer func() {
+		applyTheme(themeDark, nil)
+		applyPalette(paletteDefault)
+	}()
+
+	applyTheme(themeLight, nil)
+	applyPalette(paletteDefault)
+	if theme.Error != lightColors.Error || mutedStyle.GetForeground() != lipgloss.Color(lightColors.TextMuted) {
+		t.Errorf("Expected light colors, got error %s and muted %v", theme.Error, mutedStyle.GetForeground())
+	}
+
+	applyPalette(paletteColorBlind)
+	if theme.Error != colorBlindTheme.Error {
+		t.Error("Expected the color-blind palette to keep its own status colors")
+	}
+}
@@ -0,0 +1,203 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/auth"
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/jira"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// jiraConcurrency caps Jira issue lookups in flight, as with team members
+const jiraConcurrency = 4
+
+// jiraIssueMsg delivers the status of a Jira issue referenced by PRs
+type jiraIssueMsg struct {
+	key   string
+	issue *cache.JiraIssue
+	err   error
+}
+
+// applyJiraConfig connects to the configured Jira instance, forgetting
+// issues read from a previous one
+func (m *MultiTabModel) applyJiraConfig(cfg jira.Config) {
+	if cfg.URL != m.jiraURL {
+		m.jiraIssues = make(map[string]*cache.JiraIssue)
+		m.jiraErrors = make(map[string]error)
+	}
+	m.jiraURL = cfg.URL
+	m.Jira = nil
+	if cfg.Enabled() {
+		m.Jira = jira.NewClient(cfg, auth.JiraToken())
+	}
+}
+
+// ticketKeys returns the ticket keys in a PR's title and branch that the
+// Jira integration resolves, or nil without one
+func ticketKeys(client *jira.Client, pr *gh.PullRequest) []string {
+	if client == nil || pr == nil {
+		return nil
+	}
+	var keys []string
+	for _, key := range services.TicketKeys(pr.GetTitle(), pr.GetHead().GetRef()) {
+		if client.Tracks(key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// withTicketColumn appends the Ticket column, taking its width from the PR
+// title column as far as its minimum allows
+func withTicketColumn(columns []table.Column, terminalWidth int) []table.Column {
+	width := max(14, (terminalWidth-12)*11/100)
+
+	result := make([]table.Column, len(columns), len(columns)+1)
+	copy(result, columns)
+	result[0].Width = max(24, result[0].Width-width-2) // Cell padding too
+	return append(result, table.Column{Title: "🎫 Ticket", Width: width})
+}
+
+// ticketCell shows the Jira status of each ticket a PR references, e.g.
+// "🔄 ABC-12 In Review"
+func ticketCell(pr *gh.PullRequest, opts tableRowOptions) string {
+	keys := ticketKeys(opts.Jira, pr)
+	if len(keys) == 0 {
+		return "-"
+	}
+	cells := make([]string, len(keys))
+	for i, key := range keys {
+		cells[i] = ticketStatus(key, opts.JiraIssues[key], opts.JiraLoading[key])
+	}
+	return strings.Join(cells, ", ")
+}
+
+// ticketStatus describes one ticket with an icon for its status category
+func ticketStatus(key string, issue *cache.JiraIssue, loading bool) string {
+	switch {
+	case issue == nil && loading:
+		return "⏳ " + key
+	case issue == nil:
+		return key
+	case issue.Missing:
+		return key + " not found"
+	}
+	icon := "🆕"
+	switch issue.Category {
+	case "done":
+		icon = theme.Passed
+	case "indeterminate":
+		icon = theme.Running
+	}
+	return fmt.Sprintf("%s %s %s", icon, key, issue.Status)
+}
+
+// ticketLines lists the selected PR's tickets with their status and summary
+// for the detail pane
+func (m *MultiTabModel) ticketLines(pr *gh.PullRequest, width int) []string {
+	var lines []string
+	for _, key := range ticketKeys(m.Jira, pr) {
+		issue := m.jiraIssues[key]
+		line := "🎫 " + ticketStatus(key, issue, m.jiraLoading[key])
+		switch {
+		case issue != nil && !issue.Missing:
+			line += " · " + issue.Summary
+		case m.jiraErrors[key] != nil:
+			line += " · 🚫 " + m.jiraErrors[key].Error()
+		}
+		lines = append(lines, clipText(line, width))
+	}
+	return lines
+}
+
+// jiraIssuesCmd looks up the Jira issues the PRs reference that aren't known
+// yet or were read longer than the cache keeps them ago. Each arrival starts
+// the next lookup until all are current.
+func (m *MultiTabModel) jiraIssuesCmd(tab *TabState, prs []*gh.PullRequest) tea.Cmd {
+	if m.Jira == nil {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	for _, pr := range prs {
+		for _, key := range ticketKeys(m.Jira, pr) {
+			if len(m.jiraLoading) >= jiraConcurrency {
+				return tea.Batch(cmds...)
+			}
+			issue := m.jiraIssues[key]
+			current := issue != nil && time.Since(issue.FetchedAt) < jira.IssueTTL
+			if current || m.jiraLoading[key] || m.jiraErrors[key] != nil {
+				continue
+			}
+			cmds = append(cmds, m.fetchJiraIssueCmd(key, tab))
+		}
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	return tea.Batch(cmds...)
+}
+
+// ticketsCmd looks up the tickets a tab shows: every PR's for the Ticket
+// column, else the selected PR's while the detail pane is open
+func (m *MultiTabModel) ticketsCmd(tab *TabState) tea.Cmd {
+	switch {
+	case tab.Config.TicketColumn:
+		return m.jiraIssuesCmd(tab, tab.PRs)
+	case tab.ShowDetails && tab.SelectedPR() != nil:
+		return m.jiraIssuesCmd(tab, []*gh.PullRequest{tab.SelectedPR()})
+	}
+	return nil
+}
+
+// fetchJiraIssueCmd looks up one issue, marking it as loading
+func (m *MultiTabModel) fetchJiraIssueCmd(key string, tab *TabState) tea.Cmd {
+	m.jiraLoading[key] = true
+
+	client := m.Jira
+	prCache := tab.PRCache
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 10*time.Second)
+		defer cancel()
+
+		issue, err := client.FetchIssue(ctx, key, prCache)
+		return jiraIssueMsg{key: key, issue: issue, err: err}
+	}
+}
+
+// handleJiraIssue stores an issue's status, redraws the tabs that may show
+// it and continues any pending lookups. Failed lookups aren't retried until
+// the configuration changes; those tickets show their key alone.
+func (m *MultiTabModel) handleJiraIssue(msg jiraIssueMsg) (tea.Model, tea.Cmd) {
+	delete(m.jiraLoading, msg.key)
+	if msg.err != nil {
+		m.jiraErrors[msg.key] = msg.err
+		m.Log.Warn("Jira issue not read", "key", msg.key, "err", msg.err)
+	} else {
+		m.jiraIssues[msg.key] = msg.issue
+	}
+
+	var cmds []tea.Cmd
+	for _, tab := range m.TabManager.Tabs {
+		if !tab.Loaded {
+			continue
+		}
+		if tab.Config.TicketColumn {
+			m.updateTableRows(tab)
+		}
+		if cmd := m.ticketsCmd(tab); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	if len(cmds) == 0 {
+		return m, nil
+	}
+	return m, tea.Batch(cmds...)
+}
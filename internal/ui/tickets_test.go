@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/jira"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestTicketColumn tests resolving ticket keys of configured projects and
+// showing their status in the Ticket column and detail pane
+func TestTicketColumn(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	model.applyJiraConfig(jira.Config{URL: "https://jira.example.com", Projects: []string{"ABC"}})
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"org/api"}, TicketColumn: true})
+
+	pr := labeledPR(1)
+	pr.Title = gh.String("ABC-12: Retry uploads with SHA-256 checks")
+	pr.Head = &gh.PullRequestBranch{Ref: gh.String("feature/ABC-12-OPS-3")}
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: []*gh.PullRequest{pr}})
+	if !model.jiraLoading["ABC-12"] || model.jiraLoading["OPS-3"] || len(model.jiraLoading) != 1 {
+		t.Fatalf("Expected only the ABC ticket to be looked up, got %v", model.jiraLoading)
+	}
+
+	model.Update(jiraIssueMsg{key: "ABC-12", issue: &cache.JiraIssue{
+		Key: "ABC-12", Summary: "Retry failed uploads", Status: "In Review", Category: "indeterminate", FetchedAt: time.Now(),
+	}})
+	row := tab.Table.Rows()[0]
+	if cell := row[len(row)-1]; cell != theme.Running+" ABC-12 In Review" {
+		t.Errorf("Unexpected Ticket cell %q", cell)
+	}
+	if lines := model.ticketLines(pr, 80); len(lines) != 1 || !strings.Contains(lines[0], "In Review · Retry failed uploads") {
+		t.Errorf("Expected the ticket's summary in the detail pane, got %q", lines)
+	}
+	if cmd := model.ticketsCmd(tab); cmd != nil {
+		t.Error("Expected no lookup for a ticket read moments ago")
+	}
+
+	model.applyJiraConfig(jira.Config{})
+	if cell := ticketCell(pr, model.rowOptions(tab)); cell != "-" {
+		t.Errorf("Expected no tickets without Jira configured, got %q", cell)
+	}
+}
@@ -11,6 +11,10 @@ import (
 type PRData struct {
 	*gh.PullRequest
 	Enhanced *EnhancedData `json:"enhanced,omitempty"`
+
+	// Repository stack from cached repo metadata, when known
+	RepoLanguage string   `json:"repo_language,omitempty"`
+	RepoTopics   []string `json:"repo_topics,omitempty"`
 }
 
 // EnhancedData contains additional PR information from detailed API calls
@@ -18,13 +22,28 @@ type EnhancedData struct {
 	Number         int       `json:"number"`
 	Comments       int       `json:"comments"`
 	ReviewComments int       `json:"review_comments"`
-	ReviewStatus   string    `json:"review_status"` // "approved", "changes_requested", "pending", "unknown"
-	ChecksStatus   string    `json:"checks_status"` // "success", "failure", "pending", "unknown"
-	Mergeable      string    `json:"mergeable"`     // "clean", "conflicts", "unknown"
+	ReviewStatus   string    `json:"review_status"`   // "approved", "changes_requested", "pending", "unknown"
+	ChecksStatus   string    `json:"checks_status"`   // "success", "failure", "pending", "unknown"
+	ReportedChecks []string  `json:"reported_checks"` // Check and status names on the head commit
+	Mergeable      string    `json:"mergeable"`       // "clean", "conflicts", "unknown"
+	Behind         bool      `json:"behind"`          // Head branch lacks commits on the base ("Update branch")
 	Additions      int       `json:"additions"`
 	Deletions      int       `json:"deletions"`
 	ChangedFiles   int       `json:"changed_files"`
 	EnhancedAt     time.Time `json:"enhanced_at"`
+
+	// Each reviewer's standing verdict: APPROVED, CHANGES_REQUESTED,
+	// DISMISSED, or COMMENTED when they only commented
+	Reviewers map[string]string `json:"reviewers,omitempty"`
+
+	// Branch protection's verdict: the review decision (APPROVED,
+	// CHANGES_REQUESTED, REVIEW_REQUIRED; "" without required reviews) and
+	// the merge state (CLEAN, BLOCKED, BEHIND, ...; "" when unknown)
+	ReviewDecision string `json:"review_decision,omitempty"`
+	MergeState     string `json:"merge_state,omitempty"`
+
+	// When someone other than the author first reviewed; zero before anyone did
+	FirstReviewAt time.Time `json:"first_review_at,omitempty"`
 }
 
 // FilterOptions represents filtering criteria for PRs
@@ -0,0 +1,185 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// unreadMarker marks PRs that changed since they were last viewed
+const unreadMarker = "🔵"
+
+// SeenStore remembers the last update of each PR when it was viewed, keyed
+// by PR ("owner/repo#123") and persisted locally. A PR is unread until it is
+// viewed, and again once it is updated after that.
+type SeenStore struct {
+	mu    sync.Mutex
+	path  string // Empty path keeps state in memory only
+	seen  map[string]time.Time
+	fresh bool // Nothing was saved before this session
+}
+
+// NewSeenStore creates a seen store backed by the given file. A missing or
+// unreadable file starts afresh, see Baseline.
+func NewSeenStore(path string) *SeenStore {
+	store := &SeenStore{
+		path:  path,
+		seen:  make(map[string]time.Time),
+		fresh: true,
+	}
+
+	if path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			var saved map[string]time.Time
+			if json.Unmarshal(data, &saved) == nil && saved != nil {
+				store.seen = saved
+				store.fresh = false
+			}
+		}
+	}
+
+	return store
+}
+
+// Baseline marks PRs never seen before read during the first session, so
+// the PRs open when read tracking starts don't all show as unread
+func (s *SeenStore) Baseline(prs []*gh.PullRequest) error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.fresh {
+		return nil
+	}
+	added := false
+	for _, pr := range prs {
+		key := services.PRKey(pr)
+		if _, seen := s.seen[key]; !seen {
+			s.seen[key] = pr.GetUpdatedAt().Time
+			added = true
+		}
+	}
+	if !added {
+		return nil
+	}
+	return s.save()
+}
+
+// Unread reports whether a PR changed since it was last viewed
+func (s *SeenStore) Unread(pr *gh.PullRequest) bool {
+	if s == nil || pr == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.unread(pr)
+}
+
+// unread reports whether a PR is unread; the caller must hold the lock
+func (s *SeenStore) unread(pr *gh.PullRequest) bool {
+	seen, ok := s.seen[services.PRKey(pr)]
+	return !ok || pr.GetUpdatedAt().After(seen)
+}
+
+// Count returns how many of the PRs are unread
+func (s *SeenStore) Count(prs []*gh.PullRequest) int {
+	count := 0
+	for _, pr := range prs {
+		if s.Unread(pr) {
+			count++
+		}
+	}
+	return count
+}
+
+// MarkRead marks PRs read as of their current update, returning how many
+// were unread. The file is only written when something changed.
+func (s *SeenStore) MarkRead(prs ...*gh.PullRequest) (int, error) {
+	if s == nil {
+		return 0, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	marked := 0
+	for _, pr := range prs {
+		if pr == nil || !s.unread(pr) {
+			continue
+		}
+		s.seen[services.PRKey(pr)] = pr.GetUpdatedAt().Time
+		marked++
+	}
+	if marked == 0 {
+		return 0, nil
+	}
+	return marked, s.save()
+}
+
+// save writes the seen state to disk; the caller must hold the lock
+func (s *SeenStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(s.seen, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode read state: %w", err)
+	}
+	if err := cache.WriteFile(s.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to save read state: %w", err)
+	}
+	return nil
+}
+
+// getSeenFilePath returns the path the read state of PRs is saved to
+func getSeenFilePath() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/.prcompass_seen.json", homeDir)
+}
+
+// markRead marks the PR being viewed read, redrawing the tab if it was
+// unread. Merged and closed PRs aren't tracked.
+func (m *MultiTabModel) markRead(tab *TabState, pr *gh.PullRequest) {
+	if pr == nil || isHistoryPR(pr) {
+		return
+	}
+	marked, err := tab.Seen.MarkRead(pr)
+	if err != nil {
+		m.Log.Warn("Read state not saved", "pr", services.PRKey(pr), "err", err)
+	}
+	if marked > 0 {
+		m.updateTableRows(tab)
+	}
+}
+
+// markAllRead marks every open PR the tab shows read, inbox style
+func (m *MultiTabModel) markAllRead(tab *TabState) {
+	var open []*gh.PullRequest
+	for _, pr := range tab.FilteredPRs {
+		if !isHistoryPR(pr) {
+			open = append(open, pr)
+		}
+	}
+	marked, err := tab.Seen.MarkRead(open...)
+	switch {
+	case err != nil:
+		tab.StatusMsg = fmt.Sprintf("Read state not saved: %v", err)
+	case marked == 0:
+		tab.StatusMsg = "No unread PRs"
+	default:
+		tab.StatusMsg = fmt.Sprintf("Marked %d %s read", marked, plural(marked, "PR", "PRs"))
+	}
+	m.updateTableRows(tab)
+}
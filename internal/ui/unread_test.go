@@ -0,0 +1,76 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// updatedPR returns a PR last updated at the given time
+func updatedPR(number int, updated time.Time) *gh.PullRequest {
+	pr := labeledPR(number)
+	pr.UpdatedAt = &gh.Timestamp{Time: updated}
+	return pr
+}
+
+// TestSeenStore tests that PRs updated after they were viewed are unread
+// again, and that the first session starts with the open PRs read
+func TestSeenStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "seen.json")
+	monday := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	store := NewSeenStore(path)
+	if err := store.Baseline([]*gh.PullRequest{updatedPR(1, monday)}); err != nil || store.Unread(updatedPR(1, monday)) {
+		t.Fatalf("Expected the first session's PRs to start read (%v)", err)
+	}
+
+	reloaded := NewSeenStore(path)
+	reloaded.Baseline([]*gh.PullRequest{updatedPR(2, monday)})
+	if !reloaded.Unread(updatedPR(2, monday)) {
+		t.Error("Expected PRs new in later sessions to be unread")
+	}
+	if !reloaded.Unread(updatedPR(1, monday.Add(time.Hour))) {
+		t.Error("Expected a PR updated since it was seen to be unread")
+	}
+	if marked, err := reloaded.MarkRead(updatedPR(1, monday.Add(time.Hour)), updatedPR(2, monday)); err != nil || marked != 2 {
+		t.Errorf("MarkRead() = %d, %v; want 2 marked", marked, err)
+	}
+	if NewSeenStore(path).Count([]*gh.PullRequest{updatedPR(1, monday.Add(time.Hour)), updatedPR(2, monday)}) != 0 {
+		t.Error("Expected marking read to persist")
+	}
+}
+
+// TestUnreadRows tests the unread badge, marking a PR read by viewing its
+// details and marking all read with a
+func TestUnreadRows(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	model.TabManager.Seen = NewSeenStore("")
+	model.TabManager.Seen.fresh = false
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Test Tab", Mode: "repos", Repos: []string{"org/api"}})
+	now := time.Now()
+	model.handleTabPRsMessage(tabPrsMsg{tabName: "Test Tab", prs: []*gh.PullRequest{updatedPR(1, now), updatedPR(2, now), updatedPR(3, now)}})
+
+	if cell := tab.Table.Rows()[0][0]; !strings.HasPrefix(cell, unreadMarker) {
+		t.Errorf("Expected an unread badge, got %q", cell)
+	}
+	if footer := renderTableFooter(tab); !strings.Contains(footer, "3 unread") {
+		t.Errorf("Expected the footer to count unread PRs, got %q", footer)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	if tab.Seen.Unread(tab.FilteredPRs[0]) || !tab.Seen.Unread(tab.FilteredPRs[1]) {
+		t.Error("Expected viewing a PR's details to mark only that PR read")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	if count := tab.Seen.Count(tab.PRs); count != 0 || tab.StatusMsg != "Marked 2 PRs read" {
+		t.Errorf("Expected a to mark the rest read, got %d unread and %q", count, tab.StatusMsg)
+	}
+	if cell := tab.Table.Rows()[1][0]; strings.Contains(cell, unreadMarker) {
+		t.Errorf("Expected the badge to disappear, got %q", cell)
+	}
+}
@@ -0,0 +1,145 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// branchUpdateCheckDelay gives GitHub time to merge the base branch in
+// before the PR is checked again
+const branchUpdateCheckDelay = 5 * time.Second
+
+// branchUpdateCheckAttempts caps how often an updated PR still shown as
+// behind is checked again
+const branchUpdateCheckAttempts = 3
+
+// updateBranchResultMsg reports whether GitHub accepted a branch update
+type updateBranchResultMsg struct {
+	tabName string
+	pr      *gh.PullRequest
+	err     error
+}
+
+// branchUpdateCheckMsg delivers fresh enhancement data for a PR whose
+// branch update is running
+type branchUpdateCheckMsg struct {
+	tabName string
+	pr      *gh.PullRequest
+	data    types.EnhancedData
+	err     error
+	attempt int
+}
+
+// confirmUpdateBranch asks before merging the base branch into the selected
+// PR's branch, like GitHub's "Update branch" button
+func (m *MultiTabModel) confirmUpdateBranch(tab *TabState) {
+	if m.writeUnavailable(tab) {
+		return
+	}
+	pr := tab.SelectedPR()
+	if pr == nil {
+		tab.StatusMsg = "No PR selected"
+		return
+	}
+	key := services.PRKey(pr)
+	if data, ok := tab.EnhancedData[pr.GetNumber()]; ok && !data.Behind {
+		tab.StatusMsg = fmt.Sprintf("%s isn't behind %s", key, pr.GetBase().GetRef())
+		return
+	}
+
+	m.pendingConfirm = &confirmPrompt{
+		prompt:    fmt.Sprintf("Update %s with the latest %s?", key, pr.GetBase().GetRef()),
+		apply:     func() { tab.StatusMsg = fmt.Sprintf("📥 Updating the branch of %s...", key) },
+		onConfirm: m.updateBranchCmd(tab.Config.Name, pr),
+	}
+	tab.StatusMsg = m.pendingConfirm.prompt + " (y/n)"
+}
+
+// updateBranchCmd asks GitHub to merge the base branch into one PR's branch
+func (m *MultiTabModel) updateBranchCmd(tabName string, pr *gh.PullRequest) tea.Cmd {
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 10*time.Second)
+		defer cancel()
+
+		err := github.UpdatePullRequestBranch(ctx, token, pr)
+		return updateBranchResultMsg{tabName: tabName, pr: pr, err: err}
+	}
+}
+
+// handleUpdateBranchResult reports an accepted or refused branch update and
+// starts watching for GitHub to finish it
+func (m *MultiTabModel) handleUpdateBranchResult(msg updateBranchResultMsg) (tea.Model, tea.Cmd) {
+	key := services.PRKey(msg.pr)
+	status := fmt.Sprintf("📥 GitHub is merging %s into %s...", msg.pr.GetBase().GetRef(), key)
+	if msg.err != nil {
+		status = fmt.Sprintf("Updating the branch of %s failed: %v", key, msg.err)
+	}
+
+	for _, tab := range m.TabManager.Tabs {
+		if tab.Config.Name == msg.tabName {
+			tab.StatusMsg = status
+		}
+	}
+	if msg.err != nil {
+		return m, nil
+	}
+	// The head commit moves, so its checks and details are outdated
+	delete(m.checkRuns, key)
+	delete(m.prDetails, key)
+	return m, m.branchUpdateCheckCmd(msg.tabName, msg.pr, 0)
+}
+
+// branchUpdateCheckCmd re-enhances a PR after branchUpdateCheckDelay to see
+// whether its branch caught up with the base
+func (m *MultiTabModel) branchUpdateCheckCmd(tabName string, pr *gh.PullRequest, attempt int) tea.Cmd {
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		time.Sleep(branchUpdateCheckDelay)
+
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 20*time.Second)
+		defer cancel()
+		data, err := services.FetchEnhancedData(ctx, token, pr)
+		return branchUpdateCheckMsg{tabName: tabName, pr: pr, data: data, err: err, attempt: attempt}
+	}
+}
+
+// handleBranchUpdateCheck stores the rechecked PR and reports once its
+// branch is up to date, checking again while GitHub is still merging
+func (m *MultiTabModel) handleBranchUpdateCheck(msg branchUpdateCheckMsg) (tea.Model, tea.Cmd) {
+	var tab *TabState
+	for _, candidate := range m.TabManager.Tabs {
+		if candidate.Config.Name == msg.tabName {
+			tab = candidate
+			break
+		}
+	}
+	if tab == nil {
+		return m, nil // Closed meanwhile
+	}
+	key := services.PRKey(msg.pr)
+
+	if msg.err != nil {
+		tab.StatusMsg = fmt.Sprintf("Branch update of %s requested; checking on it failed: %v", key, msg.err)
+		return m, nil
+	}
+	tab.EnhancedData[msg.data.Number] = msg.data
+	m.updateTableRows(tab)
+
+	switch {
+	case !msg.data.Behind:
+		tab.StatusMsg = fmt.Sprintf("✅ %s is up to date with %s", key, msg.pr.GetBase().GetRef())
+	case msg.attempt+1 < branchUpdateCheckAttempts:
+		return m, m.branchUpdateCheckCmd(msg.tabName, msg.pr, msg.attempt+1)
+	default:
+		tab.StatusMsg = fmt.Sprintf("%s is still behind %s; GitHub may still be merging, press r to check later", key, msg.pr.GetBase().GetRef())
+	}
+	return m, nil
+}
@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bjess9/pr-compass/internal/ui/types"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// TestUpdateBranch tests confirming a branch update and following it until
+// the PR is no longer behind
+func TestUpdateBranch(t *testing.T) {
+	model, tab := detailTestModel("test-token")
+	pr := tab.PRs[0]
+	pr.Base.Ref = gh.String("main")
+
+	tab.EnhancedData[12] = types.EnhancedData{Number: 12, Mergeable: "clean", ChecksStatus: "success"}
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlB})
+	if model.pendingConfirm != nil || tab.StatusMsg != "org/api#12 isn't behind main" {
+		t.Fatalf("Expected up-to-date PRs to be left alone, got %q", tab.StatusMsg)
+	}
+
+	tab.EnhancedData[12] = types.EnhancedData{Number: 12, Mergeable: "clean", ChecksStatus: "success", Behind: true}
+	model.updateTableRows(tab)
+	if status := tab.Table.Rows()[0][4]; !strings.HasPrefix(status, "⚠️ Behind") {
+		t.Errorf("Expected the row to show the branch behind, got %q", status)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlB})
+	if tab.StatusMsg != "Update org/api#12 with the latest main? (y/n)" {
+		t.Fatalf("Expected to be asked to update the branch, got %q", tab.StatusMsg)
+	}
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")}); cmd == nil || tab.StatusMsg != "📥 Updating the branch of org/api#12..." {
+		t.Errorf("Expected the update to start, got %q", tab.StatusMsg)
+	}
+
+	if _, cmd := model.Update(updateBranchResultMsg{tabName: "Main", pr: pr, err: errors.New("merge conflict")}); cmd != nil || !strings.Contains(tab.StatusMsg, "failed: merge conflict") {
+		t.Errorf("Expected a refused update to be reported, got %q", tab.StatusMsg)
+	}
+	if _, cmd := model.Update(updateBranchResultMsg{tabName: "Main", pr: pr}); cmd == nil || tab.StatusMsg != "📥 GitHub is merging main into org/api#12..." {
+		t.Errorf("Expected an accepted update to be followed, got %q", tab.StatusMsg)
+	}
+
+	// Still behind: check again until the attempts run out
+	behind := tab.EnhancedData[12]
+	if _, cmd := model.Update(branchUpdateCheckMsg{tabName: "Main", pr: pr, data: behind}); cmd == nil {
+		t.Error("Expected a PR still behind to be checked again")
+	}
+	model.Update(branchUpdateCheckMsg{tabName: "Main", pr: pr, data: behind, attempt: branchUpdateCheckAttempts - 1})
+	if !strings.Contains(tab.StatusMsg, "still behind main") {
+		t.Errorf("Expected to give up after the last attempt, got %q", tab.StatusMsg)
+	}
+
+	caughtUp := types.EnhancedData{Number: 12, Mergeable: "clean", ChecksStatus: "success"}
+	model.Update(branchUpdateCheckMsg{tabName: "Main", pr: pr, data: caughtUp})
+	if tab.StatusMsg != "✅ org/api#12 is up to date with main" || tab.EnhancedData[12].Behind {
+		t.Errorf("Expected the update to be reported done, got %q", tab.StatusMsg)
+	}
+	if status := tab.Table.Rows()[0][4]; !strings.HasPrefix(status, "✅ Ready") {
+		t.Errorf("Expected the row to show the PR ready, got %q", status)
+	}
+}
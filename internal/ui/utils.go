@@ -8,7 +8,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/jira"
 	"github.com/bjess9/pr-compass/internal/ui/formatters"
+	"github.com/bjess9/pr-compass/internal/ui/services"
 	"github.com/bjess9/pr-compass/internal/ui/types"
 	gh "github.com/google/go-github/v55/github"
 
@@ -37,29 +41,21 @@ func IsWSL() bool {
 	return false
 }
 
-// getTerminalWidth returns the current terminal width, with fallback to reasonable default
-func getTerminalWidth() int {
-	// Try to get actual terminal width
-	if width := getActualTerminalWidth(); width > 0 {
-		return width
-	}
-	// Use a larger fallback that fills most modern terminal windows
-	return 160
-}
+// defaultTerminalWidth sizes tables created before the first
+// tea.WindowSizeMsg arrives; they are resized once it does
+const defaultTerminalWidth = 160
 
-// getActualTerminalWidth attempts to get the real terminal width
-func getActualTerminalWidth() int {
-	// Try to get terminal size from bubbletea's term package
-	// This is a more robust approach than trying to implement our own
-	// For now, we'll use a conservative default that works well
-	return 0 // Use fallback for consistency
+func createTableColumns() []table.Column {
+	return createTableColumnsForWidth(defaultTerminalWidth)
 }
 
-func createTableColumns() []table.Column {
-	totalWidth := getTerminalWidth() - 12 // Account for borders, padding, selection indicators, and scrollbars
+// createTableColumnsForWidth sizes the table columns for the given terminal width
+func createTableColumnsForWidth(terminalWidth int) []table.Column {
+	totalWidth := terminalWidth - tableChrome - 10*fitCellPadding // Borders, and the padding of the 10 cells
 
 	// Minimum widths to ensure readability
 	minPRWidth := 32      // PR title only (no number)
+	minTypeWidth := 8     // Conventional-commit type
 	minAuthorWidth := 10  // Author only
 	minRepoWidth := 12    // Repo only
 	minStatusWidth := 10  // Status + CI
@@ -69,19 +65,21 @@ func createTableColumns() []table.Column {
 	minCreatedWidth := 8  // Created time
 	minUpdatedWidth := 8  // Updated time
 
-	// Calculate optimal widths for 9 columns (split Author/Repo into separate columns)
-	prNameWidth := max(minPRWidth, totalWidth*22/100)        // 22% - PR title (no number)
+	// Calculate optimal widths for 10 columns (split Author/Repo into separate columns)
+	prNameWidth := max(minPRWidth, totalWidth*20/100)        // 20% - PR title (no number)
+	typeWidth := max(minTypeWidth, totalWidth*6/100)         // 6%  - Conventional-commit type
 	authorWidth := max(minAuthorWidth, totalWidth*10/100)    // 10% - Author only
-	repoWidth := max(minRepoWidth, totalWidth*12/100)        // 12% - Repo only (guaranteed visibility)
-	statusWidth := max(minStatusWidth, totalWidth*12/100)    // 12% - Status + CI
+	repoWidth := max(minRepoWidth, totalWidth*11/100)        // 11% - Repo only (guaranteed visibility)
+	statusWidth := max(minStatusWidth, totalWidth*11/100)    // 11% - Status + CI
 	reviewsWidth := max(minReviewsWidth, totalWidth*9/100)   // 9%  - Review status
 	commentsWidth := max(minCommentsWidth, totalWidth*7/100) // 7%  - Comments only
 	filesWidth := max(minFilesWidth, totalWidth*10/100)      // 10% - Files only
-	createdWidth := max(minCreatedWidth, totalWidth*9/100)   // 9%  - Created time
-	updatedWidth := max(minUpdatedWidth, totalWidth*9/100)   // 9%  - Updated time
+	createdWidth := max(minCreatedWidth, totalWidth*8/100)   // 8%  - Created time
+	updatedWidth := max(minUpdatedWidth, totalWidth*8/100)   // 8%  - Updated time
 
 	return []table.Column{
 		{Title: "📋 Pull Request", Width: prNameWidth}, // PR title only (no number)
+		{Title: "🏷️ Type", Width: typeWidth},          // feat/fix/chore/docs from the title prefix
 		{Title: "👤 Author", Width: authorWidth},       // Author only
 		{Title: "📦 Repo", Width: repoWidth},           // Repo only (guaranteed visible)
 		{Title: "⚡ Status/CI", Width: statusWidth},    // Status + CI
@@ -93,6 +91,22 @@ func createTableColumns() []table.Column {
 	}
 }
 
+// withStackColumns appends the repo Language and Topics columns, taking
+// their width from the PR title column as far as its minimum allows
+func withStackColumns(columns []table.Column, terminalWidth int) []table.Column {
+	totalWidth := terminalWidth - 12
+	languageWidth := max(8, totalWidth*6/100)
+	topicsWidth := max(12, totalWidth*10/100)
+
+	result := make([]table.Column, len(columns), len(columns)+2)
+	copy(result, columns)
+	result[0].Width = max(24, result[0].Width-languageWidth-topicsWidth-4) // Cell padding too
+	return append(result,
+		table.Column{Title: "🧰 Language", Width: languageWidth}, // Repo primary language
+		table.Column{Title: "🏷️ Topics", Width: topicsWidth},    // Repo topics
+	)
+}
+
 // max returns the maximum of two integers (helper function for Go < 1.21)
 func max(a, b int) int {
 	if a > b {
@@ -133,7 +147,7 @@ func createTableRows(prs []*gh.PullRequest) []table.Row {
 		statusCombined := mergeStatus + " " + ciStatus
 
 		// Review Status
-		reviews := getPRReviewIndicator(pr)
+		reviews := getPRReviewIndicator(pr, defaultStaleDays)
 
 		// Comments (split from activity)
 		comments := getPRCommentCount(pr)
@@ -148,14 +162,15 @@ func createTableRows(prs []*gh.PullRequest) []table.Row {
 
 		row := table.Row{
 			prName,
-			author,           // Author only
-			repoName,         // Repo only (guaranteed visible)
-			statusCombined,   // Status + CI
-			reviews,          // Review status
-			comments,         // Comments only
-			files,            // Files only (placeholder)
-			timeSinceCreated, // When PR was created
-			timeSinceUpdated, // When PR was updated
+			formatTitleType(pr), // Conventional-commit type
+			author,              // Author only
+			repoName,            // Repo only (guaranteed visible)
+			statusCombined,      // Status + CI
+			reviews,             // Review status
+			comments,            // Comments only
+			files,               // Files only (placeholder)
+			timeSinceCreated,    // When PR was created
+			timeSinceUpdated,    // When PR was updated
 		}
 
 		rows[i] = row
@@ -163,16 +178,86 @@ func createTableRows(prs []*gh.PullRequest) []table.Row {
 	return rows
 }
 
+// tableRowOptions carries per-tab display settings into table row creation
+type tableRowOptions struct {
+	SizeBudget       int                        // Changed lines before a PR is flagged for splitting (0 disables)
+	RequireIssueLink bool                       // Flag PRs that don't reference an issue or ticket
+	WarnDays         int                        // Days without updates before a row turns yellow (0 disables)
+	StaleDays        int                        // Days without updates before a row turns red (0 disables)
+	DuplicateCounts  map[string]int             // PR key -> size of its cross-repo duplicate group
+	Blockers         *BlockerStore              // Local "blocked on" annotations (nil disables)
+	Notes            *NoteStore                 // Private PR notes (nil disables)
+	Pins             *PinStore                  // Pinned PRs (nil disables)
+	Seen             *SeenStore                 // When PRs were last viewed (nil disables)
+	Reviews          map[string]submittedReview // PR key -> review verdict submitted from this session
+	Highlight        string                     // Search query whose matches are underlined
+	RequiredChecks   map[string][]string        // "owner/name@branch" -> status checks branch protection requires
+	TeamMembers      map[string][]string        // "org/slug" -> members of a team asked to review
+	TitleWidth       int                        // Width of the PR title column, 0 before the terminal size is known
+
+	// StackColumns appends repo Language and Topics cells from RepoMetadata
+	StackColumns bool
+	RepoMetadata map[string]*cache.RepoMetadata // "owner/name" -> metadata, nil while unknown
+	RepoLoading  map[string]bool                // Repos whose metadata is being fetched
+
+	// AssigneeColumn and LabelColumn append the PR's assignees and labels,
+	// after any stack columns, and PolicyColumn its branch protection verdict
+	AssigneeColumn bool
+	LabelColumn    bool
+	PolicyColumn   bool
+
+	// MilestoneColumn and ProjectColumn append the PR's milestone and its
+	// Projects boards from ProjectItems, after the columns above
+	MilestoneColumn bool
+	ProjectColumn   bool
+	ProjectItems    map[string][]github.ProjectItem // PR key -> boards, nil while unknown
+	ProjectLoading  bool                            // The tab's boards are being looked up
+
+	// TicketColumn appends the Jira status of the tickets a PR references
+	TicketColumn bool
+	Jira         *jira.Client                // nil when Jira isn't configured
+	JiraIssues   map[string]*cache.JiraIssue // Ticket key -> issue, nil while unknown
+	JiraLoading  map[string]bool             // Ticket keys being looked up
+}
+
 // createTableRowsWithEnhancement creates table rows using enhanced data when available
 func createTableRowsWithEnhancement(prs []*gh.PullRequest, enhancedData map[int]types.EnhancedData) []table.Row {
-	// Get column widths for dynamic truncation
-	columns := createTableColumns()
-	prColumnWidth := columns[0].Width
+	return createTableRowsWithOptions(prs, enhancedData, tableRowOptions{})
+}
+
+// createTableRowsWithOptions creates enhanced table rows honouring per-tab display settings
+func createTableRowsWithOptions(prs []*gh.PullRequest, enhancedData map[int]types.EnhancedData, opts tableRowOptions) []table.Row {
+	// Titles are truncated to the title column, as sized for the terminal
+	prColumnWidth := opts.TitleWidth
+	if prColumnWidth <= 0 {
+		prColumnWidth = createTableColumns()[0].Width
+	}
 
 	rows := make([]table.Row, len(prs))
 	for i, pr := range prs {
-		// PR Name (smart formatting with ticket detection)
-		prName := formatPRTitle(pr, prColumnWidth)
+		// PR Name (smart formatting with ticket detection), grouped duplicates get a
+		// count badge and PRs breaking the issue link policy get a marker
+		badge := ""
+		if opts.Pins.Pinned(pr) {
+			badge = pinMarker + " "
+		}
+		if _, blocked := opts.Blockers.Get(pr); blocked {
+			badge += blockedMarker + " "
+		}
+		if _, noted := opts.Notes.Get(pr); noted {
+			badge += noteMarker + " "
+		}
+		if count := opts.DuplicateCounts[services.PRKey(pr)]; count > 1 {
+			badge += fmt.Sprintf("%s%d ", duplicateMarker, count)
+		}
+		if opts.RequireIssueLink && !services.HasIssueLink(pr.GetTitle(), pr.GetBody(), pr.GetHead().GetRef()) {
+			badge += missingIssueMarker + " "
+		}
+		if !isHistoryPR(pr) && opts.Seen.Unread(pr) {
+			badge += unreadMarker + " "
+		}
+		title := formatPRTitle(pr, prColumnWidth-len(badge))
+		prName := badge + title
 
 		// Author and Repo (now separate columns for better visibility)
 		author := "Unknown"
@@ -192,33 +277,92 @@ func createTableRowsWithEnhancement(prs []*gh.PullRequest, enhancedData map[int]
 
 		// Status + CI (compact single-line format with enhanced CI data)
 		mergeStatus := getPRStatusIndicatorEnhanced(pr, enhancedData)
+		if mergeStatus == theme.Passed+" Ready" && len(missingRequiredChecks(pr, enhancedData, opts.RequiredChecks)) > 0 {
+			// Mergeable, but blocked on a required check that never reported
+			mergeStatus = theme.Attention + " Missing Checks"
+		}
 		ciStatus := getCIStatusEnhanced(pr, enhancedData) // New function for CI status
 		statusCombined := mergeStatus + " " + ciStatus
+		if pr.AutoMerge != nil {
+			// GitHub merges it on its own once requirements pass
+			statusCombined = autoMergeMarker + " " + statusCombined
+		}
+		if isHistoryPR(pr) {
+			statusCombined = historyStatus(pr, time.Now())
+		}
 
 		// Review Status - enhanced with detailed review info
-		reviews := getPRReviewIndicatorEnhanced(pr, enhancedData)
+		reviews := getPRReviewIndicatorEnhanced(pr, enhancedData, opts.StaleDays)
+		if teams := teamReviewIndicator(pr, enhancedData, opts.TeamMembers); teams != "" {
+			reviews = teams
+		}
+		if review, ok := opts.Reviews[services.PRKey(pr)]; ok {
+			// Show our verdict until enhancement data catches up with it
+			if enhanced, exists := enhancedData[pr.GetNumber()]; !exists || enhanced.EnhancedAt.Before(review.at) {
+				reviews = review.indicator()
+			}
+		}
 
 		// Comments - enhanced with detailed comment counts when available
 		comments := getPRCommentCountEnhanced(pr, enhancedData)
 
 		// Files - enhanced with file change info when available
 		files := getPRFileChangesEnhanced(pr, enhancedData)
+		if isOverSizeBudget(pr, enhancedData, opts.SizeBudget) {
+			files = sizeBudgetMarker + " " + files
+		}
 
 		// Use the formatter for consistent timestamps
 		formatter := formatters.NewPRFormatter()
 		timeSinceCreated := formatter.HumanizeTimeSince(pr.GetCreatedAt().Time)
 		timeSinceUpdated := formatter.HumanizeTimeSince(pr.GetUpdatedAt().Time)
 
+		// Underline what the tab's search matched
+		if opts.Highlight != "" {
+			prName = badge + highlightMatches(title, opts.Highlight)
+			author = highlightMatches(author, opts.Highlight)
+			repoName = highlightMatches(repoName, opts.Highlight)
+		}
+
+		// Rows not updated in a while, and merged and closed rows, are styled
+		// once rendered, see colorAgedRows
+		marker := agingMarker(pr, opts.WarnDays, opts.StaleDays, time.Now())
+		if isHistoryPR(pr) {
+			marker = historyMarker
+		}
 		row := table.Row{
-			prName,
-			author,           // Author only
-			repoName,         // Repo only (guaranteed visible)
-			statusCombined,   // Status + CI
-			reviews,          // Review status (enhanced)
-			comments,         // Comments only (enhanced)
-			files,            // Files only (enhanced)
-			timeSinceCreated, // When PR was created
-			timeSinceUpdated, // When PR was updated
+			marker + prName,
+			formatTitleType(pr), // Conventional-commit type
+			author,              // Author only
+			repoName,            // Repo only (guaranteed visible)
+			statusCombined,      // Status + CI
+			reviews,             // Review status (enhanced)
+			comments,            // Comments only (enhanced)
+			files,               // Files only (enhanced)
+			timeSinceCreated,    // When PR was created
+			timeSinceUpdated,    // When PR was updated
+		}
+		if opts.StackColumns {
+			language, topics := repoStackCells(repoFullName, opts)
+			row = append(row, language, topics)
+		}
+		if opts.AssigneeColumn {
+			row = append(row, assigneeCell(pr))
+		}
+		if opts.LabelColumn {
+			row = append(row, labelCell(pr))
+		}
+		if opts.PolicyColumn {
+			row = append(row, policyCell(pr, enhancedData))
+		}
+		if opts.MilestoneColumn {
+			row = append(row, milestoneCell(pr))
+		}
+		if opts.ProjectColumn {
+			row = append(row, projectCell(pr, opts))
+		}
+		if opts.TicketColumn {
+			row = append(row, ticketCell(pr, opts))
 		}
 
 		rows[i] = row
@@ -226,6 +370,26 @@ func createTableRowsWithEnhancement(prs []*gh.PullRequest, enhancedData map[int]
 	return rows
 }
 
+// repoStackCells formats a repo's language and topics for the table
+func repoStackCells(repo string, opts tableRowOptions) (string, string) {
+	metadata := opts.RepoMetadata[repo]
+	if metadata == nil {
+		if opts.RepoLoading[repo] {
+			return "⏳", "⏳"
+		}
+		return "-", "-"
+	}
+
+	language, topics := metadata.Language, strings.Join(metadata.Topics, ", ")
+	if language == "" {
+		language = "-"
+	}
+	if topics == "" {
+		topics = "-"
+	}
+	return language, topics
+}
+
 // getPRStatusIndicator returns merge readiness status
 func getPRStatusIndicator(pr *gh.PullRequest) string {
 	// Focus on MERGE READINESS with enhanced visual indicators
@@ -238,23 +402,23 @@ func getPRStatusIndicator(pr *gh.PullRequest) string {
 	mergeableState := pr.GetMergeableState()
 	switch mergeableState {
 	case "dirty":
-		return "⚠️ Conflicts"
+		return theme.Attention + " Conflicts"
 	case "blocked":
 		return "🚫 Blocked"
 	case "behind":
-		return "📥 Behind"
+		return theme.Attention + " Behind"
 	case "clean":
-		return "✅ Ready"
+		return theme.Passed + " Ready"
 	case "unstable":
-		return "🔄 Checks"
+		return theme.Running + " Checks"
 	default:
 		// For non-draft PRs without explicit state, assume ready
-		return "✅ Ready"
+		return theme.Passed + " Ready"
 	}
 }
 
 // getPRReviewIndicator returns HUMAN REVIEW STATUS with enhanced visual indicators
-func getPRReviewIndicator(pr *gh.PullRequest) string {
+func getPRReviewIndicator(pr *gh.PullRequest, staleDays int) string {
 	// Focus ONLY on human review process - completely separate from merge status
 
 	// For drafts, they're work in progress so reviews don't make sense yet
@@ -269,13 +433,12 @@ func getPRReviewIndicator(pr *gh.PullRequest) string {
 	}
 
 	// Check if PR is old and might need attention (regardless of merge state)
-	daysSinceUpdated := time.Since(pr.GetUpdatedAt().Time).Hours() / 24
-	if daysSinceUpdated > 5 { // Older than 5 days
+	if services.NotUpdatedFor(pr, staleDays, time.Now()) {
 		return "🕰️ Stale"
 	}
 
 	// Check if it's recent and might not need formal review
-	if daysSinceUpdated < 1 {
+	if time.Since(pr.GetUpdatedAt().Time) < 24*time.Hour {
 		return "🆕 Recent"
 	}
 
@@ -328,11 +491,18 @@ func getPRStatusIndicatorEnhanced(pr *gh.PullRequest, enhancedData map[int]types
 		switch enhanced.Mergeable {
 		case "clean":
 			if enhanced.ChecksStatus == "failure" {
-				return "❌ Failed Checks"
+				return theme.Failed + " Failed Checks"
+			}
+			if enhanced.MergeState == "BLOCKED" {
+				// Mergeable, but branch protection's rules aren't met yet
+				return "🚫 Blocked"
 			}
-			return "✅ Ready"
+			if enhanced.Behind {
+				return theme.Attention + " Behind"
+			}
+			return theme.Passed + " Ready"
 		case "conflicts":
-			return "⚠️ Conflicts"
+			return theme.Attention + " Conflicts"
 		default:
 			// Fall through to basic logic
 		}
@@ -343,27 +513,31 @@ func getPRStatusIndicatorEnhanced(pr *gh.PullRequest, enhancedData map[int]types
 }
 
 // getPRReviewIndicatorEnhanced returns enhanced review status
-func getPRReviewIndicatorEnhanced(pr *gh.PullRequest, enhancedData map[int]types.EnhancedData) string {
+func getPRReviewIndicatorEnhanced(pr *gh.PullRequest, enhancedData map[int]types.EnhancedData, staleDays int) string {
 	prNumber := pr.GetNumber()
 
 	// Try to get enhanced data first
 	if enhanced, exists := enhancedData[prNumber]; exists {
 		switch enhanced.ReviewStatus {
 		case "approved":
-			return "✅ Approved"
+			return theme.Passed + " Approved"
 		case "changes_requested":
-			return "🔄 Changes"
+			return theme.Running + " Changes"
 		case "pending":
 			return "⏳ Pending"
 		case "no_review":
+			// Pending review requests show as progress, as in the list-only indicator
+			if requested := len(pr.RequestedReviewers) + len(pr.RequestedTeams); requested > 0 {
+				return fmt.Sprintf("⏳ 0/%d", requested)
+			}
 			return "📝 No Review"
 		default:
-			return "❓ Unknown"
+			return theme.Unknown + " Unknown"
 		}
 	}
 
 	// Fall back to original logic
-	return getPRReviewIndicator(pr)
+	return getPRReviewIndicator(pr, staleDays)
 }
 
 // getCIStatusEnhanced returns CI status from enhanced data
@@ -374,15 +548,15 @@ func getCIStatusEnhanced(pr *gh.PullRequest, enhancedData map[int]types.Enhanced
 	if enhanced, exists := enhancedData[prNumber]; exists {
 		switch enhanced.ChecksStatus {
 		case "success":
-			return "✅ CI"
+			return theme.Passed + " CI"
 		case "failure":
-			return "❌ CI"
+			return theme.Failed + " CI"
 		case "pending":
-			return "🔄 CI"
+			return theme.Running + " CI"
 		case "skipped":
-			return "⚪ CI"
+			return theme.Skipped + " CI"
 		default:
-			return "❓ CI"
+			return theme.Unknown + " CI"
 		}
 	}
 
@@ -424,6 +598,7 @@ func formatPRTitle(pr *gh.PullRequest, maxWidth int) string {
 	}
 
 	// Fallback: Use original title, truncated if necessary
+	maxWidth = max(maxWidth, 0) // Badges can take a narrow column's whole width
 	if len(title) > maxWidth {
 		if maxWidth > 3 {
 			title = title[:maxWidth-3] + "..."
@@ -482,6 +657,55 @@ func getPRFileChangesEnhanced(pr *gh.PullRequest, enhancedData map[int]types.Enh
 	return "?"
 }
 
+// titleTypeColors gives each conventional-commit type a colored marker
+var titleTypeColors = map[string]string{
+	"feat":     "🟢",
+	"fix":      "🔴",
+	"perf":     "🟠",
+	"refactor": "🟣",
+	"docs":     "🔵",
+	"test":     "🟡",
+	"build":    "🟤",
+	"ci":       "🟤",
+	"chore":    "⚪",
+	"style":    "⚪",
+	"revert":   "⚫",
+}
+
+// formatTitleType renders the Type column from the PR title prefix, with "!" for breaking changes
+func formatTitleType(pr *gh.PullRequest) string {
+	kind, breaking := services.ParseTitleType(pr.GetTitle())
+	if kind == "" {
+		return "-"
+	}
+	label := kind
+	if theme.TypeColors {
+		label = titleTypeColors[kind] + " " + kind
+	}
+	if breaking {
+		label += "!"
+	}
+	return label
+}
+
+// duplicateMarker prefixes PRs that belong to a cross-repo duplicate group
+const duplicateMarker = "🔁"
+
+// missingIssueMarker flags PRs that don't reference an issue or ticket when require_issue_link is set
+const missingIssueMarker = "🎫"
+
+// sizeBudgetMarker flags PRs that exceed the configured review size budget
+const sizeBudgetMarker = "✂️"
+
+// isOverSizeBudget reports whether a PR's enhanced data shows more changed lines than the budget
+func isOverSizeBudget(pr *gh.PullRequest, enhancedData map[int]types.EnhancedData, budget int) bool {
+	enhanced, exists := enhancedData[pr.GetNumber()]
+	if !exists {
+		return false
+	}
+	return services.ExceedsSizeBudget(&enhanced, budget)
+}
+
 // getPRLabelsDisplay returns a smart display of important labels
 func getPRLabelsDisplay(pr *gh.PullRequest) string {
 	if len(pr.Labels) == 0 {
@@ -553,10 +777,10 @@ func errorView(err error) string {
 	message = errorStyle.Render(errorMsg)
 
 	// Add helpful suggestions
-	suggestions = mutedStyle.Render("💡 Try: Check your config file or run 'gh auth login' to authenticate")
+	suggestions = mutedStyle.Render("💡 Try: Run 'pr-compass doctor' to check your token, SSO authorization, rate limit and config")
 
 	// Compact help text with compass emoji
-	helpText := "🧭 Press q to quit • r to retry • Check connection"
+	helpText := "🧭 Press q to quit • r to retry • E to see the log"
 	help := helpStyle.Render(helpText)
 
 	return "\n" + title + "\n\n" + message + "\n\n" + suggestions + "\n\n" + help + "\n"
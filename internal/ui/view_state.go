@@ -0,0 +1,158 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+)
+
+// TabView is the filter and sort order a tab was left with
+type TabView struct {
+	FilterMode  string `json:"filter_mode,omitempty"`
+	FilterValue string `json:"filter_value,omitempty"`
+	Sort        string `json:"sort,omitempty"` // A SortKey name; empty sorts by update time
+	Ascending   bool   `json:"ascending,omitempty"`
+}
+
+// ViewStore remembers each tab's filter and sort order, keyed by tab name,
+// persisted locally so tabs reopen showing the same PRs in the same order
+type ViewStore struct {
+	mu    sync.Mutex
+	path  string // Empty path keeps views in memory only
+	views map[string]TabView
+}
+
+// NewViewStore creates a view store backed by the given file. A missing or
+// unreadable file starts with every tab unfiltered.
+func NewViewStore(path string) *ViewStore {
+	store := &ViewStore{
+		path:  path,
+		views: make(map[string]TabView),
+	}
+
+	if path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			var saved map[string]TabView
+			if json.Unmarshal(data, &saved) == nil && saved != nil {
+				store.views = saved
+			}
+		}
+	}
+
+	return store
+}
+
+// Get returns the view a tab was left with
+func (s *ViewStore) Get(tabName string) TabView {
+	if s == nil {
+		return TabView{}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.views[tabName]
+}
+
+// Set remembers a tab's view; the default, unfiltered view is forgotten
+func (s *ViewStore) Set(tabName string, view TabView) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if view == (TabView{}) {
+		delete(s.views, tabName)
+	} else {
+		s.views[tabName] = view
+	}
+	return s.save()
+}
+
+// Rename moves the view remembered for a tab to its new name
+func (s *ViewStore) Rename(oldName, newName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	view, ok := s.views[oldName]
+	if !ok {
+		return nil
+	}
+	delete(s.views, oldName)
+	s.views[newName] = view
+	return s.save()
+}
+
+// save writes views to disk; the caller must hold the lock
+func (s *ViewStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(s.views, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode tab views: %w", err)
+	}
+	if err := cache.WriteFile(s.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to save tab views: %w", err)
+	}
+	return nil
+}
+
+// getViewsFilePath returns the path tab views are saved to
+func getViewsFilePath() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/.prcompass_views.json", homeDir)
+}
+
+// currentView returns the tab's view worth restoring. Filters being typed
+// are left out, and presets are remembered by the preset store instead.
+func currentView(tab *TabState) TabView {
+	view := TabView{Ascending: tab.SortAscending}
+	if tab.SortKey != SortUpdated {
+		view.Sort = tab.SortKey.String()
+	}
+	if tab.FilterMode != "" && tab.FilterMode != "preset" && !tab.typingFilter() {
+		view.FilterMode = tab.FilterMode
+		view.FilterValue = tab.FilterValue
+	}
+	return view
+}
+
+// rememberViews saves the view of every tab whose filter or sort changed
+func (m *MultiTabModel) rememberViews() {
+	for _, tab := range m.TabManager.Tabs {
+		view := currentView(tab)
+		if view == m.Views.Get(tab.Config.Name) {
+			continue
+		}
+		if err := m.Views.Set(tab.Config.Name, view); err != nil {
+			tab.StatusMsg += " • " + err.Error()
+		}
+	}
+}
+
+// restoreViews reapplies the filter and sort order each tab had when PR
+// Compass last closed. Filters the tab's config no longer allows are skipped.
+func (m *MultiTabModel) restoreViews() {
+	for _, tab := range m.TabManager.Tabs {
+		view := m.Views.Get(tab.Config.Name)
+		for key := SortKey(0); key < sortKeyCount; key++ {
+			if key.String() == view.Sort {
+				tab.SortKey = key
+			}
+		}
+		tab.SortAscending = view.Ascending
+
+		switch {
+		case view.FilterMode == "size" && tab.Config.ReviewSizeBudget <= 0:
+		case view.FilterMode == "unlinked" && !tab.Config.RequireIssueLink:
+		default:
+			// Applied to the PRs once they load
+			tab.FilterMode = view.FilterMode
+			tab.FilterValue = view.FilterValue
+		}
+	}
+}
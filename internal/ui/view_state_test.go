@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestTabViewsRestored tests that each tab's filter and sort order are saved
+// as they change and restored by the next session
+func TestTabViewsRestored(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "views.json")
+	model, main := presetTestModel(t)
+	model.Views = NewViewStore(path)
+	other := model.TabManager.AddTab(&TabConfig{Name: "Other", Mode: "repos", Repos: []string{"org/web"}})
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("O")})
+	if len(main.FilteredPRs) != 1 {
+		t.Fatalf("Expected the draft filter applied, got %d PRs", len(main.FilteredPRs))
+	}
+
+	// A filter being typed isn't a view to restore
+	model.TabManager.NextTab()
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	if view := model.Views.Get("Other"); view != (TabView{}) {
+		t.Errorf("Expected no view saved while typing a filter, got %+v", view)
+	}
+
+	restarted := NewMultiTabModel("test-token", nil)
+	restarted.Views = NewViewStore(path)
+	tab := restarted.TabManager.AddTab(&TabConfig{Name: "Main", Mode: "repos", Repos: []string{"org/api"}})
+	untouched := restarted.TabManager.AddTab(other.Config)
+	restarted.restoreViews()
+	if tab.FilterMode != "draft" || tab.SortKey != SortCreated || !tab.SortAscending {
+		t.Fatalf("Expected the draft filter and ascending created order, got %q %s ascending=%v",
+			tab.FilterMode, tab.SortKey, tab.SortAscending)
+	}
+	if untouched.FilterMode != "" || untouched.SortKey != SortUpdated {
+		t.Error("Expected a tab without a saved view to open unfiltered")
+	}
+
+	restarted.setTabPRs(tab, main.PRs)
+	if len(tab.FilteredPRs) != 1 || !tab.FilteredPRs[0].GetDraft() {
+		t.Errorf("Expected the restored filter applied once PRs load, got %d PRs", len(tab.FilteredPRs))
+	}
+}
@@ -83,6 +83,10 @@ func (vm *ViewModel) formatFilterDescription(mode, value string) string {
 		return fmt.Sprintf("by status: %s", value)
 	case "draft":
 		return "drafts only"
+	case "requested":
+		return "needs my review"
+	case "mine":
+		return "my PRs"
 	default:
 		return fmt.Sprintf("%s: %s", mode, value)
 	}
@@ -98,8 +102,11 @@ type TableViewModel struct {
 
 // CreateTableViewModel creates a table view model
 func (vm *ViewModel) CreateTableViewModel(prs []*gh.PullRequest, enhancementQueue map[int]bool, selectedIndex, height, width int) TableViewModel {
-	// Use the table component to create rows
+	// Use the table component to create rows, truncated to the terminal width
 	tableComponent := components.NewTableComponent()
+	if width > 0 {
+		tableComponent = components.NewTableComponentForWidth(width)
+	}
 
 	// Convert to PRData format for the table component
 	prDataList := make([]*types.PRData, len(prs))
@@ -173,22 +180,76 @@ func (vm *ViewModel) CreateHelpViewModel() HelpViewModel {
 					{"↑/↓, j/k", "Navigate PRs"},
 					{"Tab/Shift+Tab", "Switch tabs"},
 					{"Ctrl+1-9", "Switch to tab number"},
-					{"Enter", "Open PR in browser"},
+					{"Ctrl+T", "Add a tab, saved to the config"},
+					{"Ctrl+W/Ctrl+Z", "Close tab / reopen last closed tab"},
+					{"</>, Ctrl+E", "Move / rename tab, saved to the config"},
+					{"Enter", "Open PR in browser, or with open_with when set"},
+					{"Alt+Enter", "Open PR in browser"},
+					{"Ctrl+O", "Open all listed PRs (up to 10)"},
 				},
 			},
 			{
 				Title: "Filtering",
 				Items: []HelpItem{
-					{"a", "Filter by author"},
+					{"/", "Fuzzy search titles, branches, authors, repos"},
+					{"f", "Filter by author"},
 					{"s", "Filter by status"},
+					{"t", "Cycle title type filter (feat, fix, ...)"},
+					{"#", "Filter by a label present in this tab"},
+					{"y", "Filter by a milestone present in this tab"},
+					{"Y", "Filter by a Projects board column"},
+					{"n", "Toggle PRs requesting my review (or my team's)"},
+					{"p", "Toggle PRs I opened"},
+					{"L/T", "Filter by repo language/topic"},
 					{"d", "Toggle draft filter"},
+					{"b", "Toggle size budget filter"},
+					{"l", "Toggle PRs without a linked issue"},
+					{"S", "Toggle PRs not updated in stale_days"},
 					{"c", "Clear filters"},
+					{"o/O", "Cycle sort key / reverse sort order"},
+					{"↑/↓ in prompt", "Recall earlier values typed in this tab"},
 				},
 			},
 			{
 				Title: "Actions",
 				Items: []HelpItem{
 					{"r", "Refresh PRs"},
+					{"i", "Show repo and author info for selected PR"},
+					{"v", "Toggle description and review timeline pane"},
+					{"V", "Review the selected PR's diff (n/p between files)"},
+					{"x/X", "List the selected PR's checks / open the failing one"},
+					{"F", "Re-run the selected PR's failed checks"},
+					{"H", "Show the feed of PR activity this session"},
+					{"Q", "Show open PRs per author and requested reviewer"},
+					{"E/e", "Show the log / change its level"},
+					{"Z", "Dismiss or show the repos that failed to load"},
+					{"A", "Review the selected PR: approve, request changes or comment"},
+					{"M", "Merge the selected PR (merge/squash/rebase)"},
+					{"Ctrl+R", "Mark the selected draft ready / convert to draft"},
+					{"G", "Arm auto-merge on the selected PR / disable it"},
+					{"Ctrl+B", "Update the selected PR's branch with its base"},
+					{"C", "Comment on the selected PR"},
+					{"R", "Request reviewers on the selected PR"},
+					{"I", "Assign yourself to the selected PR / unassign"},
+					{"Ctrl+L", "Add or remove labels on the selected PR"},
+					{"B", "Set what the selected PR is blocked on"},
+					{"1-5", "Toggle quick filter (0 clears)"},
+					{"6-9", "Apply filter preset (again or 0 clears)"},
+					{"N", "Edit a private note on the selected PR"},
+					{"*", "Pin or unpin the selected PR"},
+					{"a", "Mark every PR shown read"},
+					{"w", "Show or hide recently merged and closed PRs"},
+					{"W", "Open new PRs from watched repos"},
+					{"P", "Switch config profile"},
+					{"u", "Copy GitHub search URL for this view"},
+					{"U", "Open GitHub search URL for this view"},
+					{"m", "Copy table as markdown"},
+					{"D", "Open all PRs in duplicate group"},
+					{"Ctrl+A", "Approve all PRs in duplicate group"},
+					{"z", "Toggle compact density"},
+					{"-", "Hide least important column"},
+					{"=", "Reset layout for this screen size"},
+					{"←/→", "Scroll columns that don't fit the terminal"},
 					{"h, ?", "Show/hide help"},
 					{"q, Ctrl+C", "Quit application"},
 				},
@@ -268,7 +268,16 @@ func TestCreateHelpViewModel(t *testing.T) {
 		t.Errorf("Expected %d sections, got %d", len(expectedSections), len(helpVM.Sections))
 	}
 
+	// Each key is listed once, so the help can't describe two bindings for it
+	seen := make(map[string]string)
 	for i, section := range helpVM.Sections {
+		for _, item := range section.Items {
+			if other, ok := seen[item.Key]; ok {
+				t.Errorf("Key %q listed twice: %q and %q", item.Key, other, item.Description)
+			}
+			seen[item.Key] = item.Description
+		}
+
 		if section.Title != expectedSections[i] {
 			t.Errorf("Expected section %d title '%s', got '%s'", i, expectedSections[i], section.Title)
 		}
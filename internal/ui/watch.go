@@ -0,0 +1,269 @@
+package ui
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bjess9/pr-compass/internal/cache"
+	"github.com/bjess9/pr-compass/internal/config"
+	"github.com/bjess9/pr-compass/internal/github"
+	"github.com/bjess9/pr-compass/internal/ui/services"
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+// watchedPRLimit is how many open PRs a watch check lists across all watched
+// repos; new PRs are the most recently updated, so they are always included
+const watchedPRLimit = 200
+
+// WatchStore remembers the highest PR number seen in each watched repo,
+// persisted locally so PRs opened while PR Compass was closed are reported
+// on the next start. PR numbers only grow, so anything above it is new.
+type WatchStore struct {
+	mu       sync.Mutex
+	path     string         // Empty path keeps state in memory only
+	lastSeen map[string]int // Keyed by "owner/repo"
+}
+
+// watchTickMsg triggers a check of the watched repos
+type watchTickMsg struct{}
+
+// watchResultMsg delivers the open PRs of the watched repos
+type watchResultMsg struct {
+	prs []*gh.PullRequest
+	err error
+}
+
+// NewWatchStore creates a watch store backed by the given file. A missing or
+// unreadable file starts without a baseline.
+func NewWatchStore(path string) *WatchStore {
+	store := &WatchStore{
+		path:     path,
+		lastSeen: make(map[string]int),
+	}
+
+	if path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			var saved map[string]int
+			if json.Unmarshal(data, &saved) == nil && saved != nil {
+				store.lastSeen = saved
+			}
+		}
+	}
+
+	return store
+}
+
+// Observe records the open PRs of the watched repos and returns those opened
+// since the previous check, oldest first. The first check of a repo only sets
+// the baseline, so existing PRs aren't reported.
+func (s *WatchStore) Observe(repos []string, prs []*gh.PullRequest) ([]*gh.PullRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	highest := make(map[string]int)
+	for _, repo := range repos {
+		highest[strings.ToLower(repo)] = 0
+	}
+
+	var opened []*gh.PullRequest
+	for _, pr := range prs {
+		repo := strings.ToLower(pr.GetBase().GetRepo().GetFullName())
+		top, watched := highest[repo]
+		if !watched {
+			continue
+		}
+		if pr.GetNumber() > top {
+			highest[repo] = pr.GetNumber()
+		}
+		if seen, known := s.lastSeen[repo]; known && pr.GetNumber() > seen {
+			opened = append(opened, pr)
+		}
+	}
+
+	for repo, top := range highest {
+		if top > s.lastSeen[repo] {
+			s.lastSeen[repo] = top
+		} else if _, known := s.lastSeen[repo]; !known {
+			s.lastSeen[repo] = 0 // No open PRs yet; any PR from now on is new
+		}
+	}
+
+	sort.Slice(opened, func(i, j int) bool {
+		return opened[i].GetCreatedAt().Before(opened[j].GetCreatedAt().Time)
+	})
+	return opened, s.save()
+}
+
+// save writes the baseline to disk; the caller must hold the lock
+func (s *WatchStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(s.lastSeen, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode watched repos: %w", err)
+	}
+	if err := cache.WriteFile(s.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to save watched repos: %w", err)
+	}
+	return nil
+}
+
+// getWatchFilePath returns the path the repo watch baseline is saved to
+func getWatchFilePath() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/.prcompass_watch.json", homeDir)
+}
+
+// validateWatchRepos checks that watched repos are "owner/name"
+func validateWatchRepos(repos []string) error {
+	for _, repo := range repos {
+		owner, name, ok := strings.Cut(repo, "/")
+		if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+			return fmt.Errorf("watch_repos: %q is not in owner/name form", repo)
+		}
+	}
+	return nil
+}
+
+// watchCheckCmd lists the open PRs of the watched repos, independent of tabs
+func (m *MultiTabModel) watchCheckCmd() tea.Cmd {
+	if len(m.WatchRepos) == 0 {
+		return nil
+	}
+
+	cfg := &config.Config{Mode: "repos", Repos: m.WatchRepos, IncludeDrafts: true, MaxPRs: watchedPRLimit}
+	token := m.TabManager.Token
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.TabManager.Ctx, 30*time.Second)
+		defer cancel()
+
+		prs, err := github.FetchPRsFromConfig(ctx, cfg, token)
+		return watchResultMsg{prs: prs, err: err}
+	}
+}
+
+// watchTickCmd schedules the next watch check on the global refresh interval,
+// slowed down like tab refreshes in public and night mode
+func (m *MultiTabModel) watchTickCmd() tea.Cmd {
+	interval := m.TabManager.GlobalRefreshInterval
+	if interval == 0 {
+		interval = 5
+	}
+	if m.readOnly() && interval < publicModeRefreshMinutes {
+		interval = publicModeRefreshMinutes
+	}
+	delay := m.WorkHours.refreshDelay(time.Now(), time.Duration(interval)*time.Minute)
+	return tea.Tick(delay, func(time.Time) tea.Msg { return watchTickMsg{} })
+}
+
+// handleWatchResult turns newly opened PRs into alerts and a desktop
+// notification, then schedules the next check
+func (m *MultiTabModel) handleWatchResult(msg watchResultMsg) (tea.Model, tea.Cmd) {
+	next := m.watchTickCmd()
+	if msg.err != nil {
+		m.watchErr = msg.err
+		return m, next
+	}
+	m.watchErr = nil
+
+	opened, err := m.Watches.Observe(m.WatchRepos, msg.prs)
+	if err != nil {
+		m.watchErr = err
+	}
+	if len(opened) == 0 {
+		return m, next
+	}
+
+	m.watchAlerts = append(m.watchAlerts, opened...)
+	return m, tea.Batch(next, notifyCmd(watchNotification(opened)), m.watchAlertCmd(opened))
+}
+
+// watchNotification summarizes newly opened PRs for a desktop notification
+func watchNotification(opened []*gh.PullRequest) (string, string) {
+	latest := opened[len(opened)-1]
+	title := fmt.Sprintf("New PR in %s", latest.GetBase().GetRepo().GetFullName())
+	body := fmt.Sprintf("#%d %s (%s)", latest.GetNumber(), latest.GetTitle(), latest.GetUser().GetLogin())
+	if len(opened) > 1 {
+		title = fmt.Sprintf("%d new PRs in watched repos", len(opened))
+		body = "Latest: " + services.PRKey(latest) + " " + latest.GetTitle()
+	}
+	return title, body
+}
+
+// watchBanner announces the latest alert and how many more are waiting
+func (m *MultiTabModel) watchBanner() string {
+	if len(m.watchAlerts) == 0 {
+		if m.watchErr != nil {
+			return fmt.Sprintf("👁 Repo watch failed: %v", m.watchErr)
+		}
+		return ""
+	}
+
+	latest := m.watchAlerts[len(m.watchAlerts)-1]
+	banner := fmt.Sprintf("👁 New PR %s: %s (%s)", services.PRKey(latest), latest.GetTitle(), latest.GetUser().GetLogin())
+	if more := len(m.watchAlerts) - 1; more > 0 {
+		banner += fmt.Sprintf(" • +%d more", more)
+	}
+	return banner + " • W to open"
+}
+
+// openWatchAlerts opens every alerted PR in the browser and dismisses them
+func (m *MultiTabModel) openWatchAlerts(tab *TabState) tea.Cmd {
+	if len(m.watchAlerts) == 0 {
+		tab.StatusMsg = "No new PRs in watched repos"
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	for _, pr := range m.watchAlerts {
+		cmds = append(cmds, openURLCmd(pr.GetHTMLURL()))
+	}
+	tab.StatusMsg = fmt.Sprintf("Opened %d new PRs from watched repos", len(m.watchAlerts))
+	m.watchAlerts = nil
+	return tea.Batch(cmds...)
+}
+
+// notifyCmd shows a desktop notification, ignoring platforms without one
+func notifyCmd(title, body string) tea.Cmd {
+	return func() tea.Msg {
+		_ = notify(title, body) // Best effort; the banner still shows the alert
+		return nil
+	}
+}
+
+// notify shows a desktop notification
+func notify(title, body string) error {
+	var cmd *exec.Cmd
+
+	if IsWSL() {
+		return fmt.Errorf("desktop notifications are unsupported in WSL")
+	}
+	switch runtime.GOOS {
+	case "linux":
+		cmd = exec.Command("notify-send", "--app-name=PR Compass", title, body)
+	case "darwin":
+		script := fmt.Sprintf("display notification %q with title %q", body, title)
+		cmd = exec.Command("osascript", "-e", script)
+	default:
+		return fmt.Errorf("unsupported platform")
+	}
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to show notification: %w", err)
+	}
+	return nil
+}
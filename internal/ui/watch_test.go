@@ -0,0 +1,96 @@
+package ui
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	gh "github.com/google/go-github/v55/github"
+)
+
+func watchTestPR(repo string, number int) *gh.PullRequest {
+	return &gh.PullRequest{
+		Number:    gh.Int(number),
+		Title:     gh.String("Change"),
+		HTMLURL:   gh.String("https://github.com/" + repo + "/pull/1"),
+		User:      &gh.User{Login: gh.String("alice")},
+		CreatedAt: &gh.Timestamp{Time: time.Now().Add(time.Duration(number) * time.Second)},
+		Base:      &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String(repo)}},
+	}
+}
+
+// TestWatchStoreObserve tests the baseline, new PR detection and persistence
+func TestWatchStoreObserve(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "watch.json")
+	repos := []string{"org/api", "org/empty"}
+	store := NewWatchStore(path)
+
+	opened, err := store.Observe(repos, []*gh.PullRequest{watchTestPR("org/api", 10), watchTestPR("org/api", 7)})
+	if err != nil || len(opened) != 0 {
+		t.Fatalf("Expected the first check to only set a baseline, got %d PRs (err %v)", len(opened), err)
+	}
+
+	// Reloading keeps the baseline, so PRs opened meanwhile are reported
+	store = NewWatchStore(path)
+	opened, _ = store.Observe(repos, []*gh.PullRequest{
+		watchTestPR("org/api", 12), watchTestPR("org/api", 11), watchTestPR("org/api", 7),
+		watchTestPR("org/empty", 1), watchTestPR("org/other", 99),
+	})
+	var keys []int
+	for _, pr := range opened {
+		keys = append(keys, pr.GetNumber())
+	}
+	if len(keys) != 3 || keys[0] != 1 || keys[1] != 11 || keys[2] != 12 {
+		t.Errorf("Expected #1, #11 and #12 oldest first, got %v", keys)
+	}
+
+	if opened, _ = store.Observe(repos, []*gh.PullRequest{watchTestPR("org/api", 12)}); len(opened) != 0 {
+		t.Errorf("Expected no repeat alerts, got %d", len(opened))
+	}
+}
+
+// TestWatchAlerts tests the banner and opening alerted PRs
+func TestWatchAlerts(t *testing.T) {
+	model, tab := approveTestModel("test-token")
+	model.WatchRepos = []string{"org/web"}
+
+	model.Update(watchResultMsg{prs: []*gh.PullRequest{watchTestPR("org/web", 3)}})
+	if banner := model.watchBanner(); banner != "" {
+		t.Errorf("Expected no banner after the baseline check, got %q", banner)
+	}
+
+	_, cmd := model.Update(watchResultMsg{prs: []*gh.PullRequest{watchTestPR("org/web", 4), watchTestPR("org/web", 5)}})
+	if cmd == nil {
+		t.Error("Expected a notification and the next check to be scheduled")
+	}
+	if banner := model.watchBanner(); !strings.Contains(banner, "org/web#5") || !strings.Contains(banner, "+1 more") {
+		t.Errorf("Expected the latest new PR in the banner, got %q", banner)
+	}
+
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("W")}); cmd == nil {
+		t.Error("Expected W to open the new PRs")
+	}
+	if model.watchBanner() != "" || !strings.Contains(tab.StatusMsg, "Opened 2") {
+		t.Errorf("Expected alerts to be dismissed once opened, got %q", tab.StatusMsg)
+	}
+
+	model.Update(watchResultMsg{err: errors.New("rate limited")})
+	if banner := model.watchBanner(); !strings.Contains(banner, "rate limited") {
+		t.Errorf("Expected a failed check to be reported, got %q", banner)
+	}
+}
+
+// TestValidateWatchRepos tests the owner/name check
+func TestValidateWatchRepos(t *testing.T) {
+	if err := validateWatchRepos([]string{"org/api"}); err != nil {
+		t.Errorf("Expected owner/name to be valid, got %v", err)
+	}
+	for _, repo := range []string{"api", "org/", "org/api/extra"} {
+		if err := validateWatchRepos([]string{repo}); err == nil {
+			t.Errorf("Expected %q to be rejected", repo)
+		}
+	}
+}
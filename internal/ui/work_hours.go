@@ -0,0 +1,178 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// defaultNightRefreshMinutes is the auto-refresh interval outside work hours
+// when the config doesn't set one
+const defaultNightRefreshMinutes = 60
+
+// weekdayNames maps the day names accepted in work_hours.days
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// WorkHours configures when PR Compass refreshes at full speed. Outside these
+// hours it runs in night mode: longer refresh intervals and no background
+// enhancement, saving API budget and battery.
+type WorkHours struct {
+	Start               string   `mapstructure:"start" yaml:"start"`                                           // "09:00"
+	End                 string   `mapstructure:"end" yaml:"end"`                                               // "18:00", after Start
+	Days                []string `mapstructure:"days" yaml:"days,omitempty"`                                   // mon..sun; default mon-fri
+	Timezone            string   `mapstructure:"timezone" yaml:"timezone,omitempty"`                           // IANA zone; default local time
+	NightRefreshMinutes int      `mapstructure:"night_refresh_minutes" yaml:"night_refresh_minutes,omitempty"` // Default 60
+}
+
+// Validate checks the times, days and time zone. A nil WorkHours is valid and
+// means every hour is a work hour.
+func (w *WorkHours) Validate() error {
+	if w == nil {
+		return nil
+	}
+	start, err := parseClock(w.Start)
+	if err != nil {
+		return fmt.Errorf("work_hours.start: %w", err)
+	}
+	end, err := parseClock(w.End)
+	if err != nil {
+		return fmt.Errorf("work_hours.end: %w", err)
+	}
+	if end <= start {
+		return fmt.Errorf("work_hours.end (%s) must be after work_hours.start (%s)", w.End, w.Start)
+	}
+	for _, day := range w.Days {
+		if _, ok := weekdayNames[dayKey(day)]; !ok {
+			return fmt.Errorf("work_hours.days: unknown day %q (use mon, tue, ... sun)", day)
+		}
+	}
+	if w.Timezone != "" {
+		if _, err := time.LoadLocation(w.Timezone); err != nil {
+			return fmt.Errorf("work_hours.timezone: %w", err)
+		}
+	}
+	if w.NightRefreshMinutes < 0 {
+		return fmt.Errorf("work_hours.night_refresh_minutes must not be negative")
+	}
+	return nil
+}
+
+// Active reports whether now falls within work hours. Unparseable settings
+// count as work hours, since Validate rejects them at load time.
+func (w *WorkHours) Active(now time.Time) bool {
+	if w == nil {
+		return true
+	}
+	start, errStart := parseClock(w.Start)
+	end, errEnd := parseClock(w.End)
+	if errStart != nil || errEnd != nil {
+		return true
+	}
+	local := now.In(w.location())
+	if !w.workday(local.Weekday()) {
+		return false
+	}
+	minute := local.Hour()*60 + local.Minute()
+	return minute >= start && minute < end
+}
+
+// NextStart returns when the next work period begins after now
+func (w *WorkHours) NextStart(now time.Time) time.Time {
+	start, err := parseClock(w.Start)
+	if err != nil {
+		return now
+	}
+	local := now.In(w.location())
+	for offset := 0; offset <= 7; offset++ {
+		day := local.AddDate(0, 0, offset)
+		candidate := time.Date(day.Year(), day.Month(), day.Day(), start/60, start%60, 0, 0, local.Location())
+		if candidate.After(now) && w.workday(candidate.Weekday()) {
+			return candidate
+		}
+	}
+	return now
+}
+
+// refreshDelay returns how long to wait before the next auto-refresh. At night
+// the interval stretches, but never past the start of the next work period.
+func (w *WorkHours) refreshDelay(now time.Time, regular time.Duration) time.Duration {
+	if w.Active(now) {
+		return regular
+	}
+	night := w.nightInterval()
+	if night < regular {
+		night = regular
+	}
+	if untilStart := w.NextStart(now).Sub(now); untilStart < night {
+		night = untilStart
+	}
+	if night < time.Minute {
+		night = time.Minute
+	}
+	return night
+}
+
+// nightInterval is the auto-refresh interval outside work hours
+func (w *WorkHours) nightInterval() time.Duration {
+	if w.NightRefreshMinutes > 0 {
+		return time.Duration(w.NightRefreshMinutes) * time.Minute
+	}
+	return defaultNightRefreshMinutes * time.Minute
+}
+
+// workday reports whether work hours apply on the given weekday
+func (w *WorkHours) workday(day time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return day != time.Saturday && day != time.Sunday
+	}
+	for _, name := range w.Days {
+		if weekday, ok := weekdayNames[dayKey(name)]; ok && weekday == day {
+			return true
+		}
+	}
+	return false
+}
+
+// location returns the configured time zone, falling back to local time
+func (w *WorkHours) location() *time.Location {
+	if w.Timezone == "" {
+		return time.Local
+	}
+	if loc, err := time.LoadLocation(w.Timezone); err == nil {
+		return loc
+	}
+	return time.Local
+}
+
+// nightMode reports whether the model currently runs in night mode
+func (m *MultiTabModel) nightMode() bool {
+	return !m.WorkHours.Active(time.Now())
+}
+
+// nightModeBanner explains what night mode changes and when it ends
+func (m *MultiTabModel) nightModeBanner(now time.Time) string {
+	next := m.WorkHours.NextStart(now).In(m.WorkHours.location())
+	return fmt.Sprintf("🌙 Night mode until %s: refreshing every %dm, background enhancement paused • r refreshes now",
+		next.Format("Mon 15:04"), int(m.WorkHours.nightInterval().Minutes()))
+}
+
+// parseClock parses "HH:MM" into minutes after midnight
+func parseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("expected a time like 09:00, got %q", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// dayKey normalizes a day name such as "Monday" or "MON" to "mon"
+func dayKey(day string) string {
+	day = strings.ToLower(strings.TrimSpace(day))
+	if len(day) > 3 {
+		day = day[:3]
+	}
+	return day
+}
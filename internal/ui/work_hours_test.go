@@ -0,0 +1,115 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	gh "github.com/google/go-github/v55/github"
+)
+
+func TestWorkHoursActive(t *testing.T) {
+	hours := &WorkHours{Start: "09:00", End: "18:00", Timezone: "UTC"}
+	tests := []struct {
+		name string
+		now  time.Time
+		want bool
+	}{
+		{"weekday morning", time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC), true},
+		{"weekday evening", time.Date(2024, 3, 4, 18, 0, 0, 0, time.UTC), false},
+		{"before start", time.Date(2024, 3, 4, 8, 59, 0, 0, time.UTC), false},
+		{"saturday", time.Date(2024, 3, 9, 12, 0, 0, 0, time.UTC), false},
+		{"other zone", time.Date(2024, 3, 4, 14, 0, 0, 0, time.FixedZone("UTC+5", 5*3600)), true},
+	}
+	for _, tt := range tests {
+		if got := hours.Active(tt.now); got != tt.want {
+			t.Errorf("%s: Active() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	var unset *WorkHours
+	if !unset.Active(time.Now()) {
+		t.Error("Expected no work hours to mean always active")
+	}
+}
+
+func TestWorkHoursRefreshDelay(t *testing.T) {
+	hours := &WorkHours{Start: "09:00", End: "18:00", Days: []string{"Monday", "tue"}, Timezone: "UTC", NightRefreshMinutes: 90}
+	regular := 5 * time.Minute
+
+	monday := time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC)
+	if got := hours.refreshDelay(monday, regular); got != regular {
+		t.Errorf("Expected regular interval during work hours, got %v", got)
+	}
+	if got := hours.refreshDelay(monday.Add(8*time.Hour), regular); got != 90*time.Minute {
+		t.Errorf("Expected night interval in the evening, got %v", got)
+	}
+	// The night interval stops at the start of the work day
+	if got := hours.refreshDelay(time.Date(2024, 3, 5, 8, 30, 0, 0, time.UTC), regular); got != 30*time.Minute {
+		t.Errorf("Expected refresh at 09:00, got %v", got)
+	}
+	// Tuesday evening's next start is the following Monday
+	if got := hours.NextStart(time.Date(2024, 3, 5, 20, 0, 0, 0, time.UTC)); !got.Equal(time.Date(2024, 3, 11, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected next start Monday 09:00, got %v", got)
+	}
+}
+
+func TestWorkHoursValidate(t *testing.T) {
+	invalid := []*WorkHours{
+		{Start: "9am", End: "18:00"},
+		{Start: "18:00", End: "09:00"},
+		{Start: "09:00", End: "18:00", Days: []string{"someday"}},
+		{Start: "09:00", End: "18:00", Timezone: "Mars/Olympus"},
+	}
+	for _, hours := range invalid {
+		if err := hours.Validate(); err == nil {
+			t.Errorf("Expected %+v to be rejected", hours)
+		}
+	}
+
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	configYAML := `work_hours:
+  start: "08:30"
+  end: "17:00"
+  night_refresh_minutes: 120
+tabs:
+  - name: Product
+    mode: repos
+    repos: [org/app]
+`
+	if err := os.WriteFile(configPath, []byte(configYAML), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	multiConfig, err := LoadMultiTabConfigFromPath(configPath)
+	if err != nil {
+		t.Fatalf("LoadMultiTabConfigFromPath() error = %v", err)
+	}
+	if multiConfig.WorkHours == nil || multiConfig.WorkHours.Start != "08:30" || multiConfig.WorkHours.NightRefreshMinutes != 120 {
+		t.Errorf("Expected work hours to load, got %+v", multiConfig.WorkHours)
+	}
+}
+
+// TestNightModePausesEnhancement tests that night mode skips enhancement and shows a banner
+func TestNightModePausesEnhancement(t *testing.T) {
+	model := NewMultiTabModel("test-token", nil)
+	tab := model.TabManager.AddTab(&TabConfig{Name: "Main", Mode: "repos", Repos: []string{"org/api"}})
+	tab.PRs = []*gh.PullRequest{{Number: gh.Int(1), Title: gh.String("Fix")}}
+
+	// Work hours only on a day that isn't today
+	tomorrow := time.Now().AddDate(0, 0, 1).Weekday().String()
+	model.WorkHours = &WorkHours{Start: "00:00", End: "23:59", Days: []string{tomorrow}}
+
+	if cmd := model.startEnhancementForTab(tab); cmd != nil || len(tab.EnhancementQueue) != 0 {
+		t.Error("Expected no enhancement in night mode")
+	}
+	if bar := model.renderTabBar(); !strings.Contains(bar, "Night mode until") {
+		t.Errorf("Expected night mode banner, got:\n%s", bar)
+	}
+
+	model.WorkHours = nil
+	if cmd := model.startEnhancementForTab(tab); cmd == nil {
+		t.Error("Expected enhancement without work hours")
+	}
+}